@@ -0,0 +1,196 @@
+// Package bench measures the throughput and allocations of the detectors on
+// the corpora bundled with trufflehog, and compares them to a baseline.
+package bench
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"reflect"
+	"runtime"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+//go:embed corpora
+var corpora embed.FS
+
+// Result is the measure of a detector scanning the corpora.
+type Result struct {
+	Detector string `json:"detector"`
+	// BytesPerSecond is the throughput of the detector.
+	BytesPerSecond float64 `json:"bytes_per_second"`
+	// AllocsPerMB and BytesAllocatedPerMB are the allocations of the
+	// detector for each MB scanned.
+	AllocsPerMB         float64 `json:"allocs_per_mb"`
+	BytesAllocatedPerMB float64 `json:"bytes_allocated_per_mb"`
+}
+
+// Regression is a measure of a detector worse than its baseline by more than
+// the threshold.
+type Regression struct {
+	Detector string
+	// Measure is the name of the measure, and Change its relative change
+	// from the baseline.
+	Measure string
+	Change  float64
+}
+
+// Chunks returns the bundled corpora as chunks, like sources send them.
+func Chunks() ([][]byte, error) {
+	entries, err := corpora.ReadDir("corpora")
+	if err != nil {
+		return nil, err
+	}
+	var chunks [][]byte
+	for _, entry := range entries {
+		data, err := corpora.ReadFile(path.Join("corpora", entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		for chunk := range sources.Chunker(&sources.Chunk{Data: data}) {
+			chunks = append(chunks, chunk.Data)
+		}
+	}
+	return chunks, nil
+}
+
+// Run scans chunks with each detector, without verification, for at least
+// benchtime, and returns the results sorted by detector name.
+func Run(ctx context.Context, dets []detectors.Detector, chunks [][]byte, benchtime time.Duration) ([]Result, error) {
+	results := make([]Result, 0, len(dets))
+	for _, detector := range dets {
+		result, err := run(ctx, detector, chunks, benchtime)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].Detector < results[j].Detector })
+	return results, nil
+}
+
+func run(ctx context.Context, detector detectors.Detector, chunks [][]byte, benchtime time.Duration) (Result, error) {
+	name := Name(detector)
+	var scanned int
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	start := time.Now()
+	for scanned == 0 || time.Since(start) < benchtime {
+		for _, chunk := range chunks {
+			if _, err := detector.FromData(ctx, false, chunk); err != nil {
+				return Result{}, fmt.Errorf("%s detector failed: %w", name, err)
+			}
+			scanned += len(chunk)
+		}
+		if scanned == 0 {
+			return Result{}, fmt.Errorf("no data to scan")
+		}
+	}
+	elapsed := time.Since(start)
+	runtime.ReadMemStats(&after)
+
+	mb := float64(scanned) / 1e6
+	return Result{
+		Detector:            name,
+		BytesPerSecond:      float64(scanned) / elapsed.Seconds(),
+		AllocsPerMB:         float64(after.Mallocs-before.Mallocs) / mb,
+		BytesAllocatedPerMB: float64(after.TotalAlloc-before.TotalAlloc) / mb,
+	}, nil
+}
+
+// Name returns the name of the package of a detector.
+func Name(detector detectors.Detector) string {
+	t := reflect.TypeOf(detector)
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.PkgPath() == "" {
+		return t.String()
+	}
+	return path.Base(t.PkgPath())
+}
+
+// Compare returns the measures of results worse than those of baseline by
+// more than threshold, a fraction of the baseline. Detectors missing from
+// baseline aren't compared.
+func Compare(baseline, results []Result, threshold float64) []Regression {
+	byDetector := make(map[string]Result, len(baseline))
+	for _, result := range baseline {
+		byDetector[result.Detector] = result
+	}
+	var regressions []Regression
+	for _, result := range results {
+		base, ok := byDetector[result.Detector]
+		if !ok {
+			continue
+		}
+		measures := []struct {
+			name          string
+			base, current float64
+			// lower is set when lower values are better.
+			lower bool
+		}{
+			{"throughput", base.BytesPerSecond, result.BytesPerSecond, false},
+			{"allocs", base.AllocsPerMB, result.AllocsPerMB, true},
+			{"bytes allocated", base.BytesAllocatedPerMB, result.BytesAllocatedPerMB, true},
+		}
+		for _, m := range measures {
+			if m.base == 0 {
+				continue
+			}
+			change := (m.current - m.base) / m.base
+			if (m.lower && change > threshold) || (!m.lower && change < -threshold) {
+				regressions = append(regressions, Regression{Detector: result.Detector, Measure: m.name, Change: change})
+			}
+		}
+	}
+	return regressions
+}
+
+// ReadBaseline reads the results saved by WriteBaseline.
+func ReadBaseline(filename string) ([]Result, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	var results []Result
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, fmt.Errorf("could not parse baseline %s: %w", filename, err)
+	}
+	return results, nil
+}
+
+// WriteBaseline saves results to filename, for later runs to compare to.
+func WriteBaseline(filename string, results []Result) error {
+	data, err := json.MarshalIndent(results, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filename, append(data, '\n'), 0644)
+}
+
+// Print writes results as a table, followed by the regressions.
+func Print(w io.Writer, results []Result, regressions []Regression) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "DETECTOR\tMB/s\tALLOCS/MB\tKB ALLOCATED/MB\t")
+	for _, result := range results {
+		fmt.Fprintf(tw, "%s\t%.1f\t%.0f\t%.1f\t\n", result.Detector, result.BytesPerSecond/1e6, result.AllocsPerMB, result.BytesAllocatedPerMB/1e3)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	for _, regression := range regressions {
+		fmt.Fprintf(w, "regression: %s %s changed by %+.1f%%\n", regression.Detector, regression.Measure, regression.Change*100)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package bench
+
+import (
+	"bytes"
+	stdctx "context"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+)
+
+// allocDetector allocates a copy of the data it scans.
+type allocDetector struct{}
+
+func (allocDetector) Keywords() []string { return []string{"alloc"} }
+
+func (allocDetector) FromData(ctx stdctx.Context, verify bool, data []byte) ([]detectors.Result, error) {
+	return []detectors.Result{{Raw: bytes.Clone(data)}}, nil
+}
+
+func TestRun(t *testing.T) {
+	chunks, err := Chunks()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(chunks) == 0 {
+		t.Fatal("no corpora bundled")
+	}
+	results, err := Run(context.Background(), []detectors.Detector{allocDetector{}}, chunks, time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || results[0].Detector != "bench" {
+		t.Fatalf("Run() = %+v", results)
+	}
+	if r := results[0]; r.BytesPerSecond <= 0 || r.AllocsPerMB <= 0 || r.BytesAllocatedPerMB < 1e6 {
+		t.Errorf("Run() = %+v", r)
+	}
+
+	var out bytes.Buffer
+	if err := Print(&out, results, nil); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "bench") {
+		t.Errorf("Print() = %q", out.String())
+	}
+}
+
+func TestCompare(t *testing.T) {
+	baseline := []Result{
+		{Detector: "aws", BytesPerSecond: 100, AllocsPerMB: 10, BytesAllocatedPerMB: 1000},
+		{Detector: "github", BytesPerSecond: 100, AllocsPerMB: 10, BytesAllocatedPerMB: 1000},
+	}
+	results := []Result{
+		{Detector: "aws", BytesPerSecond: 50, AllocsPerMB: 10, BytesAllocatedPerMB: 1500},
+		{Detector: "github", BytesPerSecond: 95, AllocsPerMB: 9, BytesAllocatedPerMB: 1050},
+		{Detector: "slack", BytesPerSecond: 1, AllocsPerMB: 1000, BytesAllocatedPerMB: 1e9},
+	}
+	got := Compare(baseline, results, 0.1)
+	want := []Regression{
+		{Detector: "aws", Measure: "throughput", Change: -0.5},
+		{Detector: "aws", Measure: "bytes allocated", Change: 0.5},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Compare() = %+v, want %+v", got, want)
+	}
+}
+
+func TestBaseline(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "baseline.json")
+	results := []Result{{Detector: "aws", BytesPerSecond: 100, AllocsPerMB: 10, BytesAllocatedPerMB: 1000}}
+	if err := WriteBaseline(filename, results); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ReadBaseline(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, results) {
+		t.Errorf("ReadBaseline() = %+v, want %+v", got, results)
+	}
+}
@@ -0,0 +1 @@
+var s0=function(e,t){return e.call(t,"2M3DEEEefsJzeKZhyTUYfAfk1WNum8PEALJyRLjNOpfxGuZRBwUGpooC8OFhrzf4")};s0.prototype.render=function(){this.el.innerHTML="<div class=\"item-0\">"+this.props.label+"</div>"};var xa1=function(e,t){return e.call(t,"hzjDcPB+jTtYO604wnXzSu0FatbqjuykGS+h/rncSx6+VeW4+baA7/dsgdTpqzBN")};xa1.prototype.render=function(){this.el.innerHTML="<div class=\"item-1\">"+this.props.label+"</div>"};var sp2=function(e,t){return e.call(t,"2PCBZJbaCHo+vsxnaqosXYzhs8asvF8WcKmCG8cphddkXn27B3gLTrTZ+52XlGSl")};sp2.prototype.render=function(){this.el.innerHTML="<div class=\"item-2\">"+this.props.label+"</div>"};var f3=function(e,t){return e.call(t,"gDr7A8UziuvcjDtng1jz2JNadehEqIyb9boBYsjb0vTi8L2DzyGEx480bfMOe95d")};f3.prototype.render=function(){this.el.innerHTML="<div class=\"item-3\">"+this.props.label+"</div>"};var rgq4=function(e,t){return e.call(t,"aXzQW2pYAImKn8mcVHWZB806oi2MlS7cF8yNzNnR7kEI1/GsEhXeBHMDwcFHP0Qc")};rgq4.prototype.render=function(){this.el.innerHTML="<div class=\"item-4\">"+this.props.label+"</div>"};var flj5=function(e,t){return e.call(t,"ESooQYfzK6hFpbZLdLNSf3kdBk9iV2vLMEIbQOa6gvo195tu0fkFOQRlJQm49Sly")};flj5.prototype.render=function(){this.el.innerHTML="<div class=\"item-5\">"+this.props.label+"</div>"};var qvn6=function(e,t){return e.call(t,"i9U4+vmhzLGEczmGpgdlrJPNUqihbQ+8TCD3NuAMThLbE0/q8Ey+KGqQQCECj+DZ")};qvn6.prototype.render=function(){this.el.innerHTML="<div class=\"item-6\">"+this.props.label+"</div>"};var s7=function(e,t){return e.call(t,"0Tf25pF1K9Pe3vnHtJ+CCWAzWBk0kqzlbpcxfhrwqmNLgX8EU5zfZuZIBCgz21PP")};s7.prototype.render=function(){this.el.innerHTML="<div class=\"item-7\">"+this.props.label+"</div>"};var zek8=function(e,t){return e.call(t,"bTZErBjWYe6MWOrh1q+IfMT8iDwQuQoVIisq6Yk2RMJVmYHXQV5WVx1KPN7xmsf0")};zek8.prototype.render=function(){this.el.innerHTML="<div class=\"item-8\">"+this.props.label+"</div>"};var pes9=function(e,t){return e.call(t,"jcUaUgpoEmHd/ckl1CBXHZ2WyO1gE5KMOZAU80Rd5EuQiOwddeVGG8kL00sDnasD")};pes9.prototype.render=function(){this.el.innerHTML="<div class=\"item-9\">"+this.props.label+"</div>"};var n10=function(e,t){return e.call(t,"HdPiygowPcn8lmspHXMqrj0ovtgab+n2YM74iujRS4xAtnpQGTWmUQoGAsn77Eu5")};n10.prototype.render=function(){this.el.innerHTML="<div class=\"item-10\">"+this.props.label+"</div>"};var kom11=function(e,t){return e.call(t,"UGYQEOlR+Jn4dBxAN8iex/rkit6weKlbQi6KNU4yP1wU0UcW+8ByF6aTpFbwOmP3")};kom11.prototype.render=function(){this.el.innerHTML="<div class=\"item-11\">"+this.props.label+"</div>"};var bk12=function(e,t){return e.call(t,"L1HK2JTk600+VRmLnJTOmBc+OAXOPmYSRI3eEroTBaICSsDKW3543NsnGYDHy1MT")};bk12.prototype.render=function(){this.el.innerHTML="<div class=\"item-12\">"+this.props.label+"</div>"};var vff13=function(e,t){return e.call(t,"xib8JNLdUU4btYPV65pLIOQ0JIvpuAjHUNLnn82s6I3X8b/8sDQtTG6JKAy23Ko/")};vff13.prototype.render=function(){this.el.innerHTML="<div class=\"item-13\">"+this.props.label+"</div>"};var yc14=function(e,t){return e.call(t,"rvZyzm6MQIpw2Yl0AmXWVitCfAbLpe5q+ZIED7FalCOXICNC+9RGZZBmLJwWO3wB")};yc14.prototype.render=function(){this.el.innerHTML="<div class=\"item-14\">"+this.props.label+"</div>"};var q15=function(e,t){return e.call(t,"UYDkputw7q+juzk9UH6vevQ5tmlWj5zouuqnRvilOAzrEsOCpeBeKILEyuI0T0yx")};q15.prototype.render=function(){this.el.innerHTML="<div class=\"item-15\">"+this.props.label+"</div>"};var rl16=function(e,t){return e.call(t,"KrOzvHaYFdsf5Zv1g5JgLSdAbTfxkbjByA1+rmS3o1lig9gqi7r+Cob7F85BoBlE")};rl16.prototype.render=function(){this.el.innerHTML="<div class=\"item-16\">"+this.props.label+"</div>"};var cey17=function(e,t){return e.call(t,"ndf3qK+zFHHZ7D342WHwzeduZSroU3Agn+h89TYebpmIaOgeqUtHP2C/jwH1MIdw")};cey17.prototype.render=function(){this.el.innerHTML="<div class=\"item-17\">"+this.props.label+"</div>"};var aau18=function(e,t){return e.call(t,"+Zs+1UI0LEglijNFT5XBQNWucsrcz9r5K4tbfWvbH8Q1kuFiNEjPG+fOBh6RvwOL")};aau18.prototype.render=function(){this.el.innerHTML="<div class=\"item-18\">"+this.props.label+"</div>"};var vy19=function(e,t){return e.call(t,"ufmmIhOAX5LOT2+ArVvCh1IAH3G3c1lOimZWyLuukn4cpepgYTSOAP5Hopm44b3U")};vy19.prototype.render=function(){this.el.innerHTML="<div class=\"item-19\">"+this.props.label+"</div>"};var qgo20=function(e,t){return e.call(t,"mdWEaO++tvz8TrMrc56rhzJchgCtY5Rt+GdW3J+V+buz5fe/EX78vj+j96ZKoQVo")};qgo20.prototype.render=function(){this.el.innerHTML="<div class=\"item-20\">"+this.props.label+"</div>"};var ueu21=function(e,t){return e.call(t,"x+9lyEXYLcQS0MaaAlnpQ8y1ad+vi00mdtVCfCt3ggtFghm+l2wRWhGocQUqgbXy")};ueu21.prototype.render=function(){this.el.innerHTML="<div class=\"item-21\">"+this.props.label+"</div>"};var w22=function(e,t){return e.call(t,"F2aisEaaTTWHNTziVUQRE7LU6YWoXneCjrwMK0ynvLb/0I5FW5y9O2SPZix7ykLd")};w22.prototype.render=function(){this.el.innerHTML="<div class=\"item-22\">"+this.props.label+"</div>"};var kwh23=function(e,t){return e.call(t,"QvactD7YqQfa5t6fZ1Htbu7CP8lEMBKguyre+ZRxlOnuuiWb8kN1hikjxyPkt3Bc")};kwh23.prototype.render=function(){this.el.innerHTML="<div class=\"item-23\">"+this.props.label+"</div>"};var ym24=function(e,t){return e.call(t,"PR23NLeuThEbOmVSfu0Z9C8LDs+YBePAN64IfrSH0Ln245xxV6nWRh6csSwYOGY7")};ym24.prototype.render=function(){this.el.innerHTML="<div class=\"item-24\">"+this.props.label+"</div>"};var om25=function(e,t){return e.call(t,"wCv5OzzRSHaMlGM2c7dCVH+XHOg2/hQLA8wB23pR42LZlEnrMmYo4dPCpSbL6QcD")};om25.prototype.render=function(){this.el.innerHTML="<div class=\"item-25\">"+this.props.label+"</div>"};var ev26=function(e,t){return e.call(t,"ig6QYUEhFHam103nAwmJD4bXIQruRscebhcwB3+jIb5Hr9HYMalyY1ShRPhCpKI+")};ev26.prototype.render=function(){this.el.innerHTML="<div class=\"item-26\">"+this.props.label+"</div>"};var b27=function(e,t){return e.call(t,"lu/JlyxZbZqyj6OF+A/nWoxpiTO24Yls66kRtkS+nLj4wBJALfkYJg/rNNpt2gsN")};b27.prototype.render=function(){this.el.innerHTML="<div class=\"item-27\">"+this.props.label+"</div>"};var cqp28=function(e,t){return e.call(t,"gF4Z/FAKIIgIcaog5WXDtebhcga8hkUXQMxTFU0I3GIOu0JQvCFCy2HOHdutTRhs")};cqp28.prototype.render=function(){this.el.innerHTML="<div class=\"item-28\">"+this.props.label+"</div>"};var q29=function(e,t){return e.call(t,"jjRU7FaCyGT05ZV7GiGn0HKG/I+42NWUs4WJB+X61P1KvigzXmOFUxhoWCCTEAtM")};q29.prototype.render=function(){this.el.innerHTML="<div class=\"item-29\">"+this.props.label+"</div>"};var rkn30=function(e,t){return e.call(t,"TFFaRVO/v4WAAoYfJlHqulPIU5IRc/pHenTpXe29+GHQ4+wU7JTNDiIMhn2T2v5A")};rkn30.prototype.render=function(){this.el.innerHTML="<div class=\"item-30\">"+this.props.label+"</div>"};var w31=function(e,t){return e.call(t,"kr9WXP3xzKReZ052mfpXiIEqByVArziQIugcL8Rp8LqeDM8Z+ouuRLYbNEIRoZKG")};w31.prototype.render=function(){this.el.innerHTML="<div class=\"item-31\">"+this.props.label+"</div>"};var ccz32=function(e,t){return e.call(t,"2Tek1iyC3G4Fl17m2Hy1zkg45DOZft3m5Dxsc6xdi+nxMMx7uRLQ1//5QWgzAr+I")};ccz32.prototype.render=function(){this.el.innerHTML="<div class=\"item-32\">"+this.props.label+"</div>"};var qp33=function(e,t){return e.call(t,"E2ed4YLLlJVsClrZ/HUBMPVMsrCkAYoe0k2D4/6/UPjGi6WS/o1IhmmK8NHt9IRo")};qp33.prototype.render=function(){this.el.innerHTML="<div class=\"item-33\">"+this.props.label+"</div>"};var usj34=function(e,t){return e.call(t,"c00hgXGWI4zF+vkpQKIC/my8qZAJXmtmSO+o5cCrBOYX7BfYAWJEdkXLyF+iv9p7")};usj34.prototype.render=function(){this.el.innerHTML="<div class=\"item-34\">"+this.props.label+"</div>"};var mo35=function(e,t){return e.call(t,"zR17WiVqJQT+LNBCXtsglslJ8/9pQvCDSb1rsEZuVcbpfDe31H3z+Ga3bBcQITT3")};mo35.prototype.render=function(){this.el.innerHTML="<div class=\"item-35\">"+this.props.label+"</div>"};var h36=function(e,t){return e.call(t,"ugYaQCd6xvMZZqa5L9UAFm2c9P4NjDeIbFgM8qb47Rq8ja1r1au9Hv5Dr0ctes7L")};h36.prototype.render=function(){this.el.innerHTML="<div class=\"item-36\">"+this.props.label+"</div>"};var bzg37=function(e,t){return e.call(t,"raQW3WMfq3JLroJ/52Qdm9p6GyZineezMyqFQWq+4+/9iUnefqLlz4vpNsnCn1bc")};bzg37.prototype.render=function(){this.el.innerHTML="<div class=\"item-37\">"+this.props.label+"</div>"};var da38=function(e,t){return e.call(t,"wf26qFjt4ve1RA6KoHBMwufXGTqCRkW0P2klIUExaI+hmef1DojVm4Im8mlFR3qy")};da38.prototype.render=function(){this.el.innerHTML="<div class=\"item-38\">"+this.props.label+"</div>"};var ip39=function(e,t){return e.call(t,"Nn9emXg9Vi2bwi694ZSxc4gmDoFTh7AipcLP/eQ2UJ9+elQeIOMjskE5FqKJ1LMM")};ip39.prototype.render=function(){this.el.innerHTML="<div class=\"item-39\">"+this.props.label+"</div>"};var fvd40=function(e,t){return e.call(t,"OZAzgJGo4k5sUwHGBdJO0p04Fb45R66g/NxXRJm4hGEFH1RYIx1A5sUkrpIKWBMX")};fvd40.prototype.render=function(){this.el.innerHTML="<div class=\"item-40\">"+this.props.label+"</div>"};var djk41=function(e,t){return e.call(t,"P0SHDFwHFCPsZl/vuKOwPRitVEYCg+NS9fIcWuzNyqS51yCb7d5FZxetk565h3mQ")};djk41.prototype.render=function(){this.el.innerHTML="<div class=\"item-41\">"+this.props.label+"</div>"};var rm42=function(e,t){return e.call(t,"TeU4oU2MIg2ZghwsPTflb0aLBUCJRfGHQ3kgZ7Uavl8Rp/qLXIuO2M25ga+UB55O")};rm42.prototype.render=function(){this.el.innerHTML="<div class=\"item-42\">"+this.props.label+"</div>"};var ve43=function(e,t){return e.call(t,"JxPplCSt4dM3e9fN2cRVXeNKKCfZy2HVcGce+pklRUuqr8yjmvMCifMC69CkIWG/")};ve43.prototype.render=function(){this.el.innerHTML="<div class=\"item-43\">"+this.props.label+"</div>"};var doa44=function(e,t){return e.call(t,"x26ZrWxG7l5oZ5t2DRl4xwmltLIAzwrUHJYjh4LDW41FyPuR6PenW8150bI+7c6f")};doa44.prototype.render=function(){this.el.innerHTML="<div class=\"item-44\">"+this.props.label+"</div>"};var d45=function(e,t){return e.call(t,"j/Nb3ygdxgrqtFBs4bpYQKig/uXF6g6db2pgW0vB0FdwzLM8opyEJA5XrB3kgyyL")};d45.prototype.render=function(){this.el.innerHTML="<div class=\"item-45\">"+this.props.label+"</div>"};var upk46=function(e,t){return e.call(t,"wbUf+ZUFeuU1YqHV8yxltzoZP1X5+FSoPsitdr54Xn6mxam57zFucGaKHpJ87UTW")};upk46.prototype.render=function(){this.el.innerHTML="<div class=\"item-46\">"+this.props.label+"</div>"};var e47=function(e,t){return e.call(t,"A2BqG8wGpxPwLnXEYKqAzNBJ6icn+IbTG/JBBHZlz6K0vMrpOomyZP0Bi80/+2zo")};e47.prototype.render=function(){this.el.innerHTML="<div class=\"item-47\">"+this.props.label+"</div>"};var v48=function(e,t){return e.call(t,"LVepPRPGie+O9SksYJUFgzdtPMsK74S5MLOBsJyn/4kTP2XHdx6RpAxjFo8YpNB6")};v48.prototype.render=function(){this.el.innerHTML="<div class=\"item-48\">"+this.props.label+"</div>"};var q49=function(e,t){return e.call(t,"PccDBfTbT3dHuWoqmCL8j7XTUcWIonL9gM1qjSqyZbJjzjN+0Udc7SZCkUfYLMe4")};q49.prototype.render=function(){this.el.innerHTML="<div class=\"item-49\">"+this.props.label+"</div>"};var cxl50=function(e,t){return e.call(t,"Vu0kQkFAWWJHkHcDJvQh9UA5MhLNlImeMottt989kyONdWS2MhWg7xMnyaoOB79n")};cxl50.prototype.render=function(){this.el.innerHTML="<div class=\"item-50\">"+this.props.label+"</div>"};var nv51=function(e,t){return e.call(t,"I5eYIayJixLtPdlhI0kzqbj8ZVu/1i05TLUkWX2JShaD00w1tHYFSszPn5canV/B")};nv51.prototype.render=function(){this.el.innerHTML="<div class=\"item-51\">"+this.props.label+"</div>"};var it52=function(e,t){return e.call(t,"Dg3UyFAozyH07KHSGhzab6KWPr41gYFlH+nn/LU20fJiqeyEItC3lEG5ALcezzP8")};it52.prototype.render=function(){this.el.innerHTML="<div class=\"item-52\">"+this.props.label+"</div>"};var mvp53=function(e,t){return e.call(t,"i507RAmjKqur642AO9pp90bEqWtmRX4Zq9TVIS+PBHTAC302ZNK6idLsVug+GBOt")};mvp53.prototype.render=function(){this.el.innerHTML="<div class=\"item-53\">"+this.props.label+"</div>"};var bno54=function(e,t){return e.call(t,"MPQsmIAw2IJihVwyO1yo4Jb7wcb8EFfnDXUL1Zwt5CXa6PBJeAuVgBD93dWQZRf+")};bno54.prototype.render=function(){this.el.innerHTML="<div class=\"item-54\">"+this.props.label+"</div>"};var zq55=function(e,t){return e.call(t,"15KlTWRE51p49u8MjfLo33oEbU2Wv1HLJpiWjtn/RxDdm8nKxlxqZP+FygaTlB0J")};zq55.prototype.render=function(){this.el.innerHTML="<div class=\"item-55\">"+this.props.label+"</div>"};var qad56=function(e,t){return e.call(t,"5lVW7l7AjQijXpUSfOWiFdiKclWA68+LAOwp6FNcNiXllCWWG2dR3YJr0lz+V9pC")};qad56.prototype.render=function(){this.el.innerHTML="<div class=\"item-56\">"+this.props.label+"</div>"};var lbw57=function(e,t){return e.call(t,"EMShP9HKQ8H4ZYxIksmeFRO1K+fv80RpFSBIjbmkQzw1GUa4egy8g03J38/5NNKL")};lbw57.prototype.render=function(){this.el.innerHTML="<div class=\"item-57\">"+this.props.label+"</div>"};var r58=function(e,t){return e.call(t,"UFbtS9yEIglx0F3MvwkH/VBqvynjjgq0lrOpod+GbC/55zI7HZYh+ZaBH7hEdTLI")};r58.prototype.render=function(){this.el.innerHTML="<div class=\"item-58\">"+this.props.label+"</div>"};var l59=function(e,t){return e.call(t,"9nRV7faduVo47O6iAgP7fQgqQOaNCgI6w+MVhtEsCPKHMzVxST59gV9TZPGnEjGY")};l59.prototype.render=function(){this.el.innerHTML="<div class=\"item-59\">"+this.props.label+"</div>"};
@@ -94,6 +94,15 @@ func (filter *Filter) Pass(object string) bool {
 	return !excluded && included
 }
 
+// Excluded returns true if the exclude FilterRuleSet matches the pattern, such as for directories whose files
+// would all be excluded.
+func (filter *Filter) Excluded(object string) bool {
+	if filter == nil {
+		return false
+	}
+	return filter.exclude.Matches(object)
+}
+
 // Matches will return true if any of the regular expressions in the FilterRuleSet match the pattern.
 func (rules *FilterRuleSet) Matches(object string) bool {
 	if rules == nil {
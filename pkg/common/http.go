@@ -3,6 +3,7 @@ package common
 import (
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
 	"net"
 	"net/http"
 	"strings"
@@ -79,9 +80,11 @@ type CustomTransport struct {
 	T http.RoundTripper
 }
 
+// RoundTrip adds the TruffleHog User-Agent to req and adapts the number of
+// concurrent requests made to its host to the host's rate limits.
 func (t *CustomTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	req.Header.Add("User-Agent", "TruffleHog")
-	return t.T.RoundTrip(req)
+	return defaultHostLimiter.roundTrip(t.T, req)
 }
 
 func NewCustomTransport(T http.RoundTripper) *CustomTransport {
@@ -158,3 +161,29 @@ func SaneHttpClientTimeOut(timeOutSeconds int64) *http.Client {
 	httpClient.Transport = NewCustomTransport(nil)
 	return httpClient
 }
+
+// RetryableHttpClientTLS returns a client like RetryableHttpClientTimeout that
+// also trusts the PEM encoded CA certificates in caPEM, and skips the
+// verification of certificates if insecure is set.
+func RetryableHttpClientTLS(timeOutSeconds int64, caPEM string, insecure bool) (*http.Client, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: insecure}
+	if caPEM != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM([]byte(caPEM)) {
+			return nil, errors.New("could not parse CA certificate")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	httpClient := retryablehttp.NewClient()
+	httpClient.RetryMax = 3
+	httpClient.Logger = nil
+	httpClient.HTTPClient.Timeout = time.Duration(timeOutSeconds) * time.Second
+	httpClient.HTTPClient.Transport = NewCustomTransport(transport)
+	return httpClient.StandardClient(), nil
+}
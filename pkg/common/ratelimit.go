@@ -0,0 +1,261 @@
+package common
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultHostConcurrency is the number of concurrent requests allowed to
+	// each host until the host starts rate limiting them.
+	DefaultHostConcurrency = 32
+	// maxRateLimitWait bounds how long requests to a rate limited host are
+	// held back, so a far away quota reset doesn't stall the scan.
+	maxRateLimitWait = time.Minute
+	// defaultRateLimitWait is used when a host rate limits a request without
+	// saying when to retry.
+	defaultRateLimitWait = time.Second
+)
+
+// hostLimiter adapts the number of concurrent requests made to each host to
+// its rate limits. The limit of a host is halved every time it answers with a
+// 429 and grows back by about one request per round of successful responses,
+// up to the configured maximum. Requests are also held back until the time
+// given by Retry-After, or the quota reset once the host reports it exhausted.
+type hostLimiter struct {
+	mu    sync.Mutex
+	max   int
+	hosts map[string]*hostLimit
+	// requests and rateLimited count the responses, and those saying their
+	// host is rate limiting requests.
+	requests, rateLimited uint64
+}
+
+type hostLimit struct {
+	limit    float64
+	inFlight int
+	// until holds back new requests while the host is rate limited.
+	until time.Time
+	// released is closed when a request finishes, waking up waiters.
+	released chan struct{}
+}
+
+var defaultHostLimiter = newHostLimiter(DefaultHostConcurrency)
+
+// SetHostConcurrency sets the maximum number of concurrent requests made to
+// each host by the clients of this package.
+func SetHostConcurrency(n int) {
+	defaultHostLimiter.setMax(n)
+}
+
+// HTTPRequests returns the number of HTTP requests made by the clients of this
+// package, and how many of them were rate limited.
+func HTTPRequests() (requests, rateLimited uint64) {
+	return atomic.LoadUint64(&defaultHostLimiter.requests), atomic.LoadUint64(&defaultHostLimiter.rateLimited)
+}
+
+func newHostLimiter(max int) *hostLimiter {
+	if max < 1 {
+		max = 1
+	}
+	return &hostLimiter{max: max, hosts: make(map[string]*hostLimit)}
+}
+
+func (l *hostLimiter) setMax(n int) {
+	if n < 1 {
+		n = 1
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.max = n
+	for _, h := range l.hosts {
+		if h.limit > float64(n) {
+			h.limit = float64(n)
+		}
+	}
+}
+
+// limit returns the current concurrency limit of host.
+func (l *hostLimiter) limit(host string) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if h, ok := l.hosts[host]; ok {
+		return int(h.limit)
+	}
+	return l.max
+}
+
+// acquire blocks until a request to host may be made.
+func (l *hostLimiter) acquire(ctx context.Context, host string) error {
+	for {
+		l.mu.Lock()
+		h, ok := l.hosts[host]
+		if !ok {
+			h = &hostLimit{limit: float64(l.max), released: make(chan struct{})}
+			l.hosts[host] = h
+		}
+		if wait := time.Until(h.until); wait > 0 {
+			l.mu.Unlock()
+			timer := time.NewTimer(wait)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return ctx.Err()
+			case <-timer.C:
+			}
+			continue
+		}
+		if h.inFlight < int(h.limit) {
+			h.inFlight++
+			l.mu.Unlock()
+			return nil
+		}
+		released := h.released
+		l.mu.Unlock()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-released:
+		}
+	}
+}
+
+// release records the response to a request made to host and lets the next
+// request go.
+func (l *hostLimiter) release(host string, resp *http.Response) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	h := l.hosts[host]
+	h.inFlight--
+	close(h.released)
+	h.released = make(chan struct{})
+
+	if resp == nil {
+		return
+	}
+	atomic.AddUint64(&l.requests, 1)
+	limited, wait := rateLimited(resp, time.Now())
+	switch {
+	case limited:
+		atomic.AddUint64(&l.rateLimited, 1)
+		if resp.StatusCode == http.StatusTooManyRequests {
+			h.limit /= 2
+			if h.limit < 1 {
+				h.limit = 1
+			}
+			log.Debugf("%s is rate limiting requests, lowering its concurrency to %d", host, int(h.limit))
+		}
+		if until := time.Now().Add(wait); until.After(h.until) {
+			h.until = until
+		}
+	case resp.StatusCode < http.StatusBadRequest:
+		h.limit += 1 / h.limit
+		if h.limit > float64(l.max) {
+			h.limit = float64(l.max)
+		}
+	}
+}
+
+// roundTrip makes req with t once its host allows it. The request holds its
+// slot until its response body is closed, or until it fails.
+func (l *hostLimiter) roundTrip(t http.RoundTripper, req *http.Request) (*http.Response, error) {
+	host := req.URL.Host
+	if err := l.acquire(req.Context(), host); err != nil {
+		return nil, err
+	}
+	resp, err := t.RoundTrip(req)
+	if err != nil || resp == nil {
+		l.release(host, resp)
+		return resp, err
+	}
+	body := &releasingBody{ReadCloser: resp.Body}
+	body.release = func() { l.release(host, resp) }
+	resp.Body = body
+	return resp, nil
+}
+
+// releasingBody releases the slot of its request when closed.
+type releasingBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
+
+// rateLimited reports whether resp says its host is rate limiting requests,
+// and how long to wait before sending it more.
+func rateLimited(resp *http.Response, now time.Time) (bool, time.Duration) {
+	wait, hasWait := retryAfter(resp.Header, now)
+	if resp.StatusCode != http.StatusTooManyRequests {
+		remaining := firstHeader(resp.Header, "X-RateLimit-Remaining", "RateLimit-Remaining")
+		if remaining != "0" {
+			return false, 0
+		}
+		if !hasWait {
+			wait, hasWait = rateLimitReset(resp.Header, now)
+		}
+	}
+	if !hasWait {
+		wait = defaultRateLimitWait
+	}
+	if wait < 0 {
+		wait = 0
+	}
+	if wait > maxRateLimitWait {
+		wait = maxRateLimitWait
+	}
+	return true, wait
+}
+
+// retryAfter parses the Retry-After header, given either in seconds or as an
+// HTTP date.
+func retryAfter(header http.Header, now time.Time) (time.Duration, bool) {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return date.Sub(now), true
+	}
+	return 0, false
+}
+
+// rateLimitReset parses the reset time of the rate limit headers. Providers
+// either give the number of seconds left or the unix time of the reset.
+func rateLimitReset(header http.Header, now time.Time) (time.Duration, bool) {
+	value := firstHeader(header, "X-RateLimit-Reset", "RateLimit-Reset")
+	seconds, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	// Anything past a year's worth of seconds is a unix time.
+	if seconds > 365*24*60*60 {
+		return time.Unix(seconds, 0).Sub(now), true
+	}
+	return time.Duration(seconds) * time.Second, true
+}
+
+func firstHeader(header http.Header, keys ...string) string {
+	for _, key := range keys {
+		if value := header.Get(key); value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,144 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRateLimited(t *testing.T) {
+	now := time.Now()
+	tests := map[string]struct {
+		status  int
+		header  map[string]string
+		limited bool
+		wait    time.Duration
+	}{
+		"ok":                 {status: 200, header: map[string]string{"X-RateLimit-Remaining": "10"}},
+		"429":                {status: 429, limited: true, wait: defaultRateLimitWait},
+		"429 retry after":    {status: 429, header: map[string]string{"Retry-After": "5"}, limited: true, wait: 5 * time.Second},
+		"429 long wait":      {status: 429, header: map[string]string{"Retry-After": "3600"}, limited: true, wait: maxRateLimitWait},
+		"exhausted":          {status: 200, header: map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": "7"}, limited: true, wait: 7 * time.Second},
+		"exhausted unix":     {status: 403, header: map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": strconv.FormatInt(now.Add(20*time.Second).Unix(), 10)}, limited: true, wait: 20 * time.Second},
+		"exhausted no reset": {status: 200, header: map[string]string{"RateLimit-Remaining": "0"}, limited: true, wait: defaultRateLimitWait},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := &http.Response{StatusCode: tt.status, Header: http.Header{}}
+			for k, v := range tt.header {
+				resp.Header.Set(k, v)
+			}
+			limited, wait := rateLimited(resp, now)
+			if limited != tt.limited {
+				t.Fatalf("limited = %v, want %v", limited, tt.limited)
+			}
+			// Unix reset times only have a precision of a second.
+			if diff := wait - tt.wait; diff > time.Second || diff < -time.Second {
+				t.Errorf("wait = %s, want %s", wait, tt.wait)
+			}
+		})
+	}
+}
+
+func TestHostLimiter(t *testing.T) {
+	ctx := context.Background()
+	l := newHostLimiter(8)
+	tooMany := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": []string{"0"}}}
+	ok := &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}
+
+	for _, want := range []int{4, 2, 1, 1} {
+		if err := l.acquire(ctx, "example.com"); err != nil {
+			t.Fatal(err)
+		}
+		l.release("example.com", tooMany)
+		if got := l.limit("example.com"); got != want {
+			t.Fatalf("limit = %d, want %d", got, want)
+		}
+	}
+	if l.requests != 4 || l.rateLimited != 4 {
+		t.Errorf("counted %d requests, %d rate limited, want 4 and 4", l.requests, l.rateLimited)
+	}
+	if got := l.limit("other.com"); got != 8 {
+		t.Errorf("limit of another host = %d, want 8", got)
+	}
+
+	for i := 0; i < 100; i++ {
+		if err := l.acquire(ctx, "example.com"); err != nil {
+			t.Fatal(err)
+		}
+		l.release("example.com", ok)
+	}
+	if got := l.limit("example.com"); got != 8 {
+		t.Errorf("limit after successes = %d, want 8", got)
+	}
+
+	// Requests over the limit wait for one to finish.
+	l.setMax(1)
+	if err := l.acquire(ctx, "example.com"); err != nil {
+		t.Fatal(err)
+	}
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if err := l.acquire(timeoutCtx, "example.com"); err == nil {
+		t.Fatal("acquired a request over the limit")
+	}
+	done := make(chan error)
+	go func() { done <- l.acquire(ctx, "example.com") }()
+	l.release("example.com", ok)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestHostLimiter_roundTrip(t *testing.T) {
+	l := newHostLimiter(1)
+	var fail bool
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if fail {
+			return nil, errors.New("connection refused")
+		}
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(strings.NewReader("ok"))}, nil
+	})
+	req, err := http.NewRequest(http.MethodGet, "https://example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The request holds its slot until its body is closed.
+	resp, err := l.roundTrip(transport, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := l.roundTrip(transport, req.WithContext(timeoutCtx)); err == nil {
+		t.Fatal("made a request over the limit before the body was closed")
+	}
+	if err := resp.Body.Close(); err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	if got := l.hosts["example.com"].inFlight; got != 0 {
+		t.Fatalf("%d requests in flight after the body was closed, want 0", got)
+	}
+
+	// Failed requests release their slot right away.
+	fail = true
+	if _, err := l.roundTrip(transport, req); err == nil {
+		t.Fatal("failed request returned no error")
+	}
+	if got := l.hosts["example.com"].inFlight; got != 0 {
+		t.Errorf("%d requests in flight after a failure, want 0", got)
+	}
+}
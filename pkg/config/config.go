@@ -1,17 +1,38 @@
 package config
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
 	"os"
 
+	"sigs.k8s.io/yaml"
+
 	"github.com/trufflesecurity/trufflehog/v3/pkg/custom_detectors"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/custom_detectorspb"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/protoyaml"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
 )
 
 // Config holds user supplied configuration.
 type Config struct {
 	Detectors []detectors.Detector
+	Sources   []Source
+}
+
+// Source is a source declared in the configuration file, to scan several
+// sources in a single run. Its options are those of sources.Config, spelled
+// in camelCase like "orgs" or "includeMembers".
+type Source struct {
+	// Type is the name of the command that scans the source, like github or s3.
+	Type string `json:"type"`
+	// Name identifies the source in logs, and defaults to its type.
+	Name string `json:"name"`
+	// Schedule is when the server command scans the source, as a cron
+	// expression like "0 */6 * * *" or an interval like "@every 6h".
+	Schedule string `json:"schedule"`
+	sources.Config
 }
 
 // Read parses a given filename into a Config.
@@ -25,6 +46,13 @@ func Read(filename string) (*Config, error) {
 
 // NewYAML parses the given YAML data into a Config.
 func NewYAML(input []byte) (*Config, error) {
+	// Sources aren't part of the custom detectors message, so they are parsed
+	// separately from the rest of the YAML.
+	input, configuredSources, err := parseSources(input)
+	if err != nil {
+		return nil, err
+	}
+
 	// Parse the raw YAML into a structure.
 	var messages custom_detectorspb.CustomDetectors
 	if err := protoyaml.UnmarshalStrict(input, &messages); err != nil {
@@ -41,5 +69,61 @@ func NewYAML(input []byte) (*Config, error) {
 	}
 	return &Config{
 		Detectors: detectors,
+		Sources:   configuredSources,
 	}, nil
 }
+
+// parseSources returns the sources declared in the YAML data, and the data
+// without them.
+func parseSources(input []byte) ([]byte, []Source, error) {
+	data, err := yaml.YAMLToJSON(input)
+	if err != nil {
+		return nil, nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil || fields["sources"] == nil {
+		// Data that isn't a map is left to the detectors parsing to report.
+		return input, nil, nil
+	}
+
+	var rawSources []json.RawMessage
+	if err := json.Unmarshal(fields["sources"], &rawSources); err != nil {
+		return nil, nil, fmt.Errorf("invalid sources: %w", err)
+	}
+	var configuredSources []Source
+	for i, raw := range rawSources {
+		source, err := NewSource(raw)
+		if err != nil {
+			return nil, nil, fmt.Errorf("source %d: %w", i+1, err)
+		}
+		configuredSources = append(configuredSources, source)
+	}
+
+	delete(fields, "sources")
+	if input, err = json.Marshal(fields); err != nil {
+		return nil, nil, err
+	}
+	return input, configuredSources, nil
+}
+
+// NewSource parses a source declared in JSON, like those of the sources list
+// of the configuration file.
+func NewSource(data []byte) (Source, error) {
+	var source Source
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&source); err != nil {
+		return Source{}, err
+	}
+	if source.Type == "" {
+		return Source{}, fmt.Errorf("no type")
+	}
+	// Filters are built from the files of the include and exclude flags.
+	if source.Filter != nil {
+		return Source{}, fmt.Errorf(`unknown field "filter"`)
+	}
+	if source.Name == "" {
+		source.Name = source.Type
+	}
+	return source, nil
+}
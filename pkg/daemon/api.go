@@ -0,0 +1,324 @@
+package daemon
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/config"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/engine"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/output"
+)
+
+const (
+	// maxFinishedJobs is how many finished scans are kept, with their results,
+	// to be queried through the API.
+	maxFinishedJobs = 100
+	// maxRequestSize is the size of the largest scan request that is accepted.
+	maxRequestSize = 1 << 20
+)
+
+// Scan statuses reported by the API.
+const (
+	jobRunning   = "running"
+	jobFinished  = "finished"
+	jobFailed    = "failed"
+	jobCancelled = "cancelled"
+)
+
+// jobs are the scans submitted through the API.
+type jobs struct {
+	mu     sync.Mutex
+	nextID int
+	byID   map[string]*job
+}
+
+// job is a scan submitted through the API, with its results encoded in the
+// JSON format of --json.
+type job struct {
+	id     string
+	source config.Source
+	cancel func()
+
+	mu       sync.Mutex
+	status   string
+	started  time.Time
+	finished time.Time
+	err      string
+	chunks   uint64
+	bytes    uint64
+	results  [][]byte
+	// updated is closed and replaced when results are found or the scan ends.
+	updated chan struct{}
+}
+
+// jobStatus is the status of a scan reported by the API.
+type jobStatus struct {
+	ID       string     `json:"id"`
+	Name     string     `json:"name"`
+	Type     string     `json:"type"`
+	Status   string     `json:"status"`
+	Started  time.Time  `json:"started"`
+	Finished *time.Time `json:"finished,omitempty"`
+	Error    string     `json:"error,omitempty"`
+	Chunks   uint64     `json:"chunks_scanned"`
+	Bytes    uint64     `json:"bytes_scanned"`
+	Results  int        `json:"results"`
+}
+
+func (j *job) jobStatus() jobStatus {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	s := jobStatus{
+		ID:      j.id,
+		Name:    j.source.Name,
+		Type:    j.source.Type,
+		Status:  j.status,
+		Started: j.started,
+		Error:   j.err,
+		Chunks:  j.chunks,
+		Bytes:   j.bytes,
+		Results: len(j.results),
+	}
+	if !j.finished.IsZero() {
+		finished := j.finished
+		s.Finished = &finished
+	}
+	return s
+}
+
+// notify wakes up the requests streaming the results of the scan. It must be
+// called with the lock held.
+func (j *job) notify() {
+	close(j.updated)
+	j.updated = make(chan struct{})
+}
+
+// EnableAPI serves the API submitting scans on /scans, to the clients
+// authenticated with the bearer token. The API is only served with a token
+// since scans can read the files and credentials of the server.
+func (d *Daemon) EnableAPI(token string) {
+	d.apiToken = token
+	d.jobs = &jobs{byID: map[string]*job{}}
+}
+
+// apiRoutes adds the routes of the API to the router:
+//
+//	POST   /scans              submits a scan of a source, declared like those of the configuration file
+//	GET    /scans              lists the scans
+//	GET    /scans/{id}         returns the status of a scan
+//	GET    /scans/{id}/results streams the results of a scan as JSON lines, until it ends
+//	DELETE /scans/{id}         cancels a scan
+func (d *Daemon) apiRoutes(ctx context.Context, router *mux.Router) {
+	api := router.PathPrefix("/scans").Subrouter()
+	api.Use(d.authenticate)
+	api.HandleFunc("", func(w http.ResponseWriter, r *http.Request) { d.submitScan(ctx, w, r) }).Methods(http.MethodPost)
+	api.HandleFunc("", d.listScans).Methods(http.MethodGet)
+	api.HandleFunc("/{id}", d.getScan).Methods(http.MethodGet)
+	api.HandleFunc("/{id}/results", d.streamResults).Methods(http.MethodGet)
+	api.HandleFunc("/{id}", d.cancelScan).Methods(http.MethodDelete)
+}
+
+func (d *Daemon) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		want := "Bearer " + d.apiToken
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte(want)) != 1 {
+			writeError(w, http.StatusUnauthorized, "invalid token")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (d *Daemon) submitScan(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestSize))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	source, err := config.NewSource(body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid source: "+err.Error())
+		return
+	}
+	if !engine.IsSourceType(source.Type) {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("unknown source type %q", source.Type))
+		return
+	}
+	if source.Concurrency == 0 {
+		source.Concurrency = d.concurrency
+	}
+
+	// Scans outlive the request submitting them.
+	jobCtx, cancel := context.WithCancel(ctx)
+	d.jobs.mu.Lock()
+	d.jobs.nextID++
+	j := &job{
+		id:      strconv.Itoa(d.jobs.nextID),
+		source:  source,
+		cancel:  cancel,
+		status:  jobRunning,
+		started: time.Now(),
+		updated: make(chan struct{}),
+	}
+	d.jobs.byID[j.id] = j
+	d.jobs.pruneLocked()
+	d.jobs.mu.Unlock()
+
+	go func() {
+		defer common.RecoverWithExit(ctx)
+		defer cancel()
+		d.runJob(jobCtx, j)
+	}()
+	logrus.WithField("scan", j.id).WithField("source", source.Name).Info("scan submitted")
+	writeJSON(w, http.StatusCreated, j.jobStatus())
+}
+
+func (d *Daemon) runJob(ctx context.Context, j *job) {
+	chunks, bytes, err := scanSource(ctx, d.engineOptions, j.source.Type, j.source.Config, func(r *detectors.ResultWithMetadata) {
+		encoded, err := output.MarshalResult(r)
+		if err != nil {
+			logrus.WithError(err).Error("could not marshal result")
+			return
+		}
+		j.mu.Lock()
+		j.results = append(j.results, encoded)
+		j.notify()
+		j.mu.Unlock()
+	})
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.finished = time.Now()
+	j.chunks, j.bytes = chunks, bytes
+	switch {
+	case j.status == jobCancelled:
+	case err != nil:
+		j.status = jobFailed
+		j.err = err.Error()
+	default:
+		j.status = jobFinished
+	}
+	j.notify()
+}
+
+// pruneLocked forgets the oldest finished scans beyond maxFinishedJobs. It must
+// be called with the lock held.
+func (js *jobs) pruneLocked() {
+	var finished []*job
+	for _, j := range js.byID {
+		if j.jobStatus().Status != jobRunning {
+			finished = append(finished, j)
+		}
+	}
+	if len(finished) <= maxFinishedJobs {
+		return
+	}
+	sort.Slice(finished, func(i, k int) bool { return finished[i].started.Before(finished[k].started) })
+	for _, j := range finished[:len(finished)-maxFinishedJobs] {
+		delete(js.byID, j.id)
+	}
+}
+
+func (d *Daemon) job(w http.ResponseWriter, r *http.Request) *job {
+	d.jobs.mu.Lock()
+	defer d.jobs.mu.Unlock()
+	j, ok := d.jobs.byID[mux.Vars(r)["id"]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "scan not found")
+		return nil
+	}
+	return j
+}
+
+func (d *Daemon) listScans(w http.ResponseWriter, _ *http.Request) {
+	d.jobs.mu.Lock()
+	statuses := []jobStatus{}
+	for _, j := range d.jobs.byID {
+		statuses = append(statuses, j.jobStatus())
+	}
+	d.jobs.mu.Unlock()
+	sort.Slice(statuses, func(i, k int) bool { return statuses[i].Started.Before(statuses[k].Started) })
+	writeJSON(w, http.StatusOK, statuses)
+}
+
+func (d *Daemon) getScan(w http.ResponseWriter, r *http.Request) {
+	if j := d.job(w, r); j != nil {
+		writeJSON(w, http.StatusOK, j.jobStatus())
+	}
+}
+
+func (d *Daemon) cancelScan(w http.ResponseWriter, r *http.Request) {
+	j := d.job(w, r)
+	if j == nil {
+		return
+	}
+	j.mu.Lock()
+	if j.status == jobRunning {
+		j.status = jobCancelled
+	}
+	j.mu.Unlock()
+	j.cancel()
+	writeJSON(w, http.StatusOK, j.jobStatus())
+}
+
+// streamResults writes the results found so far, then the ones found until
+// the scan ends, one JSON document per line.
+func (d *Daemon) streamResults(w http.ResponseWriter, r *http.Request) {
+	j := d.job(w, r)
+	if j == nil {
+		return
+	}
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	flusher, _ := w.(http.Flusher)
+	sent := 0
+	for {
+		j.mu.Lock()
+		results := j.results[sent:]
+		done := !j.finished.IsZero()
+		updated := j.updated
+		j.mu.Unlock()
+
+		for _, result := range results {
+			if _, err := fmt.Fprintf(w, "%s\n", result); err != nil {
+				return
+			}
+		}
+		sent += len(results)
+		if flusher != nil {
+			flusher.Flush()
+		}
+		if done {
+			return
+		}
+		select {
+		case <-updated:
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		logrus.WithError(err).Debug("could not write response")
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"error": message})
+}
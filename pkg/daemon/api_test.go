@@ -0,0 +1,49 @@
+package daemon
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+func TestDaemon_API(t *testing.T) {
+	d, err := New(nil, "", 1, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.EnableAPI("secret")
+	handler := d.Handler(context.Background())
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		token      string
+		wantStatus int
+	}{
+		{name: "no token", method: http.MethodGet, path: "/scans", wantStatus: http.StatusUnauthorized},
+		{name: "wrong token", method: http.MethodGet, path: "/scans", token: "nope", wantStatus: http.StatusUnauthorized},
+		{name: "list", method: http.MethodGet, path: "/scans", token: "secret", wantStatus: http.StatusOK},
+		{name: "unknown scan", method: http.MethodGet, path: "/scans/42", token: "secret", wantStatus: http.StatusNotFound},
+		{name: "unknown type", method: http.MethodPost, path: "/scans", body: `{"type": "nope"}`, token: "secret", wantStatus: http.StatusBadRequest},
+		{name: "unknown field", method: http.MethodPost, path: "/scans", body: `{"type": "s3", "nope": 1}`, token: "secret", wantStatus: http.StatusBadRequest},
+		{name: "health", method: http.MethodGet, path: "/healthz", wantStatus: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			if rec.Code != tt.wantStatus {
+				t.Errorf("%s %s = %d, want %d: %s", tt.method, tt.path, rec.Code, tt.wantStatus, rec.Body)
+			}
+		})
+	}
+}
@@ -0,0 +1,342 @@
+package daemon
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/config"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/engine"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/schedule"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/git"
+)
+
+// Daemon scans the sources declared in the configuration file on their
+// schedules, each with its own engine, and serves the status of the scans on
+// health and metrics endpoints.
+type Daemon struct {
+	sources       []*scheduledSource
+	state         *state
+	engineOptions []engine.EngineOption
+	// concurrency is the concurrency of the sources that don't set theirs.
+	concurrency int
+	// handleResult is called with the results of every scheduled scan, one
+	// at a time.
+	handleResult func(*detectors.ResultWithMetadata)
+	resultsMu    sync.Mutex
+
+	apiToken string
+	jobs     *jobs
+
+	webhooks     *WebhookConfig
+	webhookScans chan struct{}
+}
+
+// scheduledSource is a configured source and the status of its scans.
+type scheduledSource struct {
+	config.Source
+	schedule schedule.Schedule
+
+	mu           sync.Mutex
+	running      bool
+	nextScan     time.Time
+	lastScan     time.Time
+	lastDuration time.Duration
+	lastError    string
+	successes    uint64
+	failures     uint64
+	verified     uint64
+	unverified   uint64
+	chunks       uint64
+	bytes        uint64
+}
+
+// New returns a Daemon scanning the configured sources, whose state is
+// persisted at statePath when it is set.
+func New(configured []config.Source, statePath string, concurrency int, handleResult func(*detectors.ResultWithMetadata), engineOptions ...engine.EngineOption) (*Daemon, error) {
+	st, err := loadState(statePath)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &Daemon{state: st, engineOptions: engineOptions, concurrency: concurrency, handleResult: handleResult}
+	names := map[string]bool{}
+	for _, src := range configured {
+		if src.Schedule == "" {
+			return nil, fmt.Errorf("source %q has no schedule", src.Name)
+		}
+		sched, err := schedule.Parse(src.Schedule)
+		if err != nil {
+			return nil, fmt.Errorf("source %q: %w", src.Name, err)
+		}
+		// The state of sources is kept by their name.
+		if names[src.Name] {
+			return nil, fmt.Errorf("several sources are named %q", src.Name)
+		}
+		names[src.Name] = true
+		if src.Concurrency == 0 {
+			src.Concurrency = concurrency
+		}
+		d.sources = append(d.sources, &scheduledSource{Source: src, schedule: sched})
+	}
+	return d, nil
+}
+
+// Run scans the sources on their schedules until the context is cancelled,
+// and only returns then, even without sources. Scans of different sources run
+// concurrently, and a scan of a source that takes longer than its schedule
+// delays its next scan.
+func (d *Daemon) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, src := range d.sources {
+		wg.Add(1)
+		go func(src *scheduledSource) {
+			defer common.RecoverWithExit(ctx)
+			defer wg.Done()
+			d.runSchedule(ctx, src)
+		}(src)
+	}
+	wg.Wait()
+	<-ctx.Done()
+}
+
+func (d *Daemon) runSchedule(ctx context.Context, src *scheduledSource) {
+	for {
+		next := src.schedule.Next(time.Now())
+		if next.IsZero() {
+			logrus.WithField("source", src.Name).Error("schedule never matches, the source won't be scanned")
+			return
+		}
+		src.mu.Lock()
+		src.nextScan = next
+		src.mu.Unlock()
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		d.scan(ctx, src)
+	}
+}
+
+// scan scans a source since the start of its last successful scan, unless
+// the source sets the time to scan since.
+func (d *Daemon) scan(ctx context.Context, src *scheduledSource) {
+	logger := logrus.WithField("source", src.Name)
+	started := time.Now()
+	src.mu.Lock()
+	src.running = true
+	src.mu.Unlock()
+
+	c := src.Config
+	if last := d.state.lastSuccess(src.Name); c.Since.IsZero() && !last.IsZero() {
+		c.Since = last
+	}
+	logger.WithField("since", c.Since).Info("scanning source")
+	err := d.runScan(ctx, src, c)
+
+	src.mu.Lock()
+	src.running = false
+	src.lastScan = started
+	src.lastDuration = time.Since(started)
+	src.lastError = ""
+	if err != nil {
+		src.failures++
+		src.lastError = err.Error()
+	} else {
+		src.successes++
+	}
+	src.mu.Unlock()
+
+	if err != nil {
+		logger.WithError(err).Error("failed to scan source")
+		return
+	}
+	logger.WithField("duration", time.Since(started)).Info("scanned source")
+	if err := d.state.scanSucceeded(src.Name, started); err != nil {
+		logger.WithError(err).Error("could not save state")
+	}
+}
+
+func (d *Daemon) runScan(ctx context.Context, src *scheduledSource, c sources.Config) error {
+	chunks, bytes, err := scanSource(ctx, d.engineOptions, src.Type, c, func(r *detectors.ResultWithMetadata) {
+		src.mu.Lock()
+		if r.Verified {
+			src.verified++
+		} else {
+			src.unverified++
+		}
+		src.mu.Unlock()
+
+		d.resultsMu.Lock()
+		d.handleResult(r)
+		d.resultsMu.Unlock()
+	})
+
+	src.mu.Lock()
+	src.chunks += chunks
+	src.bytes += bytes
+	src.mu.Unlock()
+	return err
+}
+
+// scanSource scans a source with a new engine, calling handleResult with each
+// of its results, and returns the number of chunks and bytes scanned.
+func scanSource(ctx context.Context, engineOptions []engine.EngineOption, sourceType string, c sources.Config, handleResult func(*detectors.ResultWithMetadata)) (chunks, bytes uint64, err error) {
+	// Git sources are cloned from their repo, like the URI of the git command.
+	if sourceType == "git" && c.RepoPath == "" {
+		path, isRemote, err := git.PrepareRepoSinceCommit(ctx, c.Repo, c.BaseRef)
+		if err != nil {
+			return 0, 0, err
+		}
+		if isRemote {
+			defer os.RemoveAll(path)
+		}
+		c.RepoPath = path
+	}
+
+	e := engine.Start(ctx, engineOptions...)
+	err = e.ScanSource(ctx, sourceType, c)
+	// The engine is finished even when the source failed to start, which
+	// stops its workers.
+	if waitErr := e.Wait(ctx, handleResult); err == nil {
+		err = waitErr
+	}
+	return e.ChunksScanned(), e.BytesScanned(), err
+}
+
+// Handler serves the status of the sources on /healthz, as JSON, and their
+// metrics on /metrics, in the Prometheus text format. It also serves the API
+// and the webhooks when they are enabled, whose scans run until the context is
+// cancelled.
+func (d *Daemon) Handler(ctx context.Context) http.Handler {
+	router := mux.NewRouter()
+	router.HandleFunc("/healthz", d.serveHealth)
+	router.HandleFunc("/metrics", d.serveMetrics)
+	if d.jobs != nil {
+		d.apiRoutes(ctx, router)
+	}
+	if d.webhooks != nil {
+		d.webhookRoutes(ctx, router)
+	}
+	return router
+}
+
+// sourceStatus is the status of a source reported by /healthz.
+type sourceStatus struct {
+	Name      string     `json:"name"`
+	Type      string     `json:"type"`
+	Schedule  string     `json:"schedule"`
+	Running   bool       `json:"running"`
+	LastScan  *time.Time `json:"last_scan,omitempty"`
+	NextScan  *time.Time `json:"next_scan,omitempty"`
+	LastError string     `json:"last_error,omitempty"`
+}
+
+func (d *Daemon) serveHealth(w http.ResponseWriter, _ *http.Request) {
+	status := struct {
+		Status  string         `json:"status"`
+		Sources []sourceStatus `json:"sources"`
+	}{Status: "ok"}
+	for _, src := range d.sources {
+		src.mu.Lock()
+		s := sourceStatus{
+			Name:      src.Name,
+			Type:      src.Type,
+			Schedule:  src.Schedule,
+			Running:   src.running,
+			LastError: src.lastError,
+		}
+		if !src.lastScan.IsZero() {
+			lastScan := src.lastScan
+			s.LastScan = &lastScan
+		}
+		if !src.nextScan.IsZero() {
+			nextScan := src.nextScan
+			s.NextScan = &nextScan
+		}
+		src.mu.Unlock()
+		status.Sources = append(status.Sources, s)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(status); err != nil {
+		logrus.WithError(err).Debug("could not write health status")
+	}
+}
+
+// labelEscaper escapes the values of labels in the Prometheus text format.
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// metric is a metric of the sources, written in the Prometheus text format.
+type metric struct {
+	name, help, kind string
+	samples          []string
+}
+
+func (m *metric) add(value interface{}, labels ...string) {
+	var pairs []string
+	for i := 0; i+1 < len(labels); i += 2 {
+		pairs = append(pairs, fmt.Sprintf(`%s="%s"`, labels[i], labelEscaper.Replace(labels[i+1])))
+	}
+	m.samples = append(m.samples, fmt.Sprintf("%s{%s} %v", m.name, strings.Join(pairs, ","), value))
+}
+
+func (d *Daemon) serveMetrics(w http.ResponseWriter, _ *http.Request) {
+	scans := &metric{name: "trufflehog_scans_total", help: "Scans completed, by status.", kind: "counter"}
+	results := &metric{name: "trufflehog_results_total", help: "Results found.", kind: "counter"}
+	chunks := &metric{name: "trufflehog_chunks_scanned_total", help: "Chunks scanned.", kind: "counter"}
+	bytes := &metric{name: "trufflehog_bytes_scanned_total", help: "Bytes scanned.", kind: "counter"}
+	running := &metric{name: "trufflehog_scan_running", help: "Whether a scan is running.", kind: "gauge"}
+	lastScan := &metric{name: "trufflehog_last_scan_timestamp_seconds", help: "Start time of the last scan.", kind: "gauge"}
+	lastDuration := &metric{name: "trufflehog_last_scan_duration_seconds", help: "Duration of the last scan.", kind: "gauge"}
+	lastFailed := &metric{name: "trufflehog_last_scan_failed", help: "Whether the last scan failed.", kind: "gauge"}
+
+	sorted := append([]*scheduledSource(nil), d.sources...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+	for _, src := range sorted {
+		src.mu.Lock()
+		scans.add(src.successes, "source", src.Name, "status", "success")
+		scans.add(src.failures, "source", src.Name, "status", "failure")
+		results.add(src.verified, "source", src.Name, "verified", "true")
+		results.add(src.unverified, "source", src.Name, "verified", "false")
+		chunks.add(src.chunks, "source", src.Name)
+		bytes.add(src.bytes, "source", src.Name)
+		running.add(boolValue(src.running), "source", src.Name)
+		if !src.lastScan.IsZero() {
+			lastScan.add(src.lastScan.Unix(), "source", src.Name)
+			lastDuration.add(src.lastDuration.Seconds(), "source", src.Name)
+			lastFailed.add(boolValue(src.lastError != ""), "source", src.Name)
+		}
+		src.mu.Unlock()
+	}
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	for _, m := range []*metric{scans, results, chunks, bytes, running, lastScan, lastDuration, lastFailed} {
+		fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", m.name, m.help, m.name, m.kind)
+		for _, sample := range m.samples {
+			fmt.Fprintln(w, sample)
+		}
+	}
+}
+
+func boolValue(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,198 @@
+package daemon
+
+import (
+	stdctx "context"
+	"crypto/subtle"
+	"io"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/engine"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/scannerpb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// grpcSourceName is the source name of the results of data scanned through
+// the gRPC service.
+const grpcSourceName = "trufflehog - grpc"
+
+// GRPCServer returns a server of the Scanner service of scannerpb, which scans
+// with a new engine for each call until the call ends or its deadline is
+// exceeded. Like the API, which must be enabled first, the service
+// authenticates its clients with the bearer token of the API, sent in the
+// authorization metadata of calls.
+func (d *Daemon) GRPCServer() *grpc.Server {
+	s := grpc.NewServer(
+		grpc.UnaryInterceptor(func(ctx stdctx.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			if err := d.authenticateCall(ctx); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}),
+		grpc.StreamInterceptor(func(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			if err := d.authenticateCall(ss.Context()); err != nil {
+				return err
+			}
+			return handler(srv, ss)
+		}),
+	)
+	scannerpb.RegisterScannerServer(s, &scannerServer{d: d})
+	return s
+}
+
+func (d *Daemon) authenticateCall(ctx stdctx.Context) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) != 1 || subtle.ConstantTimeCompare([]byte(values[0]), []byte("Bearer "+d.apiToken)) != 1 {
+		return status.Error(codes.Unauthenticated, "invalid token")
+	}
+	return nil
+}
+
+// scannerServer implements the Scanner service.
+type scannerServer struct {
+	scannerpb.UnimplementedScannerServer
+	d *Daemon
+}
+
+func (s *scannerServer) ScanGit(req *scannerpb.ScanGitRequest, stream scannerpb.Scanner_ScanGitServer) error {
+	if req.GetUri() == "" {
+		return status.Error(codes.InvalidArgument, "uri is required")
+	}
+	c := sources.Config{
+		Repo:        req.GetUri(),
+		HeadRef:     req.GetBranch(),
+		BaseRef:     req.GetSinceCommit(),
+		MaxDepth:    int(req.GetMaxDepth()),
+		Concurrency: s.d.concurrency,
+	}
+	ctx := context.AddLogger(stream.Context())
+	var sendErr error
+	_, _, err := scanSource(ctx, s.d.engineOptions, "git", c, func(r *detectors.ResultWithMetadata) {
+		if sendErr != nil || (req.GetOnlyVerified() && !r.Verified) {
+			return
+		}
+		sendErr = stream.Send(resultPB(r))
+	})
+	if ctx.Err() != nil {
+		return status.FromContextError(ctx.Err()).Err()
+	}
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return sendErr
+}
+
+func (s *scannerServer) ScanBytes(ctx stdctx.Context, req *scannerpb.ScanBytesRequest) (*scannerpb.ScanBytesResponse, error) {
+	resp := &scannerpb.ScanBytesResponse{}
+	err := s.scanChunks(context.AddLogger(ctx), func(chunks chan *sources.Chunk) error {
+		chunks <- dataChunk(req, 0)
+		return nil
+	}, func(r *detectors.ResultWithMetadata) error {
+		if !req.GetOnlyVerified() || r.Verified {
+			resp.Results = append(resp.Results, resultPB(r))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *scannerServer) StreamResults(stream scannerpb.Scanner_StreamResultsServer) error {
+	// The results of each request are told apart by the source ID of its
+	// chunk, which is the index of the request.
+	var onlyVerified []bool
+	var mu sync.Mutex
+	return s.scanChunks(context.AddLogger(stream.Context()), func(chunks chan *sources.Chunk) error {
+		for {
+			req, err := stream.Recv()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			mu.Lock()
+			onlyVerified = append(onlyVerified, req.GetOnlyVerified())
+			id := len(onlyVerified) - 1
+			mu.Unlock()
+			chunks <- dataChunk(req, int64(id))
+		}
+	}, func(r *detectors.ResultWithMetadata) error {
+		mu.Lock()
+		skip := onlyVerified[r.SourceID] && !r.Verified
+		mu.Unlock()
+		if skip {
+			return nil
+		}
+		return stream.Send(resultPB(r))
+	})
+}
+
+// scanChunks scans the chunks sent by send with a new engine, calling
+// handleResult with each of their results until it fails.
+func (s *scannerServer) scanChunks(ctx context.Context, send func(chunks chan *sources.Chunk) error, handleResult func(*detectors.ResultWithMetadata) error) error {
+	e := engine.Start(ctx, s.d.engineOptions...)
+	sendErr := make(chan error, 1)
+	go func() {
+		defer common.RecoverWithExit(ctx)
+		sendErr <- send(e.ChunksChan())
+		e.Finish(ctx)
+	}()
+
+	var resultErr error
+	for r := range e.ResultsChan() {
+		if resultErr == nil {
+			resultErr = handleResult(&r)
+		}
+	}
+	if ctx.Err() != nil {
+		return status.FromContextError(ctx.Err()).Err()
+	}
+	if err := <-sendErr; err != nil {
+		return err
+	}
+	return resultErr
+}
+
+// dataChunk returns the chunk of the data of a request, whose results have id
+// as their source ID.
+func dataChunk(req *scannerpb.ScanBytesRequest, id int64) *sources.Chunk {
+	return &sources.Chunk{
+		SourceName: grpcSourceName,
+		SourceID:   id,
+		SourceType: sourcespb.SourceType_SOURCE_TYPE_FILESYSTEM,
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Filesystem{
+				Filesystem: &source_metadatapb.Filesystem{File: req.GetName()},
+			},
+		},
+		Data:   req.GetData(),
+		Verify: true,
+	}
+}
+
+func resultPB(r *detectors.ResultWithMetadata) *scannerpb.Result {
+	return &scannerpb.Result{
+		DetectorType:   r.DetectorType,
+		DetectorName:   r.DetectorType.String(),
+		DecoderType:    r.DecoderType,
+		Verified:       r.Verified,
+		Raw:            string(r.Raw),
+		Redacted:       r.Redacted,
+		ExtraData:      r.ExtraData,
+		SourceName:     r.SourceName,
+		SourceMetadata: r.SourceMetadata,
+	}
+}
@@ -0,0 +1,63 @@
+package daemon
+
+import (
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/scannerpb"
+)
+
+func TestDaemon_GRPCServer(t *testing.T) {
+	d, err := New(nil, "", 1, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.EnableAPI("secret")
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := d.GRPCServer()
+	go server.Serve(listener)
+	defer server.Stop()
+
+	conn, err := grpc.Dial(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := scannerpb.NewScannerClient(conn)
+
+	tests := []struct {
+		name     string
+		token    string
+		uri      string
+		wantCode codes.Code
+	}{
+		{name: "no token", uri: "https://github.com/trufflesecurity/test_keys", wantCode: codes.Unauthenticated},
+		{name: "wrong token", token: "nope", uri: "https://github.com/trufflesecurity/test_keys", wantCode: codes.Unauthenticated},
+		{name: "no uri", token: "secret", wantCode: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.token != "" {
+				ctx = context.AddLogger(metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+tt.token))
+			}
+			stream, err := client.ScanGit(ctx, &scannerpb.ScanGitRequest{Uri: tt.uri})
+			if err == nil {
+				_, err = stream.Recv()
+			}
+			if status.Code(err) != tt.wantCode {
+				t.Errorf("ScanGit() error = %v, want code %v", err, tt.wantCode)
+			}
+		})
+	}
+}
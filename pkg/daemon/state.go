@@ -0,0 +1,74 @@
+package daemon
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// state persists the last successful scan of each source, so the next scan
+// of a source only covers what changed since, for the sources that support
+// scanning since a time. A state without a path is only kept in memory.
+type state struct {
+	path    string
+	mu      sync.Mutex
+	Sources map[string]*sourceState `json:"sources"`
+}
+
+// sourceState is the state of a source, by its name.
+type sourceState struct {
+	// LastSuccess is the start time of the last scan that completed.
+	LastSuccess time.Time `json:"last_success"`
+}
+
+// loadState reads the state stored at path. A missing file yields an empty
+// state that will be written to path on save.
+func loadState(path string) (*state, error) {
+	st := &state{path: path, Sources: map[string]*sourceState{}}
+	if path == "" {
+		return st, nil
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return st, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, st); err != nil {
+		return nil, fmt.Errorf("could not parse state %s: %w", path, err)
+	}
+	if st.Sources == nil {
+		st.Sources = map[string]*sourceState{}
+	}
+	return st, nil
+}
+
+// lastSuccess returns the start time of the last scan of a source that
+// completed, or the zero time if it was never scanned.
+func (s *state) lastSuccess(name string) time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if src, ok := s.Sources[name]; ok {
+		return src.LastSuccess
+	}
+	return time.Time{}
+}
+
+// scanSucceeded records the start time of a scan that completed and saves
+// the state.
+func (s *state) scanSucceeded(name string, started time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.Sources[name] = &sourceState{LastSuccess: started}
+	if s.path == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(s.path, data, 0600)
+}
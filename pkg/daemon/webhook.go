@@ -0,0 +1,547 @@
+package daemon
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/git"
+)
+
+const (
+	// maxWebhookSize is the size of the largest event that is accepted, which
+	// is the largest event GitHub sends.
+	maxWebhookSize = 25 << 20
+	// maxWebhookScans is how many pushes are scanned at once. Other pushes
+	// wait for a scan to end.
+	maxWebhookScans = 4
+
+	// statusContext names the commit statuses of the scans.
+	statusContext = "trufflehog"
+)
+
+// Statuses of the scans of pushes, reported on the scanned commits.
+const (
+	statusPending = "pending"
+	statusSuccess = "success"
+	statusFailure = "failure"
+	statusError   = "error"
+)
+
+// WebhookConfig configures the webhooks receiving the push and pull request
+// events of GitHub, GitLab and Bitbucket.
+type WebhookConfig struct {
+	// Secret signs the events of GitHub and Bitbucket, and is the token of
+	// the events of GitLab.
+	Secret string
+	// The tokens of the providers clone private repositories and report the
+	// status of the scans on the scanned commits. Without its token, the
+	// public repositories of a provider are scanned without reporting.
+	GitHubToken    string
+	GitLabToken    string
+	BitbucketToken string
+	// OnlyVerified only fails the status of the scans with verified results.
+	OnlyVerified bool
+}
+
+// EnableWebhooks serves the webhooks on /webhooks/github, /webhooks/gitlab and
+// /webhooks/bitbucket. The commits pushed by the events are scanned in the
+// background, and their results are handled like those of scheduled scans.
+func (d *Daemon) EnableWebhooks(c WebhookConfig) {
+	d.webhooks = &c
+	d.webhookScans = make(chan struct{}, maxWebhookScans)
+}
+
+// pushScan is the scan of the commits of a push or pull request.
+type pushScan struct {
+	provider string
+	// repo is the full name of the repository, like owner/name.
+	repo     string
+	cloneURL string
+	// fetchURL and fetchRef are fetched after cloning, when the head of a
+	// pull request isn't on a branch of the repository. An empty fetchURL
+	// fetches from the clone URL.
+	fetchURL string
+	fetchRef string
+	// update is the ref update whose new commits are scanned. Its ref is
+	// empty when the commits aren't on a branch of the repository.
+	update git.RefUpdate
+	// statusURL is where the status of the scan is reported, and linkURL is
+	// the page of the commit linked to from the status.
+	statusURL string
+	linkURL   string
+}
+
+func (d *Daemon) webhookRoutes(ctx context.Context, router *mux.Router) {
+	router.HandleFunc("/webhooks/{provider}", func(w http.ResponseWriter, r *http.Request) {
+		d.receiveWebhook(ctx, w, r)
+	}).Methods(http.MethodPost)
+}
+
+func (d *Daemon) receiveWebhook(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	provider := mux.Vars(r)["provider"]
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookSize))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var scans []pushScan
+	switch provider {
+	case "github":
+		if !validSignature(d.webhooks.Secret, body, r.Header.Get("X-Hub-Signature-256")) {
+			writeError(w, http.StatusUnauthorized, "invalid signature")
+			return
+		}
+		scans, err = parseGitHubEvent(r.Header.Get("X-GitHub-Event"), body)
+	case "gitlab":
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("X-Gitlab-Token")), []byte(d.webhooks.Secret)) != 1 {
+			writeError(w, http.StatusUnauthorized, "invalid token")
+			return
+		}
+		scans, err = parseGitLabEvent(r.Header.Get("X-Gitlab-Event"), body)
+	case "bitbucket":
+		if !validSignature(d.webhooks.Secret, body, r.Header.Get("X-Hub-Signature")) {
+			writeError(w, http.StatusUnauthorized, "invalid signature")
+			return
+		}
+		scans, err = parseBitbucketEvent(r.Header.Get("X-Event-Key"), body)
+	default:
+		writeError(w, http.StatusNotFound, fmt.Sprintf("unknown provider %q", provider))
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid event: "+err.Error())
+		return
+	}
+
+	for _, s := range scans {
+		go func(s pushScan) {
+			defer common.RecoverWithExit(ctx)
+			d.webhookScans <- struct{}{}
+			defer func() { <-d.webhookScans }()
+			d.runPushScan(ctx, s)
+		}(s)
+	}
+	// Events are answered before their scans end, since providers only wait
+	// for a few seconds.
+	writeJSON(w, http.StatusAccepted, map[string]int{"scans": len(scans)})
+}
+
+// validSignature reports whether the signature of a body, like sha256=<hex>,
+// is its HMAC-SHA256 with the secret.
+func validSignature(secret string, body []byte, signature string) bool {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	want := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	return hmac.Equal([]byte(signature), []byte(want))
+}
+
+type githubRepository struct {
+	FullName    string `json:"full_name"`
+	CloneURL    string `json:"clone_url"`
+	HTMLURL     string `json:"html_url"`
+	StatusesURL string `json:"statuses_url"`
+}
+
+// parseGitHubEvent returns the scans of the push and pull_request events of
+// GitHub. Pull requests are scanned when they are opened and when commits are
+// pushed to them.
+func parseGitHubEvent(event string, body []byte) ([]pushScan, error) {
+	switch event {
+	case "push":
+		var push struct {
+			Ref        string           `json:"ref"`
+			Before     string           `json:"before"`
+			After      string           `json:"after"`
+			Deleted    bool             `json:"deleted"`
+			Repository githubRepository `json:"repository"`
+		}
+		if err := json.Unmarshal(body, &push); err != nil {
+			return nil, err
+		}
+		if push.Deleted {
+			return nil, nil
+		}
+		return []pushScan{githubScan(push.Repository, git.RefUpdate{OldRev: push.Before, NewRev: push.After, Ref: push.Ref})}, nil
+	case "pull_request":
+		var pr struct {
+			Action      string `json:"action"`
+			Number      int    `json:"number"`
+			PullRequest struct {
+				Head struct {
+					SHA  string           `json:"sha"`
+					Ref  string           `json:"ref"`
+					Repo githubRepository `json:"repo"`
+				} `json:"head"`
+			} `json:"pull_request"`
+			Repository githubRepository `json:"repository"`
+		}
+		if err := json.Unmarshal(body, &pr); err != nil {
+			return nil, err
+		}
+		if pr.Action != "opened" && pr.Action != "reopened" && pr.Action != "synchronize" {
+			return nil, nil
+		}
+		head := pr.PullRequest.Head
+		update := git.RefUpdate{NewRev: head.SHA}
+		if head.Repo.FullName == pr.Repository.FullName {
+			update.Ref = "refs/heads/" + head.Ref
+		}
+		s := githubScan(pr.Repository, update)
+		s.fetchRef = fmt.Sprintf("refs/pull/%d/head", pr.Number)
+		return []pushScan{s}, nil
+	default:
+		return nil, nil
+	}
+}
+
+func githubScan(repo githubRepository, update git.RefUpdate) pushScan {
+	return pushScan{
+		provider:  "github",
+		repo:      repo.FullName,
+		cloneURL:  repo.CloneURL,
+		update:    update,
+		statusURL: strings.Replace(repo.StatusesURL, "{sha}", update.NewRev, 1),
+		linkURL:   repo.HTMLURL + "/commit/" + update.NewRev,
+	}
+}
+
+type gitlabProject struct {
+	ID                int    `json:"id"`
+	PathWithNamespace string `json:"path_with_namespace"`
+	WebURL            string `json:"web_url"`
+	GitHTTPURL        string `json:"git_http_url"`
+}
+
+// parseGitLabEvent returns the scans of the push, tag push and merge request
+// events of GitLab. Merge requests are scanned when they are opened and when
+// commits are pushed to them.
+func parseGitLabEvent(event string, body []byte) ([]pushScan, error) {
+	switch event {
+	case "Push Hook", "Tag Push Hook":
+		var push struct {
+			Ref     string        `json:"ref"`
+			Before  string        `json:"before"`
+			After   string        `json:"after"`
+			Project gitlabProject `json:"project"`
+		}
+		if err := json.Unmarshal(body, &push); err != nil {
+			return nil, err
+		}
+		update := git.RefUpdate{OldRev: push.Before, NewRev: push.After, Ref: push.Ref}
+		if update.Deleted() {
+			return nil, nil
+		}
+		s, err := gitlabScan(push.Project, update)
+		if err != nil {
+			return nil, err
+		}
+		return []pushScan{s}, nil
+	case "Merge Request Hook":
+		var mr struct {
+			Project          gitlabProject `json:"project"`
+			ObjectAttributes struct {
+				IID             int    `json:"iid"`
+				Action          string `json:"action"`
+				OldRev          string `json:"oldrev"`
+				SourceBranch    string `json:"source_branch"`
+				SourceProjectID int    `json:"source_project_id"`
+				TargetProjectID int    `json:"target_project_id"`
+				LastCommit      struct {
+					ID string `json:"id"`
+				} `json:"last_commit"`
+			} `json:"object_attributes"`
+		}
+		if err := json.Unmarshal(body, &mr); err != nil {
+			return nil, err
+		}
+		attrs := mr.ObjectAttributes
+		// Updates without an old revision don't push commits.
+		if attrs.Action != "open" && attrs.Action != "reopen" && (attrs.Action != "update" || attrs.OldRev == "") {
+			return nil, nil
+		}
+		update := git.RefUpdate{NewRev: attrs.LastCommit.ID}
+		if attrs.SourceProjectID == attrs.TargetProjectID {
+			update.Ref = "refs/heads/" + attrs.SourceBranch
+		}
+		s, err := gitlabScan(mr.Project, update)
+		if err != nil {
+			return nil, err
+		}
+		s.fetchRef = fmt.Sprintf("refs/merge-requests/%d/head", attrs.IID)
+		return []pushScan{s}, nil
+	default:
+		return nil, nil
+	}
+}
+
+func gitlabScan(project gitlabProject, update git.RefUpdate) (pushScan, error) {
+	// The API is served by the host of the project.
+	u, err := url.Parse(project.WebURL)
+	if err != nil {
+		return pushScan{}, fmt.Errorf("invalid project URL %q: %w", project.WebURL, err)
+	}
+	return pushScan{
+		provider:  "gitlab",
+		repo:      project.PathWithNamespace,
+		cloneURL:  project.GitHTTPURL,
+		update:    update,
+		statusURL: fmt.Sprintf("%s://%s/api/v4/projects/%d/statuses/%s", u.Scheme, u.Host, project.ID, update.NewRev),
+		linkURL:   project.WebURL + "/-/commit/" + update.NewRev,
+	}, nil
+}
+
+type bitbucketRepository struct {
+	FullName string `json:"full_name"`
+	Links    struct {
+		HTML struct {
+			Href string `json:"href"`
+		} `json:"html"`
+	} `json:"links"`
+}
+
+type bitbucketRef struct {
+	Type   string `json:"type"`
+	Name   string `json:"name"`
+	Target struct {
+		Hash string `json:"hash"`
+	} `json:"target"`
+}
+
+// parseBitbucketEvent returns the scans of the push and pull request events
+// of Bitbucket Cloud. Pull requests are scanned when they are created and
+// updated.
+func parseBitbucketEvent(event string, body []byte) ([]pushScan, error) {
+	switch event {
+	case "repo:push":
+		var push struct {
+			Repository bitbucketRepository `json:"repository"`
+			Push       struct {
+				Changes []struct {
+					Old *bitbucketRef `json:"old"`
+					New *bitbucketRef `json:"new"`
+				} `json:"changes"`
+			} `json:"push"`
+		}
+		if err := json.Unmarshal(body, &push); err != nil {
+			return nil, err
+		}
+		var scans []pushScan
+		for _, change := range push.Push.Changes {
+			// Deleted refs have no new target.
+			if change.New == nil {
+				continue
+			}
+			update := git.RefUpdate{NewRev: change.New.Target.Hash, Ref: "refs/heads/" + change.New.Name}
+			if change.New.Type == "tag" {
+				update.Ref = "refs/tags/" + change.New.Name
+			}
+			if change.Old != nil {
+				update.OldRev = change.Old.Target.Hash
+			}
+			scans = append(scans, bitbucketScan(push.Repository, update))
+		}
+		return scans, nil
+	case "pullrequest:created", "pullrequest:updated":
+		var pr struct {
+			Repository  bitbucketRepository `json:"repository"`
+			PullRequest struct {
+				Source struct {
+					Branch struct {
+						Name string `json:"name"`
+					} `json:"branch"`
+					Commit struct {
+						Hash string `json:"hash"`
+					} `json:"commit"`
+					Repository bitbucketRepository `json:"repository"`
+				} `json:"source"`
+			} `json:"pullrequest"`
+		}
+		if err := json.Unmarshal(body, &pr); err != nil {
+			return nil, err
+		}
+		source := pr.PullRequest.Source
+		update := git.RefUpdate{NewRev: source.Commit.Hash}
+		s := bitbucketScan(pr.Repository, update)
+		if source.Repository.FullName == pr.Repository.FullName {
+			s.update.Ref = "refs/heads/" + source.Branch.Name
+		} else {
+			// Bitbucket has no refs of pull requests, their head is fetched
+			// from the branch of the fork.
+			s.fetchURL = source.Repository.Links.HTML.Href + ".git"
+			s.fetchRef = source.Branch.Name
+		}
+		return []pushScan{s}, nil
+	default:
+		return nil, nil
+	}
+}
+
+func bitbucketScan(repo bitbucketRepository, update git.RefUpdate) pushScan {
+	return pushScan{
+		provider:  "bitbucket",
+		repo:      repo.FullName,
+		cloneURL:  repo.Links.HTML.Href + ".git",
+		update:    update,
+		statusURL: fmt.Sprintf("https://api.bitbucket.org/2.0/repositories/%s/commit/%s/statuses/build", repo.FullName, update.NewRev),
+		linkURL:   repo.Links.HTML.Href + "/commits/" + update.NewRev,
+	}
+}
+
+// runPushScan scans the commits of a push, reporting the status of the scan
+// on its head commit.
+func (d *Daemon) runPushScan(ctx context.Context, s pushScan) {
+	logger := logrus.WithField("repo", s.repo).WithField("commit", s.update.NewRev)
+	logger.Info("scanning pushed commits")
+	d.reportStatus(ctx, s, statusPending, "Scanning for secrets")
+
+	found, err := d.scanPush(ctx, s)
+	switch {
+	case err != nil:
+		logger.WithError(err).Error("failed to scan pushed commits")
+		d.reportStatus(ctx, s, statusError, "The scan failed")
+	case found > 0:
+		logger.WithField("results", found).Info("found secrets in pushed commits")
+		d.reportStatus(ctx, s, statusFailure, fmt.Sprintf("Found %d secrets", found))
+	default:
+		logger.Info("found no secrets in pushed commits")
+		d.reportStatus(ctx, s, statusSuccess, "No secrets found")
+	}
+}
+
+// scanPush scans the commits a push adds to a clone of its repository, and
+// returns the number of results that fail its status.
+func (d *Daemon) scanPush(ctx context.Context, s pushScan) (int, error) {
+	path, isRemote, err := git.PrepareRepo(ctx, d.authenticatedURL(s.provider, s.cloneURL))
+	if isRemote {
+		defer os.RemoveAll(path)
+	}
+	if err != nil {
+		return 0, err
+	}
+	// The refs of the repository are changed, which only clones can be.
+	if !isRemote {
+		return 0, fmt.Errorf("%s is not a remote repository", s.cloneURL)
+	}
+
+	if s.fetchRef != "" {
+		remote := "origin"
+		if s.fetchURL != "" {
+			remote = d.authenticatedURL(s.provider, s.fetchURL)
+		}
+		// The output isn't reported since it could hold the token of the remote.
+		if err := exec.Command("git", "-C", path, "fetch", "--quiet", remote, s.fetchRef).Run(); err != nil {
+			return 0, fmt.Errorf("could not fetch %s: %w", s.fetchRef, err)
+		}
+	}
+	if s.update.Ref != "" {
+		if err := git.ResetPushedRef(path, s.update); err != nil {
+			return 0, err
+		}
+	}
+
+	c := sources.Config{
+		RepoPath:    path,
+		PushedRevs:  []string{s.update.NewRev},
+		Concurrency: d.concurrency,
+	}
+	found := 0
+	_, _, err = scanSource(ctx, d.engineOptions, "git", c, func(r *detectors.ResultWithMetadata) {
+		if r.Verified || !d.webhooks.OnlyVerified {
+			found++
+		}
+		d.resultsMu.Lock()
+		d.handleResult(r)
+		d.resultsMu.Unlock()
+	})
+	return found, err
+}
+
+// token returns the token of a provider.
+func (c *WebhookConfig) token(provider string) string {
+	switch provider {
+	case "github":
+		return c.GitHubToken
+	case "gitlab":
+		return c.GitLabToken
+	default:
+		return c.BitbucketToken
+	}
+}
+
+// authenticatedURL returns the URL to clone a repository of a provider with its
+// token, if it is set.
+func (d *Daemon) authenticatedURL(provider, rawURL string) string {
+	token := d.webhooks.token(provider)
+	u, err := url.Parse(rawURL)
+	if token == "" || err != nil {
+		return rawURL
+	}
+	username := map[string]string{"github": "x-access-token", "gitlab": "oauth2", "bitbucket": "x-token-auth"}[provider]
+	u.User = url.UserPassword(username, token)
+	return u.String()
+}
+
+// reportStatus reports the status of the scan of a push on its head commit,
+// with the commit status API of its provider.
+func (d *Daemon) reportStatus(ctx context.Context, s pushScan, status, description string) {
+	token := d.webhooks.token(s.provider)
+	if token == "" {
+		return
+	}
+	var payload interface{}
+	switch s.provider {
+	case "github":
+		payload = map[string]string{"state": status, "description": description, "context": statusContext}
+	case "gitlab":
+		state := map[string]string{statusPending: "running", statusSuccess: "success", statusFailure: "failed", statusError: "failed"}[status]
+		payload = map[string]string{"state": state, "description": description, "name": statusContext, "target_url": s.linkURL}
+	case "bitbucket":
+		state := map[string]string{statusPending: "INPROGRESS", statusSuccess: "SUCCESSFUL", statusFailure: "FAILED", statusError: "FAILED"}[status]
+		payload = map[string]string{"state": state, "description": description, "key": statusContext, "name": statusContext, "url": s.linkURL}
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return
+	}
+
+	logger := logrus.WithField("repo", s.repo).WithField("commit", s.update.NewRev)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.statusURL, bytes.NewReader(body))
+	if err != nil {
+		logger.WithError(err).Error("could not report commit status")
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.provider == "gitlab" {
+		req.Header.Set("PRIVATE-TOKEN", token)
+	} else {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := common.SaneHttpClient().Do(req)
+	if err != nil {
+		logger.WithError(err).Error("could not report commit status")
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		logger.WithField("status", resp.Status).Error("could not report commit status")
+	}
+}
@@ -0,0 +1,235 @@
+package daemon
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/git"
+)
+
+func TestValidSignature(t *testing.T) {
+	// The example of the GitHub documentation on validating deliveries.
+	signature := "sha256=757107ea0eb2509fc211221cce984b8a37570b6d7586c22c46f4379c8b043e17"
+	if !validSignature("It's a Secret to Everybody", []byte("Hello, World!"), signature) {
+		t.Error("valid signature was rejected")
+	}
+	if validSignature("nope", []byte("Hello, World!"), signature) {
+		t.Error("signature with another secret was accepted")
+	}
+	if validSignature("It's a Secret to Everybody", []byte("Hello, World!"), "") {
+		t.Error("missing signature was accepted")
+	}
+}
+
+func TestParseEvents(t *testing.T) {
+	const (
+		before = "1111111111111111111111111111111111111111"
+		after  = "2222222222222222222222222222222222222222"
+		zeros  = "0000000000000000000000000000000000000000"
+	)
+	githubRepo := `{"full_name": "acme/app", "clone_url": "https://github.com/acme/app.git", "html_url": "https://github.com/acme/app", "statuses_url": "https://api.github.com/repos/acme/app/statuses/{sha}"}`
+	gitlabProject := `{"id": 7, "path_with_namespace": "acme/app", "web_url": "https://gitlab.example.com/acme/app", "git_http_url": "https://gitlab.example.com/acme/app.git"}`
+	bitbucketRepo := `{"full_name": "acme/app", "links": {"html": {"href": "https://bitbucket.org/acme/app"}}}`
+	bitbucketFork := `{"full_name": "someone/app", "links": {"html": {"href": "https://bitbucket.org/someone/app"}}}`
+
+	tests := []struct {
+		name  string
+		parse func(string, []byte) ([]pushScan, error)
+		event string
+		body  string
+		want  []pushScan
+	}{
+		{
+			name:  "github push",
+			parse: parseGitHubEvent,
+			event: "push",
+			body:  `{"ref": "refs/heads/main", "before": "` + before + `", "after": "` + after + `", "repository": ` + githubRepo + `}`,
+			want: []pushScan{{
+				provider:  "github",
+				repo:      "acme/app",
+				cloneURL:  "https://github.com/acme/app.git",
+				update:    git.RefUpdate{OldRev: before, NewRev: after, Ref: "refs/heads/main"},
+				statusURL: "https://api.github.com/repos/acme/app/statuses/" + after,
+				linkURL:   "https://github.com/acme/app/commit/" + after,
+			}},
+		},
+		{
+			name:  "github deleted branch",
+			parse: parseGitHubEvent,
+			event: "push",
+			body:  `{"ref": "refs/heads/old", "before": "` + before + `", "after": "` + zeros + `", "deleted": true, "repository": ` + githubRepo + `}`,
+		},
+		{
+			name:  "github pull request from a fork",
+			parse: parseGitHubEvent,
+			event: "pull_request",
+			body:  `{"action": "synchronize", "number": 12, "pull_request": {"head": {"sha": "` + after + `", "ref": "fix", "repo": {"full_name": "someone/app"}}}, "repository": ` + githubRepo + `}`,
+			want: []pushScan{{
+				provider:  "github",
+				repo:      "acme/app",
+				cloneURL:  "https://github.com/acme/app.git",
+				fetchRef:  "refs/pull/12/head",
+				update:    git.RefUpdate{NewRev: after},
+				statusURL: "https://api.github.com/repos/acme/app/statuses/" + after,
+				linkURL:   "https://github.com/acme/app/commit/" + after,
+			}},
+		},
+		{
+			name:  "github closed pull request",
+			parse: parseGitHubEvent,
+			event: "pull_request",
+			body:  `{"action": "closed", "number": 12, "repository": ` + githubRepo + `}`,
+		},
+		{
+			name:  "github ping",
+			parse: parseGitHubEvent,
+			event: "ping",
+			body:  `{"zen": "Keep it logically awesome."}`,
+		},
+		{
+			name:  "gitlab push",
+			parse: parseGitLabEvent,
+			event: "Push Hook",
+			body:  `{"ref": "refs/heads/feature", "before": "` + zeros + `", "after": "` + after + `", "project": ` + gitlabProject + `}`,
+			want: []pushScan{{
+				provider:  "gitlab",
+				repo:      "acme/app",
+				cloneURL:  "https://gitlab.example.com/acme/app.git",
+				update:    git.RefUpdate{OldRev: zeros, NewRev: after, Ref: "refs/heads/feature"},
+				statusURL: "https://gitlab.example.com/api/v4/projects/7/statuses/" + after,
+				linkURL:   "https://gitlab.example.com/acme/app/-/commit/" + after,
+			}},
+		},
+		{
+			name:  "gitlab merge request",
+			parse: parseGitLabEvent,
+			event: "Merge Request Hook",
+			body:  `{"project": ` + gitlabProject + `, "object_attributes": {"iid": 3, "action": "update", "oldrev": "` + before + `", "source_branch": "fix", "source_project_id": 7, "target_project_id": 7, "last_commit": {"id": "` + after + `"}}}`,
+			want: []pushScan{{
+				provider:  "gitlab",
+				repo:      "acme/app",
+				cloneURL:  "https://gitlab.example.com/acme/app.git",
+				fetchRef:  "refs/merge-requests/3/head",
+				update:    git.RefUpdate{NewRev: after, Ref: "refs/heads/fix"},
+				statusURL: "https://gitlab.example.com/api/v4/projects/7/statuses/" + after,
+				linkURL:   "https://gitlab.example.com/acme/app/-/commit/" + after,
+			}},
+		},
+		{
+			name:  "gitlab merge request update without commits",
+			parse: parseGitLabEvent,
+			event: "Merge Request Hook",
+			body:  `{"project": ` + gitlabProject + `, "object_attributes": {"iid": 3, "action": "update", "last_commit": {"id": "` + after + `"}}}`,
+		},
+		{
+			name:  "bitbucket push",
+			parse: parseBitbucketEvent,
+			event: "repo:push",
+			body:  `{"repository": ` + bitbucketRepo + `, "push": {"changes": [{"old": null, "new": {"type": "tag", "name": "v1", "target": {"hash": "` + after + `"}}}, {"old": {"target": {"hash": "` + before + `"}}, "new": null}]}}`,
+			want: []pushScan{{
+				provider:  "bitbucket",
+				repo:      "acme/app",
+				cloneURL:  "https://bitbucket.org/acme/app.git",
+				update:    git.RefUpdate{NewRev: after, Ref: "refs/tags/v1"},
+				statusURL: "https://api.bitbucket.org/2.0/repositories/acme/app/commit/" + after + "/statuses/build",
+				linkURL:   "https://bitbucket.org/acme/app/commits/" + after,
+			}},
+		},
+		{
+			name:  "bitbucket pull request from a fork",
+			parse: parseBitbucketEvent,
+			event: "pullrequest:created",
+			body:  `{"repository": ` + bitbucketRepo + `, "pullrequest": {"source": {"branch": {"name": "fix"}, "commit": {"hash": "` + after + `"}, "repository": ` + bitbucketFork + `}}}`,
+			want: []pushScan{{
+				provider:  "bitbucket",
+				repo:      "acme/app",
+				cloneURL:  "https://bitbucket.org/acme/app.git",
+				fetchURL:  "https://bitbucket.org/someone/app.git",
+				fetchRef:  "fix",
+				update:    git.RefUpdate{NewRev: after},
+				statusURL: "https://api.bitbucket.org/2.0/repositories/acme/app/commit/" + after + "/statuses/build",
+				linkURL:   "https://bitbucket.org/acme/app/commits/" + after,
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.parse(tt.event, []byte(tt.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDaemon_Webhooks(t *testing.T) {
+	d, err := New(nil, "", 1, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.EnableWebhooks(WebhookConfig{Secret: "It's a Secret to Everybody"})
+	handler := d.Handler(context.Background())
+
+	tests := []struct {
+		name       string
+		path       string
+		header     http.Header
+		body       string
+		wantStatus int
+	}{
+		{
+			name:       "github ping",
+			path:       "/webhooks/github",
+			header:     http.Header{"X-Github-Event": {"ping"}, "X-Hub-Signature-256": {"sha256=757107ea0eb2509fc211221cce984b8a37570b6d7586c22c46f4379c8b043e17"}},
+			body:       "Hello, World!",
+			wantStatus: http.StatusAccepted,
+		},
+		{
+			name:       "github invalid signature",
+			path:       "/webhooks/github",
+			header:     http.Header{"X-Github-Event": {"push"}, "X-Hub-Signature-256": {"sha256=nope"}},
+			body:       "{}",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "gitlab ignored event",
+			path:       "/webhooks/gitlab",
+			header:     http.Header{"X-Gitlab-Event": {"Issue Hook"}, "X-Gitlab-Token": {"It's a Secret to Everybody"}},
+			body:       "{}",
+			wantStatus: http.StatusAccepted,
+		},
+		{
+			name:       "gitlab invalid token",
+			path:       "/webhooks/gitlab",
+			header:     http.Header{"X-Gitlab-Event": {"Push Hook"}, "X-Gitlab-Token": {"nope"}},
+			body:       "{}",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "unknown provider",
+			path:       "/webhooks/gitea",
+			body:       "{}",
+			wantStatus: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, tt.path, strings.NewReader(tt.body))
+			for key, values := range tt.header {
+				req.Header[key] = values
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			if rec.Code != tt.wantStatus {
+				t.Errorf("POST %s = %d, want %d: %s", tt.path, rec.Code, tt.wantStatus, rec.Body)
+			}
+		})
+	}
+}
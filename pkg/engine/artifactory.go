@@ -0,0 +1,62 @@
+package engine
+
+import (
+	"github.com/go-errors/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/artifactory"
+)
+
+// ScanArtifactory scans the artifacts stored in a JFrog Artifactory instance.
+func (e *Engine) ScanArtifactory(ctx context.Context, c sources.Config) error {
+	connection := &sourcespb.Artifactory{
+		Endpoint:           c.Endpoint,
+		Repositories:       c.Repos,
+		IgnoreRepositories: c.ExcludeRepos,
+		PackageTypes:       c.PackageTypes,
+		IncludePaths:       c.IncludePaths,
+		IgnorePaths:        c.ExcludePaths,
+		MaxSize:            c.MaxSize,
+	}
+	switch {
+	case len(c.Username) > 0:
+		connection.Credential = &sourcespb.Artifactory_BasicAuth{
+			BasicAuth: &credentialspb.BasicAuth{
+				Username: c.Username,
+				Password: c.Token,
+			},
+		}
+	case len(c.Token) > 0:
+		connection.Credential = &sourcespb.Artifactory_AccessToken{
+			AccessToken: c.Token,
+		}
+	default:
+		return errors.New("an Artifactory access token is required")
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		logrus.WithError(err).Error("failed to marshal artifactory connection")
+		return err
+	}
+
+	concurrency := c.Concurrency
+	if concurrency == 0 {
+		concurrency = e.sourceConcurrency
+	}
+	artifactorySource := artifactory.Source{}
+	err = artifactorySource.Init(ctx, "trufflehog - artifactory", 0, int64(sourcespb.SourceType_SOURCE_TYPE_JFROG_ARTIFACTORY), true, &conn, concurrency)
+	if err != nil {
+		return errors.WrapPrefix(err, "failed to init artifactory source", 0)
+	}
+
+	e.RunSource(ctx, "artifactory", &artifactorySource)
+	return nil
+}
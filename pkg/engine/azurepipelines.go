@@ -0,0 +1,52 @@
+package engine
+
+import (
+	"github.com/go-errors/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/azurepipelines"
+)
+
+// ScanAzurePipelines scans the run logs and artifacts of an Azure DevOps organization.
+func (e *Engine) ScanAzurePipelines(ctx context.Context, c sources.Config) error {
+	connection := &sourcespb.AzurePipelines{
+		Endpoint:        c.Endpoint,
+		Projects:        c.Projects,
+		IgnoreProjects:  c.ExcludeProjects,
+		Pipelines:       c.Pipelines,
+		IgnorePipelines: c.ExcludePipelines,
+		MaxRuns:         int64(c.MaxBuilds),
+		SkipArtifacts:   c.SkipArtifacts,
+	}
+	if len(c.Token) == 0 {
+		return errors.New("a personal access token is required")
+	}
+	connection.Credential = &sourcespb.AzurePipelines_Token{
+		Token: c.Token,
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		logrus.WithError(err).Error("failed to marshal azure pipelines connection")
+		return err
+	}
+
+	concurrency := c.Concurrency
+	if concurrency == 0 {
+		concurrency = e.sourceConcurrency
+	}
+	azurePipelinesSource := azurepipelines.Source{}
+	err = azurePipelinesSource.Init(ctx, "trufflehog - azure pipelines", 0, int64(sourcespb.SourceType_SOURCE_TYPE_AZURE_PIPELINES), true, &conn, concurrency)
+	if err != nil {
+		return errors.WrapPrefix(err, "failed to init azure pipelines source", 0)
+	}
+
+	e.RunSource(ctx, "azure-pipelines", &azurePipelinesSource)
+	return nil
+}
@@ -0,0 +1,55 @@
+package engine
+
+import (
+	"github.com/go-errors/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/box"
+)
+
+// ScanBox scans the folders and files of a Box enterprise.
+func (e *Engine) ScanBox(ctx context.Context, c sources.Config) error {
+	connection := &sourcespb.Box{
+		Endpoint:     c.Endpoint,
+		Folders:      c.Folders,
+		AllUsers:     c.AllUsers,
+		SkipVersions: c.SkipHistory,
+	}
+	switch {
+	case len(c.JWTConfig) > 0:
+		connection.Credential = &sourcespb.Box_JwtConfig{
+			JwtConfig: c.JWTConfig,
+		}
+	case len(c.Token) > 0:
+		connection.Credential = &sourcespb.Box_Token{
+			Token: c.Token,
+		}
+	default:
+		return errors.New("must provide a JWT config or a token")
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		logrus.WithError(err).Error("failed to marshal Box connection")
+		return err
+	}
+
+	concurrency := c.Concurrency
+	if concurrency == 0 {
+		concurrency = e.sourceConcurrency
+	}
+	boxSource := box.Source{}
+	err = boxSource.Init(ctx, "trufflehog - box", 0, int64(sourcespb.SourceType_SOURCE_TYPE_BOX), true, &conn, concurrency)
+	if err != nil {
+		return errors.WrapPrefix(err, "failed to init Box source", 0)
+	}
+
+	e.RunSource(ctx, "box", &boxSource)
+	return nil
+}
@@ -1,25 +1,31 @@
 package engine
 
 import (
-	"runtime"
-
 	"github.com/go-errors/errors"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
-	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/circleci"
 )
 
 // ScanCircleCI scans CircleCI logs.
-func (e *Engine) ScanCircleCI(ctx context.Context, token string) error {
+func (e *Engine) ScanCircleCI(ctx context.Context, c sources.Config) error {
 	connection := &sourcespb.CircleCI{
 		Credential: &sourcespb.CircleCI_Token{
-			Token: token,
+			Token: c.Token,
 		},
+		Projects:         c.Projects,
+		IgnoreProjects:   c.ExcludeProjects,
+		Orgs:             c.Orgs,
+		IncludeArtifacts: c.IncludeArtifacts,
+	}
+	if !c.Since.IsZero() {
+		connection.Since = timestamppb.New(c.Since)
 	}
 
 	var conn anypb.Any
@@ -29,20 +35,16 @@ func (e *Engine) ScanCircleCI(ctx context.Context, token string) error {
 		return err
 	}
 
+	concurrency := c.Concurrency
+	if concurrency == 0 {
+		concurrency = e.sourceConcurrency
+	}
 	circleSource := circleci.Source{}
-	err = circleSource.Init(ctx, "trufflehog - Circle CI", 0, int64(sourcespb.SourceType_SOURCE_TYPE_CIRCLECI), true, &conn, runtime.NumCPU())
+	err = circleSource.Init(ctx, "trufflehog - Circle CI", 0, int64(sourcespb.SourceType_SOURCE_TYPE_CIRCLECI), true, &conn, concurrency)
 	if err != nil {
 		return errors.WrapPrefix(err, "failed to init Circle CI source", 0)
 	}
 
-	e.sourcesWg.Add(1)
-	go func() {
-		defer common.RecoverWithExit(ctx)
-		defer e.sourcesWg.Done()
-		err := circleSource.Chunks(ctx, e.ChunksChan())
-		if err != nil {
-			logrus.WithError(err).Error("error scanning Circle CI")
-		}
-	}()
+	e.RunSource(ctx, "circleci", &circleSource)
 	return nil
 }
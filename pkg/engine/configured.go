@@ -0,0 +1,67 @@
+package engine
+
+import (
+	"fmt"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// scanFuncs are the scans of the sources that can be declared in the
+// configuration file, by the name of the command that scans them.
+var scanFuncs = map[string]func(*Engine, context.Context, sources.Config) error{
+	"git":             (*Engine).ScanGit,
+	"github":          (*Engine).ScanGitHub,
+	"gitlab":          (*Engine).ScanGitLab,
+	"filesystem":      (*Engine).ScanFileSystem,
+	"s3":              (*Engine).ScanS3,
+	"syslog":          (*Engine).ScanSyslog,
+	"circleci":        (*Engine).ScanCircleCI,
+	"slack":           (*Engine).ScanSlack,
+	"teams":           (*Engine).ScanTeams,
+	"sharepoint":      (*Engine).ScanSharePoint,
+	"confluence":      (*Engine).ScanConfluence,
+	"box":             (*Engine).ScanBox,
+	"npm":             (*Engine).ScanNPM,
+	"rubygems":        (*Engine).ScanRubyGems,
+	"maven":           (*Engine).ScanMaven,
+	"goproxy":         (*Engine).ScanGoModuleProxy,
+	"crates":          (*Engine).ScanCrates,
+	"artifactory":     (*Engine).ScanArtifactory,
+	"tfstate":         (*Engine).ScanTerraformState,
+	"terraform-cloud": (*Engine).ScanTerraformCloud,
+	"jenkins":         (*Engine).ScanJenkins,
+	"github-actions":  (*Engine).ScanGitHubActions,
+	"azure-pipelines": (*Engine).ScanAzurePipelines,
+	"teamcity":        (*Engine).ScanTeamCity,
+	"postgres":        (*Engine).ScanPostgres,
+	"mysql":           (*Engine).ScanMySQL,
+	"mongodb":         (*Engine).ScanMongoDB,
+	"redis":           (*Engine).ScanRedis,
+	"dynamodb":        (*Engine).ScanDynamoDB,
+	"sqs":             (*Engine).ScanSQS,
+	"kafka":           (*Engine).ScanKafka,
+	"gcp-logging":     (*Engine).ScanGCPLogging,
+	"journald":        (*Engine).ScanJournald,
+	"http":            (*Engine).ScanWebsite,
+	"smb":             (*Engine).ScanSMB,
+	"disk-image":      (*Engine).ScanDiskImage,
+	"procenv":         (*Engine).ScanProcessEnvironment,
+}
+
+// IsSourceType reports whether sources of a type can be scanned by ScanSource.
+func IsSourceType(sourceType string) bool {
+	_, ok := scanFuncs[sourceType]
+	return ok
+}
+
+// ScanSource scans a source declared in the configuration file, whose type is
+// the name of the command that scans it, like github or s3. Sources scan
+// concurrently, so any number of them can be scanned before Finish.
+func (e *Engine) ScanSource(ctx context.Context, sourceType string, c sources.Config) error {
+	scan, ok := scanFuncs[sourceType]
+	if !ok {
+		return fmt.Errorf("unknown source type %q", sourceType)
+	}
+	return scan(e, ctx, c)
+}
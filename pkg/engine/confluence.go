@@ -0,0 +1,61 @@
+package engine
+
+import (
+	"github.com/go-errors/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/confluence"
+)
+
+// ScanConfluence scans Confluence spaces with the provided configuration.
+func (e *Engine) ScanConfluence(ctx context.Context, c sources.Config) error {
+	connection := &sourcespb.Confluence{
+		Endpoint:              c.Endpoint,
+		Spaces:                c.Spaces,
+		IgnoreSpaces:          c.ExcludeSpaces,
+		SkipHistory:           c.SkipHistory,
+		SkipAttachments:       c.SkipAttachments,
+		InsecureSkipVerifyTls: c.InsecureSkipVerifyTLS,
+	}
+	switch {
+	case len(c.Username) > 0:
+		connection.Credential = &sourcespb.Confluence_BasicAuth{
+			BasicAuth: &credentialspb.BasicAuth{
+				Username: c.Username,
+				Password: c.Token,
+			},
+		}
+	case len(c.Token) > 0:
+		connection.Credential = &sourcespb.Confluence_Token{
+			Token: c.Token,
+		}
+	default:
+		connection.Credential = &sourcespb.Confluence_Unauthenticated{}
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		logrus.WithError(err).Error("failed to marshal Confluence connection")
+		return err
+	}
+
+	concurrency := c.Concurrency
+	if concurrency == 0 {
+		concurrency = e.sourceConcurrency
+	}
+	confluenceSource := confluence.Source{}
+	err = confluenceSource.Init(ctx, "trufflehog - confluence", 0, int64(sourcespb.SourceType_SOURCE_TYPE_CONFLUENCE), true, &conn, concurrency)
+	if err != nil {
+		return errors.WrapPrefix(err, "failed to init Confluence source", 0)
+	}
+
+	e.RunSource(ctx, "confluence", &confluenceSource)
+	return nil
+}
@@ -0,0 +1,53 @@
+package engine
+
+import (
+	"github.com/go-errors/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/crates"
+)
+
+// ScanCrates scans the published crates of a crate registry.
+func (e *Engine) ScanCrates(ctx context.Context, c sources.Config) error {
+	connection := &sourcespb.Crates{
+		Endpoint:    c.Endpoint,
+		Crates:      c.Packages,
+		Owners:      c.Owners,
+		MaxVersions: int64(c.MaxVersions),
+	}
+	if len(c.Token) > 0 {
+		connection.Credential = &sourcespb.Crates_Token{
+			Token: c.Token,
+		}
+	} else {
+		connection.Credential = &sourcespb.Crates_Unauthenticated{
+			Unauthenticated: &credentialspb.Unauthenticated{},
+		}
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		logrus.WithError(err).Error("failed to marshal crates connection")
+		return err
+	}
+
+	concurrency := c.Concurrency
+	if concurrency == 0 {
+		concurrency = e.sourceConcurrency
+	}
+	cratesSource := crates.Source{}
+	err = cratesSource.Init(ctx, "trufflehog - crates", 0, int64(sourcespb.SourceType_SOURCE_TYPE_CRATES), true, &conn, concurrency)
+	if err != nil {
+		return errors.WrapPrefix(err, "failed to init crates source", 0)
+	}
+
+	e.RunSource(ctx, "crates", &cratesSource)
+	return nil
+}
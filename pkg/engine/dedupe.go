@@ -0,0 +1,103 @@
+package engine
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"sync"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+)
+
+// maxChunkResults is the number of chunks chunkResults remembers. At most a
+// few hundred bytes each, for chunks without results, it bounds its memory
+// during scans of whole organizations.
+const maxChunkResults = 1 << 18
+
+// chunkResults remembers the results found in the data of the chunks scanned
+// last, so chunks with the same data, like vendored dependencies and files
+// copied across repositories, get their results without being scanned again.
+// The chunks used the least recently are forgotten past maxEntries.
+type chunkResults struct {
+	mu         sync.Mutex
+	maxEntries int
+	entries    map[[sha256.Size]byte]*list.Element
+	// recent lists the chunks from the most recently used. Its values are the
+	// *chunkResult of the chunks having results, and only the key of the
+	// others, which is most of them.
+	recent *list.List
+}
+
+// chunkResult is the results of a chunk having some.
+type chunkResult struct {
+	key     [sha256.Size]byte
+	results []detectors.Result
+}
+
+func newChunkResults(maxEntries int) *chunkResults {
+	return &chunkResults{
+		maxEntries: maxEntries,
+		entries:    make(map[[sha256.Size]byte]*list.Element),
+		recent:     list.New(),
+	}
+}
+
+// get returns the results found in data, if data was scanned.
+func (c *chunkResults) get(key [sha256.Size]byte) ([]detectors.Result, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.recent.MoveToFront(element)
+	if result, ok := element.Value.(*chunkResult); ok {
+		return result.results, true
+	}
+	return nil, true
+}
+
+// set records the results found in the data of key, forgetting the chunk used
+// the least recently if there are too many.
+func (c *chunkResults) set(key [sha256.Size]byte, results []detectors.Result) {
+	var value interface{} = key
+	if len(results) > 0 {
+		value = &chunkResult{key: key, results: results}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if element, ok := c.entries[key]; ok {
+		element.Value = value
+		c.recent.MoveToFront(element)
+		return
+	}
+	c.entries[key] = c.recent.PushFront(value)
+	if c.recent.Len() <= c.maxEntries {
+		return
+	}
+	oldest := c.recent.Back()
+	c.recent.Remove(oldest)
+	switch value := oldest.Value.(type) {
+	case *chunkResult:
+		delete(c.entries, value.key)
+	case [sha256.Size]byte:
+		delete(c.entries, value)
+	}
+}
+
+// scanResults collects the results of the detectors scanning a chunk, which
+// verify their results concurrently.
+type scanResults struct {
+	mu      sync.Mutex
+	wg      sync.WaitGroup
+	results []detectors.Result
+	// failed is set when a detector couldn't scan the chunk, so its results
+	// are incomplete.
+	failed bool
+}
+
+func (s *scanResults) add(results []detectors.Result, ok bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.results = append(s.results, results...)
+	s.failed = s.failed || !ok
+}
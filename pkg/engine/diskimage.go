@@ -0,0 +1,41 @@
+package engine
+
+import (
+	"github.com/go-errors/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/diskimage"
+)
+
+// ScanDiskImage scans the files of the filesystems of VM disk images.
+func (e *Engine) ScanDiskImage(ctx context.Context, c sources.Config) error {
+	connection := &sourcespb.DiskImage{
+		Paths:        c.Locations,
+		ExcludePaths: c.ExcludePaths,
+		MaxSize:      c.MaxSize,
+	}
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		logrus.WithError(err).Error("failed to marshal disk image connection")
+		return err
+	}
+
+	concurrency := c.Concurrency
+	if concurrency == 0 {
+		concurrency = e.sourceConcurrency
+	}
+	diskImageSource := diskimage.Source{}
+	err = diskImageSource.Init(ctx, "trufflehog - disk image", 0, int64(sourcespb.SourceType_SOURCE_TYPE_DISK_IMAGE), true, &conn, concurrency)
+	if err != nil {
+		return errors.WrapPrefix(err, "failed to init disk image source", 0)
+	}
+
+	e.RunSource(ctx, "disk-image", &diskImageSource)
+	return nil
+}
@@ -0,0 +1,67 @@
+package engine
+
+import (
+	"fmt"
+
+	"github.com/go-errors/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/dynamodb"
+)
+
+// ScanDynamoDB scans the items of DynamoDB tables.
+func (e *Engine) ScanDynamoDB(ctx context.Context, c sources.Config) error {
+	connection := &sourcespb.DynamoDB{
+		Region:       c.Region,
+		Tables:       c.Tables,
+		IgnoreTables: c.ExcludeTables,
+		Attributes:   c.Attributes,
+		Segments:     int64(c.Segments),
+		MaxItems:     int64(c.MaxRows),
+		Endpoint:     c.Endpoint,
+	}
+	switch {
+	case c.CloudCred:
+		if len(c.Key) > 0 || len(c.Secret) > 0 {
+			return fmt.Errorf("cannot use cloud credentials and an access key together")
+		}
+		connection.Credential = &sourcespb.DynamoDB_CloudEnvironment{
+			CloudEnvironment: &credentialspb.CloudEnvironment{},
+		}
+	case len(c.Key) > 0 && len(c.Secret) > 0:
+		connection.Credential = &sourcespb.DynamoDB_AccessKey{
+			AccessKey: &credentialspb.KeySecret{
+				Key:    c.Key,
+				Secret: c.Secret,
+			},
+		}
+	default:
+		return errors.New("an access key or cloud credentials are required")
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		logrus.WithError(err).Error("failed to marshal dynamodb connection")
+		return err
+	}
+
+	concurrency := c.Concurrency
+	if concurrency == 0 {
+		concurrency = e.sourceConcurrency
+	}
+	dynamodbSource := dynamodb.Source{}
+	err = dynamodbSource.Init(ctx, "trufflehog - dynamodb", 0, int64(sourcespb.SourceType_SOURCE_TYPE_DYNAMODB), true, &conn, concurrency)
+	if err != nil {
+		return errors.WrapPrefix(err, "failed to init dynamodb source", 0)
+	}
+
+	e.RunSource(ctx, "dynamodb", &dynamodbSource)
+	return nil
+}
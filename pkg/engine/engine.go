@@ -2,13 +2,18 @@ package engine
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"path"
+	"reflect"
 	"runtime"
-	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/sirupsen/logrus"
+	"golang.org/x/sync/semaphore"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
@@ -22,6 +27,8 @@ import (
 )
 
 type Engine struct {
+	// concurrency is the default of the concurrency of the sources, detectors
+	// and verifiers that aren't set.
 	concurrency     int
 	chunks          chan *sources.Chunk
 	results         chan detectors.ResultWithMetadata
@@ -32,20 +39,118 @@ type Engine struct {
 	detectorAvgTime sync.Map
 	sourcesWg       sync.WaitGroup
 	workersWg       sync.WaitGroup
+	// scanned are the sources started by the engine, for progress reporting.
+	scanned   []scannedSource
+	scannedMu sync.Mutex
+	// sourceErrs are the errors of the sources that failed.
+	sourceErrs   []error
+	sourceErrsMu sync.Mutex
+	// work are the chunks handed to the workers, which are those of chunks
+	// unless they are spooled or their memory is limited.
+	work chan *sources.Chunk
+	// memory bounds the size of the chunks held by the workers, when
+	// maxMemory is set.
+	memory    *semaphore.Weighted
+	maxMemory int64
+	// spoolDir is the directory of the file the chunks the workers aren't
+	// ready for are queued in, up to spoolSize bytes, when set.
+	spoolDir  string
+	spoolSize int64
+	// keywords finds the detectors chunks are scanned with.
+	keywords keywordMatcher
+	// detectorTimeout is the time a detector has to scan a chunk.
+	detectorTimeout time.Duration
+	// detectorTimeouts counts the chunks each detector ran out of time on.
+	detectorTimeouts   map[string]int
+	detectorTimeoutsMu sync.Mutex
+	// duplicates has the results of the chunks scanned, to skip scanning
+	// chunks with the same data, unless scanDuplicates is set.
+	duplicates      *chunkResults
+	duplicateChunks uint64
+	scanDuplicates  bool
+	// started and finished are the times the engine started and finished,
+	// and verifications and resultStats count the results, for Stats.
+	started       time.Time
+	finished      time.Time
+	verifications uint64
+	resultStats   map[string]ResultStats
+	statsMu       sync.Mutex
+	// sourceConcurrency is the number of workers of the sources that don't
+	// set theirs.
+	sourceConcurrency int
+	// detectorConcurrency is the number of workers scanning chunks.
+	detectorConcurrency int
+	// verifiers bounds the number of detectors verifying their results at
+	// once, which run apart from the workers so these keep scanning chunks
+	// while the results are verified.
+	verifiers           *semaphore.Weighted
+	verifierConcurrency int
 	// filterUnverified is used to reduce the number of unverified results.
 	// If there are multiple unverified results for the same chunk for the same detector,
 	// only the first one will be kept.
 	filterUnverified bool
+	// queue is the work queue the chunks are pushed to for the workers of
+	// other machines to scan, rather than scanning them, when set.
+	queue           Queue
+	chunksQueued    uint64
+	chunksNotQueued uint64
+	// popped has the queue of each chunk popped by ScanQueue, to ack it once
+	// scanned.
+	popped sync.Map
 }
 
 type EngineOption func(*Engine)
 
+// WithConcurrency sets the concurrency of the sources, detectors and
+// verifiers not set with their own options.
 func WithConcurrency(concurrency int) EngineOption {
 	return func(e *Engine) {
 		e.concurrency = concurrency
 	}
 }
 
+// WithScanDuplicates scans every chunk, rather than reporting the results
+// found in the chunks with the same data scanned before.
+func WithScanDuplicates(scan bool) EngineOption {
+	return func(e *Engine) {
+		e.scanDuplicates = scan
+	}
+}
+
+// WithSpool queues the chunks the workers aren't ready for in a file of up to
+// size bytes in dir, rather than blocking the sources. An empty dir is the
+// default directory for temporary files.
+func WithSpool(dir string, size int64) EngineOption {
+	return func(e *Engine) {
+		e.spoolDir = dir
+		e.spoolSize = size
+	}
+}
+
+// WithSourceConcurrency sets the number of workers producing the chunks of
+// each source whose config doesn't set its concurrency.
+func WithSourceConcurrency(concurrency int) EngineOption {
+	return func(e *Engine) {
+		e.sourceConcurrency = concurrency
+	}
+}
+
+// WithDetectorConcurrency sets the number of workers scanning the chunks with
+// the detectors.
+func WithDetectorConcurrency(concurrency int) EngineOption {
+	return func(e *Engine) {
+		e.detectorConcurrency = concurrency
+	}
+}
+
+// WithVerifierConcurrency sets the number of detectors verifying their
+// results at once, which bounds the HTTP calls made to verify them.
+func WithVerifierConcurrency(concurrency int) EngineOption {
+	return func(e *Engine) {
+		e.verifierConcurrency = concurrency
+	}
+}
+
 func WithDetectors(verify bool, d ...detectors.Detector) EngineOption {
 	return func(e *Engine) {
 		if e.detectors == nil {
@@ -65,6 +170,23 @@ func WithDecoders(decoders ...decoders.Decoder) EngineOption {
 	}
 }
 
+// WithMaxMemory bounds the size in bytes of the chunks being scanned at once.
+// The sources are blocked from sending more chunks until enough of them were
+// scanned.
+func WithMaxMemory(maxMemory int64) EngineOption {
+	return func(e *Engine) {
+		e.maxMemory = maxMemory
+	}
+}
+
+// WithDetectorTimeout sets the time a detector has to scan a chunk, verifying
+// its results included, after which the chunk is left unscanned by it.
+func WithDetectorTimeout(timeout time.Duration) EngineOption {
+	return func(e *Engine) {
+		e.detectorTimeout = timeout
+	}
+}
+
 // WithFilterUnverified sets the filterUnverified flag on the engine. If set to
 // true, the engine will only return the first unverified result for a chunk for a detector.
 func WithFilterUnverified(filter bool) EngineOption {
@@ -73,11 +195,22 @@ func WithFilterUnverified(filter bool) EngineOption {
 	}
 }
 
+// WithQueue pushes the chunks of the sources to q, for the workers of a
+// distributed scan to scan them, rather than scanning them. The results are
+// those of the workers.
+func WithQueue(q Queue) EngineOption {
+	return func(e *Engine) {
+		e.queue = q
+	}
+}
+
 func Start(ctx context.Context, options ...EngineOption) *Engine {
 	e := &Engine{
-		chunks:          make(chan *sources.Chunk),
-		results:         make(chan detectors.ResultWithMetadata),
-		detectorAvgTime: sync.Map{},
+		started:          time.Now(),
+		chunks:           make(chan *sources.Chunk),
+		results:          make(chan detectors.ResultWithMetadata),
+		detectorAvgTime:  sync.Map{},
+		detectorTimeouts: map[string]int{},
 	}
 
 	for _, option := range options {
@@ -91,30 +224,82 @@ func Start(ctx context.Context, options ...EngineOption) *Engine {
 		logrus.Warn("No concurrency specified, defaulting to ", numCPU)
 		e.concurrency = numCPU
 	}
-	logrus.Debugf("running with up to %d workers", e.concurrency)
+	if e.sourceConcurrency == 0 {
+		e.sourceConcurrency = e.concurrency
+	}
+	if e.detectorConcurrency == 0 {
+		e.detectorConcurrency = e.concurrency
+	}
+	if e.verifierConcurrency == 0 {
+		e.verifierConcurrency = e.concurrency
+	}
+	e.verifiers = semaphore.NewWeighted(int64(e.verifierConcurrency))
+	logrus.Debugf("running with up to %d source workers, %d detector workers and %d verifiers",
+		e.sourceConcurrency, e.detectorConcurrency, e.verifierConcurrency)
+	// Each source worker and verifier makes one request at a time, so hosts
+	// never see more requests than the largest of them, fewer while they rate
+	// limit us.
+	common.SetHostConcurrency(max(e.sourceConcurrency, e.verifierConcurrency))
 
 	if len(e.decoders) == 0 {
 		e.decoders = decoders.DefaultDecoders()
 	}
 
+	if e.detectorTimeout == 0 {
+		e.detectorTimeout = defaultDetectorTimeout
+	}
+
 	if len(e.detectors) == 0 {
 		e.detectors = map[bool][]detectors.Detector{}
 		e.detectors[true] = DefaultDetectors()
 		e.detectors[false] = []detectors.Detector{}
 	}
 
+	keywords, err := newKeywordMatcher(e.detectors, e.detectorConcurrency)
+	if err != nil {
+		logrus.WithError(err).Fatal("could not load the keywords of the detectors")
+	}
+	e.keywords = keywords
+
 	logrus.Debugf("loaded %d decoders", len(e.decoders))
 	logrus.Debugf("loaded %d detectors total, %d with verification enabled. %d with verification disabled",
 		len(e.detectors[true])+len(e.detectors[false]),
 		len(e.detectors[true]),
 		len(e.detectors[false]))
 
+	if !e.scanDuplicates {
+		e.duplicates = newChunkResults(maxChunkResults)
+	}
+
+	e.work = e.chunks
+	if e.spoolSize > 0 {
+		spool, err := newSpool(e.spoolDir, e.spoolSize)
+		if err != nil {
+			logrus.WithError(err).Fatal("could not create the spool of chunks")
+		}
+		logrus.Debugf("spooling up to %d bytes of chunks to %s", e.spoolSize, spool.file.Name())
+		spooled := make(chan *sources.Chunk)
+		go spool.run(ctx, e.work, spooled)
+		e.work = spooled
+	}
+	if e.maxMemory > 0 {
+		logrus.Debugf("limiting the chunks being scanned to %d bytes", e.maxMemory)
+		e.memory = semaphore.NewWeighted(e.maxMemory)
+		limited := make(chan *sources.Chunk)
+		go e.limitMemory(ctx, e.work, limited)
+		e.work = limited
+	}
+
 	// start the workers
-	for i := 0; i < e.concurrency; i++ {
+	for i := 0; i < e.detectorConcurrency; i++ {
 		e.workersWg.Add(1)
 		go func() {
 			defer common.RecoverWithExit(ctx)
 			defer e.workersWg.Done()
+			if e.queue != nil {
+				e.queueWorker(ctx)
+				return
+			}
 			e.detectorWorker(ctx)
 		}()
 	}
@@ -130,18 +315,103 @@ func (e *Engine) Finish(ctx context.Context) {
 	// wait for the sources to finish putting chunks onto the chunks channel
 	e.sourcesWg.Wait()
 	close(e.chunks)
-	// wait for the workers to finish processing all of the chunks and putting
-	// results onto the results channel
+	// wait for the workers, and the verifications they started, to finish
+	// processing all of the chunks and putting results onto the results
+	// channel. Results are sent unbuffered, so all of them were received.
 	e.workersWg.Wait()
 
-	// TODO: re-evaluate whether this is needed and investigate why if so
-	//
-	// not entirely sure why results don't get processed without this pause
-	// since we've put all results on the channel at this point.
-	time.Sleep(time.Second)
+	e.statsMu.Lock()
+	e.finished = time.Now()
+	e.statsMu.Unlock()
 	close(e.results)
 }
 
+// RunSource scans an initialized source in the background, until it sent all
+// its chunks. Any implementation of sources.Source can be scanned, such as
+// those of programs embedding the engine. The error of the source is logged
+// and returned by Err, unless the scan was cancelled through its context.
+//
+// A source still running once its context is done, such as at the deadline
+// of the scan, is abandoned: the chunks it sends from then on are dropped,
+// so it can't hold up the scan.
+func (e *Engine) RunSource(ctx context.Context, name string, source sources.Source) {
+	e.addSource(name, source)
+	e.sourcesWg.Add(1)
+	chunks := make(chan *sources.Chunk)
+	done := make(chan struct{})
+	go func() {
+		defer common.RecoverWithExit(ctx)
+		defer close(done)
+		e.sourceDone(ctx, name, source.Chunks(ctx, chunks))
+	}()
+	go func() {
+		defer e.sourcesWg.Done()
+		for {
+			select {
+			case chunk := <-chunks:
+				e.chunks <- chunk
+			case <-done:
+				return
+			case <-ctx.Done():
+				go drainChunks(chunks, done)
+				return
+			}
+		}
+	}()
+}
+
+// drainChunks drops the chunks of an abandoned source until it returns.
+func drainChunks(chunks chan *sources.Chunk, done chan struct{}) {
+	for {
+		select {
+		case <-chunks:
+		case <-done:
+			return
+		}
+	}
+}
+
+// sourceDone records the error of a source that finished scanning.
+func (e *Engine) sourceDone(ctx context.Context, name string, err error) {
+	// Scans cancelled through their context aren't failures.
+	if err == nil || common.IsDone(ctx) {
+		return
+	}
+	logrus.WithError(err).Errorf("error scanning %s", name)
+	e.sourceErrsMu.Lock()
+	defer e.sourceErrsMu.Unlock()
+	e.sourceErrs = append(e.sourceErrs, fmt.Errorf("could not scan %s: %w", name, err))
+}
+
+// Err returns the errors of the sources that failed, joined, or nil when
+// every source scanned successfully up to now. Chunks that couldn't be pushed
+// to the queue of WithQueue fail the scan too.
+func (e *Engine) Err() error {
+	e.sourceErrsMu.Lock()
+	defer e.sourceErrsMu.Unlock()
+	errs := e.sourceErrs
+	if n := atomic.LoadUint64(&e.chunksNotQueued); n > 0 {
+		errs = append(errs[:len(errs):len(errs)], fmt.Errorf("could not push %d chunks to the queue", n))
+	}
+	return errors.Join(errs...)
+}
+
+// Wait finishes the engine once the sources started up to now sent all their
+// chunks, and calls handleResult from the calling goroutine with each result
+// of the chunks. It returns once every chunk was scanned, with the errors of
+// the sources that failed. Unlike Finish, it takes care of reading the
+// results while the engine finishes, and no more sources may be scanned once
+// it is called.
+func (e *Engine) Wait(ctx context.Context, handleResult func(*detectors.ResultWithMetadata)) error {
+	go e.Finish(ctx)
+	for r := range e.ResultsChan() {
+		if handleResult != nil {
+			handleResult(&r)
+		}
+	}
+	return e.Err()
+}
+
 func (e *Engine) ChunksChan() chan *sources.Chunk {
 	return e.chunks
 }
@@ -151,11 +421,17 @@ func (e *Engine) ResultsChan() chan detectors.ResultWithMetadata {
 }
 
 func (e *Engine) ChunksScanned() uint64 {
-	return e.chunksScanned
+	return atomic.LoadUint64(&e.chunksScanned)
 }
 
 func (e *Engine) BytesScanned() uint64 {
-	return e.bytesScanned
+	return atomic.LoadUint64(&e.bytesScanned)
+}
+
+// DuplicateChunks returns the number of chunks whose results were those of a
+// chunk with the same data scanned before.
+func (e *Engine) DuplicateChunks() uint64 {
+	return atomic.LoadUint64(&e.duplicateChunks)
 }
 
 func (e *Engine) DetectorAvgTime() map[string][]time.Duration {
@@ -178,10 +454,124 @@ func (e *Engine) DetectorAvgTime() map[string][]time.Duration {
 	return avgTime
 }
 
+// limitMemory hands the chunks of in to the workers through out while their
+// size is within the memory limit, and blocks the sources otherwise.
+func (e *Engine) limitMemory(ctx context.Context, in <-chan *sources.Chunk, out chan<- *sources.Chunk) {
+	defer common.RecoverWithExit(ctx)
+	defer close(out)
+	for chunk := range in {
+		// Acquiring can't fail as the context never ends, and it eventually
+		// succeeds since the workers release the memory of each chunk.
+		_ = e.memory.Acquire(context.Background(), e.chunkMemory(chunk))
+		out <- chunk
+	}
+}
+
+// chunkMemory is the memory a chunk accounts for. A chunk larger than the
+// limit takes all of it rather than blocking forever.
+func (e *Engine) chunkMemory(chunk *sources.Chunk) int64 {
+	size := int64(len(chunk.Data))
+	if size > e.maxMemory {
+		return e.maxMemory
+	}
+	return size
+}
+
+// DetectorTimeouts returns the number of chunks each detector ran out of time
+// on, by detector name.
+func (e *Engine) DetectorTimeouts() map[string]int {
+	e.detectorTimeoutsMu.Lock()
+	defer e.detectorTimeoutsMu.Unlock()
+	timeouts := make(map[string]int, len(e.detectorTimeouts))
+	for name, n := range e.detectorTimeouts {
+		timeouts[name] = n
+	}
+	return timeouts
+}
+
+const (
+	defaultDetectorTimeout = 10 * time.Second
+	// detectorGracePeriod is how long detectors have to return once their
+	// context ended, with the results they didn't finish verifying.
+	detectorGracePeriod = time.Second
+)
+
+// detect scans data with a detector within the detector timeout. A detector
+// still running past it, like one stuck on a regex over adversarial input,
+// is left running in the background so it doesn't hold up the worker.
+func (e *Engine) detect(ctx context.Context, detector detectors.Detector, verify bool, data []byte) ([]detectors.Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, e.detectorTimeout)
+	defer cancel()
+	type detection struct {
+		results []detectors.Result
+		err     error
+	}
+	done := make(chan detection, 1)
+	go func() {
+		var d detection
+		defer func() { done <- d }()
+		defer common.Recover(ctx)
+		d.results, d.err = detector.FromData(ctx, verify, data)
+	}()
+
+	select {
+	case d := <-done:
+		return d.results, d.err
+	case <-ctx.Done():
+	}
+	grace := time.NewTimer(detectorGracePeriod)
+	defer grace.Stop()
+	select {
+	case d := <-done:
+		return d.results, d.err
+	case <-grace.C:
+	}
+
+	name := detectorName(detector)
+	e.detectorTimeoutsMu.Lock()
+	e.detectorTimeouts[name]++
+	first := e.detectorTimeouts[name] == 1
+	e.detectorTimeoutsMu.Unlock()
+	if first {
+		logrus.Warnf("the %s detector ran out of time scanning a chunk, see --detector-timeout", name)
+	}
+	return nil, fmt.Errorf("%s detector timed out after %s", name, e.detectorTimeout)
+}
+
+// detectorName returns the name of the package of a detector.
+func detectorName(detector detectors.Detector) string {
+	t := reflect.TypeOf(detector)
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.PkgPath() == "" {
+		return t.String()
+	}
+	return path.Base(t.PkgPath())
+}
+
 func (e *Engine) detectorWorker(ctx context.Context) {
-	for originalChunk := range e.chunks {
+	for originalChunk := range e.work {
+		// The data of the chunk may change while it is scanned.
+		memory := e.chunkMemory(originalChunk)
+		// verifying tracks the detectors still verifying the results of the
+		// chunk, which holds on to its memory, and to its ack when it was
+		// popped from a queue, until they are done.
+		var verifying sync.WaitGroup
 		for chunk := range sources.Chunker(originalChunk) {
 			atomic.AddUint64(&e.bytesScanned, uint64(len(chunk.Data)))
+			var key [sha256.Size]byte
+			if e.duplicates != nil {
+				key = sha256.Sum256(chunk.Data)
+				if results, ok := e.duplicates.get(key); ok {
+					atomic.AddUint64(&e.duplicateChunks, 1)
+					for _, result := range results {
+						e.sendResult(chunk, result)
+					}
+					continue
+				}
+			}
+			found := &scanResults{}
 			for _, decoder := range e.decoders {
 				var decoderType detectorspb.DecoderType
 				switch decoder.(type) {
@@ -197,74 +587,114 @@ func (e *Engine) detectorWorker(ctx context.Context) {
 				if decoded == nil {
 					continue
 				}
-				dataLower := strings.ToLower(string(decoded.Data))
+				matches := e.keywords.Match(decoded.Data)
 				for verify, detectorsSet := range e.detectors {
-					for _, detector := range detectorsSet {
-						start := time.Now()
-						foundKeyword := false
-						for _, kw := range detector.Keywords() {
-							if strings.Contains(dataLower, strings.ToLower(kw)) {
-								foundKeyword = true
-								break
-							}
-						}
-						if !foundKeyword {
+					for i, detector := range detectorsSet {
+						if !matches[verify][i] {
 							continue
 						}
-
-						results, err := func() ([]detectors.Result, error) {
-							ctx, cancel := context.WithTimeout(ctx, time.Second*10)
-							defer cancel()
-							defer common.Recover(ctx)
-							return detector.FromData(ctx, verify, decoded.Data)
-						}()
-						if err != nil {
-							logrus.WithFields(logrus.Fields{
-								"source_type": decoded.SourceType.String(),
-								"metadata":    decoded.SourceMetadata,
-							}).WithError(err).Error("could not scan chunk")
+						if !verify {
+							found.add(e.scanWithDetector(ctx, chunk, decoded, decoderType, detector, false))
 							continue
 						}
-
-						if e.filterUnverified {
-							results = detectors.CleanResults(results)
-						}
-						for _, result := range results {
-							resultChunk := chunk
-							if SupportsLineNumbers(chunk.SourceType) {
-								copyChunk := *chunk
-								copyMetaDataClone := proto.Clone(chunk.SourceMetadata)
-								if copyMetaData, ok := copyMetaDataClone.(*source_metadatapb.MetaData); ok {
-									copyChunk.SourceMetadata = copyMetaData
-								}
-								fragStart, mdLine := FragmentFirstLine(&copyChunk)
-								SetResultLineNumber(&copyChunk, &result, fragStart, mdLine)
-								resultChunk = &copyChunk
-							}
-							result.DecoderType = decoderType
-							e.results <- detectors.CopyMetadata(resultChunk, result)
-
-						}
-						if len(results) > 0 {
-							elapsed := time.Since(start)
-							detectorName := results[0].DetectorType.String()
-							avgTimeI, ok := e.detectorAvgTime.Load(detectorName)
-							var avgTime []time.Duration
-							if ok {
-								avgTime, ok = avgTimeI.([]time.Duration)
-								if !ok {
-									continue
-								}
-							}
-							avgTime = append(avgTime, elapsed)
-							e.detectorAvgTime.Store(detectorName, avgTime)
+						if err := e.verifiers.Acquire(ctx, 1); err != nil {
+							found.add(nil, false)
+							continue
 						}
+						verifying.Add(1)
+						found.wg.Add(1)
+						e.workersWg.Add(1)
+						go func() {
+							defer e.workersWg.Done()
+							defer verifying.Done()
+							defer found.wg.Done()
+							defer e.verifiers.Release(1)
+							// A detector panicking fails the chunk too.
+							var results []detectors.Result
+							var ok bool
+							defer func() { found.add(results, ok) }()
+							defer common.Recover(ctx)
+							results, ok = e.scanWithDetector(ctx, chunk, decoded, decoderType, detector, true)
+						}()
 					}
 				}
 			}
+			if e.duplicates != nil {
+				go func() {
+					found.wg.Wait()
+					if !found.failed {
+						e.duplicates.set(key, found.results)
+					}
+				}()
+			}
 		}
 		atomic.AddUint64(&e.chunksScanned, 1)
+		go func() {
+			verifying.Wait()
+			if e.memory != nil {
+				e.memory.Release(memory)
+			}
+			e.ackPopped(ctx, originalChunk)
+		}()
+	}
+}
+
+// scanWithDetector scans the decoded data of chunk with detector and sends
+// its results. It returns the results, and whether the detector could scan
+// the chunk.
+func (e *Engine) scanWithDetector(ctx context.Context, chunk, decoded *sources.Chunk, decoderType detectorspb.DecoderType, detector detectors.Detector, verify bool) ([]detectors.Result, bool) {
+	start := time.Now()
+	results, err := e.detect(ctx, detector, verify, decoded.Data)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"source_type": decoded.SourceType.String(),
+			"metadata":    decoded.SourceMetadata,
+		}).WithError(err).Error("could not scan chunk")
+		return nil, false
+	}
+
+	if e.filterUnverified {
+		results = detectors.CleanResults(results)
+	}
+	if verify {
+		atomic.AddUint64(&e.verifications, uint64(len(results)))
+	}
+	for i := range results {
+		results[i].DecoderType = decoderType
+		e.sendResult(chunk, results[i])
+	}
+	if len(results) > 0 {
+		elapsed := time.Since(start)
+		detectorName := results[0].DetectorType.String()
+		avgTimeI, ok := e.detectorAvgTime.Load(detectorName)
+		var avgTime []time.Duration
+		if ok {
+			avgTime, ok = avgTimeI.([]time.Duration)
+			if !ok {
+				return results, true
+			}
+		}
+		avgTime = append(avgTime, elapsed)
+		e.detectorAvgTime.Store(detectorName, avgTime)
+	}
+	return results, true
+}
+
+// sendResult sends a result found in chunk, with the metadata of chunk.
+func (e *Engine) sendResult(chunk *sources.Chunk, result detectors.Result) {
+	resultChunk := chunk
+	if SupportsLineNumbers(chunk.SourceType) {
+		copyChunk := *chunk
+		copyMetaDataClone := proto.Clone(chunk.SourceMetadata)
+		if copyMetaData, ok := copyMetaDataClone.(*source_metadatapb.MetaData); ok {
+			copyChunk.SourceMetadata = copyMetaData
+		}
+		fragStart, mdLine := FragmentFirstLine(&copyChunk)
+		SetResultLineNumber(&copyChunk, &result, fragStart, mdLine)
+		resultChunk = &copyChunk
 	}
+	e.countResult(result)
+	e.results <- detectors.CopyMetadata(resultChunk, result)
 }
 
 // gitSources is a list of sources that utilize the Git source. It is stored this way because slice consts are not
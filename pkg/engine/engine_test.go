@@ -0,0 +1,223 @@
+package engine
+
+import (
+	stdctx "context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/sync/semaphore"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/decoders"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// slowDetector finds a result once its delay passed, ignoring the context.
+type slowDetector struct {
+	delay time.Duration
+}
+
+func (d slowDetector) Keywords() []string { return []string{"slow"} }
+
+func (d slowDetector) FromData(ctx stdctx.Context, verify bool, data []byte) ([]detectors.Result, error) {
+	time.Sleep(d.delay)
+	return []detectors.Result{{Raw: data}}, nil
+}
+
+func TestEngine_detect(t *testing.T) {
+	e := &Engine{detectorTimeout: 10 * time.Millisecond, detectorTimeouts: map[string]int{}}
+	ctx := context.Background()
+
+	results, err := e.detect(ctx, slowDetector{}, false, []byte("slow"))
+	if err != nil || len(results) != 1 {
+		t.Fatalf("detect() = %v, %v", results, err)
+	}
+
+	start := time.Now()
+	if _, err := e.detect(ctx, slowDetector{delay: time.Minute}, false, []byte("slow")); err == nil {
+		t.Fatal("detect() didn't time out")
+	}
+	if elapsed := time.Since(start); elapsed > e.detectorTimeout+detectorGracePeriod+time.Second {
+		t.Errorf("detect() returned after %s", elapsed)
+	}
+	if timeouts := e.DetectorTimeouts(); timeouts["engine"] != 1 {
+		t.Errorf("DetectorTimeouts() = %v", timeouts)
+	}
+}
+
+// countingDetector finds a result after a while, counting how many of its
+// calls run at once.
+type countingDetector struct {
+	running, maxRunning *int32
+}
+
+func (d countingDetector) Keywords() []string { return []string{"count"} }
+
+func (d countingDetector) FromData(ctx stdctx.Context, verify bool, data []byte) ([]detectors.Result, error) {
+	running := atomic.AddInt32(d.running, 1)
+	defer atomic.AddInt32(d.running, -1)
+	for {
+		max := atomic.LoadInt32(d.maxRunning)
+		if running <= max || atomic.CompareAndSwapInt32(d.maxRunning, max, running) {
+			break
+		}
+	}
+	time.Sleep(10 * time.Millisecond)
+	return []detectors.Result{{Raw: data}}, nil
+}
+
+func TestEngine_verifierConcurrency(t *testing.T) {
+	var running, maxRunning int32
+	detectorSets := map[bool][]detectors.Detector{true: {countingDetector{&running, &maxRunning}}}
+	keywords, err := newKeywordMatcher(detectorSets, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := &Engine{
+		work:             make(chan *sources.Chunk),
+		results:          make(chan detectors.ResultWithMetadata, 10),
+		decoders:         []decoders.Decoder{&decoders.UTF8{}},
+		detectors:        detectorSets,
+		keywords:         keywords,
+		verifiers:        semaphore.NewWeighted(3),
+		detectorTimeout:  time.Second,
+		detectorTimeouts: map[string]int{},
+	}
+	ctx := context.Background()
+
+	// A single worker keeps scanning chunks while their results are verified.
+	e.workersWg.Add(1)
+	go func() {
+		defer e.workersWg.Done()
+		e.detectorWorker(ctx)
+	}()
+	for i := 0; i < 10; i++ {
+		e.work <- &sources.Chunk{Data: []byte("count me")}
+	}
+	close(e.work)
+	e.workersWg.Wait()
+	close(e.results)
+
+	var results int
+	for range e.results {
+		results++
+	}
+	if results != 10 {
+		t.Errorf("got %d results, want 10", results)
+	}
+	if maxRunning != 3 {
+		t.Errorf("%d detectors verified at once, want 3", maxRunning)
+	}
+}
+
+// callsDetector finds its data, counting its calls.
+type callsDetector struct {
+	calls *int32
+}
+
+func (d callsDetector) Keywords() []string { return []string{"vendored"} }
+
+func (d callsDetector) FromData(ctx stdctx.Context, verify bool, data []byte) ([]detectors.Result, error) {
+	atomic.AddInt32(d.calls, 1)
+	return []detectors.Result{{Raw: data}}, nil
+}
+
+func TestEngine_duplicateChunks(t *testing.T) {
+	var calls int32
+	detectorSets := map[bool][]detectors.Detector{false: {callsDetector{&calls}}}
+	keywords, err := newKeywordMatcher(detectorSets, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := &Engine{
+		work:             make(chan *sources.Chunk),
+		results:          make(chan detectors.ResultWithMetadata, 10),
+		decoders:         []decoders.Decoder{&decoders.UTF8{}},
+		detectors:        detectorSets,
+		keywords:         keywords,
+		verifiers:        semaphore.NewWeighted(1),
+		detectorTimeout:  time.Second,
+		detectorTimeouts: map[string]int{},
+		duplicates:       newChunkResults(maxChunkResults),
+	}
+	ctx := context.Background()
+	go e.detectorWorker(ctx)
+
+	data := []byte("vendored secret")
+	for i := 0; i < 5; i++ {
+		e.work <- &sources.Chunk{SourceName: fmt.Sprint(i), Data: data}
+		result := <-e.results
+		if result.SourceName != fmt.Sprint(i) || string(result.Raw) != string(data) {
+			t.Fatalf("result %d = %+v", i, result)
+		}
+		// The results of a chunk are remembered once all its detectors are
+		// done.
+		for {
+			if _, ok := e.duplicates.get(sha256.Sum256(data)); ok {
+				break
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+	close(e.work)
+
+	if calls != 1 {
+		t.Errorf("detector called %d times, want 1", calls)
+	}
+	if duplicates := e.DuplicateChunks(); duplicates != 4 {
+		t.Errorf("DuplicateChunks() = %d, want 4", duplicates)
+	}
+}
+
+func TestChunkResults_evicts(t *testing.T) {
+	c := newChunkResults(2)
+	keys := [][sha256.Size]byte{sha256.Sum256([]byte("a")), sha256.Sum256([]byte("b")), sha256.Sum256([]byte("c"))}
+	c.set(keys[0], []detectors.Result{{Raw: []byte("secret")}})
+	c.set(keys[1], nil)
+	// Getting a chunk makes it the most recently used, so the other one is
+	// forgotten for the third.
+	if results, ok := c.get(keys[0]); !ok || len(results) != 1 {
+		t.Fatalf("get(a) = %v, %t", results, ok)
+	}
+	c.set(keys[2], nil)
+	if _, ok := c.get(keys[1]); ok {
+		t.Error("b is remembered past the limit")
+	}
+	for _, key := range []int{0, 2} {
+		if _, ok := c.get(keys[key]); !ok {
+			t.Errorf("chunk %d is forgotten", key)
+		}
+	}
+	if len(c.entries) != 2 || c.recent.Len() != 2 {
+		t.Errorf("%d entries and %d listed, want 2", len(c.entries), c.recent.Len())
+	}
+}
+
+func TestEngine_Stats(t *testing.T) {
+	e := &Engine{started: time.Now().Add(-time.Minute), detectorTimeouts: map[string]int{}}
+	e.countResult(detectors.Result{DetectorType: detectorspb.DetectorType_AWS, Verified: true})
+	e.countResult(detectors.Result{DetectorType: detectorspb.DetectorType_AWS})
+	e.countResult(detectors.Result{DetectorType: detectorspb.DetectorType_Github})
+	e.finished = e.started.Add(90 * time.Second)
+
+	stats := e.Stats()
+	want := map[string]ResultStats{"AWS": {Verified: 1, Unverified: 1}, "Github": {Unverified: 1}}
+	if !reflect.DeepEqual(stats.Results, want) {
+		t.Errorf("Results = %v, want %v", stats.Results, want)
+	}
+	data, err := json.Marshal(stats)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"duration":"1m30s"`) || !strings.Contains(string(data), `"AWS":{"verified":1,"unverified":1}`) {
+		t.Errorf("json.Marshal() = %s", data)
+	}
+}
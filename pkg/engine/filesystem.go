@@ -1,14 +1,11 @@
 package engine
 
 import (
-	"runtime"
-
 	"github.com/go-errors/errors"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 
-	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
@@ -18,7 +15,11 @@ import (
 // ScanFileSystem scans a given file system.
 func (e *Engine) ScanFileSystem(ctx context.Context, c sources.Config) error {
 	connection := &sourcespb.Filesystem{
-		Directories: c.Directories,
+		Directories:    c.Directories,
+		Paths:          c.Paths,
+		ScanGitHistory: c.ScanGitHistory,
+		FollowSymlinks: c.FollowSymlinks,
+		UseGitignore:   c.UseGitignore,
 	}
 	var conn anypb.Any
 	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
@@ -28,18 +29,15 @@ func (e *Engine) ScanFileSystem(ctx context.Context, c sources.Config) error {
 	}
 
 	fileSystemSource := filesystem.Source{}
-	err = fileSystemSource.Init(ctx, "trufflehog - filesystem", 0, int64(sourcespb.SourceType_SOURCE_TYPE_FILESYSTEM), true, &conn, runtime.NumCPU())
+	concurrency := c.Concurrency
+	if concurrency == 0 {
+		concurrency = e.sourceConcurrency
+	}
+	err = fileSystemSource.Init(ctx, "trufflehog - filesystem", 0, int64(sourcespb.SourceType_SOURCE_TYPE_FILESYSTEM), true, &conn, concurrency)
 	if err != nil {
 		return errors.WrapPrefix(err, "could not init filesystem source", 0)
 	}
-	e.sourcesWg.Add(1)
-	go func() {
-		defer common.RecoverWithExit(ctx)
-		defer e.sourcesWg.Done()
-		err := fileSystemSource.Chunks(ctx, e.ChunksChan())
-		if err != nil {
-			logrus.WithError(err).Error("error scanning filesystem")
-		}
-	}()
+	fileSystemSource.WithFilter(c.Filter)
+	e.RunSource(ctx, "filesystem", &fileSystemSource)
 	return nil
 }
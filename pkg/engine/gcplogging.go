@@ -0,0 +1,73 @@
+package engine
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/go-errors/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/gcplogging"
+)
+
+// ScanGCPLogging scans the entries of GCP Cloud Logging.
+func (e *Engine) ScanGCPLogging(ctx context.Context, c sources.Config) error {
+	connection := &sourcespb.GCPLogging{
+		Resources:  c.Projects,
+		Filter:     c.Query,
+		MaxEntries: int64(c.MaxMessages),
+		Endpoint:   c.Endpoint,
+	}
+	if !c.Since.IsZero() {
+		connection.Since = timestamppb.New(c.Since)
+	}
+	if !c.Until.IsZero() {
+		connection.Until = timestamppb.New(c.Until)
+	}
+	switch {
+	case c.CloudCred:
+		if len(c.CredentialsFile) > 0 {
+			return fmt.Errorf("cannot use cloud credentials and a service account key together")
+		}
+		connection.Credential = &sourcespb.GCPLogging_CloudEnvironment{
+			CloudEnvironment: &credentialspb.CloudEnvironment{},
+		}
+	case len(c.CredentialsFile) > 0:
+		key, err := os.ReadFile(c.CredentialsFile)
+		if err != nil {
+			return errors.WrapPrefix(err, "could not read service account key", 0)
+		}
+		connection.Credential = &sourcespb.GCPLogging_JsonSa{
+			JsonSa: string(key),
+		}
+	default:
+		return errors.New("a service account key or cloud credentials are required")
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		logrus.WithError(err).Error("failed to marshal gcp logging connection")
+		return err
+	}
+
+	concurrency := c.Concurrency
+	if concurrency == 0 {
+		concurrency = e.sourceConcurrency
+	}
+	loggingSource := gcplogging.Source{}
+	err = loggingSource.Init(ctx, "trufflehog - gcp logging", 0, int64(sourcespb.SourceType_SOURCE_TYPE_GCP_LOGGING), true, &conn, concurrency)
+	if err != nil {
+		return errors.WrapPrefix(err, "failed to init gcp logging source", 0)
+	}
+
+	e.RunSource(ctx, "gcp-logging", &loggingSource)
+	return nil
+}
@@ -2,12 +2,10 @@ package engine
 
 import (
 	"fmt"
-	"runtime"
 
 	gogit "github.com/go-git/go-git/v5"
-	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/anypb"
 
-	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
@@ -21,6 +19,7 @@ func (e *Engine) ScanGit(ctx context.Context, c sources.Config) error {
 	opts := []git.ScanOption{
 		git.ScanOptionFilter(c.Filter),
 		git.ScanOptionLogOptions(logOptions),
+		git.ScanOptionTimeout(c.RepoTimeout),
 	}
 
 	repo, err := gogit.PlainOpenWithOptions(c.RepoPath, &gogit.PlainOpenOptions{DetectDotGit: true})
@@ -28,6 +27,15 @@ func (e *Engine) ScanGit(ctx context.Context, c sources.Config) error {
 		return fmt.Errorf("could not open repo: %s: %w", c.RepoPath, err)
 	}
 
+	if !c.Since.IsZero() {
+		logOptions.Since = &c.Since
+	}
+	if !c.Until.IsZero() {
+		logOptions.Until = &c.Until
+	}
+	if c.Author != "" {
+		opts = append(opts, git.ScanOptionAuthor(c.Author))
+	}
 	if c.MaxDepth != 0 {
 		opts = append(opts, git.ScanOptionMaxDepth(int64(c.MaxDepth)))
 	}
@@ -37,9 +45,28 @@ func (e *Engine) ScanGit(ctx context.Context, c sources.Config) error {
 	if c.HeadRef != "" {
 		opts = append(opts, git.ScanOptionHeadCommit(c.HeadRef))
 	}
+	if c.AllBranches {
+		opts = append(opts, git.ScanOptionAllBranches(true))
+	}
+	if c.IncludeTags {
+		opts = append(opts, git.ScanOptionIncludeTags(true))
+	}
+	if c.IncludeUnreachable {
+		opts = append(opts, git.ScanOptionIncludeUnreachable(true))
+	}
+	if c.RecurseSubmodules {
+		opts = append(opts, git.ScanOptionRecurseSubmodules(true))
+	}
+	if c.Blame {
+		opts = append(opts, git.ScanOptionBlame(true))
+	}
 	scanOptions := git.NewScanOptions(opts...)
 
-	gitSource := git.NewGit(sourcespb.SourceType_SOURCE_TYPE_GIT, 0, 0, "trufflehog - git", true, runtime.NumCPU(),
+	concurrency := c.Concurrency
+	if concurrency == 0 {
+		concurrency = e.sourceConcurrency
+	}
+	gitSource := git.NewGit(sourcespb.SourceType_SOURCE_TYPE_GIT, 0, 0, "trufflehog - git", true, concurrency,
 		func(file, email, commit, timestamp, repository string, line int64) *source_metadatapb.MetaData {
 			return &source_metadatapb.MetaData{
 				Data: &source_metadatapb.MetaData_Git{
@@ -55,14 +82,46 @@ func (e *Engine) ScanGit(ctx context.Context, c sources.Config) error {
 			}
 		})
 
-	e.sourcesWg.Add(1)
-	go func() {
-		defer common.RecoverWithExit(ctx)
-		defer e.sourcesWg.Done()
-		err := gitSource.ScanRepo(ctx, repo, c.RepoPath, scanOptions, e.ChunksChan())
-		if err != nil {
-			logrus.WithError(err).Fatal("could not scan repo")
-		}
-	}()
+	e.RunSource(ctx, "git", &gitRepoSource{
+		git:         gitSource,
+		repo:        repo,
+		config:      c,
+		scanOptions: scanOptions,
+	})
+	return nil
+}
+
+// gitRepoSource is the source of the chunks of a local repository, scanned
+// like a hook would when its config has Staged or PushedRevs set.
+type gitRepoSource struct {
+	git         *git.Git
+	repo        *gogit.Repository
+	config      sources.Config
+	scanOptions *git.ScanOptions
+	sources.Progress
+}
+
+var _ sources.Source = (*gitRepoSource)(nil)
+
+func (s *gitRepoSource) Type() sourcespb.SourceType {
+	return sourcespb.SourceType_SOURCE_TYPE_GIT
+}
+
+func (s *gitRepoSource) SourceID() int64 { return 0 }
+
+func (s *gitRepoSource) JobID() int64 { return 0 }
+
+func (s *gitRepoSource) Init(context.Context, string, int64, int64, bool, *anypb.Any, int) error {
 	return nil
 }
+
+func (s *gitRepoSource) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) error {
+	switch {
+	case s.config.Staged:
+		return s.git.ScanStaged(ctx, s.repo, s.config.RepoPath, s.scanOptions, chunksChan)
+	case s.config.PushedRevs != nil:
+		return s.git.ScanPushed(ctx, s.repo, s.config.RepoPath, s.config.PushedRevs, s.scanOptions, chunksChan)
+	default:
+		return s.git.ScanRepo(ctx, s.repo, s.config.RepoPath, s.scanOptions, chunksChan)
+	}
+}
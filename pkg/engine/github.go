@@ -1,12 +1,16 @@
 package engine
 
 import (
+	"os"
+	"time"
+
 	"github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
-	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/github"
@@ -17,18 +21,75 @@ func (e *Engine) ScanGitHub(ctx context.Context, c sources.Config) error {
 	source := github.Source{}
 
 	connection := sourcespb.GitHub{
-		Endpoint:      c.Endpoint,
-		Organizations: c.Orgs,
-		Repositories:  c.Repos,
-		ScanUsers:     c.IncludeMembers,
-		IgnoreRepos:   c.ExcludeRepos,
-		IncludeRepos:  c.IncludeRepos,
-	}
-	if len(c.Token) > 0 {
+		Endpoint:                   c.Endpoint,
+		Organizations:              c.Orgs,
+		Repositories:               c.Repos,
+		ScanUsers:                  c.IncludeMembers,
+		IgnoreRepos:                c.ExcludeRepos,
+		IncludeRepos:               c.IncludeRepos,
+		IncludeGists:               c.IncludeGists,
+		Users:                      c.Users,
+		IncludeIssueComments:       c.IncludeIssueComments,
+		IncludePullRequestComments: c.IncludePullRequestComments,
+		IncludeWikis:               c.IncludeWikis,
+		IncludeReleases:            c.IncludeReleases,
+		MaxReleaseAssetSize:        c.MaxReleaseAssetSize,
+		UseGraphql:                 c.UseGraphQL,
+		Teams:                      c.Teams,
+		ExcludeArchived:            c.ExcludeArchived,
+		AllBranches:                c.AllBranches,
+		IncludeTags:                c.IncludeTags,
+		Blame:                      c.Blame,
+		Languages:                  c.Languages,
+		MaxRepoSize:                c.MaxRepoSize,
+		InsecureSkipVerifyTls:      c.InsecureSkipVerifyTLS,
+		StateFile:                  c.StateFile,
+		RepoTimeoutSeconds:         int64(c.RepoTimeout / time.Second),
+	}
+	pushedAfter := c.PushedAfter
+	if pushedAfter.IsZero() {
+		pushedAfter = c.Since
+	}
+	if !pushedAfter.IsZero() {
+		connection.PushedAfter = timestamppb.New(pushedAfter)
+	}
+	if c.CAPath != "" {
+		ca, err := os.ReadFile(c.CAPath)
+		if err != nil {
+			logrus.WithError(err).Error("failed to read CA certificate")
+			return err
+		}
+		connection.TlsCa = string(ca)
+	}
+	if c.SSHKeyPath != "" {
+		key, err := os.ReadFile(c.SSHKeyPath)
+		if err != nil {
+			logrus.WithError(err).Error("failed to read SSH key")
+			return err
+		}
+		connection.SshKey = string(key)
+		connection.SshKeyPassphrase = c.SSHKeyPassphrase
+	}
+	connection.HttpCredentials = c.HTTPCredentials
+	switch {
+	case len(c.AppID) > 0:
+		privateKey, err := os.ReadFile(c.KeyPath)
+		if err != nil {
+			logrus.WithError(err).Error("failed to read github app private key")
+			return err
+		}
+		connection.Credential = &sourcespb.GitHub_GithubApp{
+			GithubApp: &credentialspb.GitHubApp{
+				AppId:          c.AppID,
+				InstallationId: c.InstallationID,
+				PrivateKey:     string(privateKey),
+			},
+		}
+	case len(c.Token) > 0:
 		connection.Credential = &sourcespb.GitHub_Token{
 			Token: c.Token,
 		}
-	} else {
+	default:
 		connection.Credential = &sourcespb.GitHub_Unauthenticated{}
 	}
 	connection.IncludeForks = c.IncludeForks
@@ -44,14 +105,6 @@ func (e *Engine) ScanGitHub(ctx context.Context, c sources.Config) error {
 		return err
 	}
 
-	e.sourcesWg.Add(1)
-	go func() {
-		defer common.RecoverWithExit(ctx)
-		defer e.sourcesWg.Done()
-		err := source.Chunks(ctx, e.ChunksChan())
-		if err != nil {
-			logrus.WithError(err).Fatal("could not scan github")
-		}
-	}()
+	e.RunSource(ctx, "github", &source)
 	return nil
 }
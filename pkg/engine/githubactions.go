@@ -0,0 +1,51 @@
+package engine
+
+import (
+	"github.com/go-errors/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/githubactions"
+)
+
+// ScanGitHubActions scans the workflow run logs and artifacts of GitHub repositories.
+func (e *Engine) ScanGitHubActions(ctx context.Context, c sources.Config) error {
+	connection := &sourcespb.GitHubActions{
+		Endpoint:      c.Endpoint,
+		Repositories:  c.Repos,
+		Organizations: c.Orgs,
+		IgnoreRepos:   c.ExcludeRepos,
+		MaxRuns:       int64(c.MaxBuilds),
+		SkipArtifacts: c.SkipArtifacts,
+	}
+	if len(c.Token) == 0 {
+		return errors.New("a GitHub token is required")
+	}
+	connection.Credential = &sourcespb.GitHubActions_Token{
+		Token: c.Token,
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		logrus.WithError(err).Error("failed to marshal github actions connection")
+		return err
+	}
+
+	concurrency := c.Concurrency
+	if concurrency == 0 {
+		concurrency = e.sourceConcurrency
+	}
+	githubActionsSource := githubactions.Source{}
+	err = githubActionsSource.Init(ctx, "trufflehog - github actions", 0, int64(sourcespb.SourceType_SOURCE_TYPE_GITHUB_ACTIONS), true, &conn, concurrency)
+	if err != nil {
+		return errors.WrapPrefix(err, "failed to init github actions source", 0)
+	}
+
+	e.RunSource(ctx, "github-actions", &githubActionsSource)
+	return nil
+}
@@ -2,15 +2,15 @@ package engine
 
 import (
 	"fmt"
-	"runtime"
+	"os"
 
 	"github.com/go-errors/errors"
 	gogit "github.com/go-git/go-git/v5"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
-	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
@@ -24,10 +24,44 @@ func (e *Engine) ScanGitLab(ctx context.Context, c sources.Config) error {
 	opts := []git.ScanOption{
 		git.ScanOptionFilter(c.Filter),
 		git.ScanOptionLogOptions(logOptions),
+		git.ScanOptionAllBranches(c.AllBranches),
+		git.ScanOptionIncludeTags(c.IncludeTags),
+		git.ScanOptionBlame(c.Blame),
+		git.ScanOptionTimeout(c.RepoTimeout),
 	}
 	scanOptions := git.NewScanOptions(opts...)
 
-	connection := &sourcespb.GitLab{}
+	connection := &sourcespb.GitLab{
+		Groups:                c.Groups,
+		IncludeRepos:          c.IncludeRepos,
+		IgnoreRepos:           c.ExcludeRepos,
+		MergeRequestIid:       int64(c.MergeRequest),
+		MergeRequestProject:   c.MergeRequestProject,
+		IncludeSnippets:       c.IncludeSnippets,
+		IncludeWikis:          c.IncludeWikis,
+		IncludeJobLogs:        c.IncludeJobLogs,
+		MaxJobs:               int64(c.MaxJobs),
+		IncludeIssueComments:  c.IncludeIssueComments,
+		IncludeMrComments:     c.IncludeMergeRequestComments,
+		InsecureSkipVerifyTls: c.InsecureSkipVerifyTLS,
+		StateFile:             c.StateFile,
+	}
+	if c.CAPath != "" {
+		ca, err := os.ReadFile(c.CAPath)
+		if err != nil {
+			return errors.WrapPrefix(err, "could not open TLS CA file", 0)
+		}
+		connection.TlsCa = string(ca)
+	}
+	if c.SSHKeyPath != "" {
+		key, err := os.ReadFile(c.SSHKeyPath)
+		if err != nil {
+			return errors.WrapPrefix(err, "could not open SSH key file", 0)
+		}
+		connection.SshKey = string(key)
+		connection.SshKeyPassphrase = c.SSHKeyPassphrase
+	}
+	connection.HttpCredentials = c.HTTPCredentials
 
 	switch {
 	case len(c.Token) > 0:
@@ -38,6 +72,14 @@ func (e *Engine) ScanGitLab(ctx context.Context, c sources.Config) error {
 		return fmt.Errorf("must provide token")
 	}
 
+	jobLogsSince := c.JobLogsSince
+	if jobLogsSince.IsZero() {
+		jobLogsSince = c.Since
+	}
+	if !jobLogsSince.IsZero() {
+		connection.JobLogsSince = timestamppb.New(jobLogsSince)
+	}
+
 	if len(c.Endpoint) > 0 {
 		connection.Endpoint = c.Endpoint
 	}
@@ -54,20 +96,16 @@ func (e *Engine) ScanGitLab(ctx context.Context, c sources.Config) error {
 	}
 
 	gitlabSource := gitlab.Source{}
-	err = gitlabSource.Init(ctx, "trufflehog - gitlab", 0, int64(sourcespb.SourceType_SOURCE_TYPE_GITLAB), true, &conn, runtime.NumCPU())
+	concurrency := c.Concurrency
+	if concurrency == 0 {
+		concurrency = e.sourceConcurrency
+	}
+	err = gitlabSource.Init(ctx, "trufflehog - gitlab", 0, int64(sourcespb.SourceType_SOURCE_TYPE_GITLAB), true, &conn, concurrency)
 	if err != nil {
 		return errors.WrapPrefix(err, "could not init GitLab source", 0)
 	}
 	gitlabSource.WithScanOptions(scanOptions)
 
-	e.sourcesWg.Add(1)
-	go func() {
-		defer common.RecoverWithExit(ctx)
-		defer e.sourcesWg.Done()
-		err := gitlabSource.Chunks(ctx, e.ChunksChan())
-		if err != nil {
-			logrus.WithError(err).Error("error scanning GitLab")
-		}
-	}()
+	e.RunSource(ctx, "gitlab", &gitlabSource)
 	return nil
 }
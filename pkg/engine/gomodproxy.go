@@ -0,0 +1,60 @@
+package engine
+
+import (
+	"github.com/go-errors/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/gomodproxy"
+)
+
+// ScanGoModuleProxy scans the modules served by a Go module proxy.
+func (e *Engine) ScanGoModuleProxy(ctx context.Context, c sources.Config) error {
+	connection := &sourcespb.GoModuleProxy{
+		Endpoint:    c.Endpoint,
+		Modules:     c.Packages,
+		MaxVersions: int64(c.MaxVersions),
+	}
+	switch {
+	case len(c.Username) > 0:
+		connection.Credential = &sourcespb.GoModuleProxy_BasicAuth{
+			BasicAuth: &credentialspb.BasicAuth{
+				Username: c.Username,
+				Password: c.Token,
+			},
+		}
+	case len(c.Token) > 0:
+		connection.Credential = &sourcespb.GoModuleProxy_Token{
+			Token: c.Token,
+		}
+	default:
+		connection.Credential = &sourcespb.GoModuleProxy_Unauthenticated{
+			Unauthenticated: &credentialspb.Unauthenticated{},
+		}
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		logrus.WithError(err).Error("failed to marshal Go module proxy connection")
+		return err
+	}
+
+	concurrency := c.Concurrency
+	if concurrency == 0 {
+		concurrency = e.sourceConcurrency
+	}
+	goproxySource := gomodproxy.Source{}
+	err = goproxySource.Init(ctx, "trufflehog - goproxy", 0, int64(sourcespb.SourceType_SOURCE_TYPE_GO_MODULE_PROXY), true, &conn, concurrency)
+	if err != nil {
+		return errors.WrapPrefix(err, "failed to init Go module proxy source", 0)
+	}
+
+	e.RunSource(ctx, "goproxy", &goproxySource)
+	return nil
+}
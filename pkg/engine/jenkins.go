@@ -0,0 +1,61 @@
+package engine
+
+import (
+	"github.com/go-errors/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/jenkins"
+)
+
+// ScanJenkins scans the jobs, builds and artifacts of a Jenkins instance.
+func (e *Engine) ScanJenkins(ctx context.Context, c sources.Config) error {
+	connection := &sourcespb.Jenkins{
+		Endpoint:              c.Endpoint,
+		Folders:               c.Folders,
+		IgnoreFolders:         c.ExcludeFolders,
+		MaxBuilds:             int64(c.MaxBuilds),
+		SkipArtifacts:         c.SkipArtifacts,
+		InsecureSkipVerifyTls: c.InsecureSkipVerifyTLS,
+	}
+	switch {
+	case len(c.Username) > 0:
+		connection.Credential = &sourcespb.Jenkins_BasicAuth{
+			BasicAuth: &credentialspb.BasicAuth{
+				Username: c.Username,
+				Password: c.Token,
+			},
+		}
+	case len(c.Token) > 0:
+		return errors.New("a username is required to authenticate with a Jenkins API token")
+	default:
+		connection.Credential = &sourcespb.Jenkins_Unauthenticated{
+			Unauthenticated: &credentialspb.Unauthenticated{},
+		}
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		logrus.WithError(err).Error("failed to marshal jenkins connection")
+		return err
+	}
+
+	concurrency := c.Concurrency
+	if concurrency == 0 {
+		concurrency = e.sourceConcurrency
+	}
+	jenkinsSource := jenkins.Source{}
+	err = jenkinsSource.Init(ctx, "trufflehog - jenkins", 0, int64(sourcespb.SourceType_SOURCE_TYPE_JENKINS), true, &conn, concurrency)
+	if err != nil {
+		return errors.WrapPrefix(err, "failed to init jenkins source", 0)
+	}
+
+	e.RunSource(ctx, "jenkins", &jenkinsSource)
+	return nil
+}
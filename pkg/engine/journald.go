@@ -0,0 +1,51 @@
+package engine
+
+import (
+	"github.com/go-errors/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/journald"
+)
+
+// ScanJournald scans the entries of the systemd journal.
+func (e *Engine) ScanJournald(ctx context.Context, c sources.Config) error {
+	connection := &sourcespb.Journald{
+		Units: c.Units,
+		Tail:  c.Tail,
+	}
+	if !c.Since.IsZero() {
+		connection.Since = timestamppb.New(c.Since)
+	}
+	if !c.Until.IsZero() {
+		connection.Until = timestamppb.New(c.Until)
+	}
+	switch len(c.Directories) {
+	case 0:
+	case 1:
+		connection.Directory = c.Directories[0]
+	default:
+		return errors.New("only one journal directory can be scanned")
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		logrus.WithError(err).Error("failed to marshal journald connection")
+		return err
+	}
+
+	journaldSource := journald.Source{}
+	err = journaldSource.Init(ctx, "trufflehog - journald", 0, int64(sourcespb.SourceType_SOURCE_TYPE_JOURNALD), true, &conn, c.Concurrency)
+	if err != nil {
+		return errors.WrapPrefix(err, "failed to init journald source", 0)
+	}
+
+	e.RunSource(ctx, "journald", &journaldSource)
+	return nil
+}
@@ -0,0 +1,103 @@
+package engine
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/go-errors/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/kafka"
+)
+
+// ScanKafka scans the messages of Kafka topics.
+func (e *Engine) ScanKafka(ctx context.Context, c sources.Config) error {
+	if len(c.Brokers) == 0 {
+		return errors.New("at least one broker is required")
+	}
+	connection := &sourcespb.Kafka{
+		Brokers:               c.Brokers,
+		Topics:                c.Topics,
+		StartOffset:           c.StartOffset,
+		EndOffset:             c.EndOffset,
+		Tls:                   c.TLS,
+		InsecureSkipVerifyTls: c.InsecureSkipVerifyTLS,
+		Tail:                  c.Tail,
+		MaxMessages:           int64(c.MaxMessages),
+	}
+	for _, partition := range c.Partitions {
+		p, err := strconv.ParseInt(partition, 10, 64)
+		if err != nil {
+			return errors.Errorf("invalid partition number %q", partition)
+		}
+		connection.Partitions = append(connection.Partitions, p)
+	}
+	if !c.Since.IsZero() {
+		connection.Since = timestamppb.New(c.Since)
+	}
+	if !c.Until.IsZero() {
+		connection.Until = timestamppb.New(c.Until)
+	}
+
+	credential := &credentialspb.BasicAuth{Username: c.Username, Password: c.Secret}
+	switch c.SASLMechanism {
+	case "":
+		connection.Credential = &sourcespb.Kafka_Unauthenticated{Unauthenticated: &credentialspb.Unauthenticated{}}
+	case "plain":
+		connection.Credential = &sourcespb.Kafka_SaslPlain{SaslPlain: credential}
+	case "scram-sha-256":
+		connection.Credential = &sourcespb.Kafka_SaslScramSha256{SaslScramSha256: credential}
+	case "scram-sha-512":
+		connection.Credential = &sourcespb.Kafka_SaslScramSha512{SaslScramSha512: credential}
+	default:
+		return errors.Errorf("unsupported SASL mechanism %q", c.SASLMechanism)
+	}
+
+	if c.CAPath != "" {
+		ca, err := os.ReadFile(c.CAPath)
+		if err != nil {
+			return errors.WrapPrefix(err, "could not open TLS CA file", 0)
+		}
+		connection.TlsCa = string(ca)
+	}
+	if c.CertPath != "" && c.KeyPath != "" {
+		cert, err := os.ReadFile(c.CertPath)
+		if err != nil {
+			return errors.WrapPrefix(err, "could not open TLS cert file", 0)
+		}
+		connection.TlsCert = string(cert)
+
+		key, err := os.ReadFile(c.KeyPath)
+		if err != nil {
+			return errors.WrapPrefix(err, "could not open TLS key file", 0)
+		}
+		connection.TlsKey = string(key)
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		logrus.WithError(err).Error("failed to marshal kafka connection")
+		return err
+	}
+
+	concurrency := c.Concurrency
+	if concurrency == 0 {
+		concurrency = e.sourceConcurrency
+	}
+	kafkaSource := kafka.Source{}
+	err = kafkaSource.Init(ctx, "trufflehog - kafka", 0, int64(sourcespb.SourceType_SOURCE_TYPE_KAFKA), true, &conn, concurrency)
+	if err != nil {
+		return errors.WrapPrefix(err, "failed to init kafka source", 0)
+	}
+
+	e.RunSource(ctx, "kafka", &kafkaSource)
+	return nil
+}
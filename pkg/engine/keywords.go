@@ -0,0 +1,87 @@
+package engine
+
+import (
+	"strings"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/decoders"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// keywordMatcher finds the detectors whose keywords are in the data of a
+// chunk, which are the only ones the chunk is scanned with. By default the
+// keywords of each detector are searched for one after the other, and builds
+// with the hyperscan tag search for all of them at once with Hyperscan. Either
+// way, the detectors then match their regular expressions themselves.
+type keywordMatcher interface {
+	// Match returns whether the keywords of each detector of the engine were
+	// found in data, by index of the detectors.
+	Match(data []byte) map[bool][]bool
+}
+
+// containsMatcher searches for the keywords of each detector in turn.
+type containsMatcher struct {
+	keywords map[bool][][]string
+}
+
+func newContainsMatcher(detectorSets map[bool][]detectors.Detector) *containsMatcher {
+	return &containsMatcher{keywords: lowerKeywords(detectorSets)}
+}
+
+func (m *containsMatcher) Match(data []byte) map[bool][]bool {
+	dataLower := strings.ToLower(string(data))
+	matches := make(map[bool][]bool, len(m.keywords))
+	for verify, keywords := range m.keywords {
+		matches[verify] = make([]bool, len(keywords))
+		for i, detectorKeywords := range keywords {
+			for _, kw := range detectorKeywords {
+				if strings.Contains(dataLower, kw) {
+					matches[verify][i] = true
+					break
+				}
+			}
+		}
+	}
+	return matches
+}
+
+// lowerKeywords returns the keywords of the detectors in lower case, as they
+// are matched case-insensitively.
+func lowerKeywords(detectorSets map[bool][]detectors.Detector) map[bool][][]string {
+	keywords := make(map[bool][][]string, len(detectorSets))
+	for verify, detectorsSet := range detectorSets {
+		keywords[verify] = make([][]string, len(detectorsSet))
+		for i, detector := range detectorsSet {
+			for _, kw := range detector.Keywords() {
+				keywords[verify][i] = append(keywords[verify][i], strings.ToLower(kw))
+			}
+		}
+	}
+	return keywords
+}
+
+// DetectorsWithKeywords returns the detectors of ds having a keyword in data,
+// as it is decoded by decs, which are the only ones that can find results in
+// it. Scans of data known in advance, such as the staged changes of a
+// pre-commit hook, start faster with them than with all the detectors.
+func DetectorsWithKeywords(data []byte, decs []decoders.Decoder, ds []detectors.Detector) []detectors.Detector {
+	var decoded []string
+	for _, decoder := range decs {
+		if chunk := decoder.FromChunk(&sources.Chunk{Data: data}); chunk != nil {
+			decoded = append(decoded, strings.ToLower(string(chunk.Data)))
+		}
+	}
+	var found []detectors.Detector
+	for _, detector := range ds {
+	keywords:
+		for _, kw := range detector.Keywords() {
+			for _, data := range decoded {
+				if strings.Contains(data, strings.ToLower(kw)) {
+					found = append(found, detector)
+					break keywords
+				}
+			}
+		}
+	}
+	return found
+}
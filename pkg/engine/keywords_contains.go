@@ -0,0 +1,11 @@
+//go:build !hyperscan
+
+package engine
+
+import (
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+)
+
+func newKeywordMatcher(detectorSets map[bool][]detectors.Detector, concurrency int) (keywordMatcher, error) {
+	return newContainsMatcher(detectorSets), nil
+}
@@ -0,0 +1,15 @@
+//go:build hyperscan
+
+#include <stdint.h>
+#include <hs/hs.h>
+
+#include "_cgo_export.h"
+
+static int onMatch(unsigned int id, unsigned long long from, unsigned long long to, unsigned int flags, void *context) {
+	return keywordFound(id, from, to, flags, (uintptr_t)context);
+}
+
+// scanKeywords calls keywordFound with found for each keyword in data.
+hs_error_t scanKeywords(const hs_database_t *db, const char *data, unsigned int length, hs_scratch_t *scratch, uintptr_t found) {
+	return hs_scan(db, data, length, 0, scratch, onMatch, (void *)found);
+}
@@ -0,0 +1,148 @@
+//go:build hyperscan
+
+package engine
+
+/*
+#cgo LDFLAGS: -lhs
+#include <stdint.h>
+#include <stdlib.h>
+#include <hs/hs.h>
+
+hs_error_t scanKeywords(const hs_database_t *db, const char *data, unsigned int length, hs_scratch_t *scratch, uintptr_t found);
+*/
+import "C"
+
+import (
+	"fmt"
+	"runtime/cgo"
+	"sync/atomic"
+	"unsafe"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+)
+
+// hyperscanMatcher is a keyword prefilter searching for the keywords of every
+// detector at once, with a Hyperscan database of all of them. It requires
+// libhs. Only the keywords are compiled into the database: the detectors
+// still match their own regular expressions, which they don't expose, with
+// Go's regexp in the chunks having their keywords.
+type hyperscanMatcher struct {
+	db *C.hs_database_t
+	// scratches are the scratch spaces of the scans, one per worker.
+	scratches chan *C.hs_scratch_t
+	// refs are the detectors by ID of their keywords in the database.
+	refs []detectorRef
+	// always are the detectors with an empty keyword, found in any data.
+	always []detectorRef
+	sizes  map[bool]int
+	// fallback searches for the keywords of the data Hyperscan fails to
+	// scan, whose failures are counted by scanErrors.
+	fallback   *containsMatcher
+	scanErrors uint64
+}
+
+// detectorRef is the index of a detector in the detectors of the engine.
+type detectorRef struct {
+	verify bool
+	index  int
+}
+
+func newKeywordMatcher(detectorSets map[bool][]detectors.Detector, concurrency int) (keywordMatcher, error) {
+	m := &hyperscanMatcher{sizes: map[bool]int{}, fallback: newContainsMatcher(detectorSets)}
+	var patterns []*C.char
+	var ids, flags []C.uint
+	var lengths []C.size_t
+	defer func() {
+		for _, p := range patterns {
+			C.free(unsafe.Pointer(p))
+		}
+	}()
+	for verify, keywords := range lowerKeywords(detectorSets) {
+		m.sizes[verify] = len(keywords)
+		for i, detectorKeywords := range keywords {
+			ref := detectorRef{verify: verify, index: i}
+			id := C.uint(len(m.refs))
+			m.refs = append(m.refs, ref)
+			for _, kw := range detectorKeywords {
+				if kw == "" {
+					m.always = append(m.always, ref)
+					continue
+				}
+				patterns = append(patterns, C.CString(kw))
+				lengths = append(lengths, C.size_t(len(kw)))
+				ids = append(ids, id)
+				flags = append(flags, C.HS_FLAG_CASELESS|C.HS_FLAG_SINGLEMATCH)
+			}
+		}
+	}
+	if len(patterns) == 0 {
+		return m, nil
+	}
+
+	// The arrays passed to Hyperscan must not hold Go pointers.
+	cPatterns := (**C.char)(C.malloc(C.size_t(len(patterns)) * C.size_t(unsafe.Sizeof(uintptr(0)))))
+	defer C.free(unsafe.Pointer(cPatterns))
+	copy(unsafe.Slice(cPatterns, len(patterns)), patterns)
+	var compileErr *C.hs_compile_error_t
+	if C.hs_compile_lit_multi(cPatterns, &flags[0], &ids[0], &lengths[0], C.uint(len(patterns)), C.HS_MODE_BLOCK, nil, &m.db, &compileErr) != C.HS_SUCCESS {
+		defer C.hs_free_compile_error(compileErr)
+		return nil, fmt.Errorf("could not compile the keywords of the detectors: %s", C.GoString(compileErr.message))
+	}
+
+	var scratch *C.hs_scratch_t
+	if err := C.hs_alloc_scratch(m.db, &scratch); err != C.HS_SUCCESS {
+		return nil, fmt.Errorf("could not allocate hyperscan scratch space: error %d", err)
+	}
+	m.scratches = make(chan *C.hs_scratch_t, concurrency)
+	m.scratches <- scratch
+	for i := 1; i < concurrency; i++ {
+		var clone *C.hs_scratch_t
+		if err := C.hs_clone_scratch(scratch, &clone); err != C.HS_SUCCESS {
+			return nil, fmt.Errorf("could not allocate hyperscan scratch space: error %d", err)
+		}
+		m.scratches <- clone
+	}
+	return m, nil
+}
+
+func (m *hyperscanMatcher) Match(data []byte) map[bool][]bool {
+	found := make([]bool, len(m.refs))
+	if m.db != nil && len(data) > 0 {
+		scratch := <-m.scratches
+		h := cgo.NewHandle(found)
+		err := C.scanKeywords(m.db, (*C.char)(unsafe.Pointer(&data[0])), C.uint(len(data)), scratch, C.uintptr_t(h))
+		h.Delete()
+		m.scratches <- scratch
+		if err != C.HS_SUCCESS {
+			// The keywords found before the error may be missing some, so
+			// they are all searched for again.
+			if atomic.AddUint64(&m.scanErrors, 1) == 1 {
+				logrus.Errorf("could not search for keywords with hyperscan: error %d, searching for them one after the other", err)
+			}
+			return m.fallback.Match(data)
+		}
+	}
+
+	matches := make(map[bool][]bool, len(m.sizes))
+	for verify, size := range m.sizes {
+		matches[verify] = make([]bool, size)
+	}
+	for id, ok := range found {
+		if ok {
+			ref := m.refs[id]
+			matches[ref.verify][ref.index] = true
+		}
+	}
+	for _, ref := range m.always {
+		matches[ref.verify][ref.index] = true
+	}
+	return matches
+}
+
+//export keywordFound
+func keywordFound(id C.uint, from, to C.ulonglong, flags C.uint, found C.uintptr_t) C.int {
+	cgo.Handle(found).Value().([]bool)[id] = true
+	return 0
+}
@@ -0,0 +1,46 @@
+package engine
+
+import (
+	stdctx "context"
+	"reflect"
+	"testing"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/decoders"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+)
+
+// keywordDetector is a detector with keywords that finds nothing.
+type keywordDetector []string
+
+func (d keywordDetector) Keywords() []string { return d }
+
+func (d keywordDetector) FromData(ctx stdctx.Context, verify bool, data []byte) ([]detectors.Result, error) {
+	return nil, nil
+}
+
+func TestKeywordMatcher(t *testing.T) {
+	detectorSets := map[bool][]detectors.Detector{
+		true:  {keywordDetector{"AKIA"}, keywordDetector{"ghp_", "github"}, keywordDetector{}, keywordDetector{"xoxb"}},
+		false: {keywordDetector{""}, keywordDetector{"Stripe"}},
+	}
+	m, err := newKeywordMatcher(detectorSets, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := m.Match([]byte("token akia123 and GitHub and stripe"))
+	want := map[bool][]bool{true: {true, true, false, false}, false: {true, true}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Match() = %v, want %v", got, want)
+	}
+}
+
+func TestDetectorsWithKeywords(t *testing.T) {
+	aws, github, slack := keywordDetector{"AKIA"}, keywordDetector{"ghp_", "github"}, keywordDetector{"xoxb"}
+	// The Slack keyword is only found once the data is base64 decoded.
+	data := []byte("key = akia123\ntoken = eG94Yi0xMjM0NTY3ODkwMTIzNDU2Nzg=\n")
+	got := DetectorsWithKeywords(data, decoders.DefaultDecoders(), []detectors.Detector{aws, github, slack})
+	want := []detectors.Detector{aws, slack}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DetectorsWithKeywords() = %v, want %v", got, want)
+	}
+}
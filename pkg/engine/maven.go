@@ -0,0 +1,62 @@
+package engine
+
+import (
+	"github.com/go-errors/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/maven"
+)
+
+// ScanMaven scans the artifacts of a Maven repository.
+func (e *Engine) ScanMaven(ctx context.Context, c sources.Config) error {
+	connection := &sourcespb.Maven{
+		Endpoint:        c.Endpoint,
+		Groups:          c.Groups,
+		Artifacts:       c.Packages,
+		IgnoreArtifacts: c.ExcludePackages,
+		MaxVersions:     int64(c.MaxVersions),
+	}
+	switch {
+	case len(c.Username) > 0:
+		connection.Credential = &sourcespb.Maven_BasicAuth{
+			BasicAuth: &credentialspb.BasicAuth{
+				Username: c.Username,
+				Password: c.Token,
+			},
+		}
+	case len(c.Token) > 0:
+		connection.Credential = &sourcespb.Maven_Token{
+			Token: c.Token,
+		}
+	default:
+		connection.Credential = &sourcespb.Maven_Unauthenticated{
+			Unauthenticated: &credentialspb.Unauthenticated{},
+		}
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		logrus.WithError(err).Error("failed to marshal Maven connection")
+		return err
+	}
+
+	concurrency := c.Concurrency
+	if concurrency == 0 {
+		concurrency = e.sourceConcurrency
+	}
+	mavenSource := maven.Source{}
+	err = mavenSource.Init(ctx, "trufflehog - maven", 0, int64(sourcespb.SourceType_SOURCE_TYPE_MAVEN), true, &conn, concurrency)
+	if err != nil {
+		return errors.WrapPrefix(err, "failed to init Maven source", 0)
+	}
+
+	e.RunSource(ctx, "maven", &mavenSource)
+	return nil
+}
@@ -0,0 +1,50 @@
+package engine
+
+import (
+	"github.com/go-errors/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/mongodb"
+)
+
+// ScanMongoDB scans the string fields of the documents in a MongoDB deployment.
+func (e *Engine) ScanMongoDB(ctx context.Context, c sources.Config) error {
+	if len(c.ConnectionString) == 0 {
+		return errors.New("a connection string is required")
+	}
+	connection := &sourcespb.MongoDB{
+		Credential: &sourcespb.MongoDB_ConnectionString{
+			ConnectionString: c.ConnectionString,
+		},
+		Databases:         c.Databases,
+		Collections:       c.Collections,
+		IgnoreCollections: c.ExcludeCollections,
+		MaxDepth:          int64(c.MaxDepth),
+		MaxDocuments:      int64(c.MaxRows),
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		logrus.WithError(err).Error("failed to marshal mongodb connection")
+		return err
+	}
+
+	concurrency := c.Concurrency
+	if concurrency == 0 {
+		concurrency = e.sourceConcurrency
+	}
+	mongodbSource := mongodb.Source{}
+	err = mongodbSource.Init(ctx, "trufflehog - mongodb", 0, int64(sourcespb.SourceType_SOURCE_TYPE_MONGODB), true, &conn, concurrency)
+	if err != nil {
+		return errors.WrapPrefix(err, "failed to init mongodb source", 0)
+	}
+
+	e.RunSource(ctx, "mongodb", &mongodbSource)
+	return nil
+}
@@ -0,0 +1,50 @@
+package engine
+
+import (
+	"github.com/go-errors/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/mysql"
+)
+
+// ScanMySQL scans the string columns of MySQL and MariaDB databases.
+func (e *Engine) ScanMySQL(ctx context.Context, c sources.Config) error {
+	if len(c.ConnectionString) == 0 {
+		return errors.New("a connection string is required")
+	}
+	connection := &sourcespb.MySQL{
+		Credential: &sourcespb.MySQL_ConnectionString{
+			ConnectionString: c.ConnectionString,
+		},
+		Databases:     c.Databases,
+		Tables:        c.Tables,
+		IgnoreTables:  c.ExcludeTables,
+		MaxRows:       int64(c.MaxRows),
+		SamplePercent: c.SamplePercent,
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		logrus.WithError(err).Error("failed to marshal mysql connection")
+		return err
+	}
+
+	concurrency := c.Concurrency
+	if concurrency == 0 {
+		concurrency = e.sourceConcurrency
+	}
+	mysqlSource := mysql.Source{}
+	err = mysqlSource.Init(ctx, "trufflehog - mysql", 0, int64(sourcespb.SourceType_SOURCE_TYPE_MYSQL), true, &conn, concurrency)
+	if err != nil {
+		return errors.WrapPrefix(err, "failed to init mysql source", 0)
+	}
+
+	e.RunSource(ctx, "mysql", &mysqlSource)
+	return nil
+}
@@ -0,0 +1,53 @@
+package engine
+
+import (
+	"github.com/go-errors/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/npm"
+)
+
+// ScanNPM scans the published packages of an npm registry.
+func (e *Engine) ScanNPM(ctx context.Context, c sources.Config) error {
+	connection := &sourcespb.NPM{
+		Endpoint:    c.Endpoint,
+		Packages:    c.Packages,
+		Scopes:      c.Orgs,
+		MaxVersions: int64(c.MaxVersions),
+	}
+	if len(c.Token) > 0 {
+		connection.Credential = &sourcespb.NPM_Token{
+			Token: c.Token,
+		}
+	} else {
+		connection.Credential = &sourcespb.NPM_Unauthenticated{
+			Unauthenticated: &credentialspb.Unauthenticated{},
+		}
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		logrus.WithError(err).Error("failed to marshal npm connection")
+		return err
+	}
+
+	concurrency := c.Concurrency
+	if concurrency == 0 {
+		concurrency = e.sourceConcurrency
+	}
+	npmSource := npm.Source{}
+	err = npmSource.Init(ctx, "trufflehog - npm", 0, int64(sourcespb.SourceType_SOURCE_TYPE_NPM), true, &conn, concurrency)
+	if err != nil {
+		return errors.WrapPrefix(err, "failed to init npm source", 0)
+	}
+
+	e.RunSource(ctx, "npm", &npmSource)
+	return nil
+}
@@ -0,0 +1,50 @@
+package engine
+
+import (
+	"github.com/go-errors/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/postgres"
+)
+
+// ScanPostgres scans the textual columns of a PostgreSQL database.
+func (e *Engine) ScanPostgres(ctx context.Context, c sources.Config) error {
+	if len(c.ConnectionString) == 0 {
+		return errors.New("a connection string is required")
+	}
+	connection := &sourcespb.Postgres{
+		Credential: &sourcespb.Postgres_ConnectionString{
+			ConnectionString: c.ConnectionString,
+		},
+		Schemas:       c.Schemas,
+		Tables:        c.Tables,
+		IgnoreTables:  c.ExcludeTables,
+		MaxRows:       int64(c.MaxRows),
+		SamplePercent: c.SamplePercent,
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		logrus.WithError(err).Error("failed to marshal postgres connection")
+		return err
+	}
+
+	concurrency := c.Concurrency
+	if concurrency == 0 {
+		concurrency = e.sourceConcurrency
+	}
+	postgresSource := postgres.Source{}
+	err = postgresSource.Init(ctx, "trufflehog - postgres", 0, int64(sourcespb.SourceType_SOURCE_TYPE_POSTGRES), true, &conn, concurrency)
+	if err != nil {
+		return errors.WrapPrefix(err, "failed to init postgres source", 0)
+	}
+
+	e.RunSource(ctx, "postgres", &postgresSource)
+	return nil
+}
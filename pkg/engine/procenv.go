@@ -0,0 +1,43 @@
+package engine
+
+import (
+	"github.com/go-errors/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/procenv"
+)
+
+// ScanProcessEnvironment scans the environment variables and command lines of
+// running processes.
+func (e *Engine) ScanProcessEnvironment(ctx context.Context, c sources.Config) error {
+	connection := &sourcespb.ProcessEnvironment{
+		Pids: c.PIDs,
+	}
+	if len(c.Directories) > 0 {
+		connection.ProcPath = c.Directories[0]
+	}
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		logrus.WithError(err).Error("failed to marshal procenv connection")
+		return err
+	}
+
+	procenvSource := procenv.Source{}
+	concurrency := c.Concurrency
+	if concurrency == 0 {
+		concurrency = e.sourceConcurrency
+	}
+	err = procenvSource.Init(ctx, "trufflehog - procenv", 0, int64(sourcespb.SourceType_SOURCE_TYPE_PROCESS_ENVIRONMENT), true, &conn, concurrency)
+	if err != nil {
+		return errors.WrapPrefix(err, "failed to init procenv source", 0)
+	}
+
+	e.RunSource(ctx, "procenv", &procenvSource)
+	return nil
+}
@@ -0,0 +1,75 @@
+package engine
+
+import (
+	"time"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// scannedSource is a source started by the engine.
+type scannedSource struct {
+	name    string
+	source  sources.Source
+	started time.Time
+}
+
+// SourceProgress is the progress of a source scanned by the engine.
+type SourceProgress struct {
+	// Name is the name of the source given to RunSource, which is the name
+	// of the command scanning it for the sources of the engine, like github.
+	Name    string
+	Started time.Time
+	// Message describes what the source is scanning, like a repository.
+	Message         string
+	PercentComplete int64
+	// SectionsCompleted and SectionsTotal count the top level items of the
+	// source, like repositories or buckets, once the source listed them.
+	SectionsCompleted int32
+	SectionsTotal     int32
+}
+
+// Done reports whether the source finished scanning.
+func (p SourceProgress) Done() bool {
+	return p.PercentComplete >= 100
+}
+
+// ETA estimates how long the source will take to finish from the pace of its
+// scan up to now. It is zero when the pace isn't known yet.
+func (p SourceProgress) ETA(now time.Time) time.Duration {
+	completed := float64(p.PercentComplete) / 100
+	if p.SectionsTotal > 0 {
+		completed = float64(p.SectionsCompleted) / float64(p.SectionsTotal)
+	}
+	if completed <= 0 || completed >= 1 {
+		return 0
+	}
+	elapsed := now.Sub(p.Started)
+	return time.Duration(float64(elapsed) * (1 - completed) / completed).Round(time.Second)
+}
+
+// addSource records a source started by the engine.
+func (e *Engine) addSource(name string, source sources.Source) {
+	e.scannedMu.Lock()
+	defer e.scannedMu.Unlock()
+	e.scanned = append(e.scanned, scannedSource{name: name, source: source, started: time.Now()})
+}
+
+// SourcesProgress returns the progress of the sources started by the engine,
+// in the order they were started.
+func (e *Engine) SourcesProgress() []SourceProgress {
+	e.scannedMu.Lock()
+	defer e.scannedMu.Unlock()
+	progress := make([]SourceProgress, 0, len(e.scanned))
+	for _, s := range e.scanned {
+		p := s.source.GetProgress().Snapshot()
+		progress = append(progress, SourceProgress{
+			Name:              s.name,
+			Started:           s.started,
+			Message:           p.Message,
+			PercentComplete:   p.PercentComplete,
+			SectionsCompleted: p.SectionsCompleted,
+			SectionsTotal:     p.SectionsRemaining,
+		})
+	}
+	return progress
+}
@@ -0,0 +1,41 @@
+package engine
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSourceProgress_ETA(t *testing.T) {
+	started := time.Date(2023, time.January, 31, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		progress SourceProgress
+		want     time.Duration
+	}{
+		{
+			name:     "sections",
+			progress: SourceProgress{Started: started, PercentComplete: 25, SectionsCompleted: 25, SectionsTotal: 100},
+			want:     30 * time.Minute,
+		},
+		{
+			name:     "percent",
+			progress: SourceProgress{Started: started, PercentComplete: 50},
+			want:     10 * time.Minute,
+		},
+		{
+			name:     "not started",
+			progress: SourceProgress{Started: started, SectionsTotal: 100},
+		},
+		{
+			name:     "done",
+			progress: SourceProgress{Started: started, PercentComplete: 100, SectionsCompleted: 100, SectionsTotal: 100},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.progress.ETA(started.Add(10 * time.Minute)); got != tt.want {
+				t.Errorf("ETA() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
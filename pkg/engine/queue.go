@@ -0,0 +1,346 @@
+package engine
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// Queue is a work queue of chunks shared by the machines of a distributed
+// scan: the engines of the scans push the chunks of their sources to it, and
+// the engines of the workers pop them to scan them with their detectors.
+// Chunks are delivered at least once: those popped by a worker that is
+// stopped before acking them are scanned again.
+type Queue interface {
+	// Push adds a chunk to the queue, waiting for room in it.
+	Push(ctx context.Context, chunk *sources.Chunk) error
+	// Pop waits for the next chunk of the queue. It returns a nil chunk once
+	// ctx is done.
+	Pop(ctx context.Context) (*sources.Chunk, error)
+	// Ack removes a chunk returned by Pop from the queue, once it was
+	// scanned.
+	Ack(ctx context.Context, chunk *sources.Chunk) error
+	Close() error
+}
+
+const (
+	// queuePollInterval is how often a full queue is checked for room, and
+	// the longest a pop waits before checking its context.
+	queuePollInterval = time.Second
+)
+
+// waitQueue waits for queuePollInterval, returning false if ctx is done
+// first.
+func waitQueue(ctx context.Context) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(queuePollInterval):
+		return true
+	}
+}
+
+// RedisQueue is a Queue kept in a Redis list. The chunks popped by a worker
+// are moved to a list of its own until they are acked, from which they are
+// pushed back to the queue when a worker with its ID starts again.
+type RedisQueue struct {
+	client *redis.Client
+	key    string
+	// processingKey is the list of the chunks popped by the worker and not
+	// acked yet, when the queue is opened by one.
+	processingKey string
+	// maxChunks is the number of chunks past which pushes wait for the
+	// workers, so the queue doesn't outgrow the memory of Redis, when set.
+	maxChunks int64
+
+	mu sync.Mutex
+	// popped has the records of the chunks popped and not acked yet, which
+	// are removed from the list of the worker by value.
+	popped map[*sources.Chunk]string
+}
+
+var _ Queue = (*RedisQueue)(nil)
+
+// pushScript pushes ARGV[1] to the list KEYS[1], unless the list holds
+// ARGV[2] elements already when ARGV[2] isn't 0. It returns 0 when it didn't
+// push, so checking the length of the list and pushing to it is atomic.
+var pushScript = redis.NewScript(`
+local max = tonumber(ARGV[2])
+if max > 0 and redis.call("LLEN", KEYS[1]) >= max then
+	return 0
+end
+return redis.call("LPUSH", KEYS[1], ARGV[1])
+`)
+
+// NewRedisQueue connects to the queue named name of the Redis instance at
+// url, such as redis://localhost:6379/0. Pushes wait while it holds
+// maxChunks chunks, unless maxChunks is 0. Workers popping from the queue
+// open it with their ID, unique among its workers, and scans pushing to it
+// with an empty one.
+func NewRedisQueue(url, name, worker string, maxChunks int64) (*RedisQueue, error) {
+	opts, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, fmt.Errorf("invalid queue URL: %w", err)
+	}
+	client := redis.NewClient(opts)
+	if err := client.Ping().Err(); err != nil {
+		_ = client.Close()
+		return nil, fmt.Errorf("could not connect to the queue: %w", err)
+	}
+	q := &RedisQueue{
+		client:    client,
+		key:       "trufflehog:queue:" + name,
+		maxChunks: maxChunks,
+		popped:    map[*sources.Chunk]string{},
+	}
+	if worker == "" {
+		return q, nil
+	}
+	q.processingKey = q.key + ":processing:" + worker
+	requeued, err := q.requeue()
+	if err != nil {
+		_ = client.Close()
+		return nil, fmt.Errorf("could not requeue the chunks of worker %s: %w", worker, err)
+	}
+	if requeued > 0 {
+		logrus.WithField("worker", worker).Infof("requeued %d chunks the worker didn't finish scanning", requeued)
+	}
+	return q, nil
+}
+
+// requeue pushes the chunks the previous run of the worker didn't ack back to
+// the queue.
+func (q *RedisQueue) requeue() (int, error) {
+	for n := 0; ; n++ {
+		err := q.client.RPopLPush(q.processingKey, q.key).Err()
+		if errors.Is(err, redis.Nil) {
+			return n, nil
+		}
+		if err != nil {
+			return n, err
+		}
+	}
+}
+
+func (q *RedisQueue) Push(ctx context.Context, chunk *sources.Chunk) error {
+	record, err := encodeChunk(chunk)
+	if err != nil {
+		return err
+	}
+	for {
+		pushed, err := pushScript.Run(q.client, []string{q.key}, record, q.maxChunks).Int64()
+		if err != nil {
+			return err
+		}
+		if pushed > 0 {
+			return nil
+		}
+		if !waitQueue(ctx) {
+			return ctx.Err()
+		}
+	}
+}
+
+func (q *RedisQueue) Pop(ctx context.Context) (*sources.Chunk, error) {
+	if q.processingKey == "" {
+		return nil, errors.New("the queue wasn't opened by a worker")
+	}
+	for !common.IsDone(ctx) {
+		record, err := q.client.BRPopLPush(q.key, q.processingKey, queuePollInterval).Result()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		chunk, err := decodeChunk([]byte(record))
+		if err != nil {
+			// The record would fail every worker it is requeued to.
+			_ = q.client.LRem(q.processingKey, 1, record).Err()
+			return nil, err
+		}
+		q.mu.Lock()
+		q.popped[chunk] = record
+		q.mu.Unlock()
+		return chunk, nil
+	}
+	return nil, nil
+}
+
+func (q *RedisQueue) Ack(ctx context.Context, chunk *sources.Chunk) error {
+	q.mu.Lock()
+	record, ok := q.popped[chunk]
+	delete(q.popped, chunk)
+	q.mu.Unlock()
+	if !ok {
+		return nil
+	}
+	return q.client.LRem(q.processingKey, 1, record).Err()
+}
+
+func (q *RedisQueue) Close() error {
+	return q.client.Close()
+}
+
+// queuedChunk is the representation of a chunk outside of the engine.
+type queuedChunk struct {
+	SourceName     string
+	SourceID       int64
+	SourceType     int32
+	SourceMetadata []byte
+	Data           []byte
+	Verify         bool
+}
+
+// encodeChunk serializes chunk, for decodeChunk to read it back.
+func encodeChunk(chunk *sources.Chunk) ([]byte, error) {
+	queued := queuedChunk{
+		SourceName: chunk.SourceName,
+		SourceID:   chunk.SourceID,
+		SourceType: int32(chunk.SourceType),
+		Data:       chunk.Data,
+		Verify:     chunk.Verify,
+	}
+	if chunk.SourceMetadata != nil {
+		metadata, err := proto.Marshal(chunk.SourceMetadata)
+		if err != nil {
+			return nil, err
+		}
+		queued.SourceMetadata = metadata
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(&queued); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeChunk(record []byte) (*sources.Chunk, error) {
+	var queued queuedChunk
+	if err := gob.NewDecoder(bytes.NewReader(record)).Decode(&queued); err != nil {
+		return nil, err
+	}
+	chunk := &sources.Chunk{
+		SourceName: queued.SourceName,
+		SourceID:   queued.SourceID,
+		SourceType: sourcespb.SourceType(queued.SourceType),
+		Data:       queued.Data,
+		Verify:     queued.Verify,
+	}
+	if queued.SourceMetadata != nil {
+		metadata := &source_metadatapb.MetaData{}
+		if err := proto.Unmarshal(queued.SourceMetadata, metadata); err != nil {
+			return nil, err
+		}
+		chunk.SourceMetadata = metadata
+	}
+	return chunk, nil
+}
+
+// ScanQueue scans the chunks popped from q as those of a source, until ctx is
+// done, acking each of them once scanned. It is how the workers of a
+// distributed scan get their chunks. Chunks are tracked by their address, so
+// the engine mustn't spool them with WithSpool.
+func (e *Engine) ScanQueue(ctx context.Context, q Queue) {
+	e.RunSource(ctx, "queue", &queueSource{queue: q, popped: &e.popped})
+}
+
+// queueSource is the source of the chunks popped from a queue.
+type queueSource struct {
+	queue Queue
+	// popped has the queue of each chunk popped and not scanned yet.
+	popped *sync.Map
+	sources.Progress
+}
+
+var _ sources.Source = (*queueSource)(nil)
+
+// Type is the zero type, as the chunks of the queue keep the types of the
+// sources they came from.
+func (s *queueSource) Type() sourcespb.SourceType {
+	return sourcespb.SourceType(0)
+}
+
+func (s *queueSource) SourceID() int64 { return 0 }
+
+func (s *queueSource) JobID() int64 { return 0 }
+
+func (s *queueSource) Init(context.Context, string, int64, int64, bool, *anypb.Any, int) error {
+	return nil
+}
+
+// Chunks pops the chunks of the queue until ctx is done. Failing to pop, such
+// as while the queue is unreachable, is retried rather than ending the scan.
+func (s *queueSource) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) error {
+	for {
+		chunk, err := s.queue.Pop(ctx)
+		if err != nil {
+			logrus.WithError(err).Error("could not pop chunk from the queue")
+			if !waitQueue(ctx) {
+				return nil
+			}
+			continue
+		}
+		if chunk == nil {
+			return nil
+		}
+		s.popped.Store(chunk, s.queue)
+		select {
+		case chunksChan <- chunk:
+		case <-ctx.Done():
+			s.popped.Delete(chunk)
+			return nil
+		}
+	}
+}
+
+// ackPopped acks chunk to the queue it was popped from by ScanQueue, if any,
+// once it was scanned. The chunks whose scan was cancelled aren't acked, to
+// be scanned again.
+func (e *Engine) ackPopped(ctx context.Context, chunk *sources.Chunk) {
+	q, ok := e.popped.LoadAndDelete(chunk)
+	if !ok || common.IsDone(ctx) {
+		return
+	}
+	if err := q.(Queue).Ack(ctx, chunk); err != nil {
+		logrus.WithError(err).Error("could not ack chunk to the queue")
+	}
+}
+
+// queueWorker pushes the chunks of the sources to the queue, in place of
+// scanning them.
+func (e *Engine) queueWorker(ctx context.Context) {
+	for chunk := range e.work {
+		if err := e.queue.Push(ctx, chunk); err != nil {
+			if atomic.AddUint64(&e.chunksNotQueued, 1) == 1 {
+				logrus.WithError(err).Error("could not push chunk to the queue")
+			}
+		} else {
+			atomic.AddUint64(&e.chunksQueued, 1)
+		}
+		if e.memory != nil {
+			e.memory.Release(e.chunkMemory(chunk))
+		}
+	}
+}
+
+// ChunksQueued returns the number of chunks pushed to the queue of
+// WithQueue.
+func (e *Engine) ChunksQueued() uint64 {
+	return atomic.LoadUint64(&e.chunksQueued)
+}
@@ -0,0 +1,108 @@
+package engine
+
+import (
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/decoders"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// memQueue is a Queue of the records of its chunks, in memory.
+type memQueue struct {
+	records chan []byte
+	acked   int32
+}
+
+func (q *memQueue) Push(ctx context.Context, chunk *sources.Chunk) error {
+	record, err := encodeChunk(chunk)
+	if err != nil {
+		return err
+	}
+	q.records <- record
+	return nil
+}
+
+func (q *memQueue) Pop(ctx context.Context) (*sources.Chunk, error) {
+	select {
+	case record := <-q.records:
+		return decodeChunk(record)
+	case <-ctx.Done():
+		return nil, nil
+	}
+}
+
+func (q *memQueue) Ack(ctx context.Context, chunk *sources.Chunk) error {
+	atomic.AddInt32(&q.acked, 1)
+	return nil
+}
+
+func (q *memQueue) Close() error { return nil }
+
+func TestEngine_queue(t *testing.T) {
+	const count = 3
+	q := &memQueue{records: make(chan []byte, count)}
+	ctx := context.Background()
+
+	// The chunks of the sources of the scan are pushed rather than scanned.
+	var scanCalls int32
+	scan := Start(ctx,
+		WithConcurrency(2),
+		WithDecoders(&decoders.UTF8{}),
+		WithDetectors(false, callsDetector{&scanCalls}),
+		WithQueue(q),
+	)
+	for i := 0; i < count; i++ {
+		scan.ChunksChan() <- &sources.Chunk{
+			SourceName: "test",
+			SourceType: sourcespb.SourceType_SOURCE_TYPE_FILESYSTEM,
+			Data:       []byte(fmt.Sprintf("vendored secret %d", i)),
+		}
+	}
+	if err := scan.Wait(ctx, func(r *detectors.ResultWithMetadata) {
+		t.Errorf("scan found %+v", r)
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if queued := scan.ChunksQueued(); queued != count || scanCalls != 0 {
+		t.Fatalf("ChunksQueued() = %d with %d detector calls, want %d and none", queued, scanCalls, count)
+	}
+
+	// The worker scans them until stopped.
+	var workerCalls int32
+	worker := Start(ctx,
+		WithConcurrency(1),
+		WithDecoders(&decoders.UTF8{}),
+		WithDetectors(false, callsDetector{&workerCalls}),
+	)
+	workerCtx, cancel := context.WithCancel(ctx)
+	worker.ScanQueue(workerCtx, q)
+	found := map[string]bool{}
+	if err := worker.Wait(ctx, func(r *detectors.ResultWithMetadata) {
+		if r.SourceName != "test" || r.SourceType != sourcespb.SourceType_SOURCE_TYPE_FILESYSTEM {
+			t.Errorf("result of chunk %+v", r)
+		}
+		found[string(r.Raw)] = true
+		if len(found) == count {
+			cancel()
+		}
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if len(found) != count {
+		t.Errorf("worker found %v, want %d results", found, count)
+	}
+	// The chunks are acked once their detectors are done.
+	deadline := time.Now().Add(time.Second)
+	for atomic.LoadInt32(&q.acked) != count && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if acked := atomic.LoadInt32(&q.acked); acked != count {
+		t.Errorf("%d chunks acked, want %d", acked, count)
+	}
+}
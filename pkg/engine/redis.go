@@ -0,0 +1,57 @@
+package engine
+
+import (
+	"strconv"
+
+	"github.com/go-errors/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/redis"
+)
+
+// ScanRedis scans the values of the keys of a Redis instance.
+func (e *Engine) ScanRedis(ctx context.Context, c sources.Config) error {
+	if len(c.ConnectionString) == 0 {
+		return errors.New("a connection string is required")
+	}
+	connection := &sourcespb.Redis{
+		Credential: &sourcespb.Redis_ConnectionString{
+			ConnectionString: c.ConnectionString,
+		},
+		KeyPatterns: c.Keys,
+		IgnoreKeys:  c.ExcludeKeys,
+		MaxKeys:     int64(c.MaxRows),
+	}
+	for _, database := range c.Databases {
+		db, err := strconv.ParseInt(database, 10, 64)
+		if err != nil {
+			return errors.Errorf("invalid database number %q", database)
+		}
+		connection.Databases = append(connection.Databases, db)
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		logrus.WithError(err).Error("failed to marshal redis connection")
+		return err
+	}
+
+	concurrency := c.Concurrency
+	if concurrency == 0 {
+		concurrency = e.sourceConcurrency
+	}
+	redisSource := redis.Source{}
+	err = redisSource.Init(ctx, "trufflehog - redis", 0, int64(sourcespb.SourceType_SOURCE_TYPE_REDIS), true, &conn, concurrency)
+	if err != nil {
+		return errors.WrapPrefix(err, "failed to init redis source", 0)
+	}
+
+	e.RunSource(ctx, "redis", &redisSource)
+	return nil
+}
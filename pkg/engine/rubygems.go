@@ -0,0 +1,61 @@
+package engine
+
+import (
+	"github.com/go-errors/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/rubygems"
+)
+
+// ScanRubyGems scans the published gems of a gem server.
+func (e *Engine) ScanRubyGems(ctx context.Context, c sources.Config) error {
+	connection := &sourcespb.RubyGems{
+		Endpoint:    c.Endpoint,
+		Gems:        c.Packages,
+		Owners:      c.Owners,
+		MaxVersions: int64(c.MaxVersions),
+	}
+	switch {
+	case len(c.Username) > 0:
+		connection.Credential = &sourcespb.RubyGems_BasicAuth{
+			BasicAuth: &credentialspb.BasicAuth{
+				Username: c.Username,
+				Password: c.Token,
+			},
+		}
+	case len(c.Token) > 0:
+		connection.Credential = &sourcespb.RubyGems_Token{
+			Token: c.Token,
+		}
+	default:
+		connection.Credential = &sourcespb.RubyGems_Unauthenticated{
+			Unauthenticated: &credentialspb.Unauthenticated{},
+		}
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		logrus.WithError(err).Error("failed to marshal RubyGems connection")
+		return err
+	}
+
+	concurrency := c.Concurrency
+	if concurrency == 0 {
+		concurrency = e.sourceConcurrency
+	}
+	rubygemsSource := rubygems.Source{}
+	err = rubygemsSource.Init(ctx, "trufflehog - rubygems", 0, int64(sourcespb.SourceType_SOURCE_TYPE_RUBYGEMS), true, &conn, concurrency)
+	if err != nil {
+		return errors.WrapPrefix(err, "failed to init RubyGems source", 0)
+	}
+
+	e.RunSource(ctx, "rubygems", &rubygemsSource)
+	return nil
+}
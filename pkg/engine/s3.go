@@ -2,14 +2,12 @@ package engine
 
 import (
 	"fmt"
-	"runtime"
 
 	"github.com/go-errors/errors"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 
-	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
@@ -36,9 +34,28 @@ func (e *Engine) ScanS3(ctx context.Context, c sources.Config) error {
 			},
 		}
 	}
+	if len(c.RoleARNs) > 0 {
+		// Roles can't be assumed anonymously, so the credentials of the
+		// environment are used unless a key is given.
+		if _, ok := connection.Credential.(*sourcespb.S3_Unauthenticated); ok {
+			connection.Credential = &sourcespb.S3_CloudEnvironment{}
+		}
+		connection.RoleArns = c.RoleARNs
+		connection.ExternalId = c.ExternalID
+	}
 	if len(c.Buckets) > 0 {
 		connection.Buckets = c.Buckets
 	}
+	connection.Prefixes = c.Prefixes
+	connection.ExcludeSuffixes = c.ExcludeSuffixes
+	connection.ExcludeBuckets = c.ExcludeBuckets
+	connection.Regions = c.Regions
+	connection.DryRun = c.DryRun
+	connection.Endpoint = c.Endpoint
+	connection.ForcePathStyle = c.ForcePathStyle
+	connection.RequesterPays = c.RequesterPays
+	connection.RestoreArchived = c.RestoreArchived
+	connection.StateFile = c.StateFile
 	var conn anypb.Any
 	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
 	if err != nil {
@@ -47,19 +64,15 @@ func (e *Engine) ScanS3(ctx context.Context, c sources.Config) error {
 	}
 
 	s3Source := s3.Source{}
-	err = s3Source.Init(ctx, "trufflehog - s3", 0, int64(sourcespb.SourceType_SOURCE_TYPE_S3), true, &conn, runtime.NumCPU())
+	concurrency := c.Concurrency
+	if concurrency == 0 {
+		concurrency = e.sourceConcurrency
+	}
+	err = s3Source.Init(ctx, "trufflehog - s3", 0, int64(sourcespb.SourceType_SOURCE_TYPE_S3), true, &conn, concurrency)
 	if err != nil {
 		return errors.WrapPrefix(err, "failed to init S3 source", 0)
 	}
 
-	e.sourcesWg.Add(1)
-	go func() {
-		defer common.RecoverWithExit(ctx)
-		defer e.sourcesWg.Done()
-		err := s3Source.Chunks(ctx, e.ChunksChan())
-		if err != nil {
-			logrus.WithError(err).Error("error scanning S3")
-		}
-	}()
+	e.RunSource(ctx, "s3", &s3Source)
 	return nil
 }
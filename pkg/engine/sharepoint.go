@@ -0,0 +1,62 @@
+package engine
+
+import (
+	"github.com/go-errors/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/sharepoint"
+)
+
+// ScanSharePoint scans SharePoint document libraries and OneDrive drives.
+func (e *Engine) ScanSharePoint(ctx context.Context, c sources.Config) error {
+	connection := &sourcespb.SharePoint{
+		Endpoint:        c.Endpoint,
+		Sites:           c.Sites,
+		IgnoreSites:     c.ExcludeSites,
+		IncludeOnedrive: c.IncludeOneDrive,
+		Users:           c.Users,
+		DeltaStateFile:  c.StateFile,
+	}
+	switch {
+	case len(c.Token) > 0:
+		connection.Credential = &sourcespb.SharePoint_Token{
+			Token: c.Token,
+		}
+	case len(c.ClientID) > 0 && len(c.ClientSecret) > 0 && len(c.TenantID) > 0:
+		connection.Credential = &sourcespb.SharePoint_Authenticated{
+			Authenticated: &credentialspb.ClientCredentials{
+				TenantId:     c.TenantID,
+				ClientId:     c.ClientID,
+				ClientSecret: c.ClientSecret,
+			},
+		}
+	default:
+		return errors.New("must provide a token or a tenant ID, client ID, and client secret")
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		logrus.WithError(err).Error("failed to marshal SharePoint connection")
+		return err
+	}
+
+	concurrency := c.Concurrency
+	if concurrency == 0 {
+		concurrency = e.sourceConcurrency
+	}
+	sharepointSource := sharepoint.Source{}
+	err = sharepointSource.Init(ctx, "trufflehog - sharepoint", 0, int64(sourcespb.SourceType_SOURCE_TYPE_SHAREPOINT), true, &conn, concurrency)
+	if err != nil {
+		return errors.WrapPrefix(err, "failed to init SharePoint source", 0)
+	}
+
+	e.RunSource(ctx, "sharepoint", &sharepointSource)
+	return nil
+}
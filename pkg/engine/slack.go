@@ -0,0 +1,55 @@
+package engine
+
+import (
+	"github.com/go-errors/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/slack"
+)
+
+// ScanSlack scans the messages, threads, and file snippets of a Slack workspace.
+func (e *Engine) ScanSlack(ctx context.Context, c sources.Config) error {
+	if len(c.Token) == 0 {
+		return errors.New("must provide a Slack token")
+	}
+	connection := &sourcespb.Slack{
+		Endpoint: c.Endpoint,
+		Credential: &sourcespb.Slack_Token{
+			Token: c.Token,
+		},
+		Channels:   c.Channels,
+		IgnoreList: c.ExcludeChannels,
+	}
+	if !c.Since.IsZero() {
+		connection.Since = timestamppb.New(c.Since)
+	}
+	if !c.Until.IsZero() {
+		connection.Until = timestamppb.New(c.Until)
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		logrus.WithError(err).Error("failed to marshal Slack connection")
+		return err
+	}
+
+	concurrency := c.Concurrency
+	if concurrency == 0 {
+		concurrency = e.sourceConcurrency
+	}
+	slackSource := slack.Source{}
+	err = slackSource.Init(ctx, "trufflehog - slack", 0, int64(sourcespb.SourceType_SOURCE_TYPE_SLACK), true, &conn, concurrency)
+	if err != nil {
+		return errors.WrapPrefix(err, "failed to init Slack source", 0)
+	}
+
+	e.RunSource(ctx, "slack", &slackSource)
+	return nil
+}
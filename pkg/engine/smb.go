@@ -0,0 +1,75 @@
+package engine
+
+import (
+	"os"
+	"strings"
+
+	"github.com/go-errors/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/smb"
+)
+
+// ScanSMB scans the files of SMB/CIFS shares.
+func (e *Engine) ScanSMB(ctx context.Context, c sources.Config) error {
+	connection := &sourcespb.SMB{
+		Address:      c.Address,
+		Shares:       c.Shares,
+		ExcludePaths: c.ExcludePaths,
+		MaxSize:      c.MaxSize,
+	}
+	switch {
+	case c.Kerberos:
+		cfg, err := os.ReadFile(c.KerberosConfigPath)
+		if err != nil {
+			return errors.WrapPrefix(err, "could not read Kerberos configuration", 0)
+		}
+		kerberos := &credentialspb.Kerberos{
+			Username: c.Username,
+			Realm:    c.Realm,
+			Password: c.Secret,
+			Config:   string(cfg),
+		}
+		if c.Secret == "" && c.CCachePath != "" {
+			if kerberos.Ccache, err = os.ReadFile(strings.TrimPrefix(c.CCachePath, "FILE:")); err != nil {
+				return errors.WrapPrefix(err, "could not read Kerberos credentials cache", 0)
+			}
+		}
+		connection.Credential = &sourcespb.SMB_Kerberos{Kerberos: kerberos}
+	case c.Username != "":
+		connection.Credential = &sourcespb.SMB_Ntlm{
+			Ntlm: &credentialspb.BasicAuth{
+				Username: c.Username,
+				Password: c.Secret,
+			},
+		}
+	default:
+		connection.Credential = &sourcespb.SMB_Unauthenticated{Unauthenticated: &credentialspb.Unauthenticated{}}
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		logrus.WithError(err).Error("failed to marshal smb connection")
+		return err
+	}
+
+	concurrency := c.Concurrency
+	if concurrency == 0 {
+		concurrency = e.sourceConcurrency
+	}
+	smbSource := smb.Source{}
+	err = smbSource.Init(ctx, "trufflehog - smb", 0, int64(sourcespb.SourceType_SOURCE_TYPE_SMB), true, &conn, concurrency)
+	if err != nil {
+		return errors.WrapPrefix(err, "failed to init smb source", 0)
+	}
+
+	e.RunSource(ctx, "smb", &smbSource)
+	return nil
+}
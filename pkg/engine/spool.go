@@ -0,0 +1,176 @@
+package engine
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// spool queues the chunks the workers aren't ready for in a file, so sources
+// producing chunks faster than they are scanned don't hold them in memory.
+// Chunks are handed out in the order they came in. Once the file reaches
+// maxSize, the sources block until it is drained.
+type spool struct {
+	file    *os.File
+	maxSize int64
+
+	mu   sync.Mutex
+	cond *sync.Cond
+	// readOff and writeOff are the offsets of the next chunk to read and
+	// write in the file.
+	readOff, writeOff int64
+	// pending counts the chunks spooled but not yet handed out.
+	pending int
+	closed  bool
+}
+
+func newSpool(dir string, maxSize int64) (*spool, error) {
+	file, err := os.CreateTemp(dir, "trufflehog-spool-*")
+	if err != nil {
+		return nil, fmt.Errorf("could not create spool file: %w", err)
+	}
+	s := &spool{file: file, maxSize: maxSize}
+	s.cond = sync.NewCond(&s.mu)
+	return s, nil
+}
+
+// run hands the chunks of in to out, spooling them while out isn't ready. It
+// closes out and removes the spool file once in is closed and drained.
+func (s *spool) run(ctx context.Context, in <-chan *sources.Chunk, out chan<- *sources.Chunk) {
+	go s.drain(ctx, out)
+	defer common.RecoverWithExit(ctx)
+	for chunk := range in {
+		s.mu.Lock()
+		empty := s.pending == 0
+		s.mu.Unlock()
+		if empty {
+			select {
+			case out <- chunk:
+				continue
+			default:
+			}
+		}
+		if err := s.push(chunk); err != nil {
+			// The chunk is handed over directly rather than lost, after
+			// the spooled ones.
+			logrus.WithError(err).Error("could not spool chunk")
+			s.waitEmpty()
+			out <- chunk
+		}
+	}
+	s.mu.Lock()
+	s.closed = true
+	s.cond.Broadcast()
+	s.mu.Unlock()
+}
+
+// drain hands the spooled chunks to out.
+func (s *spool) drain(ctx context.Context, out chan<- *sources.Chunk) {
+	defer common.RecoverWithExit(ctx)
+	defer close(out)
+	defer func() {
+		_ = s.file.Close()
+		_ = os.Remove(s.file.Name())
+	}()
+	for {
+		chunk, err := s.pop()
+		if err != nil {
+			logrus.WithError(err).Error("could not read spooled chunks, they are skipped")
+		}
+		if chunk == nil && err == nil {
+			return
+		}
+		if chunk != nil {
+			out <- chunk
+		}
+		s.mu.Lock()
+		s.pending--
+		s.cond.Broadcast()
+		s.mu.Unlock()
+	}
+}
+
+// push writes chunk to the spool file, waiting for room in it.
+func (s *spool) push(chunk *sources.Chunk) error {
+	encoded, err := encodeChunk(chunk)
+	if err != nil {
+		return err
+	}
+	// Records are prefixed with their size, to be read back one at a time.
+	record := make([]byte, 8+len(encoded))
+	binary.BigEndian.PutUint64(record, uint64(len(encoded)))
+	copy(record[8:], encoded)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if int64(len(record)) > s.maxSize {
+		return fmt.Errorf("chunk of %d bytes is larger than the spool", len(chunk.Data))
+	}
+	// Space is only reclaimed once the spool is drained, which resets the
+	// file.
+	for s.writeOff+int64(len(record)) > s.maxSize {
+		s.cond.Wait()
+	}
+	if _, err := s.file.WriteAt(record, s.writeOff); err != nil {
+		return err
+	}
+	s.writeOff += int64(len(record))
+	s.pending++
+	s.cond.Broadcast()
+	return nil
+}
+
+// pop reads the next chunk of the spool file, waiting for one. It returns a
+// nil chunk once the spool is closed and drained.
+func (s *spool) pop() (*sources.Chunk, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for s.readOff == s.writeOff {
+		if s.closed && s.pending == 0 {
+			return nil, nil
+		}
+		s.cond.Wait()
+	}
+	var size [8]byte
+	if _, err := s.file.ReadAt(size[:], s.readOff); err != nil {
+		s.skipAll()
+		return nil, err
+	}
+	record := make([]byte, binary.BigEndian.Uint64(size[:]))
+	if _, err := s.file.ReadAt(record, s.readOff+int64(len(size))); err != nil {
+		s.skipAll()
+		return nil, err
+	}
+	s.readOff += int64(len(size) + len(record))
+	if s.readOff == s.writeOff {
+		// Everything written was read, so the file starts over.
+		s.readOff, s.writeOff = 0, 0
+		if err := s.file.Truncate(0); err != nil {
+			logrus.WithError(err).Debug("could not truncate spool file")
+		}
+	}
+	return decodeChunk(record)
+}
+
+// skipAll drops the spooled chunks once the file can't be read back. Only
+// the chunk being popped is left pending, for drain to account for.
+func (s *spool) skipAll() {
+	s.pending = 1
+	s.readOff, s.writeOff = 0, 0
+	_ = s.file.Truncate(0)
+}
+
+// waitEmpty waits until all the spooled chunks were handed out.
+func (s *spool) waitEmpty() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for s.pending > 0 {
+		s.cond.Wait()
+	}
+}
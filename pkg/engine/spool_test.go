@@ -0,0 +1,75 @@
+package engine
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+func TestSpool(t *testing.T) {
+	dir := t.TempDir()
+	s, err := newSpool(dir, 4096)
+	if err != nil {
+		t.Fatal(err)
+	}
+	in := make(chan *sources.Chunk)
+	out := make(chan *sources.Chunk)
+	go s.run(context.Background(), in, out)
+
+	newChunk := func(i int) *sources.Chunk {
+		return &sources.Chunk{
+			SourceName: "test",
+			SourceID:   1,
+			SourceType: sourcespb.SourceType_SOURCE_TYPE_FILESYSTEM,
+			SourceMetadata: &source_metadatapb.MetaData{
+				Data: &source_metadatapb.MetaData_Filesystem{
+					Filesystem: &source_metadatapb.Filesystem{File: fmt.Sprintf("file%d", i)},
+				},
+			},
+			Data:   []byte(fmt.Sprintf("data of chunk %d", i)),
+			Verify: true,
+		}
+	}
+
+	// The chunks are spooled, past the size of the spool too, while nothing
+	// reads them.
+	const count = 100
+	sent := make(chan struct{})
+	go func() {
+		defer close(sent)
+		for i := 0; i < count; i++ {
+			in <- newChunk(i)
+		}
+		close(in)
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	for i := 0; i < count; i++ {
+		got := <-out
+		if want := newChunk(i); !proto.Equal(got.SourceMetadata, want.SourceMetadata) ||
+			string(got.Data) != string(want.Data) || got.SourceType != want.SourceType || !got.Verify {
+			t.Fatalf("chunk %d = %+v, want %+v", i, got, want)
+		}
+	}
+	if _, ok := <-out; ok {
+		t.Fatal("out wasn't closed")
+	}
+	<-sent
+
+	// The spool file is removed once drained.
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("spool directory holds %d files", len(entries))
+	}
+}
@@ -0,0 +1,66 @@
+package engine
+
+import (
+	"fmt"
+
+	"github.com/go-errors/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/sqs"
+)
+
+// ScanSQS scans the messages of SQS queues.
+func (e *Engine) ScanSQS(ctx context.Context, c sources.Config) error {
+	connection := &sourcespb.SQS{
+		Region:            c.Region,
+		Queues:            c.Queues,
+		DeleteMessages:    c.DeleteMessages,
+		VisibilityTimeout: int64(c.VisibilityTimeout),
+		MaxMessages:       int64(c.MaxMessages),
+		Endpoint:          c.Endpoint,
+	}
+	switch {
+	case c.CloudCred:
+		if len(c.Key) > 0 || len(c.Secret) > 0 {
+			return fmt.Errorf("cannot use cloud credentials and an access key together")
+		}
+		connection.Credential = &sourcespb.SQS_CloudEnvironment{
+			CloudEnvironment: &credentialspb.CloudEnvironment{},
+		}
+	case len(c.Key) > 0 && len(c.Secret) > 0:
+		connection.Credential = &sourcespb.SQS_AccessKey{
+			AccessKey: &credentialspb.KeySecret{
+				Key:    c.Key,
+				Secret: c.Secret,
+			},
+		}
+	default:
+		return errors.New("an access key or cloud credentials are required")
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		logrus.WithError(err).Error("failed to marshal sqs connection")
+		return err
+	}
+
+	concurrency := c.Concurrency
+	if concurrency == 0 {
+		concurrency = e.sourceConcurrency
+	}
+	sqsSource := sqs.Source{}
+	err = sqsSource.Init(ctx, "trufflehog - sqs", 0, int64(sourcespb.SourceType_SOURCE_TYPE_SQS), true, &conn, concurrency)
+	if err != nil {
+		return errors.WrapPrefix(err, "failed to init sqs source", 0)
+	}
+
+	e.RunSource(ctx, "sqs", &sqsSource)
+	return nil
+}
@@ -0,0 +1,86 @@
+package engine
+
+import (
+	"encoding/json"
+	"sync/atomic"
+	"time"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+)
+
+// Stats are the statistics of a run of the engine.
+type Stats struct {
+	// Duration is the time since the engine started, until it finished.
+	Duration        time.Duration `json:"-"`
+	Bytes           uint64        `json:"bytes"`
+	Chunks          uint64        `json:"chunks"`
+	DuplicateChunks uint64        `json:"duplicate_chunks"`
+	// Results counts the results found by detector type.
+	Results map[string]ResultStats `json:"results"`
+	// Verifications is the number of results the detectors verified.
+	Verifications uint64 `json:"verifications"`
+	// HTTPRequests is the number of HTTP requests made by the detectors and
+	// sources, RateLimited how many of them were rate limited.
+	HTTPRequests     uint64         `json:"http_requests"`
+	RateLimited      uint64         `json:"rate_limited"`
+	DetectorTimeouts map[string]int `json:"detector_timeouts,omitempty"`
+}
+
+// ResultStats counts the results of a detector.
+type ResultStats struct {
+	Verified   uint64 `json:"verified"`
+	Unverified uint64 `json:"unverified"`
+}
+
+// MarshalJSON encodes the duration of the stats as a string like "1m30s".
+func (s Stats) MarshalJSON() ([]byte, error) {
+	type stats Stats
+	return json.Marshal(struct {
+		Duration string `json:"duration"`
+		stats
+	}{s.Duration.String(), stats(s)})
+}
+
+// Stats returns the statistics of the run so far.
+func (e *Engine) Stats() Stats {
+	e.statsMu.Lock()
+	results := make(map[string]ResultStats, len(e.resultStats))
+	for detector, counts := range e.resultStats {
+		results[detector] = counts
+	}
+	end := e.finished
+	e.statsMu.Unlock()
+	if end.IsZero() {
+		end = time.Now()
+	}
+
+	requests, rateLimited := common.HTTPRequests()
+	return Stats{
+		Duration:         end.Sub(e.started),
+		Bytes:            e.BytesScanned(),
+		Chunks:           e.ChunksScanned(),
+		DuplicateChunks:  e.DuplicateChunks(),
+		Results:          results,
+		Verifications:    atomic.LoadUint64(&e.verifications),
+		HTTPRequests:     requests,
+		RateLimited:      rateLimited,
+		DetectorTimeouts: e.DetectorTimeouts(),
+	}
+}
+
+// countResult adds result to the stats.
+func (e *Engine) countResult(result detectors.Result) {
+	e.statsMu.Lock()
+	defer e.statsMu.Unlock()
+	if e.resultStats == nil {
+		e.resultStats = make(map[string]ResultStats)
+	}
+	counts := e.resultStats[result.DetectorType.String()]
+	if result.Verified {
+		counts.Verified++
+	} else {
+		counts.Unverified++
+	}
+	e.resultStats[result.DetectorType.String()] = counts
+}
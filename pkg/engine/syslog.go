@@ -8,7 +8,6 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 
-	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
@@ -36,6 +35,14 @@ func (e *Engine) ScanSyslog(ctx context.Context, c sources.Config) error {
 		}
 		connection.TlsKey = string(key)
 	}
+	if c.CAPath != "" {
+		ca, err := os.ReadFile(c.CAPath)
+		if err != nil {
+			return errors.WrapPrefix(err, "could not open TLS client CA file", 0)
+		}
+		connection.TlsClientCA = string(ca)
+		connection.TlsClientNames = c.ClientNames
+	}
 
 	var conn anypb.Any
 	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
@@ -50,14 +57,6 @@ func (e *Engine) ScanSyslog(ctx context.Context, c sources.Config) error {
 		return err
 	}
 
-	e.sourcesWg.Add(1)
-	go func() {
-		defer common.RecoverWithExit(ctx)
-		defer e.sourcesWg.Done()
-		err := source.Chunks(ctx, e.ChunksChan())
-		if err != nil {
-			logrus.WithError(err).Fatal("could not scan syslog")
-		}
-	}()
+	e.RunSource(ctx, "syslog", &source)
 	return nil
 }
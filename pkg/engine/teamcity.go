@@ -0,0 +1,66 @@
+package engine
+
+import (
+	"github.com/go-errors/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/teamcity"
+)
+
+// ScanTeamCity scans the build configurations, builds and versioned settings of a TeamCity server.
+func (e *Engine) ScanTeamCity(ctx context.Context, c sources.Config) error {
+	connection := &sourcespb.TeamCity{
+		Endpoint:              c.Endpoint,
+		Projects:              c.Projects,
+		IgnoreProjects:        c.ExcludeProjects,
+		MaxBuilds:             int64(c.MaxBuilds),
+		SkipArtifacts:         c.SkipArtifacts,
+		SkipVersionedSettings: c.SkipVersionedSettings,
+		InsecureSkipVerifyTls: c.InsecureSkipVerifyTLS,
+		VcsUsername:           c.VCSUsername,
+		VcsToken:              c.VCSToken,
+	}
+	switch {
+	case len(c.Username) > 0:
+		connection.Credential = &sourcespb.TeamCity_BasicAuth{
+			BasicAuth: &credentialspb.BasicAuth{
+				Username: c.Username,
+				Password: c.Token,
+			},
+		}
+	case len(c.Token) > 0:
+		connection.Credential = &sourcespb.TeamCity_Token{
+			Token: c.Token,
+		}
+	default:
+		connection.Credential = &sourcespb.TeamCity_Unauthenticated{
+			Unauthenticated: &credentialspb.Unauthenticated{},
+		}
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		logrus.WithError(err).Error("failed to marshal teamcity connection")
+		return err
+	}
+
+	concurrency := c.Concurrency
+	if concurrency == 0 {
+		concurrency = e.sourceConcurrency
+	}
+	teamcitySource := teamcity.Source{}
+	err = teamcitySource.Init(ctx, "trufflehog - teamcity", 0, int64(sourcespb.SourceType_SOURCE_TYPE_TEAMCITY), true, &conn, concurrency)
+	if err != nil {
+		return errors.WrapPrefix(err, "failed to init teamcity source", 0)
+	}
+
+	e.RunSource(ctx, "teamcity", &teamcitySource)
+	return nil
+}
@@ -0,0 +1,63 @@
+package engine
+
+import (
+	"github.com/go-errors/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/teams"
+)
+
+// ScanTeams scans Microsoft Teams channels and chats.
+func (e *Engine) ScanTeams(ctx context.Context, c sources.Config) error {
+	connection := &sourcespb.Teams{
+		Endpoint:       c.Endpoint,
+		Teams:          c.Teams,
+		IgnoreTeams:    c.ExcludeTeams,
+		Channels:       c.Channels,
+		IgnoreList:     c.ExcludeChannels,
+		IncludeChats:   c.IncludeChats,
+		DeltaStateFile: c.StateFile,
+	}
+	switch {
+	case len(c.Token) > 0:
+		connection.Credential = &sourcespb.Teams_Token{
+			Token: c.Token,
+		}
+	case len(c.ClientID) > 0 && len(c.ClientSecret) > 0 && len(c.TenantID) > 0:
+		connection.Credential = &sourcespb.Teams_Authenticated{
+			Authenticated: &credentialspb.ClientCredentials{
+				TenantId:     c.TenantID,
+				ClientId:     c.ClientID,
+				ClientSecret: c.ClientSecret,
+			},
+		}
+	default:
+		return errors.New("must provide a token or a tenant ID, client ID, and client secret")
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		logrus.WithError(err).Error("failed to marshal Teams connection")
+		return err
+	}
+
+	concurrency := c.Concurrency
+	if concurrency == 0 {
+		concurrency = e.sourceConcurrency
+	}
+	teamsSource := teams.Source{}
+	err = teamsSource.Init(ctx, "trufflehog - teams", 0, int64(sourcespb.SourceType_SOURCE_TYPE_TEAMS), true, &conn, concurrency)
+	if err != nil {
+		return errors.WrapPrefix(err, "failed to init Teams source", 0)
+	}
+
+	e.RunSource(ctx, "teams", &teamsSource)
+	return nil
+}
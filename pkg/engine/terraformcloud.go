@@ -0,0 +1,50 @@
+package engine
+
+import (
+	"github.com/go-errors/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/terraformcloud"
+)
+
+// ScanTerraformCloud scans the workspaces of Terraform Cloud or Terraform Enterprise organizations.
+func (e *Engine) ScanTerraformCloud(ctx context.Context, c sources.Config) error {
+	connection := &sourcespb.TerraformCloud{
+		Endpoint:         c.Endpoint,
+		Organizations:    c.Orgs,
+		Workspaces:       c.Workspaces,
+		IgnoreWorkspaces: c.ExcludeWorkspaces,
+		MaxVersions:      int64(c.MaxVersions),
+	}
+	if len(c.Token) == 0 {
+		return errors.New("a Terraform Cloud API token is required")
+	}
+	connection.Credential = &sourcespb.TerraformCloud_Token{
+		Token: c.Token,
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		logrus.WithError(err).Error("failed to marshal terraform cloud connection")
+		return err
+	}
+
+	concurrency := c.Concurrency
+	if concurrency == 0 {
+		concurrency = e.sourceConcurrency
+	}
+	tfcSource := terraformcloud.Source{}
+	err = tfcSource.Init(ctx, "trufflehog - terraform cloud", 0, int64(sourcespb.SourceType_SOURCE_TYPE_TERRAFORM_CLOUD), true, &conn, concurrency)
+	if err != nil {
+		return errors.WrapPrefix(err, "failed to init terraform cloud source", 0)
+	}
+
+	e.RunSource(ctx, "terraform-cloud", &tfcSource)
+	return nil
+}
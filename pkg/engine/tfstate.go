@@ -0,0 +1,52 @@
+package engine
+
+import (
+	"github.com/go-errors/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/tfstate"
+)
+
+// ScanTerraformState scans Terraform state files stored locally or in remote backends.
+func (e *Engine) ScanTerraformState(ctx context.Context, c sources.Config) error {
+	connection := &sourcespb.TerraformState{
+		Locations:     c.Locations,
+		ConsulToken:   c.Token,
+		AzureSasToken: c.SASToken,
+	}
+	if c.CloudCred {
+		connection.Credential = &sourcespb.TerraformState_CloudEnvironment{
+			CloudEnvironment: &credentialspb.CloudEnvironment{},
+		}
+	} else {
+		connection.Credential = &sourcespb.TerraformState_Unauthenticated{
+			Unauthenticated: &credentialspb.Unauthenticated{},
+		}
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		logrus.WithError(err).Error("failed to marshal terraform state connection")
+		return err
+	}
+
+	concurrency := c.Concurrency
+	if concurrency == 0 {
+		concurrency = e.sourceConcurrency
+	}
+	tfstateSource := tfstate.Source{}
+	err = tfstateSource.Init(ctx, "trufflehog - tfstate", 0, int64(sourcespb.SourceType_SOURCE_TYPE_TERRAFORM_STATE), true, &conn, concurrency)
+	if err != nil {
+		return errors.WrapPrefix(err, "failed to init terraform state source", 0)
+	}
+
+	e.RunSource(ctx, "tfstate", &tfstateSource)
+	return nil
+}
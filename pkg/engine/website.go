@@ -0,0 +1,48 @@
+package engine
+
+import (
+	"github.com/go-errors/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/website"
+)
+
+// ScanWebsite crawls websites from seed URLs.
+func (e *Engine) ScanWebsite(ctx context.Context, c sources.Config) error {
+	if len(c.Locations) == 0 {
+		return errors.New("at least one seed URL is required")
+	}
+	connection := &sourcespb.Website{
+		Seeds:        c.Locations,
+		MaxDepth:     int64(c.MaxDepth),
+		Hosts:        c.Hosts,
+		IgnoreRobots: c.IgnoreRobots,
+		MaxPages:     int64(c.MaxPages),
+		MaxSize:      c.MaxSize,
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		logrus.WithError(err).Error("failed to marshal website connection")
+		return err
+	}
+
+	concurrency := c.Concurrency
+	if concurrency == 0 {
+		concurrency = e.sourceConcurrency
+	}
+	websiteSource := website.Source{}
+	err = websiteSource.Init(ctx, "trufflehog - http", 0, int64(sourcespb.SourceType_SOURCE_TYPE_WEBSITE), true, &conn, concurrency)
+	if err != nil {
+		return errors.WrapPrefix(err, "failed to init website source", 0)
+	}
+
+	e.RunSource(ctx, "http", &websiteSource)
+	return nil
+}
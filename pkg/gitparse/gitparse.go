@@ -5,6 +5,7 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"os"
 	"os/exec"
 	"path/filepath"
 	"strconv"
@@ -22,7 +23,9 @@ const DateFormat = "Mon Jan 02 15:04:05 2006 -0700"
 
 // Commit contains commit header info and diffs.
 type Commit struct {
-	Hash    string
+	Hash string
+	// Ref is the ref the commit was reached from, when logged with --source.
+	Ref     string
 	Author  string
 	Date    time.Time
 	Message strings.Builder
@@ -69,39 +72,113 @@ func (c1 *Commit) Equal(c2 *Commit) bool {
 
 }
 
+// gitDir returns the git directory of the repo at path, which is the path
+// itself for bare repos.
+func gitDir(path string) string {
+	dotGit := filepath.Join(path, ".git")
+	if _, err := os.Stat(dotGit); err != nil {
+		return path
+	}
+	return dotGit
+}
+
 // RepoPath parses the output of the `git log` command for the `source` path.
-func RepoPath(ctx context.Context, source string, head string) (chan Commit, error) {
+// logArgs are passed to git log, such as those limiting the commits.
+func RepoPath(ctx context.Context, source string, head string, logArgs ...string) (chan Commit, error) {
 	args := []string{"-C", source, "log", "-p", "-U5", "--full-history", "--diff-filter=AM", "--date=format:%a %b %d %H:%M:%S %Y %z"}
+	args = append(args, logArgs...)
 	if head != "" {
 		args = append(args, head)
 	} else {
-		args = append(args, "--all")
+		// Notes are scanned from their refs rather than as commits.
+		args = append(args, "--exclude=refs/notes/*", "--all")
 	}
 
-	cmd := exec.Command("git", args...)
+	cmd := exec.CommandContext(ctx, "git", args...)
 
 	absPath, err := filepath.Abs(source)
 	if err == nil {
-		cmd.Env = append(cmd.Env, fmt.Sprintf("GIT_DIR=%s", filepath.Join(absPath, ".git")))
+		cmd.Env = append(cmd.Env, fmt.Sprintf("GIT_DIR=%s", gitDir(absPath)))
 	}
 
 	return executeCommand(ctx, cmd)
 }
 
+// AllBranches parses the output of the `git log --all --source` command for
+// the `source` path, which attributes each commit to the ref it was reached from.
+func AllBranches(ctx context.Context, source string, logArgs ...string) (chan Commit, error) {
+	args := []string{"-C", source, "log", "-p", "-U5", "--full-history", "--diff-filter=AM", "--date=format:%a %b %d %H:%M:%S %Y %z"}
+	args = append(args, logArgs...)
+	args = append(args, "--exclude=refs/notes/*", "--all", "--source")
+
+	cmd := exec.CommandContext(ctx, "git", args...)
+
+	absPath, err := filepath.Abs(source)
+	if err == nil {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("GIT_DIR=%s", gitDir(absPath)))
+	}
+
+	return executeCommand(ctx, cmd)
+}
+
+// Commits parses the output of the `git log` command for the given commits of
+// the `source` path, each of them diffed with its parents but without walking
+// their history. The environment is kept, like that of the git commands
+// listing the commits, so that git finds the same objects.
+func Commits(ctx context.Context, source string, hashes []string) (chan Commit, error) {
+	args := []string{"-C", source, "log", "-p", "-U5", "--full-history", "--diff-filter=AM", "--date=format:%a %b %d %H:%M:%S %Y %z", "--no-walk", "--stdin"}
+
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Env = os.Environ()
+	// There can be too many commits for the command line.
+	cmd.Stdin = strings.NewReader(strings.Join(hashes, "\n") + "\n")
+
+	return executeCommand(ctx, cmd)
+}
+
 // Unstaged parses the output of the `git diff` command for the `source` path.
 func Unstaged(ctx context.Context, source string) (chan Commit, error) {
 	args := []string{"-C", source, "diff", "-p", "-U5", "--full-history", "--diff-filter=AM", "--date=format:%a %b %d %H:%M:%S %Y %z", "HEAD"}
 
-	cmd := exec.Command("git", args...)
+	cmd := exec.CommandContext(ctx, "git", args...)
 
 	absPath, err := filepath.Abs(source)
 	if err == nil {
-		cmd.Env = append(cmd.Env, fmt.Sprintf("GIT_DIR=%s", filepath.Join(absPath, ".git")))
+		cmd.Env = append(cmd.Env, fmt.Sprintf("GIT_DIR=%s", gitDir(absPath)))
 	}
 
 	return executeCommand(ctx, cmd)
 }
 
+// Staged parses the output of the `git diff --cached` command for the `source`
+// path. The environment is kept so that, run from a pre-commit hook, git diffs
+// the index of the commit in GIT_INDEX_FILE, such as the temporary one of
+// `git commit -a` or `git commit <paths>`.
+func Staged(ctx context.Context, source string) (chan Commit, error) {
+	args := []string{"-C", source, "diff", "--cached", "-p", "-U5", "--diff-filter=AM"}
+
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Env = os.Environ()
+
+	return executeCommand(ctx, cmd)
+}
+
+// Pushed parses the output of the `git log` command for the commits reachable
+// from revs that no ref of the `source` repository points to yet, which are
+// the commits being pushed when run from a pre-receive hook. The environment
+// is kept so that git reads the objects of the push from its quarantine
+// directory.
+func Pushed(ctx context.Context, source string, revs []string) (chan Commit, error) {
+	args := []string{"-C", source, "log", "-p", "-U5", "--full-history", "--diff-filter=AM", "--date=format:%a %b %d %H:%M:%S %Y %z"}
+	args = append(args, revs...)
+	args = append(args, "--not", "--all")
+
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Env = os.Environ()
+
+	return executeCommand(ctx, cmd)
+}
+
 // executeCommand runs an exec.Cmd, reads stdout and stderr, and waits for the Cmd to complete.
 func executeCommand(ctx context.Context, cmd *exec.Cmd) (chan Commit, error) {
 	commitChan := make(chan Commit, 64)
@@ -167,6 +244,10 @@ func FromReader(ctx context.Context, stdOut io.Reader, commitChan chan Commit) {
 			if len(line) >= 47 {
 				currentCommit.Hash = string(line[7:47])
 			}
+			// With --source, the ref follows the hash after a tab.
+			if i := bytes.IndexByte(line, '\t'); i >= 0 {
+				currentCommit.Ref = strings.TrimSpace(string(line[i+1:]))
+			}
 		case isAuthorLine(line):
 			currentCommit.Author = strings.TrimRight(string(line[8:]), "\n")
 		case isDateLine(line):
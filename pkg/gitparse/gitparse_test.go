@@ -2,6 +2,9 @@ package gitparse
 
 import (
 	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -123,6 +126,101 @@ func TestSingleCommitSingleDiff(t *testing.T) {
 	}
 }
 
+func TestStaged(t *testing.T) {
+	dir := t.TempDir()
+	git := func(args ...string) {
+		cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v: %s", args, err, out)
+		}
+	}
+	git("init", "-q")
+	if err := os.WriteFile(filepath.Join(dir, "staged.env"), []byte("KEY=staged\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "unstaged.env"), []byte("KEY=unstaged\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	git("add", "staged.env")
+
+	commitChan, err := Staged(context.TODO(), dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var diffs []Diff
+	for commit := range commitChan {
+		diffs = append(diffs, commit.Diffs...)
+	}
+	if len(diffs) != 1 || diffs[0].PathB != "staged.env" || diffs[0].Content.String() != "KEY=staged\n" {
+		t.Errorf("unexpected staged diffs: %+v", diffs)
+	}
+}
+
+func TestStaged_indexFile(t *testing.T) {
+	dir := t.TempDir()
+	index := filepath.Join(t.TempDir(), "index")
+	git := func(env []string, args ...string) {
+		cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+		cmd.Env = append(os.Environ(), env...)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v: %s", args, err, out)
+		}
+	}
+	git(nil, "init", "-q")
+	if err := os.WriteFile(filepath.Join(dir, "committed.env"), []byte("KEY=committed\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// Like git commit -a, the file is only staged in the index of the hook.
+	git([]string{"GIT_INDEX_FILE=" + index}, "add", "committed.env")
+	t.Setenv("GIT_INDEX_FILE", index)
+
+	commitChan, err := Staged(context.TODO(), dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var diffs []Diff
+	for commit := range commitChan {
+		diffs = append(diffs, commit.Diffs...)
+	}
+	if len(diffs) != 1 || diffs[0].PathB != "committed.env" {
+		t.Errorf("unexpected staged diffs: %+v", diffs)
+	}
+}
+
+func TestCommits_objectDirectory(t *testing.T) {
+	dir := t.TempDir()
+	git := func(args ...string) string {
+		cmd := exec.Command("git", append([]string{"-C", dir, "-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("git %v: %v: %s", args, err, out)
+		}
+		return strings.TrimSpace(string(out))
+	}
+	git("init", "-q")
+	// The commit is only found through the object directory of the
+	// environment, like those of a push in a pre-receive hook.
+	t.Setenv("GIT_OBJECT_DIRECTORY", t.TempDir())
+	t.Setenv("GIT_ALTERNATE_OBJECT_DIRECTORIES", filepath.Join(dir, ".git", "objects"))
+	if err := os.WriteFile(filepath.Join(dir, "pushed.env"), []byte("KEY=pushed\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	git("add", "pushed.env")
+	git("commit", "-q", "-m", "pushed")
+
+	commitChan, err := Commits(context.TODO(), dir, []string{git("rev-parse", "HEAD")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var diffs []Diff
+	for commit := range commitChan {
+		diffs = append(diffs, commit.Diffs...)
+	}
+	if len(diffs) != 1 || diffs[0].PathB != "pushed.env" {
+		t.Errorf("unexpected diffs: %+v", diffs)
+	}
+}
+
 func TestMultiCommitContextDiff(t *testing.T) {
 	r := bytes.NewReader([]byte(singleCommitContextDiff))
 	commitChan := make(chan Commit)
@@ -0,0 +1,321 @@
+package handlers
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"path"
+	"unicode/utf16"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const (
+	// Chunk types of compiled Android resources.
+	resStringPoolType   = 0x0001
+	resTableType        = 0x0002
+	resXMLType          = 0x0003
+	resTablePackageType = 0x0200
+	// resUTF8Flag is set on string pools encoded in UTF-8 instead of UTF-16.
+	resUTF8Flag = 1 << 8
+
+	// minBinaryStringLength is the length of the shortest string extracted from
+	// native libraries.
+	minBinaryStringLength = 6
+)
+
+// Apk is a handler for Android application packages (APK) and app bundles
+// (AAB). Compiled resources, DEX files and native libraries are binary, so
+// their strings are extracted before being scanned.
+type Apk struct {
+	maxSize   int64
+	zipReader *zip.Reader
+}
+
+// New sets a default maximum size.
+func (a *Apk) New() {
+	a.maxSize = 250 * 1024 * 1024 // 250MB
+	a.zipReader = nil
+}
+
+// IsFiletype returns true if the provided reader is an APK or an AAB.
+func (a *Apk) IsFiletype(reader io.Reader) (io.Reader, bool) {
+	reader, zipReader := openZip(reader, a.maxSize)
+	if zipReader == nil {
+		return reader, false
+	}
+	for _, f := range zipReader.File {
+		switch f.Name {
+		case "AndroidManifest.xml", "base/manifest/AndroidManifest.xml":
+			a.zipReader = zipReader
+			return reader, true
+		}
+	}
+	return reader, false
+}
+
+// FromFile extracts the strings of the files of the package read by
+// IsFiletype.
+func (a *Apk) FromFile(_ io.Reader) chan ([]byte) {
+	apkChan := make(chan ([]byte), 512)
+	go func() {
+		defer close(apkChan)
+		for _, f := range a.zipReader.File {
+			if f.FileInfo().IsDir() {
+				continue
+			}
+			log.WithField("filename", f.Name).Trace("Handling APK file.")
+			if err := a.handleFile(f, apkChan); err != nil {
+				log.WithError(err).WithField("filename", f.Name).Debug("Error reading APK file.")
+			}
+		}
+	}()
+	return apkChan
+}
+
+func (a *Apk) handleFile(f *zip.File, apkChan chan ([]byte)) error {
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	data, err := io.ReadAll(io.LimitReader(rc, a.maxSize))
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case path.Ext(f.Name) == ".dex":
+		data = dexStrings(data)
+	case path.Ext(f.Name) == ".so":
+		data = binaryStrings(data, minBinaryStringLength)
+	case path.Base(f.Name) == "resources.arsc", path.Ext(f.Name) == ".xml":
+		// App bundles hold resources as protocol buffers, which are
+		// scanned as they are.
+		if text, ok := resourceStrings(data); ok {
+			data = text
+		}
+	}
+	sendBytes(data, apkChan)
+	return nil
+}
+
+// openZip reads a zip file, up to maxSize, to open its central directory. The
+// returned reader replays the data that was read.
+func openZip(reader io.Reader, maxSize int64) (io.Reader, *zip.Reader) {
+	magic := make([]byte, 4)
+	n, _ := io.ReadFull(reader, magic)
+	magic = magic[:n]
+	if !bytes.Equal(magic, []byte("PK\x03\x04")) {
+		return io.MultiReader(bytes.NewReader(magic), reader), nil
+	}
+
+	data, err := io.ReadAll(io.LimitReader(io.MultiReader(bytes.NewReader(magic), reader), maxSize+1))
+	if err != nil || int64(len(data)) > maxSize {
+		return io.MultiReader(bytes.NewReader(data), reader), nil
+	}
+	zipReader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return bytes.NewReader(data), nil
+	}
+	return bytes.NewReader(data), zipReader
+}
+
+// sendBytes sends data in overlapping chunks, the same way sources.Chunker
+// splits chunks.
+func sendBytes(data []byte, dataChan chan ([]byte)) {
+	for len(data) > sources.ChunkSize+sources.PeekSize {
+		dataChan <- data[:sources.ChunkSize+sources.PeekSize]
+		data = data[sources.ChunkSize:]
+	}
+	if len(data) > 0 {
+		dataChan <- data
+	}
+}
+
+// resourceStrings returns the strings of the string pools of a compiled
+// resource table or binary XML file, one per line.
+func resourceStrings(data []byte) ([]byte, bool) {
+	if len(data) < 8 {
+		return nil, false
+	}
+	if chunkType := binary.LittleEndian.Uint16(data); chunkType != resTableType && chunkType != resXMLType {
+		return nil, false
+	}
+	if size := binary.LittleEndian.Uint32(data[4:]); int64(size) < int64(len(data)) {
+		data = data[:size]
+	}
+
+	var out bytes.Buffer
+	walkResourceChunks(data, &out)
+	return out.Bytes(), true
+}
+
+// walkResourceChunks extracts the strings of the string pools among the
+// children of a chunk, descending into packages.
+func walkResourceChunks(chunk []byte, out *bytes.Buffer) {
+	headerSize := int(binary.LittleEndian.Uint16(chunk[2:]))
+	if headerSize < 8 || headerSize > len(chunk) {
+		return
+	}
+	rest := chunk[headerSize:]
+	for len(rest) >= 8 {
+		chunkType := binary.LittleEndian.Uint16(rest)
+		size := int64(binary.LittleEndian.Uint32(rest[4:]))
+		if size < 8 || size > int64(len(rest)) {
+			return
+		}
+		switch chunkType {
+		case resStringPoolType:
+			stringPool(rest[:size], out)
+		case resTablePackageType:
+			walkResourceChunks(rest[:size], out)
+		}
+		rest = rest[size:]
+	}
+}
+
+// stringPool writes the strings of a string pool chunk to out.
+func stringPool(chunk []byte, out *bytes.Buffer) {
+	if len(chunk) < 28 {
+		return
+	}
+	headerSize := int64(binary.LittleEndian.Uint16(chunk[2:]))
+	count := int64(binary.LittleEndian.Uint32(chunk[8:]))
+	flags := binary.LittleEndian.Uint32(chunk[16:])
+	stringsStart := int64(binary.LittleEndian.Uint32(chunk[20:]))
+
+	for i := int64(0); i < count; i++ {
+		offset := headerSize + 4*i
+		if offset+4 > int64(len(chunk)) {
+			return
+		}
+		start := stringsStart + int64(binary.LittleEndian.Uint32(chunk[offset:]))
+		if start >= int64(len(chunk)) {
+			continue
+		}
+		var s []byte
+		if flags&resUTF8Flag != 0 {
+			s = poolUTF8(chunk[start:])
+		} else {
+			s = poolUTF16(chunk[start:])
+		}
+		if len(s) == 0 {
+			continue
+		}
+		out.Write(s)
+		out.WriteByte('\n')
+	}
+}
+
+// poolUTF8 decodes a string of a UTF-8 string pool, which is preceded by its
+// length in UTF-16 code units and its length in bytes.
+func poolUTF8(b []byte) []byte {
+	_, b = poolLength8(b)
+	n, b := poolLength8(b)
+	if n > len(b) {
+		return nil
+	}
+	return b[:n]
+}
+
+func poolLength8(b []byte) (int, []byte) {
+	if len(b) == 0 {
+		return 0, nil
+	}
+	if b[0]&0x80 == 0 {
+		return int(b[0]), b[1:]
+	}
+	if len(b) < 2 {
+		return 0, nil
+	}
+	return int(b[0]&0x7f)<<8 | int(b[1]), b[2:]
+}
+
+// poolUTF16 decodes a string of a UTF-16 string pool, which is preceded by its
+// length in code units.
+func poolUTF16(b []byte) []byte {
+	if len(b) < 2 {
+		return nil
+	}
+	n := int(binary.LittleEndian.Uint16(b))
+	b = b[2:]
+	if n&0x8000 != 0 {
+		if len(b) < 2 {
+			return nil
+		}
+		n = (n&0x7fff)<<16 | int(binary.LittleEndian.Uint16(b))
+		b = b[2:]
+	}
+	if 2*n > len(b) {
+		return nil
+	}
+	units := make([]uint16, n)
+	for i := range units {
+		units[i] = binary.LittleEndian.Uint16(b[2*i:])
+	}
+	return []byte(string(utf16.Decode(units)))
+}
+
+// dexStrings returns the strings of the string table of a DEX file, one per
+// line. Data that isn't a DEX file is returned as is.
+func dexStrings(data []byte) []byte {
+	if len(data) < 0x70 || !bytes.HasPrefix(data, []byte("dex\n")) {
+		return data
+	}
+	count := int64(binary.LittleEndian.Uint32(data[56:]))
+	idsOffset := int64(binary.LittleEndian.Uint32(data[60:]))
+
+	var out bytes.Buffer
+	for i := int64(0); i < count; i++ {
+		offset := idsOffset + 4*i
+		if offset+4 > int64(len(data)) {
+			break
+		}
+		start := int64(binary.LittleEndian.Uint32(data[offset:]))
+		if start >= int64(len(data)) {
+			continue
+		}
+		// Skip the ULEB128 length in UTF-16 code units. The MUTF-8 string
+		// that follows is null terminated.
+		b := data[start:]
+		for len(b) > 0 && b[0]&0x80 != 0 {
+			b = b[1:]
+		}
+		if len(b) == 0 {
+			continue
+		}
+		b = b[1:]
+		end := bytes.IndexByte(b, 0)
+		if end <= 0 {
+			continue
+		}
+		out.Write(b[:end])
+		out.WriteByte('\n')
+	}
+	return out.Bytes()
+}
+
+// binaryStrings returns the runs of at least minLength printable ASCII
+// characters of a binary, one per line, like strings(1).
+func binaryStrings(data []byte, minLength int) []byte {
+	var out bytes.Buffer
+	start := -1
+	for i := 0; i <= len(data); i++ {
+		if i < len(data) && (data[i] >= 0x20 && data[i] < 0x7f || data[i] == '\t') {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 && i-start >= minLength {
+			out.Write(data[start:i])
+			out.WriteByte('\n')
+		}
+		start = -1
+	}
+	return out.Bytes()
+}
@@ -9,6 +9,8 @@ import (
 
 func DefaultHandlers() []Handler {
 	return []Handler{
+		&Apk{},
+		&Ipa{},
 		&Archive{},
 	}
 }
@@ -0,0 +1,155 @@
+package handlers
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"howett.net/plist"
+)
+
+// Ipa is a handler for iOS app archives (IPA). Property lists, provisioning
+// profiles, asset catalogs and executables of the app bundle are binary, so
+// they are decoded or their strings extracted before being scanned.
+type Ipa struct {
+	maxSize   int64
+	zipReader *zip.Reader
+}
+
+// New sets a default maximum size.
+func (i *Ipa) New() {
+	i.maxSize = 250 * 1024 * 1024 // 250MB
+	i.zipReader = nil
+}
+
+// IsFiletype returns true if the provided reader is an IPA.
+func (i *Ipa) IsFiletype(reader io.Reader) (io.Reader, bool) {
+	reader, zipReader := openZip(reader, i.maxSize)
+	if zipReader == nil {
+		return reader, false
+	}
+	for _, f := range zipReader.File {
+		if strings.HasPrefix(f.Name, "Payload/") && strings.Contains(f.Name, ".app/") {
+			i.zipReader = zipReader
+			return reader, true
+		}
+	}
+	return reader, false
+}
+
+// FromFile extracts the contents of the files of the archive read by
+// IsFiletype.
+func (i *Ipa) FromFile(_ io.Reader) chan ([]byte) {
+	ipaChan := make(chan ([]byte), 512)
+	go func() {
+		defer close(ipaChan)
+		for _, f := range i.zipReader.File {
+			if f.FileInfo().IsDir() {
+				continue
+			}
+			log.WithField("filename", f.Name).Trace("Handling IPA file.")
+			if err := i.handleFile(f, ipaChan); err != nil {
+				log.WithError(err).WithField("filename", f.Name).Debug("Error reading IPA file.")
+			}
+		}
+	}()
+	return ipaChan
+}
+
+func (i *Ipa) handleFile(f *zip.File, ipaChan chan ([]byte)) error {
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	data, err := io.ReadAll(io.LimitReader(rc, i.maxSize))
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case path.Ext(f.Name) == ".plist", path.Ext(f.Name) == ".strings":
+		// Text files that aren't property lists are scanned as they are.
+		if text, ok := plistText(data); ok {
+			data = text
+		}
+	case path.Base(f.Name) == "embedded.mobileprovision":
+		data = provisioningProfile(data)
+	case path.Ext(f.Name) == ".car", path.Ext(f.Name) == ".dylib", isMachO(data):
+		data = binaryStrings(data, minBinaryStringLength)
+	}
+	sendBytes(data, ipaChan)
+	return nil
+}
+
+// plistText decodes an XML, binary or OpenStep property list into lines of
+// key paths and values.
+func plistText(data []byte) ([]byte, bool) {
+	var value any
+	if _, err := plist.Unmarshal(data, &value); err != nil {
+		return nil, false
+	}
+	var out bytes.Buffer
+	writePlistValue(&out, "", value)
+	return out.Bytes(), true
+}
+
+func writePlistValue(out *bytes.Buffer, key string, value any) {
+	switch value := value.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(value))
+		for k := range value {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if key != "" {
+				writePlistValue(out, key+"."+k, value[k])
+				continue
+			}
+			writePlistValue(out, k, value[k])
+		}
+	case []any:
+		for idx, v := range value {
+			writePlistValue(out, fmt.Sprintf("%s[%d]", key, idx), v)
+		}
+	case []byte:
+		if text := binaryStrings(value, minBinaryStringLength); len(text) > 0 {
+			fmt.Fprintf(out, "%s = %s", key, text)
+		}
+	default:
+		fmt.Fprintf(out, "%s = %v\n", key, value)
+	}
+}
+
+// provisioningProfile decodes the property list signed in a provisioning
+// profile. Data that doesn't hold one is returned as is.
+func provisioningProfile(data []byte) []byte {
+	start := bytes.Index(data, []byte("<?xml"))
+	end := bytes.Index(data, []byte("</plist>"))
+	if start < 0 || end < start {
+		return data
+	}
+	text, ok := plistText(data[start : end+len("</plist>")])
+	if !ok {
+		return data
+	}
+	return text
+}
+
+// isMachO reports whether data is a Mach-O executable or universal binary.
+func isMachO(data []byte) bool {
+	if len(data) < 4 {
+		return false
+	}
+	switch string(data[:4]) {
+	case "\xfe\xed\xfa\xce", "\xfe\xed\xfa\xcf", "\xce\xfa\xed\xfe", "\xcf\xfa\xed\xfe", "\xca\xfe\xba\xbe":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,60 @@
+package log
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRotatingFile(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "trufflehog.log")
+	f, err := OpenRotatingFile(filename, 10, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{"first\n", "second\n", "third\n", "fourth\n"} {
+		if _, err := f.Write([]byte(line)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The oldest line was rotated out of the backups.
+	want := map[string]string{
+		filename:        "fourth\n",
+		filename + ".1": "third\n",
+		filename + ".2": "second\n",
+	}
+	for name, content := range want {
+		data, err := os.ReadFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != content {
+			t.Errorf("%s = %q, want %q", name, data, content)
+		}
+	}
+	if _, err := os.Stat(filename + ".3"); !os.IsNotExist(err) {
+		t.Errorf("found a third backup: %v", err)
+	}
+
+	// Reopening appends to the file.
+	f, err = OpenRotatingFile(filename, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Write([]byte(strings.Repeat("x", 20) + "\n")); err != nil {
+		t.Fatal(err)
+	}
+	_ = f.Close()
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(data), "fourth\n") {
+		t.Errorf("%s = %q, want it appended to", filename, data)
+	}
+}
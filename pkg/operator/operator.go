@@ -0,0 +1,387 @@
+package operator
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/config"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/schedule"
+)
+
+const (
+	// resyncInterval is how often SecretScans and their jobs are checked.
+	resyncInterval = 30 * time.Second
+	// maxFindings is how many findings are kept in the status of a
+	// SecretScan, whose size is limited like any object.
+	maxFindings = 100
+	// maxLogBytes is how much of the log of a scan is read for its results.
+	maxLogBytes = 64 << 20
+	// jobTTL is how long the jobs of scans are kept after they finish.
+	jobTTL = 24 * 60 * 60
+
+	// configPath is where the configuration of a scan is mounted in its pod.
+	configPath = "/etc/trufflehog"
+
+	managedByLabel  = "app.kubernetes.io/managed-by"
+	managedBy       = "trufflehog-operator"
+	secretScanLabel = group + "/secretscan"
+)
+
+// Operator runs the scans of SecretScans as jobs, on their schedules, and
+// writes the findings of the scans to their status.
+type Operator struct {
+	client *Client
+	// namespace is the namespace of the SecretScans, or all namespaces when
+	// it is empty.
+	namespace string
+	// image is the image of the jobs of the scans.
+	image string
+}
+
+// New returns an operator managing the SecretScans of a namespace, or of all
+// namespaces when it is empty.
+func New(client *Client, namespace, image string) *Operator {
+	return &Operator{client: client, namespace: namespace, image: image}
+}
+
+// Run reconciles the SecretScans until the context is cancelled.
+func (o *Operator) Run(ctx context.Context) {
+	ticker := time.NewTicker(resyncInterval)
+	defer ticker.Stop()
+	for {
+		if err := o.reconcileAll(ctx, time.Now()); err != nil && !common.IsDone(ctx) {
+			logrus.WithError(err).Error("could not list SecretScans")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (o *Operator) reconcileAll(ctx context.Context, now time.Time) error {
+	path := "/apis/" + apiVersion + "/" + resource
+	if o.namespace != "" {
+		path = "/apis/" + apiVersion + "/namespaces/" + o.namespace + "/" + resource
+	}
+	var list secretScanList
+	if err := o.client.do(ctx, http.MethodGet, path, "", nil, &list); err != nil {
+		return err
+	}
+	for i := range list.Items {
+		scan := &list.Items[i]
+		if err := o.reconcile(ctx, scan, now); err != nil {
+			logrus.WithError(err).WithField("secretscan", scan.Metadata.Namespace+"/"+scan.Metadata.Name).Error("could not reconcile SecretScan")
+		}
+	}
+	return nil
+}
+
+// reconcile finishes the running scan of a SecretScan when its job ended, or
+// starts a scan when one is due.
+func (o *Operator) reconcile(ctx context.Context, scan *SecretScan, now time.Time) error {
+	status := scan.Status
+	if status.Phase == PhaseRunning {
+		return o.checkJob(ctx, scan)
+	}
+
+	var next time.Time
+	if scan.Spec.Schedule == "" {
+		if status.ObservedGeneration == scan.Metadata.Generation {
+			return nil
+		}
+		next = now
+	} else {
+		sched, err := schedule.Parse(scan.Spec.Schedule)
+		if err != nil {
+			return o.fail(ctx, scan, fmt.Sprintf("invalid schedule: %s", err))
+		}
+		// Like the server, the first scan is on the schedule.
+		last := scan.Metadata.CreationTimestamp
+		if status.LastScheduleTime != nil {
+			last = *status.LastScheduleTime
+		}
+		next = sched.Next(last)
+		if next.IsZero() {
+			return o.fail(ctx, scan, "the schedule never matches")
+		}
+	}
+	if next.After(now) {
+		if status.NextScheduleTime == nil || !status.NextScheduleTime.Equal(next) {
+			status.NextScheduleTime = &next
+			return o.updateStatus(ctx, scan, status)
+		}
+		return nil
+	}
+	return o.startJob(ctx, scan, now)
+}
+
+// fail sets a SecretScan as failed, once for each change of its spec.
+func (o *Operator) fail(ctx context.Context, scan *SecretScan, message string) error {
+	status := scan.Status
+	if status.Phase == PhaseFailed && status.Message == message && status.ObservedGeneration == scan.Metadata.Generation {
+		return nil
+	}
+	status.Phase = PhaseFailed
+	status.Message = message
+	status.ObservedGeneration = scan.Metadata.Generation
+	status.NextScheduleTime = nil
+	return o.updateStatus(ctx, scan, status)
+}
+
+// startJob starts the job scanning the sources of a SecretScan, whose
+// configuration is stored in a secret since sources hold credentials.
+func (o *Operator) startJob(ctx context.Context, scan *SecretScan, now time.Time) error {
+	for i, source := range scan.Spec.Sources {
+		if _, err := config.NewSource(source); err != nil {
+			return o.fail(ctx, scan, fmt.Sprintf("invalid source %d: %s", i+1, err))
+		}
+	}
+	if len(scan.Spec.Sources) == 0 {
+		return o.fail(ctx, scan, "no sources to scan")
+	}
+	conf, err := json.Marshal(map[string]interface{}{"sources": scan.Spec.Sources})
+	if err != nil {
+		return err
+	}
+
+	meta := scan.Metadata
+	name := jobName(meta.Name, now)
+	objectMeta := map[string]interface{}{
+		"name":            name,
+		"namespace":       meta.Namespace,
+		"labels":          map[string]string{managedByLabel: managedBy, secretScanLabel: meta.Name},
+		"ownerReferences": []interface{}{ownerReference(scan)},
+	}
+	secret := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata":   objectMeta,
+		// JSON is valid YAML.
+		"stringData": map[string]string{"config.yaml": string(conf)},
+	}
+	if err := o.client.do(ctx, http.MethodPost, "/api/v1/namespaces/"+meta.Namespace+"/secrets", "application/json", secret, nil); err != nil {
+		return fmt.Errorf("could not create the secret of job %s: %w", name, err)
+	}
+	if err := o.client.do(ctx, http.MethodPost, "/apis/batch/v1/namespaces/"+meta.Namespace+"/jobs", "application/json", o.job(scan, objectMeta), nil); err != nil {
+		return fmt.Errorf("could not create job %s: %w", name, err)
+	}
+	logrus.WithField("secretscan", meta.Namespace+"/"+meta.Name).WithField("job", name).Info("started scan")
+
+	status := scan.Status
+	status.Phase = PhaseRunning
+	status.Message = ""
+	status.Job = name
+	status.ObservedGeneration = meta.Generation
+	status.LastScheduleTime = &now
+	status.NextScheduleTime = nil
+	return o.updateStatus(ctx, scan, status)
+}
+
+// job returns the job running trufflehog on the configuration of a scan.
+func (o *Operator) job(scan *SecretScan, objectMeta map[string]interface{}) map[string]interface{} {
+	image := o.image
+	if scan.Spec.Image != "" {
+		image = scan.Spec.Image
+	}
+	args := []string{"multi-scan", "--config", configPath + "/config.yaml", "--json", "--no-update"}
+	if scan.Spec.OnlyVerified {
+		args = append(args, "--only-verified")
+	}
+	if scan.Spec.Sinks.Syslog != "" {
+		args = append(args, "--syslog-output", scan.Spec.Sinks.Syslog)
+	}
+	podSpec := map[string]interface{}{
+		"restartPolicy": "Never",
+		"containers": []interface{}{map[string]interface{}{
+			"name":  "trufflehog",
+			"image": image,
+			// The entrypoint of the image splits its last argument.
+			"command":      []string{"/usr/bin/trufflehog"},
+			"args":         args,
+			"volumeMounts": []interface{}{map[string]interface{}{"name": "config", "mountPath": configPath, "readOnly": true}},
+		}},
+		"volumes": []interface{}{map[string]interface{}{
+			"name":   "config",
+			"secret": map[string]string{"secretName": objectMeta["name"].(string)},
+		}},
+	}
+	if scan.Spec.ServiceAccountName != "" {
+		podSpec["serviceAccountName"] = scan.Spec.ServiceAccountName
+	}
+	return map[string]interface{}{
+		"apiVersion": "batch/v1",
+		"kind":       "Job",
+		"metadata":   objectMeta,
+		"spec": map[string]interface{}{
+			// Failed scans are retried on the schedule.
+			"backoffLimit":            0,
+			"ttlSecondsAfterFinished": jobTTL,
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{"labels": objectMeta["labels"]},
+				"spec":     podSpec,
+			},
+		},
+	}
+}
+
+// checkJob writes the findings of the job of a SecretScan to its status once
+// the job ended.
+func (o *Operator) checkJob(ctx context.Context, scan *SecretScan) error {
+	meta := scan.Metadata
+	status := scan.Status
+	var job struct {
+		Status struct {
+			Succeeded int `json:"succeeded"`
+			Failed    int `json:"failed"`
+		} `json:"status"`
+	}
+	err := o.client.do(ctx, http.MethodGet, "/apis/batch/v1/namespaces/"+meta.Namespace+"/jobs/"+status.Job, "", nil, &job)
+	switch {
+	case isNotFound(err):
+		status.Phase = PhaseFailed
+		status.Message = fmt.Sprintf("job %s was deleted before it ended", status.Job)
+	case err != nil:
+		return err
+	case job.Status.Succeeded == 0 && job.Status.Failed == 0:
+		return nil
+	default:
+		log, err := o.jobLog(ctx, meta.Namespace, status.Job)
+		if err != nil {
+			return err
+		}
+		res := parseLog(log)
+		status.Verified, status.Unverified, status.Findings = res.verified, res.unverified, res.findings
+		if job.Status.Succeeded > 0 {
+			status.Phase = PhaseSucceeded
+			status.Message = fmt.Sprintf("found %d verified and %d unverified secrets", res.verified, res.unverified)
+		} else {
+			status.Phase = PhaseFailed
+			status.Message = "the scan failed: " + res.lastLine
+		}
+	}
+
+	// The configuration isn't needed anymore, and holds credentials.
+	if err := o.client.do(ctx, http.MethodDelete, "/api/v1/namespaces/"+meta.Namespace+"/secrets/"+status.Job, "", nil, nil); err != nil && !isNotFound(err) {
+		logrus.WithError(err).WithField("job", status.Job).Error("could not delete the secret of job")
+	}
+	now := time.Now()
+	status.LastCompletionTime = &now
+	logrus.WithField("secretscan", meta.Namespace+"/"+meta.Name).WithField("job", status.Job).Info(status.Message)
+	return o.updateStatus(ctx, scan, status)
+}
+
+// jobLog returns the log of the pod of a job.
+func (o *Operator) jobLog(ctx context.Context, namespace, job string) ([]byte, error) {
+	var pods struct {
+		Items []struct {
+			Metadata ObjectMeta `json:"metadata"`
+		} `json:"items"`
+	}
+	query := url.Values{"labelSelector": {"job-name=" + job}}
+	if err := o.client.do(ctx, http.MethodGet, "/api/v1/namespaces/"+namespace+"/pods?"+query.Encode(), "", nil, &pods); err != nil {
+		return nil, err
+	}
+	if len(pods.Items) == 0 {
+		return nil, nil
+	}
+	var log []byte
+	path := fmt.Sprintf("/api/v1/namespaces/%s/pods/%s/log?limitBytes=%d", namespace, pods.Items[0].Metadata.Name, maxLogBytes)
+	if err := o.client.do(ctx, http.MethodGet, path, "", nil, &log); err != nil {
+		return nil, fmt.Errorf("could not read the log of job %s: %w", job, err)
+	}
+	return log, nil
+}
+
+// logResults are the results found in the log of a scan.
+type logResults struct {
+	verified, unverified int
+	findings             []Finding
+	// lastLine is the last line that isn't a result, which is the error of
+	// failed scans.
+	lastLine string
+}
+
+// parseLog parses the results of a scan, printed as JSON, from its log,
+// which also holds the messages of trufflehog.
+func parseLog(log []byte) logResults {
+	var res logResults
+	scanner := bufio.NewScanner(bytes.NewReader(log))
+	scanner.Buffer(nil, 16<<20)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		var result struct {
+			SourceMetadata json.RawMessage
+			SourceName     string
+			DetectorName   string
+			DecoderName    string
+			Verified       bool
+			Redacted       string
+		}
+		if err := json.Unmarshal(line, &result); err != nil || result.DetectorName == "" {
+			if trimmed := bytes.TrimSpace(line); len(trimmed) > 0 {
+				res.lastLine = string(trimmed)
+			}
+			continue
+		}
+		if result.Verified {
+			res.verified++
+		} else {
+			res.unverified++
+		}
+		if len(res.findings) < maxFindings {
+			res.findings = append(res.findings, Finding{
+				Detector:       result.DetectorName,
+				Decoder:        result.DecoderName,
+				Verified:       result.Verified,
+				Redacted:       result.Redacted,
+				SourceName:     result.SourceName,
+				SourceMetadata: result.SourceMetadata,
+			})
+		}
+	}
+	return res
+}
+
+func (o *Operator) updateStatus(ctx context.Context, scan *SecretScan, status SecretScanStatus) error {
+	meta := scan.Metadata
+	path := "/apis/" + apiVersion + "/namespaces/" + meta.Namespace + "/" + resource + "/" + meta.Name + "/status"
+	patch := map[string]interface{}{"status": status}
+	if err := o.client.do(ctx, http.MethodPatch, path, "application/merge-patch+json", patch, nil); err != nil {
+		return fmt.Errorf("could not update status: %w", err)
+	}
+	scan.Status = status
+	return nil
+}
+
+// jobName returns the name of the job of a scan started at a time, which is
+// also the name of its secret.
+func jobName(scanName string, now time.Time) string {
+	suffix := fmt.Sprintf("-%d", now.Unix())
+	// Job names are used as labels, limited to 63 characters.
+	if len(scanName) > 63-len(suffix) {
+		scanName = scanName[:63-len(suffix)]
+	}
+	return scanName + suffix
+}
+
+func ownerReference(scan *SecretScan) map[string]interface{} {
+	return map[string]interface{}{
+		"apiVersion":         apiVersion,
+		"kind":               kind,
+		"name":               scan.Metadata.Name,
+		"uid":                scan.Metadata.UID,
+		"controller":         true,
+		"blockOwnerDeletion": true,
+	}
+}
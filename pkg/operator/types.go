@@ -0,0 +1,96 @@
+package operator
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// The group, version and resource of SecretScans, defined by
+// deploy/operator/crd.yaml.
+const (
+	group      = "trufflehog.trufflesecurity.com"
+	apiVersion = group + "/v1alpha1"
+	kind       = "SecretScan"
+	resource   = "secretscans"
+)
+
+// Phases of a SecretScan.
+const (
+	PhaseRunning   = "Running"
+	PhaseSucceeded = "Succeeded"
+	PhaseFailed    = "Failed"
+)
+
+// SecretScan is a custom resource describing sources to scan, on a schedule
+// or once, whose status holds the findings of the last scan.
+type SecretScan struct {
+	Metadata ObjectMeta       `json:"metadata"`
+	Spec     SecretScanSpec   `json:"spec"`
+	Status   SecretScanStatus `json:"status"`
+}
+
+// ObjectMeta is the metadata of objects used by the operator.
+type ObjectMeta struct {
+	Name              string    `json:"name"`
+	Namespace         string    `json:"namespace"`
+	UID               string    `json:"uid"`
+	Generation        int64     `json:"generation"`
+	CreationTimestamp time.Time `json:"creationTimestamp"`
+}
+
+// SecretScanSpec describes the sources of a SecretScan and how they are
+// scanned.
+type SecretScanSpec struct {
+	// Sources are declared like the sources of the configuration file.
+	Sources []json.RawMessage `json:"sources"`
+	// Schedule is a cron expression, a descriptor like "@daily" or an
+	// interval like "@every 6h". Without a schedule, the sources are scanned
+	// once for each change of the spec.
+	Schedule string `json:"schedule,omitempty"`
+	// OnlyVerified only reports verified results.
+	OnlyVerified bool `json:"onlyVerified,omitempty"`
+	// Image overrides the image of the operator running the scans.
+	Image string `json:"image,omitempty"`
+	// ServiceAccountName is the service account of the pods of the scans,
+	// for sources using the credentials of the pod like cloud providers.
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+	Sinks              Sinks  `json:"sinks"`
+}
+
+// Sinks are where results are sent, on top of the status of the SecretScan.
+type Sinks struct {
+	// Syslog is the URL of a syslog collector, like --syslog-output.
+	Syslog string `json:"syslog,omitempty"`
+}
+
+// SecretScanStatus is the status of the last scan of a SecretScan. Its
+// fields aren't omitted when empty, since the status is updated with merge
+// patches.
+type SecretScanStatus struct {
+	Phase              string     `json:"phase"`
+	Message            string     `json:"message"`
+	ObservedGeneration int64      `json:"observedGeneration"`
+	Job                string     `json:"job"`
+	LastScheduleTime   *time.Time `json:"lastScheduleTime"`
+	LastCompletionTime *time.Time `json:"lastCompletionTime"`
+	NextScheduleTime   *time.Time `json:"nextScheduleTime"`
+	Verified           int        `json:"verified"`
+	Unverified         int        `json:"unverified"`
+	// Findings are the first maxFindings results of the last scan, without
+	// their raw secret.
+	Findings []Finding `json:"findings"`
+}
+
+// Finding is a result of a scan.
+type Finding struct {
+	Detector       string          `json:"detector"`
+	Decoder        string          `json:"decoder"`
+	Verified       bool            `json:"verified"`
+	Redacted       string          `json:"redacted,omitempty"`
+	SourceName     string          `json:"sourceName"`
+	SourceMetadata json.RawMessage `json:"sourceMetadata,omitempty"`
+}
+
+type secretScanList struct {
+	Items []SecretScan `json:"items"`
+}
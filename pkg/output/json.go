@@ -3,9 +3,12 @@ package output
 import (
 	"encoding/json"
 	"fmt"
+	"io"
+	"os"
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
@@ -13,6 +16,29 @@ import (
 )
 
 func PrintJSON(r *detectors.ResultWithMetadata) {
+	if err := (JSONPrinter{Out: os.Stdout}).Print(context.Background(), r); err != nil {
+		logrus.WithError(err).Fatal("could not marshal result")
+	}
+}
+
+// JSONPrinter writes results as JSON lines, in the format of MarshalResult.
+type JSONPrinter struct {
+	Out io.Writer
+}
+
+var _ Printer = JSONPrinter{}
+
+func (p JSONPrinter) Print(_ context.Context, r *detectors.ResultWithMetadata) error {
+	out, err := MarshalResult(r)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(p.Out, string(out))
+	return err
+}
+
+// MarshalResult encodes a result in the JSON format of --json.
+func MarshalResult(r *detectors.ResultWithMetadata) ([]byte, error) {
 	v := &struct {
 		// SourceMetadata contains source-specific contextual information.
 		SourceMetadata *source_metadatapb.MetaData
@@ -50,9 +76,5 @@ func PrintJSON(r *detectors.ResultWithMetadata) {
 		ExtraData:      r.ExtraData,
 		StructuredData: r.StructuredData,
 	}
-	out, err := json.Marshal(v)
-	if err != nil {
-		logrus.WithError(err).Fatal("could not marshal result")
-	}
-	fmt.Println(string(out))
+	return json.Marshal(v)
 }
@@ -3,6 +3,7 @@ package output
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"log"
 	"net/url"
 	"os"
@@ -21,6 +22,20 @@ import (
 )
 
 func PrintLegacyJSON(ctx context.Context, r *detectors.ResultWithMetadata) {
+	if err := (LegacyJSONPrinter{Out: os.Stdout}).Print(ctx, r); err != nil {
+		logrus.WithError(err).Fatal("could not print result")
+	}
+}
+
+// LegacyJSONPrinter writes the results of git sources in the JSON format of
+// trufflehog v2, cloning their repositories again to find their branches.
+type LegacyJSONPrinter struct {
+	Out io.Writer
+}
+
+var _ Printer = LegacyJSONPrinter{}
+
+func (p LegacyJSONPrinter) Print(ctx context.Context, r *detectors.ResultWithMetadata) error {
 	var repo string
 	switch r.SourceType {
 	case sourcespb.SourceType_SOURCE_TYPE_GIT:
@@ -35,8 +50,11 @@ func PrintLegacyJSON(ctx context.Context, r *detectors.ResultWithMetadata) {
 
 	// cloning the repo again here is not great and only works with unauthed repos
 	repoPath, remote, err := git.PrepareRepo(ctx, repo)
-	if err != nil || repoPath == "" {
-		logrus.WithError(err).Fatal("error preparing git repo for scanning")
+	if err != nil {
+		return fmt.Errorf("error preparing git repo for scanning: %w", err)
+	}
+	if repoPath == "" {
+		return fmt.Errorf("error preparing git repo for scanning: %q", repo)
 	}
 	if remote {
 		defer os.RemoveAll(repoPath)
@@ -45,9 +63,10 @@ func PrintLegacyJSON(ctx context.Context, r *detectors.ResultWithMetadata) {
 	legacy := ConvertToLegacyJSON(r, repoPath)
 	out, err := json.Marshal(legacy)
 	if err != nil {
-		logrus.WithError(err).Fatal("could not marshal result")
+		return err
 	}
-	fmt.Println(string(out))
+	_, err = fmt.Fprintln(p.Out, string(out))
+	return err
 }
 
 func ConvertToLegacyJSON(r *detectors.ResultWithMetadata, repoPath string) *LegacyJSONOutput {
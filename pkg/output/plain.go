@@ -3,6 +3,8 @@ package output
 import (
 	"encoding/json"
 	"fmt"
+	"io"
+	"os"
 	"sort"
 	"strings"
 
@@ -11,6 +13,7 @@ import (
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
 )
@@ -22,6 +25,20 @@ var (
 )
 
 func PrintPlainOutput(r *detectors.ResultWithMetadata) {
+	if err := (PlainPrinter{Out: os.Stdout}).Print(context.Background(), r); err != nil {
+		logrus.WithError(err).Fatal("could not marshal result")
+	}
+}
+
+// PlainPrinter writes results for people to read, in color when Out is a
+// terminal.
+type PlainPrinter struct {
+	Out io.Writer
+}
+
+var _ Printer = PlainPrinter{}
+
+func (p PlainPrinter) Print(_ context.Context, r *detectors.ResultWithMetadata) error {
 	out := outputFormat{
 		DetectorType: r.Result.DetectorType.String(),
 		DecoderType:  r.Result.DecoderType.String(),
@@ -32,20 +49,20 @@ func PrintPlainOutput(r *detectors.ResultWithMetadata) {
 
 	meta, err := structToMap(out.MetaData.Data)
 	if err != nil {
-		logrus.WithError(err).Fatal("could not marshal result")
+		return err
 	}
 
 	printer := greenPrinter
 
 	if out.Verified {
-		yellowPrinter.Print("Found verified result 🐷🔑\n")
+		yellowPrinter.Fprint(p.Out, "Found verified result 🐷🔑\n")
 	} else {
 		printer = whitePrinter
-		whitePrinter.Print("Found unverified result 🐷🔑❓\n")
+		whitePrinter.Fprint(p.Out, "Found unverified result 🐷🔑❓\n")
 	}
-	printer.Printf("Detector Type: %s\n", out.DetectorType)
-	printer.Printf("Decoder Type: %s\n", out.DecoderType)
-	printer.Printf("Raw result: %s\n", whitePrinter.Sprint(out.Raw))
+	printer.Fprintf(p.Out, "Detector Type: %s\n", out.DetectorType)
+	printer.Fprintf(p.Out, "Decoder Type: %s\n", out.DecoderType)
+	printer.Fprintf(p.Out, "Raw result: %s\n", whitePrinter.Sprint(out.Raw))
 
 	var aggregateData = make(map[string]interface{})
 	var aggregateDataKeys []string
@@ -58,9 +75,10 @@ func PrintPlainOutput(r *detectors.ResultWithMetadata) {
 	}
 	sort.Strings(aggregateDataKeys)
 	for _, k := range aggregateDataKeys {
-		printer.Printf("%s: %v\n", cases.Title(language.AmericanEnglish).String(k), aggregateData[k])
+		printer.Fprintf(p.Out, "%s: %v\n", cases.Title(language.AmericanEnglish).String(k), aggregateData[k])
 	}
-	fmt.Println("")
+	_, err = fmt.Fprintln(p.Out, "")
+	return err
 }
 
 func structToMap(obj interface{}) (m map[string]map[string]interface{}, err error) {
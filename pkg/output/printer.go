@@ -0,0 +1,12 @@
+package output
+
+import (
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+)
+
+// Printer writes results in one of the output formats of the command, so
+// programs embedding the engine can print them like it does, to any writer.
+type Printer interface {
+	Print(ctx context.Context, r *detectors.ResultWithMetadata) error
+}
@@ -0,0 +1,112 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/engine"
+)
+
+const (
+	progressBarWidth = 20
+	// maxProgressMessage is the length messages are truncated to, so the
+	// line of a source fits in a terminal.
+	maxProgressMessage = 50
+)
+
+// ProgressPrinter prints the progress of the sources of a scan. On a
+// terminal the progress is redrawn in place, and otherwise it is printed
+// again at each interval.
+type ProgressPrinter struct {
+	w     io.Writer
+	tty   bool
+	start time.Time
+	// drawn is the number of lines of the last progress drawn on a terminal.
+	drawn int
+}
+
+// NewProgressPrinter returns a ProgressPrinter writing to f, usually stderr.
+func NewProgressPrinter(f *os.File) *ProgressPrinter {
+	info, err := f.Stat()
+	tty := err == nil && info.Mode()&os.ModeCharDevice != 0
+	return &ProgressPrinter{w: f, tty: tty, start: time.Now()}
+}
+
+// Interval is how often the progress should be printed.
+func (p *ProgressPrinter) Interval() time.Duration {
+	if p.tty {
+		return time.Second
+	}
+	return 30 * time.Second
+}
+
+// Print prints the progress of the sources and the number of bytes scanned.
+func (p *ProgressPrinter) Print(progress []engine.SourceProgress, bytesScanned uint64, now time.Time) {
+	p.Clear()
+	var b strings.Builder
+	for _, source := range progress {
+		b.WriteString(progressLine(source, now))
+		b.WriteByte('\n')
+	}
+	elapsed := now.Sub(p.start).Round(time.Second)
+	fmt.Fprintf(&b, "scanned %s in %s\n", formatBytes(bytesScanned), elapsed)
+	_, _ = io.WriteString(p.w, b.String())
+	if p.tty {
+		p.drawn = len(progress) + 1
+	}
+}
+
+// Clear erases the progress drawn on a terminal, before printing something
+// else like a result.
+func (p *ProgressPrinter) Clear() {
+	if p.drawn == 0 {
+		return
+	}
+	// Move up a line and erase it, for each line drawn.
+	_, _ = io.WriteString(p.w, strings.Repeat("\x1b[1A\x1b[2K", p.drawn))
+	p.drawn = 0
+}
+
+// progressLine formats the progress of a source as a bar, followed by the
+// sections completed, what is being scanned and the estimated time left.
+func progressLine(source engine.SourceProgress, now time.Time) string {
+	percent := source.PercentComplete
+	if percent > 100 {
+		percent = 100
+	}
+	filled := int(percent) * progressBarWidth / 100
+	line := fmt.Sprintf("%-14s [%s%s] %3d%%", source.Name, strings.Repeat("#", filled), strings.Repeat("-", progressBarWidth-filled), percent)
+	if source.SectionsTotal > 0 {
+		line += fmt.Sprintf("  %d/%d", source.SectionsCompleted, source.SectionsTotal)
+	}
+	if source.Done() {
+		return line + "  done"
+	}
+	if message := source.Message; message != "" {
+		if runes := []rune(message); len(runes) > maxProgressMessage {
+			message = string(runes[:maxProgressMessage-3]) + "..."
+		}
+		line += "  " + message
+	}
+	if eta := source.ETA(now); eta > 0 {
+		line += "  ETA " + eta.String()
+	}
+	return line
+}
+
+// formatBytes formats a number of bytes with a binary unit.
+func formatBytes(n uint64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := uint64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,149 @@
+package output
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+)
+
+const (
+	// syslogFacility is the local0 facility, which collectors commonly route
+	// to security tooling.
+	syslogFacility = 16
+	// Verified results are reported as critical, unverified ones as warnings.
+	syslogSeverityVerified   = 2
+	syslogSeverityUnverified = 4
+
+	// syslogSDID is the ID of the structured data of results. 32473 is the
+	// private enterprise number reserved for documentation by RFC 5612.
+	syslogSDID = "trufflehog@32473"
+
+	syslogTimeout = 10 * time.Second
+)
+
+// SyslogWriter sends results to a syslog collector as RFC5424 messages, whose
+// structured data holds the detector and verification status of the result
+// and whose message is the result in the JSON format of --json.
+type SyslogWriter struct {
+	network  string
+	address  string
+	hostname string
+	conn     net.Conn
+}
+
+// NewSyslogWriter connects to the collector at a URL like
+// udp://siem.example.com:514. The tcp scheme frames messages with their
+// length as described by RFC6587, and the tls scheme also encrypts them.
+func NewSyslogWriter(collector string) (*SyslogWriter, error) {
+	u, err := url.Parse(collector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid syslog collector %q: %w", collector, err)
+	}
+	switch u.Scheme {
+	case "udp", "tcp", "tls":
+	default:
+		return nil, fmt.Errorf("unsupported syslog protocol %q, expected udp, tcp or tls", u.Scheme)
+	}
+	if u.Port() == "" {
+		return nil, fmt.Errorf("syslog collector %q has no port", collector)
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "-"
+	}
+	w := &SyslogWriter{network: u.Scheme, address: u.Host, hostname: hostname}
+	if err := w.connect(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *SyslogWriter) connect() error {
+	var err error
+	dialer := &net.Dialer{Timeout: syslogTimeout}
+	if w.network == "tls" {
+		w.conn, err = tls.DialWithDialer(dialer, "tcp", w.address, &tls.Config{})
+	} else {
+		w.conn, err = dialer.Dial(w.network, w.address)
+	}
+	if err != nil {
+		return fmt.Errorf("could not connect to syslog collector %s: %w", w.address, err)
+	}
+	return nil
+}
+
+// Write sends a result to the collector, reconnecting once if the connection
+// was lost.
+func (w *SyslogWriter) Write(r *detectors.ResultWithMetadata) error {
+	msg, err := w.format(r, time.Now())
+	if err != nil {
+		return err
+	}
+	if w.network != "udp" {
+		// Octet counting framing, since messages may contain newlines.
+		msg = append([]byte(fmt.Sprintf("%d ", len(msg))), msg...)
+	}
+
+	if err = w.send(msg); err == nil {
+		return nil
+	}
+	w.conn.Close()
+	if err := w.connect(); err != nil {
+		return err
+	}
+	return w.send(msg)
+}
+
+func (w *SyslogWriter) send(msg []byte) error {
+	if err := w.conn.SetWriteDeadline(time.Now().Add(syslogTimeout)); err != nil {
+		return err
+	}
+	_, err := w.conn.Write(msg)
+	return err
+}
+
+// Close closes the connection to the collector.
+func (w *SyslogWriter) Close() error {
+	return w.conn.Close()
+}
+
+// format returns the RFC5424 message of a result:
+// <PRI>1 TIMESTAMP HOSTNAME APP-NAME PROCID MSGID [SD] MSG
+func (w *SyslogWriter) format(r *detectors.ResultWithMetadata, now time.Time) ([]byte, error) {
+	body, err := MarshalResult(r)
+	if err != nil {
+		return nil, err
+	}
+	severity := syslogSeverityUnverified
+	if r.Verified {
+		severity = syslogSeverityVerified
+	}
+	sd := fmt.Sprintf(`[%s detector="%s" decoder="%s" verified="%t" source="%s"]`,
+		syslogSDID,
+		escapeSDParam(r.DetectorType.String()),
+		escapeSDParam(r.DecoderType.String()),
+		r.Verified,
+		escapeSDParam(r.SourceName),
+	)
+	header := fmt.Sprintf("<%d>1 %s %s trufflehog %d result %s ",
+		syslogFacility*8+severity,
+		now.UTC().Format("2006-01-02T15:04:05.000000Z07:00"),
+		w.hostname,
+		os.Getpid(),
+		sd,
+	)
+	return append([]byte(header), body...), nil
+}
+
+// escapeSDParam escapes the characters that can't appear as is in the value
+// of a structured data parameter.
+func escapeSDParam(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`).Replace(value)
+}
@@ -1405,3 +1405,112 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = SlackTokensValidationError{}
+
+// Validate checks the field values on Kerberos with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Kerberos) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Kerberos with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in KerberosMultiError, or nil
+// if none found.
+func (m *Kerberos) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Kerberos) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Username
+
+	// no validation rules for Realm
+
+	// no validation rules for Password
+
+	// no validation rules for Ccache
+
+	// no validation rules for Config
+
+	if len(errors) > 0 {
+		return KerberosMultiError(errors)
+	}
+
+	return nil
+}
+
+// KerberosMultiError is an error wrapping multiple validation errors returned
+// by Kerberos.ValidateAll() if the designated constraints aren't met.
+type KerberosMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KerberosMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KerberosMultiError) AllErrors() []error { return m }
+
+// KerberosValidationError is the validation error returned by
+// Kerberos.Validate if the designated constraints aren't met.
+type KerberosValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KerberosValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KerberosValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KerberosValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KerberosValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KerberosValidationError) ErrorName() string { return "KerberosValidationError" }
+
+// Error satisfies the builtin error interface
+func (e KerberosValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKerberos.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KerberosValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KerberosValidationError{}
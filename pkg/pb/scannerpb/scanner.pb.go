@@ -0,0 +1,742 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.20.0
+// source: scanner.proto
+
+package scannerpb
+
+import (
+	context "context"
+	detectorspb "github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+	source_metadatapb "github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ScanGitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// uri of the repository, with an https://, file:// or ssh:// scheme.
+	Uri          string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Branch       string `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
+	SinceCommit  string `protobuf:"bytes,3,opt,name=since_commit,json=sinceCommit,proto3" json:"since_commit,omitempty"`
+	MaxDepth     int64  `protobuf:"varint,4,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+	OnlyVerified bool   `protobuf:"varint,5,opt,name=only_verified,json=onlyVerified,proto3" json:"only_verified,omitempty"`
+}
+
+func (x *ScanGitRequest) Reset() {
+	*x = ScanGitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_scanner_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScanGitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanGitRequest) ProtoMessage() {}
+
+func (x *ScanGitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scanner_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanGitRequest.ProtoReflect.Descriptor instead.
+func (*ScanGitRequest) Descriptor() ([]byte, []int) {
+	return file_scanner_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ScanGitRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *ScanGitRequest) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *ScanGitRequest) GetSinceCommit() string {
+	if x != nil {
+		return x.SinceCommit
+	}
+	return ""
+}
+
+func (x *ScanGitRequest) GetMaxDepth() int64 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+func (x *ScanGitRequest) GetOnlyVerified() bool {
+	if x != nil {
+		return x.OnlyVerified
+	}
+	return false
+}
+
+type ScanBytesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// name identifies the data in the metadata of results, like a file name.
+	Name         string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	OnlyVerified bool   `protobuf:"varint,3,opt,name=only_verified,json=onlyVerified,proto3" json:"only_verified,omitempty"`
+}
+
+func (x *ScanBytesRequest) Reset() {
+	*x = ScanBytesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_scanner_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScanBytesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanBytesRequest) ProtoMessage() {}
+
+func (x *ScanBytesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scanner_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanBytesRequest.ProtoReflect.Descriptor instead.
+func (*ScanBytesRequest) Descriptor() ([]byte, []int) {
+	return file_scanner_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ScanBytesRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ScanBytesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ScanBytesRequest) GetOnlyVerified() bool {
+	if x != nil {
+		return x.OnlyVerified
+	}
+	return false
+}
+
+type ScanBytesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *ScanBytesResponse) Reset() {
+	*x = ScanBytesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_scanner_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScanBytesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanBytesResponse) ProtoMessage() {}
+
+func (x *ScanBytesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scanner_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanBytesResponse.ProtoReflect.Descriptor instead.
+func (*ScanBytesResponse) Descriptor() ([]byte, []int) {
+	return file_scanner_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ScanBytesResponse) GetResults() []*Result {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type Result struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DetectorType   detectorspb.DetectorType    `protobuf:"varint,1,opt,name=detector_type,json=detectorType,proto3,enum=detectors.DetectorType" json:"detector_type,omitempty"`
+	DetectorName   string                      `protobuf:"bytes,2,opt,name=detector_name,json=detectorName,proto3" json:"detector_name,omitempty"`
+	DecoderType    detectorspb.DecoderType     `protobuf:"varint,3,opt,name=decoder_type,json=decoderType,proto3,enum=detectors.DecoderType" json:"decoder_type,omitempty"`
+	Verified       bool                        `protobuf:"varint,4,opt,name=verified,proto3" json:"verified,omitempty"`
+	Raw            string                      `protobuf:"bytes,5,opt,name=raw,proto3" json:"raw,omitempty"`
+	Redacted       string                      `protobuf:"bytes,6,opt,name=redacted,proto3" json:"redacted,omitempty"`
+	ExtraData      map[string]string           `protobuf:"bytes,7,rep,name=extra_data,json=extraData,proto3" json:"extra_data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	SourceName     string                      `protobuf:"bytes,8,opt,name=source_name,json=sourceName,proto3" json:"source_name,omitempty"`
+	SourceMetadata *source_metadatapb.MetaData `protobuf:"bytes,9,opt,name=source_metadata,json=sourceMetadata,proto3" json:"source_metadata,omitempty"`
+}
+
+func (x *Result) Reset() {
+	*x = Result{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_scanner_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Result) ProtoMessage() {}
+
+func (x *Result) ProtoReflect() protoreflect.Message {
+	mi := &file_scanner_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Result.ProtoReflect.Descriptor instead.
+func (*Result) Descriptor() ([]byte, []int) {
+	return file_scanner_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Result) GetDetectorType() detectorspb.DetectorType {
+	if x != nil {
+		return x.DetectorType
+	}
+	return detectorspb.DetectorType(0)
+}
+
+func (x *Result) GetDetectorName() string {
+	if x != nil {
+		return x.DetectorName
+	}
+	return ""
+}
+
+func (x *Result) GetDecoderType() detectorspb.DecoderType {
+	if x != nil {
+		return x.DecoderType
+	}
+	return detectorspb.DecoderType(0)
+}
+
+func (x *Result) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *Result) GetRaw() string {
+	if x != nil {
+		return x.Raw
+	}
+	return ""
+}
+
+func (x *Result) GetRedacted() string {
+	if x != nil {
+		return x.Redacted
+	}
+	return ""
+}
+
+func (x *Result) GetExtraData() map[string]string {
+	if x != nil {
+		return x.ExtraData
+	}
+	return nil
+}
+
+func (x *Result) GetSourceName() string {
+	if x != nil {
+		return x.SourceName
+	}
+	return ""
+}
+
+func (x *Result) GetSourceMetadata() *source_metadatapb.MetaData {
+	if x != nil {
+		return x.SourceMetadata
+	}
+	return nil
+}
+
+var File_scanner_proto protoreflect.FileDescriptor
+
+var file_scanner_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x07, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x1a, 0x0f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x9f, 0x01, 0x0a, 0x0e, 0x53, 0x63, 0x61, 0x6e, 0x47, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x21, 0x0a,
+	0x0c, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x23, 0x0a,
+	0x0d, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6f, 0x6e, 0x6c, 0x79, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x22, 0x5f, 0x0a, 0x10, 0x53, 0x63, 0x61, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6f, 0x6e, 0x6c, 0x79, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x22, 0x3e, 0x0a, 0x11, 0x53, 0x63, 0x61, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x63, 0x61, 0x6e,
+	0x6e, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x22, 0xd2, 0x03, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3c,
+	0x0a, 0x0d, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x73, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c,
+	0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x39, 0x0a, 0x0c, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x0b, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x61, 0x77, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x61, 0x77, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
+	0x64, 0x61, 0x63, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65,
+	0x64, 0x61, 0x63, 0x74, 0x65, 0x64, 0x12, 0x3d, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x63, 0x61,
+	0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x72,
+	0x61, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x65, 0x78, 0x74, 0x72,
+	0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0e, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3c, 0x0a, 0x0e, 0x45, 0x78,
+	0x74, 0x72, 0x61, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x32, 0xc5, 0x01, 0x0a, 0x07, 0x53, 0x63, 0x61,
+	0x6e, 0x6e, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x07, 0x53, 0x63, 0x61, 0x6e, 0x47, 0x69, 0x74, 0x12,
+	0x17, 0x2e, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x47, 0x69,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x73, 0x63, 0x61, 0x6e, 0x6e,
+	0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x30, 0x01, 0x12, 0x42, 0x0a, 0x09, 0x53,
+	0x63, 0x61, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x73, 0x63, 0x61, 0x6e, 0x6e,
+	0x65, 0x72, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x53, 0x63,
+	0x61, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3f, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x12, 0x19, 0x2e, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x73, 0x63,
+	0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x28, 0x01, 0x30, 0x01,
+	0x42, 0x3b, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74,
+	0x72, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x74,
+	0x72, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x68, 0x6f, 0x67, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b, 0x67,
+	0x2f, 0x70, 0x62, 0x2f, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_scanner_proto_rawDescOnce sync.Once
+	file_scanner_proto_rawDescData = file_scanner_proto_rawDesc
+)
+
+func file_scanner_proto_rawDescGZIP() []byte {
+	file_scanner_proto_rawDescOnce.Do(func() {
+		file_scanner_proto_rawDescData = protoimpl.X.CompressGZIP(file_scanner_proto_rawDescData)
+	})
+	return file_scanner_proto_rawDescData
+}
+
+var file_scanner_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_scanner_proto_goTypes = []interface{}{
+	(*ScanGitRequest)(nil),             // 0: scanner.ScanGitRequest
+	(*ScanBytesRequest)(nil),           // 1: scanner.ScanBytesRequest
+	(*ScanBytesResponse)(nil),          // 2: scanner.ScanBytesResponse
+	(*Result)(nil),                     // 3: scanner.Result
+	nil,                                // 4: scanner.Result.ExtraDataEntry
+	(detectorspb.DetectorType)(0),      // 5: detectors.DetectorType
+	(detectorspb.DecoderType)(0),       // 6: detectors.DecoderType
+	(*source_metadatapb.MetaData)(nil), // 7: source_metadata.MetaData
+}
+var file_scanner_proto_depIdxs = []int32{
+	3, // 0: scanner.ScanBytesResponse.results:type_name -> scanner.Result
+	5, // 1: scanner.Result.detector_type:type_name -> detectors.DetectorType
+	6, // 2: scanner.Result.decoder_type:type_name -> detectors.DecoderType
+	4, // 3: scanner.Result.extra_data:type_name -> scanner.Result.ExtraDataEntry
+	7, // 4: scanner.Result.source_metadata:type_name -> source_metadata.MetaData
+	0, // 5: scanner.Scanner.ScanGit:input_type -> scanner.ScanGitRequest
+	1, // 6: scanner.Scanner.ScanBytes:input_type -> scanner.ScanBytesRequest
+	1, // 7: scanner.Scanner.StreamResults:input_type -> scanner.ScanBytesRequest
+	3, // 8: scanner.Scanner.ScanGit:output_type -> scanner.Result
+	2, // 9: scanner.Scanner.ScanBytes:output_type -> scanner.ScanBytesResponse
+	3, // 10: scanner.Scanner.StreamResults:output_type -> scanner.Result
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_scanner_proto_init() }
+func file_scanner_proto_init() {
+	if File_scanner_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_scanner_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScanGitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_scanner_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScanBytesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_scanner_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScanBytesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_scanner_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Result); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_scanner_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_scanner_proto_goTypes,
+		DependencyIndexes: file_scanner_proto_depIdxs,
+		MessageInfos:      file_scanner_proto_msgTypes,
+	}.Build()
+	File_scanner_proto = out.File
+	file_scanner_proto_rawDesc = nil
+	file_scanner_proto_goTypes = nil
+	file_scanner_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// ScannerClient is the client API for Scanner service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ScannerClient interface {
+	// ScanGit scans a git repository, streaming its results until the scan ends.
+	ScanGit(ctx context.Context, in *ScanGitRequest, opts ...grpc.CallOption) (Scanner_ScanGitClient, error)
+	// ScanBytes scans data and returns its results.
+	ScanBytes(ctx context.Context, in *ScanBytesRequest, opts ...grpc.CallOption) (*ScanBytesResponse, error)
+	// StreamResults scans the data sent on the stream, streaming the results
+	// as they are found until the stream is closed by the client.
+	StreamResults(ctx context.Context, opts ...grpc.CallOption) (Scanner_StreamResultsClient, error)
+}
+
+type scannerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewScannerClient(cc grpc.ClientConnInterface) ScannerClient {
+	return &scannerClient{cc}
+}
+
+func (c *scannerClient) ScanGit(ctx context.Context, in *ScanGitRequest, opts ...grpc.CallOption) (Scanner_ScanGitClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Scanner_serviceDesc.Streams[0], "/scanner.Scanner/ScanGit", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &scannerScanGitClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Scanner_ScanGitClient interface {
+	Recv() (*Result, error)
+	grpc.ClientStream
+}
+
+type scannerScanGitClient struct {
+	grpc.ClientStream
+}
+
+func (x *scannerScanGitClient) Recv() (*Result, error) {
+	m := new(Result)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *scannerClient) ScanBytes(ctx context.Context, in *ScanBytesRequest, opts ...grpc.CallOption) (*ScanBytesResponse, error) {
+	out := new(ScanBytesResponse)
+	err := c.cc.Invoke(ctx, "/scanner.Scanner/ScanBytes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scannerClient) StreamResults(ctx context.Context, opts ...grpc.CallOption) (Scanner_StreamResultsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Scanner_serviceDesc.Streams[1], "/scanner.Scanner/StreamResults", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &scannerStreamResultsClient{stream}
+	return x, nil
+}
+
+type Scanner_StreamResultsClient interface {
+	Send(*ScanBytesRequest) error
+	Recv() (*Result, error)
+	grpc.ClientStream
+}
+
+type scannerStreamResultsClient struct {
+	grpc.ClientStream
+}
+
+func (x *scannerStreamResultsClient) Send(m *ScanBytesRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *scannerStreamResultsClient) Recv() (*Result, error) {
+	m := new(Result)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ScannerServer is the server API for Scanner service.
+type ScannerServer interface {
+	// ScanGit scans a git repository, streaming its results until the scan ends.
+	ScanGit(*ScanGitRequest, Scanner_ScanGitServer) error
+	// ScanBytes scans data and returns its results.
+	ScanBytes(context.Context, *ScanBytesRequest) (*ScanBytesResponse, error)
+	// StreamResults scans the data sent on the stream, streaming the results
+	// as they are found until the stream is closed by the client.
+	StreamResults(Scanner_StreamResultsServer) error
+}
+
+// UnimplementedScannerServer can be embedded to have forward compatible implementations.
+type UnimplementedScannerServer struct {
+}
+
+func (*UnimplementedScannerServer) ScanGit(*ScanGitRequest, Scanner_ScanGitServer) error {
+	return status.Errorf(codes.Unimplemented, "method ScanGit not implemented")
+}
+func (*UnimplementedScannerServer) ScanBytes(context.Context, *ScanBytesRequest) (*ScanBytesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScanBytes not implemented")
+}
+func (*UnimplementedScannerServer) StreamResults(Scanner_StreamResultsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamResults not implemented")
+}
+
+func RegisterScannerServer(s *grpc.Server, srv ScannerServer) {
+	s.RegisterService(&_Scanner_serviceDesc, srv)
+}
+
+func _Scanner_ScanGit_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ScanGitRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ScannerServer).ScanGit(m, &scannerScanGitServer{stream})
+}
+
+type Scanner_ScanGitServer interface {
+	Send(*Result) error
+	grpc.ServerStream
+}
+
+type scannerScanGitServer struct {
+	grpc.ServerStream
+}
+
+func (x *scannerScanGitServer) Send(m *Result) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Scanner_ScanBytes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScanBytesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScannerServer).ScanBytes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scanner.Scanner/ScanBytes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScannerServer).ScanBytes(ctx, req.(*ScanBytesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scanner_StreamResults_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ScannerServer).StreamResults(&scannerStreamResultsServer{stream})
+}
+
+type Scanner_StreamResultsServer interface {
+	Send(*Result) error
+	Recv() (*ScanBytesRequest, error)
+	grpc.ServerStream
+}
+
+type scannerStreamResultsServer struct {
+	grpc.ServerStream
+}
+
+func (x *scannerStreamResultsServer) Send(m *Result) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *scannerStreamResultsServer) Recv() (*ScanBytesRequest, error) {
+	m := new(ScanBytesRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+var _Scanner_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "scanner.Scanner",
+	HandlerType: (*ScannerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ScanBytes",
+			Handler:    _Scanner_ScanBytes_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ScanGit",
+			Handler:       _Scanner_ScanGit_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamResults",
+			Handler:       _Scanner_StreamResults_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "scanner.proto",
+}
@@ -0,0 +1,529 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: scanner.proto
+
+package scannerpb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ScanGitRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ScanGitRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ScanGitRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ScanGitRequestMultiError, or nil if
+// none found.
+func (m *ScanGitRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ScanGitRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Uri
+
+	// no validation rules for Branch
+
+	// no validation rules for SinceCommit
+
+	// no validation rules for MaxDepth
+
+	// no validation rules for OnlyVerified
+
+	if len(errors) > 0 {
+		return ScanGitRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ScanGitRequestMultiError is an error wrapping multiple validation errors returned
+// by ScanGitRequest.ValidateAll() if the designated constraints aren't met.
+type ScanGitRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScanGitRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScanGitRequestMultiError) AllErrors() []error { return m }
+
+// ScanGitRequestValidationError is the validation error returned by
+// ScanGitRequest.Validate if the designated constraints aren't met.
+type ScanGitRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScanGitRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScanGitRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScanGitRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScanGitRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScanGitRequestValidationError) ErrorName() string { return "ScanGitRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ScanGitRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScanGitRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScanGitRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScanGitRequestValidationError{}
+
+// Validate checks the field values on ScanBytesRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ScanBytesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ScanBytesRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ScanBytesRequestMultiError, or nil if
+// none found.
+func (m *ScanBytesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ScanBytesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Data
+
+	// no validation rules for Name
+
+	// no validation rules for OnlyVerified
+
+	if len(errors) > 0 {
+		return ScanBytesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ScanBytesRequestMultiError is an error wrapping multiple validation errors returned
+// by ScanBytesRequest.ValidateAll() if the designated constraints aren't met.
+type ScanBytesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScanBytesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScanBytesRequestMultiError) AllErrors() []error { return m }
+
+// ScanBytesRequestValidationError is the validation error returned by
+// ScanBytesRequest.Validate if the designated constraints aren't met.
+type ScanBytesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScanBytesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScanBytesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScanBytesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScanBytesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScanBytesRequestValidationError) ErrorName() string { return "ScanBytesRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ScanBytesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScanBytesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScanBytesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScanBytesRequestValidationError{}
+
+// Validate checks the field values on ScanBytesResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ScanBytesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ScanBytesResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ScanBytesResponseMultiError, or nil if
+// none found.
+func (m *ScanBytesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ScanBytesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ScanBytesResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ScanBytesResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ScanBytesResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ScanBytesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ScanBytesResponseMultiError is an error wrapping multiple validation errors returned
+// by ScanBytesResponse.ValidateAll() if the designated constraints aren't met.
+type ScanBytesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScanBytesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScanBytesResponseMultiError) AllErrors() []error { return m }
+
+// ScanBytesResponseValidationError is the validation error returned by
+// ScanBytesResponse.Validate if the designated constraints aren't met.
+type ScanBytesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScanBytesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScanBytesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScanBytesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScanBytesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScanBytesResponseValidationError) ErrorName() string {
+	return "ScanBytesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ScanBytesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScanBytesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScanBytesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScanBytesResponseValidationError{}
+
+// Validate checks the field values on Result with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Result) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Result with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ResultMultiError, or nil if
+// none found.
+func (m *Result) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Result) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DetectorType
+
+	// no validation rules for DetectorName
+
+	// no validation rules for DecoderType
+
+	// no validation rules for Verified
+
+	// no validation rules for Raw
+
+	// no validation rules for Redacted
+
+	// no validation rules for ExtraData
+
+	// no validation rules for SourceName
+
+	if all {
+		switch v := interface{}(m.GetSourceMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResultValidationError{
+					field:  "SourceMetadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResultValidationError{
+					field:  "SourceMetadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSourceMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResultValidationError{
+				field:  "SourceMetadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResultMultiError is an error wrapping multiple validation errors returned
+// by Result.ValidateAll() if the designated constraints aren't met.
+type ResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResultMultiError) AllErrors() []error { return m }
+
+// ResultValidationError is the validation error returned by
+// Result.Validate if the designated constraints aren't met.
+type ResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResultValidationError) ErrorName() string { return "ResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResultValidationError{}
@@ -409,6 +409,8 @@ func (m *CircleCI) validate(all bool) error {
 
 	// no validation rules for Email
 
+	// no validation rules for Artifact
+
 	if len(errors) > 0 {
 		return CircleCIMultiError(errors)
 	}
@@ -960,6 +962,18 @@ func (m *Git) validate(all bool) error {
 
 	// no validation rules for Line
 
+	// no validation rules for Branch
+
+	// no validation rules for BlameCommit
+
+	// no validation rules for BlameAuthor
+
+	// no validation rules for BlameEmail
+
+	// no validation rules for BlameTimestamp
+
+	// no validation rules for BlameAgeDays
+
 	if len(errors) > 0 {
 		return GitMultiError(errors)
 	}
@@ -1076,6 +1090,18 @@ func (m *Github) validate(all bool) error {
 
 	// no validation rules for Visibility
 
+	// no validation rules for Branch
+
+	// no validation rules for BlameCommit
+
+	// no validation rules for BlameAuthor
+
+	// no validation rules for BlameEmail
+
+	// no validation rules for BlameTimestamp
+
+	// no validation rules for BlameAgeDays
+
 	if len(errors) > 0 {
 		return GithubMultiError(errors)
 	}
@@ -1188,6 +1214,18 @@ func (m *Gitlab) validate(all bool) error {
 
 	// no validation rules for Line
 
+	// no validation rules for Branch
+
+	// no validation rules for BlameCommit
+
+	// no validation rules for BlameAuthor
+
+	// no validation rules for BlameEmail
+
+	// no validation rules for BlameTimestamp
+
+	// no validation rules for BlameAgeDays
+
 	if len(errors) > 0 {
 		return GitlabMultiError(errors)
 	}
@@ -2160,6 +2198,8 @@ func (m *Jenkins) validate(all bool) error {
 
 	// no validation rules for Timestamp
 
+	// no validation rules for File
+
 	if len(errors) > 0 {
 		return JenkinsMultiError(errors)
 	}
@@ -2272,6 +2312,12 @@ func (m *Teams) validate(all bool) error {
 
 	// no validation rules for Email
 
+	// no validation rules for TeamId
+
+	// no validation rules for TeamName
+
+	// no validation rules for ChatId
+
 	if len(errors) > 0 {
 		return TeamsMultiError(errors)
 	}
@@ -2707,255 +2753,3430 @@ var _ interface {
 	ErrorName() string
 } = PublicEventMonitoringValidationError{}
 
-// Validate checks the field values on MetaData with the rules defined in the
+// Validate checks the field values on SharePoint with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *MetaData) Validate() error {
+func (m *SharePoint) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MetaData with the rules defined in
+// ValidateAll checks the field values on SharePoint with the rules defined in
 // the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in MetaDataMultiError, or nil
-// if none found.
-func (m *MetaData) ValidateAll() error {
+// result is a list of violation errors wrapped in SharePointMultiError, or
+// nil if none found.
+func (m *SharePoint) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MetaData) validate(all bool) error {
+func (m *SharePoint) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch m.Data.(type) {
+	// no validation rules for SiteId
 
-	case *MetaData_Azure:
+	// no validation rules for SiteName
 
-		if all {
-			switch v := interface{}(m.GetAzure()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MetaDataValidationError{
-						field:  "Azure",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, MetaDataValidationError{
-						field:  "Azure",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetAzure()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return MetaDataValidationError{
-					field:  "Azure",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for DriveId
 
-	case *MetaData_Bitbucket:
+	// no validation rules for DriveName
 
-		if all {
-			switch v := interface{}(m.GetBitbucket()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MetaDataValidationError{
-						field:  "Bitbucket",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, MetaDataValidationError{
-						field:  "Bitbucket",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetBitbucket()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return MetaDataValidationError{
-					field:  "Bitbucket",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for File
 
-	case *MetaData_Circleci:
+	// no validation rules for Link
 
-		if all {
-			switch v := interface{}(m.GetCircleci()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MetaDataValidationError{
-						field:  "Circleci",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, MetaDataValidationError{
-						field:  "Circleci",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetCircleci()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return MetaDataValidationError{
-					field:  "Circleci",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Email
 
-	case *MetaData_Confluence:
+	// no validation rules for Timestamp
 
-		if all {
-			switch v := interface{}(m.GetConfluence()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MetaDataValidationError{
-						field:  "Confluence",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, MetaDataValidationError{
-						field:  "Confluence",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetConfluence()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return MetaDataValidationError{
-					field:  "Confluence",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	if len(errors) > 0 {
+		return SharePointMultiError(errors)
+	}
 
-	case *MetaData_Dockerhub:
+	return nil
+}
 
-		if all {
-			switch v := interface{}(m.GetDockerhub()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MetaDataValidationError{
-						field:  "Dockerhub",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, MetaDataValidationError{
-						field:  "Dockerhub",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetDockerhub()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return MetaDataValidationError{
-					field:  "Dockerhub",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+// SharePointMultiError is an error wrapping multiple validation errors
+// returned by SharePoint.ValidateAll() if the designated constraints aren't met.
+type SharePointMultiError []error
 
-	case *MetaData_Ecr:
+// Error returns a concatenation of all the error messages it wraps.
+func (m SharePointMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
 
-		if all {
-			switch v := interface{}(m.GetEcr()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MetaDataValidationError{
-						field:  "Ecr",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, MetaDataValidationError{
-						field:  "Ecr",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetEcr()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return MetaDataValidationError{
-					field:  "Ecr",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+// AllErrors returns a list of validation violation errors.
+func (m SharePointMultiError) AllErrors() []error { return m }
 
-	case *MetaData_Gcs:
+// SharePointValidationError is the validation error returned by
+// SharePoint.Validate if the designated constraints aren't met.
+type SharePointValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
 
-		if all {
-			switch v := interface{}(m.GetGcs()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MetaDataValidationError{
-						field:  "Gcs",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, MetaDataValidationError{
-						field:  "Gcs",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetGcs()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return MetaDataValidationError{
-					field:  "Gcs",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+// Field function returns field value.
+func (e SharePointValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SharePointValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SharePointValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SharePointValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SharePointValidationError) ErrorName() string { return "SharePointValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SharePointValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSharePoint.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SharePointValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SharePointValidationError{}
+
+// Validate checks the field values on Box with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Box) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Box with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in BoxMultiError, or nil if none found.
+func (m *Box) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Box) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FileId
+
+	// no validation rules for File
+
+	// no validation rules for VersionId
+
+	// no validation rules for Link
+
+	// no validation rules for Email
+
+	// no validation rules for Timestamp
+
+	if len(errors) > 0 {
+		return BoxMultiError(errors)
+	}
+
+	return nil
+}
+
+// BoxMultiError is an error wrapping multiple validation errors returned by
+// Box.ValidateAll() if the designated constraints aren't met.
+type BoxMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BoxMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BoxMultiError) AllErrors() []error { return m }
+
+// BoxValidationError is the validation error returned by Box.Validate if the
+// designated constraints aren't met.
+type BoxValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BoxValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BoxValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BoxValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BoxValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BoxValidationError) ErrorName() string { return "BoxValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BoxValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBox.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BoxValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BoxValidationError{}
+
+// Validate checks the field values on RubyGems with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RubyGems) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RubyGems with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RubyGemsMultiError, or nil
+// if none found.
+func (m *RubyGems) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RubyGems) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for File
+
+	// no validation rules for Package
+
+	// no validation rules for Release
+
+	// no validation rules for Link
+
+	// no validation rules for Authors
+
+	if len(errors) > 0 {
+		return RubyGemsMultiError(errors)
+	}
+
+	return nil
+}
+
+// RubyGemsMultiError is an error wrapping multiple validation errors returned
+// by RubyGems.ValidateAll() if the designated constraints aren't met.
+type RubyGemsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RubyGemsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RubyGemsMultiError) AllErrors() []error { return m }
+
+// RubyGemsValidationError is the validation error returned by
+// RubyGems.Validate if the designated constraints aren't met.
+type RubyGemsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RubyGemsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RubyGemsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RubyGemsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RubyGemsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RubyGemsValidationError) ErrorName() string { return "RubyGemsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RubyGemsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRubyGems.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RubyGemsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RubyGemsValidationError{}
+
+// Validate checks the field values on Maven with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Maven) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Maven with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MavenMultiError, or nil if none found.
+func (m *Maven) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Maven) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for File
+
+	// no validation rules for Group
+
+	// no validation rules for Artifact
+
+	// no validation rules for Version
+
+	// no validation rules for Link
+
+	if len(errors) > 0 {
+		return MavenMultiError(errors)
+	}
+
+	return nil
+}
+
+// MavenMultiError is an error wrapping multiple validation errors returned by
+// Maven.ValidateAll() if the designated constraints aren't met.
+type MavenMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MavenMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MavenMultiError) AllErrors() []error { return m }
+
+// MavenValidationError is the validation error returned by Maven.Validate if
+// the designated constraints aren't met.
+type MavenValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MavenValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MavenValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MavenValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MavenValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MavenValidationError) ErrorName() string { return "MavenValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MavenValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMaven.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MavenValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MavenValidationError{}
+
+// Validate checks the field values on GoModule with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GoModule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GoModule with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GoModuleMultiError, or nil
+// if none found.
+func (m *GoModule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GoModule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for File
+
+	// no validation rules for Module
+
+	// no validation rules for Version
+
+	// no validation rules for Link
+
+	if len(errors) > 0 {
+		return GoModuleMultiError(errors)
+	}
+
+	return nil
+}
+
+// GoModuleMultiError is an error wrapping multiple validation errors returned
+// by GoModule.ValidateAll() if the designated constraints aren't met.
+type GoModuleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GoModuleMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GoModuleMultiError) AllErrors() []error { return m }
+
+// GoModuleValidationError is the validation error returned by
+// GoModule.Validate if the designated constraints aren't met.
+type GoModuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GoModuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GoModuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GoModuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GoModuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GoModuleValidationError) ErrorName() string { return "GoModuleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GoModuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGoModule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GoModuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GoModuleValidationError{}
+
+// Validate checks the field values on Crates with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Crates) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Crates with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in CratesMultiError, or nil if none found.
+func (m *Crates) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Crates) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for File
+
+	// no validation rules for Package
+
+	// no validation rules for Release
+
+	// no validation rules for Link
+
+	// no validation rules for Publisher
+
+	// no validation rules for Yanked
+
+	if len(errors) > 0 {
+		return CratesMultiError(errors)
+	}
+
+	return nil
+}
+
+// CratesMultiError is an error wrapping multiple validation errors returned by
+// Crates.ValidateAll() if the designated constraints aren't met.
+type CratesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CratesMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CratesMultiError) AllErrors() []error { return m }
+
+// CratesValidationError is the validation error returned by Crates.Validate if
+// the designated constraints aren't met.
+type CratesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CratesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CratesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CratesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CratesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CratesValidationError) ErrorName() string { return "CratesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CratesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCrates.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CratesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CratesValidationError{}
+
+// Validate checks the field values on TerraformState with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TerraformState) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TerraformState with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TerraformStateMultiError,
+// or nil if none found.
+func (m *TerraformState) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TerraformState) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for File
+
+	// no validation rules for Address
+
+	// no validation rules for Sensitive
+
+	// no validation rules for Link
+
+	if len(errors) > 0 {
+		return TerraformStateMultiError(errors)
+	}
+
+	return nil
+}
+
+// TerraformStateMultiError is an error wrapping multiple validation errors
+// returned by TerraformState.ValidateAll() if the designated constraints
+// aren't met.
+type TerraformStateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TerraformStateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TerraformStateMultiError) AllErrors() []error { return m }
+
+// TerraformStateValidationError is the validation error returned by
+// TerraformState.Validate if the designated constraints aren't met.
+type TerraformStateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TerraformStateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TerraformStateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TerraformStateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TerraformStateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TerraformStateValidationError) ErrorName() string { return "TerraformStateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TerraformStateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTerraformState.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TerraformStateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TerraformStateValidationError{}
+
+// Validate checks the field values on TerraformCloud with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TerraformCloud) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TerraformCloud with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TerraformCloudMultiError,
+// or nil if none found.
+func (m *TerraformCloud) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TerraformCloud) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Organization
+
+	// no validation rules for Workspace
+
+	// no validation rules for Object
+
+	// no validation rules for Address
+
+	// no validation rules for Link
+
+	// no validation rules for Sensitive
+
+	// no validation rules for UnmarkedSecret
+
+	if len(errors) > 0 {
+		return TerraformCloudMultiError(errors)
+	}
+
+	return nil
+}
+
+// TerraformCloudMultiError is an error wrapping multiple validation errors
+// returned by TerraformCloud.ValidateAll() if the designated constraints
+// aren't met.
+type TerraformCloudMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TerraformCloudMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TerraformCloudMultiError) AllErrors() []error { return m }
+
+// TerraformCloudValidationError is the validation error returned by
+// TerraformCloud.Validate if the designated constraints aren't met.
+type TerraformCloudValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TerraformCloudValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TerraformCloudValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TerraformCloudValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TerraformCloudValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TerraformCloudValidationError) ErrorName() string { return "TerraformCloudValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TerraformCloudValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTerraformCloud.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TerraformCloudValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TerraformCloudValidationError{}
+
+// Validate checks the field values on GitHubActions with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GitHubActions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GitHubActions with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GitHubActionsMultiError, or
+// nil if none found.
+func (m *GitHubActions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GitHubActions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Repository
+
+	// no validation rules for Workflow
+
+	// no validation rules for RunId
+
+	// no validation rules for File
+
+	// no validation rules for Link
+
+	// no validation rules for Timestamp
+
+	if len(errors) > 0 {
+		return GitHubActionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// GitHubActionsMultiError is an error wrapping multiple validation errors
+// returned by GitHubActions.ValidateAll() if the designated constraints
+// aren't met.
+type GitHubActionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GitHubActionsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GitHubActionsMultiError) AllErrors() []error { return m }
+
+// GitHubActionsValidationError is the validation error returned by
+// GitHubActions.Validate if the designated constraints aren't met.
+type GitHubActionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GitHubActionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GitHubActionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GitHubActionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GitHubActionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GitHubActionsValidationError) ErrorName() string { return "GitHubActionsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GitHubActionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGitHubActions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GitHubActionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GitHubActionsValidationError{}
+
+// Validate checks the field values on AzurePipelines with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AzurePipelines) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AzurePipelines with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AzurePipelinesMultiError,
+// or nil if none found.
+func (m *AzurePipelines) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AzurePipelines) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Project
+
+	// no validation rules for Pipeline
+
+	// no validation rules for RunId
+
+	// no validation rules for File
+
+	// no validation rules for Link
+
+	// no validation rules for Timestamp
+
+	if len(errors) > 0 {
+		return AzurePipelinesMultiError(errors)
+	}
+
+	return nil
+}
+
+// AzurePipelinesMultiError is an error wrapping multiple validation errors
+// returned by AzurePipelines.ValidateAll() if the designated constraints
+// aren't met.
+type AzurePipelinesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AzurePipelinesMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AzurePipelinesMultiError) AllErrors() []error { return m }
+
+// AzurePipelinesValidationError is the validation error returned by
+// AzurePipelines.Validate if the designated constraints aren't met.
+type AzurePipelinesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AzurePipelinesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AzurePipelinesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AzurePipelinesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AzurePipelinesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AzurePipelinesValidationError) ErrorName() string { return "AzurePipelinesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AzurePipelinesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAzurePipelines.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AzurePipelinesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AzurePipelinesValidationError{}
+
+// Validate checks the field values on TeamCity with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TeamCity) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TeamCity with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TeamCityMultiError, or nil
+// if none found.
+func (m *TeamCity) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TeamCity) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Project
+
+	// no validation rules for BuildType
+
+	// no validation rules for BuildId
+
+	// no validation rules for BuildNumber
+
+	// no validation rules for File
+
+	// no validation rules for Link
+
+	// no validation rules for Timestamp
+
+	// no validation rules for Repository
+
+	// no validation rules for Commit
+
+	// no validation rules for Email
+
+	// no validation rules for Line
+
+	if len(errors) > 0 {
+		return TeamCityMultiError(errors)
+	}
+
+	return nil
+}
+
+// TeamCityMultiError is an error wrapping multiple validation errors returned
+// by TeamCity.ValidateAll() if the designated constraints aren't met.
+type TeamCityMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TeamCityMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TeamCityMultiError) AllErrors() []error { return m }
+
+// TeamCityValidationError is the validation error returned by
+// TeamCity.Validate if the designated constraints aren't met.
+type TeamCityValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TeamCityValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TeamCityValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TeamCityValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TeamCityValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TeamCityValidationError) ErrorName() string { return "TeamCityValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TeamCityValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTeamCity.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TeamCityValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TeamCityValidationError{}
+
+// Validate checks the field values on Postgres with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Postgres) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Postgres with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PostgresMultiError, or nil
+// if none found.
+func (m *Postgres) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Postgres) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Database
+
+	// no validation rules for Schema
+
+	// no validation rules for Table
+
+	// no validation rules for Column
+
+	// no validation rules for Row
+
+	if len(errors) > 0 {
+		return PostgresMultiError(errors)
+	}
+
+	return nil
+}
+
+// PostgresMultiError is an error wrapping multiple validation errors returned
+// by Postgres.ValidateAll() if the designated constraints aren't met.
+type PostgresMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PostgresMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PostgresMultiError) AllErrors() []error { return m }
+
+// PostgresValidationError is the validation error returned by
+// Postgres.Validate if the designated constraints aren't met.
+type PostgresValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PostgresValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PostgresValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PostgresValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PostgresValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PostgresValidationError) ErrorName() string { return "PostgresValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PostgresValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPostgres.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PostgresValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PostgresValidationError{}
+
+// Validate checks the field values on MySQL with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MySQL) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MySQL with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MySQLMultiError, or nil if none found.
+func (m *MySQL) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MySQL) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Database
+
+	// no validation rules for Table
+
+	// no validation rules for Column
+
+	// no validation rules for Row
+
+	if len(errors) > 0 {
+		return MySQLMultiError(errors)
+	}
+
+	return nil
+}
+
+// MySQLMultiError is an error wrapping multiple validation errors returned by
+// MySQL.ValidateAll() if the designated constraints aren't met.
+type MySQLMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MySQLMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MySQLMultiError) AllErrors() []error { return m }
+
+// MySQLValidationError is the validation error returned by MySQL.Validate if
+// the designated constraints aren't met.
+type MySQLValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MySQLValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MySQLValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MySQLValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MySQLValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MySQLValidationError) ErrorName() string { return "MySQLValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MySQLValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMySQL.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MySQLValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MySQLValidationError{}
+
+// Validate checks the field values on MongoDB with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MongoDB) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MongoDB with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MongoDBMultiError, or nil if none found.
+func (m *MongoDB) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MongoDB) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Database
+
+	// no validation rules for Collection
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return MongoDBMultiError(errors)
+	}
+
+	return nil
+}
+
+// MongoDBMultiError is an error wrapping multiple validation errors returned
+// by MongoDB.ValidateAll() if the designated constraints aren't met.
+type MongoDBMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MongoDBMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MongoDBMultiError) AllErrors() []error { return m }
+
+// MongoDBValidationError is the validation error returned by MongoDB.Validate
+// if the designated constraints aren't met.
+type MongoDBValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MongoDBValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MongoDBValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MongoDBValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MongoDBValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MongoDBValidationError) ErrorName() string { return "MongoDBValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MongoDBValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMongoDB.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MongoDBValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MongoDBValidationError{}
+
+// Validate checks the field values on Redis with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Redis) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Redis with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in RedisMultiError, or nil if none found.
+func (m *Redis) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Redis) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Address
+
+	// no validation rules for Database
+
+	// no validation rules for Key
+
+	// no validation rules for Type
+
+	if len(errors) > 0 {
+		return RedisMultiError(errors)
+	}
+
+	return nil
+}
+
+// RedisMultiError is an error wrapping multiple validation errors returned by
+// Redis.ValidateAll() if the designated constraints aren't met.
+type RedisMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RedisMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RedisMultiError) AllErrors() []error { return m }
+
+// RedisValidationError is the validation error returned by Redis.Validate if
+// the designated constraints aren't met.
+type RedisValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RedisValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RedisValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RedisValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RedisValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RedisValidationError) ErrorName() string { return "RedisValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RedisValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRedis.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RedisValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RedisValidationError{}
+
+// Validate checks the field values on DynamoDB with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DynamoDB) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DynamoDB with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DynamoDBMultiError, or nil
+// if none found.
+func (m *DynamoDB) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DynamoDB) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Region
+
+	// no validation rules for Table
+
+	// no validation rules for Key
+
+	if len(errors) > 0 {
+		return DynamoDBMultiError(errors)
+	}
+
+	return nil
+}
+
+// DynamoDBMultiError is an error wrapping multiple validation errors returned
+// by DynamoDB.ValidateAll() if the designated constraints aren't met.
+type DynamoDBMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DynamoDBMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DynamoDBMultiError) AllErrors() []error { return m }
+
+// DynamoDBValidationError is the validation error returned by
+// DynamoDB.Validate if the designated constraints aren't met.
+type DynamoDBValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DynamoDBValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DynamoDBValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DynamoDBValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DynamoDBValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DynamoDBValidationError) ErrorName() string { return "DynamoDBValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DynamoDBValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDynamoDB.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DynamoDBValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DynamoDBValidationError{}
+
+// Validate checks the field values on SQS with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *SQS) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SQS with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in SQSMultiError, or nil if none found.
+func (m *SQS) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SQS) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Queue
+
+	// no validation rules for MessageId
+
+	// no validation rules for Timestamp
+
+	if len(errors) > 0 {
+		return SQSMultiError(errors)
+	}
+
+	return nil
+}
+
+// SQSMultiError is an error wrapping multiple validation errors returned by
+// SQS.ValidateAll() if the designated constraints aren't met.
+type SQSMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SQSMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SQSMultiError) AllErrors() []error { return m }
+
+// SQSValidationError is the validation error returned by SQS.Validate if the
+// designated constraints aren't met.
+type SQSValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SQSValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SQSValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SQSValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SQSValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SQSValidationError) ErrorName() string { return "SQSValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SQSValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSQS.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SQSValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SQSValidationError{}
+
+// Validate checks the field values on Kafka with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Kafka) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Kafka with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in KafkaMultiError, or nil if none found.
+func (m *Kafka) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Kafka) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Topic
+
+	// no validation rules for Partition
+
+	// no validation rules for Offset
+
+	// no validation rules for Key
+
+	// no validation rules for Timestamp
+
+	if len(errors) > 0 {
+		return KafkaMultiError(errors)
+	}
+
+	return nil
+}
+
+// KafkaMultiError is an error wrapping multiple validation errors returned by
+// Kafka.ValidateAll() if the designated constraints aren't met.
+type KafkaMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KafkaMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KafkaMultiError) AllErrors() []error { return m }
+
+// KafkaValidationError is the validation error returned by Kafka.Validate if
+// the designated constraints aren't met.
+type KafkaValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KafkaValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KafkaValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KafkaValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KafkaValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KafkaValidationError) ErrorName() string { return "KafkaValidationError" }
+
+// Error satisfies the builtin error interface
+func (e KafkaValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKafka.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KafkaValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KafkaValidationError{}
+
+// Validate checks the field values on GCPLogging with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GCPLogging) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GCPLogging with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GCPLoggingMultiError, or
+// nil if none found.
+func (m *GCPLogging) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GCPLogging) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Resource
+
+	// no validation rules for LogName
+
+	// no validation rules for InsertId
+
+	// no validation rules for Timestamp
+
+	// no validation rules for Severity
+
+	// no validation rules for ResourceType
+
+	if len(errors) > 0 {
+		return GCPLoggingMultiError(errors)
+	}
+
+	return nil
+}
+
+// GCPLoggingMultiError is an error wrapping multiple validation errors
+// returned by GCPLogging.ValidateAll() if the designated constraints aren't met.
+type GCPLoggingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GCPLoggingMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GCPLoggingMultiError) AllErrors() []error { return m }
+
+// GCPLoggingValidationError is the validation error returned by
+// GCPLogging.Validate if the designated constraints aren't met.
+type GCPLoggingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GCPLoggingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GCPLoggingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GCPLoggingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GCPLoggingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GCPLoggingValidationError) ErrorName() string { return "GCPLoggingValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GCPLoggingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGCPLogging.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GCPLoggingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GCPLoggingValidationError{}
+
+// Validate checks the field values on Journald with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Journald) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Journald with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in JournaldMultiError, or nil
+// if none found.
+func (m *Journald) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Journald) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Hostname
+
+	// no validation rules for Unit
+
+	// no validation rules for Identifier
+
+	// no validation rules for Pid
+
+	// no validation rules for Timestamp
+
+	// no validation rules for Cursor
+
+	if len(errors) > 0 {
+		return JournaldMultiError(errors)
+	}
+
+	return nil
+}
+
+// JournaldMultiError is an error wrapping multiple validation errors returned
+// by Journald.ValidateAll() if the designated constraints aren't met.
+type JournaldMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JournaldMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JournaldMultiError) AllErrors() []error { return m }
+
+// JournaldValidationError is the validation error returned by
+// Journald.Validate if the designated constraints aren't met.
+type JournaldValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JournaldValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JournaldValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JournaldValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JournaldValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JournaldValidationError) ErrorName() string { return "JournaldValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JournaldValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJournald.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JournaldValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JournaldValidationError{}
+
+// Validate checks the field values on Website with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Website) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Website with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in WebsiteMultiError, or nil if none found.
+func (m *Website) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Website) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Url
+
+	// no validation rules for Referrer
+
+	// no validation rules for File
+
+	if len(errors) > 0 {
+		return WebsiteMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebsiteMultiError is an error wrapping multiple validation errors returned
+// by Website.ValidateAll() if the designated constraints aren't met.
+type WebsiteMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebsiteMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebsiteMultiError) AllErrors() []error { return m }
+
+// WebsiteValidationError is the validation error returned by Website.Validate
+// if the designated constraints aren't met.
+type WebsiteValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebsiteValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebsiteValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebsiteValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebsiteValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebsiteValidationError) ErrorName() string { return "WebsiteValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WebsiteValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebsite.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebsiteValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebsiteValidationError{}
+
+// Validate checks the field values on SMB with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *SMB) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SMB with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in SMBMultiError, or nil if none found.
+func (m *SMB) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SMB) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Address
+
+	// no validation rules for Share
+
+	// no validation rules for File
+
+	// no validation rules for Timestamp
+
+	if len(errors) > 0 {
+		return SMBMultiError(errors)
+	}
+
+	return nil
+}
+
+// SMBMultiError is an error wrapping multiple validation errors returned by
+// SMB.ValidateAll() if the designated constraints aren't met.
+type SMBMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SMBMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SMBMultiError) AllErrors() []error { return m }
+
+// SMBValidationError is the validation error returned by SMB.Validate if the
+// designated constraints aren't met.
+type SMBValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SMBValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SMBValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SMBValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SMBValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SMBValidationError) ErrorName() string { return "SMBValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SMBValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSMB.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SMBValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SMBValidationError{}
+
+// Validate checks the field values on DiskImage with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DiskImage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiskImage with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DiskImageMultiError, or nil
+// if none found.
+func (m *DiskImage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiskImage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Image
+
+	// no validation rules for Partition
+
+	// no validation rules for File
+
+	// no validation rules for Timestamp
+
+	if len(errors) > 0 {
+		return DiskImageMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiskImageMultiError is an error wrapping multiple validation errors returned
+// by DiskImage.ValidateAll() if the designated constraints aren't met.
+type DiskImageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiskImageMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiskImageMultiError) AllErrors() []error { return m }
+
+// DiskImageValidationError is the validation error returned by
+// DiskImage.Validate if the designated constraints aren't met.
+type DiskImageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiskImageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiskImageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiskImageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiskImageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiskImageValidationError) ErrorName() string { return "DiskImageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DiskImageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiskImage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiskImageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiskImageValidationError{}
+
+// Validate checks the field values on ProcessEnvironment with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ProcessEnvironment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProcessEnvironment with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ProcessEnvironmentMultiError, or nil if none found.
+func (m *ProcessEnvironment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProcessEnvironment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Pid
+
+	// no validation rules for Command
+
+	// no validation rules for Uid
+
+	// no validation rules for File
+
+	if len(errors) > 0 {
+		return ProcessEnvironmentMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProcessEnvironmentMultiError is an error wrapping multiple validation errors
+// returned by ProcessEnvironment.ValidateAll() if the designated constraints
+// aren't met.
+type ProcessEnvironmentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProcessEnvironmentMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProcessEnvironmentMultiError) AllErrors() []error { return m }
+
+// ProcessEnvironmentValidationError is the validation error returned by
+// ProcessEnvironment.Validate if the designated constraints aren't met.
+type ProcessEnvironmentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProcessEnvironmentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProcessEnvironmentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProcessEnvironmentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProcessEnvironmentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProcessEnvironmentValidationError) ErrorName() string {
+	return "ProcessEnvironmentValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ProcessEnvironmentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProcessEnvironment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProcessEnvironmentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProcessEnvironmentValidationError{}
+
+// Validate checks the field values on MetaData with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MetaData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetaData with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MetaDataMultiError, or nil
+// if none found.
+func (m *MetaData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetaData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch m.Data.(type) {
+
+	case *MetaData_Azure:
+
+		if all {
+			switch v := interface{}(m.GetAzure()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Azure",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Azure",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAzure()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetaDataValidationError{
+					field:  "Azure",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *MetaData_Bitbucket:
+
+		if all {
+			switch v := interface{}(m.GetBitbucket()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Bitbucket",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Bitbucket",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetBitbucket()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetaDataValidationError{
+					field:  "Bitbucket",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *MetaData_Circleci:
+
+		if all {
+			switch v := interface{}(m.GetCircleci()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Circleci",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Circleci",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCircleci()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetaDataValidationError{
+					field:  "Circleci",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *MetaData_Confluence:
+
+		if all {
+			switch v := interface{}(m.GetConfluence()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Confluence",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Confluence",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetConfluence()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetaDataValidationError{
+					field:  "Confluence",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *MetaData_Dockerhub:
+
+		if all {
+			switch v := interface{}(m.GetDockerhub()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Dockerhub",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Dockerhub",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDockerhub()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetaDataValidationError{
+					field:  "Dockerhub",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *MetaData_Ecr:
+
+		if all {
+			switch v := interface{}(m.GetEcr()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Ecr",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Ecr",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetEcr()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetaDataValidationError{
+					field:  "Ecr",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *MetaData_Gcs:
+
+		if all {
+			switch v := interface{}(m.GetGcs()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Gcs",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Gcs",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetGcs()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetaDataValidationError{
+					field:  "Gcs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *MetaData_Github:
+
+		if all {
+			switch v := interface{}(m.GetGithub()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Github",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Github",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetGithub()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetaDataValidationError{
+					field:  "Github",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *MetaData_Gitlab:
+
+		if all {
+			switch v := interface{}(m.GetGitlab()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Gitlab",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Gitlab",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetGitlab()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetaDataValidationError{
+					field:  "Gitlab",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *MetaData_Jira:
+
+		if all {
+			switch v := interface{}(m.GetJira()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Jira",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Jira",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetJira()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetaDataValidationError{
+					field:  "Jira",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *MetaData_Npm:
+
+		if all {
+			switch v := interface{}(m.GetNpm()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Npm",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Npm",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetNpm()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetaDataValidationError{
+					field:  "Npm",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *MetaData_Pypi:
+
+		if all {
+			switch v := interface{}(m.GetPypi()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Pypi",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Pypi",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPypi()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetaDataValidationError{
+					field:  "Pypi",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *MetaData_S3:
+
+		if all {
+			switch v := interface{}(m.GetS3()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "S3",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "S3",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetS3()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetaDataValidationError{
+					field:  "S3",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *MetaData_Slack:
+
+		if all {
+			switch v := interface{}(m.GetSlack()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Slack",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Slack",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSlack()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetaDataValidationError{
+					field:  "Slack",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *MetaData_Filesystem:
+
+		if all {
+			switch v := interface{}(m.GetFilesystem()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Filesystem",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Filesystem",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFilesystem()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetaDataValidationError{
+					field:  "Filesystem",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *MetaData_Git:
+
+		if all {
+			switch v := interface{}(m.GetGit()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Git",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Git",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetGit()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetaDataValidationError{
+					field:  "Git",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *MetaData_Test:
+
+		if all {
+			switch v := interface{}(m.GetTest()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Test",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Test",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTest()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetaDataValidationError{
+					field:  "Test",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *MetaData_Buildkite:
+
+		if all {
+			switch v := interface{}(m.GetBuildkite()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Buildkite",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Buildkite",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetBuildkite()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetaDataValidationError{
+					field:  "Buildkite",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *MetaData_Gerrit:
+
+		if all {
+			switch v := interface{}(m.GetGerrit()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Gerrit",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Gerrit",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetGerrit()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetaDataValidationError{
+					field:  "Gerrit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *MetaData_Jenkins:
+
+		if all {
+			switch v := interface{}(m.GetJenkins()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Jenkins",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Jenkins",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetJenkins()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetaDataValidationError{
+					field:  "Jenkins",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *MetaData_Teams:
+
+		if all {
+			switch v := interface{}(m.GetTeams()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Teams",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Teams",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTeams()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetaDataValidationError{
+					field:  "Teams",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *MetaData_Artifactory:
+
+		if all {
+			switch v := interface{}(m.GetArtifactory()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Artifactory",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Artifactory",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetArtifactory()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetaDataValidationError{
+					field:  "Artifactory",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *MetaData_Syslog:
+
+		if all {
+			switch v := interface{}(m.GetSyslog()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Syslog",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Syslog",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSyslog()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetaDataValidationError{
+					field:  "Syslog",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *MetaData_PublicEventMonitoring:
+
+		if all {
+			switch v := interface{}(m.GetPublicEventMonitoring()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "PublicEventMonitoring",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "PublicEventMonitoring",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPublicEventMonitoring()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetaDataValidationError{
+					field:  "PublicEventMonitoring",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *MetaData_Sharepoint:
+
+		if all {
+			switch v := interface{}(m.GetSharepoint()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Sharepoint",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Sharepoint",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSharepoint()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetaDataValidationError{
+					field:  "Sharepoint",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
 
-	case *MetaData_Github:
+	case *MetaData_Box:
 
 		if all {
-			switch v := interface{}(m.GetGithub()).(type) {
+			switch v := interface{}(m.GetBox()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, MetaDataValidationError{
-						field:  "Github",
+						field:  "Box",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -2963,30 +6184,30 @@ func (m *MetaData) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, MetaDataValidationError{
-						field:  "Github",
+						field:  "Box",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetGithub()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetBox()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return MetaDataValidationError{
-					field:  "Github",
+					field:  "Box",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *MetaData_Gitlab:
+	case *MetaData_Rubygems:
 
 		if all {
-			switch v := interface{}(m.GetGitlab()).(type) {
+			switch v := interface{}(m.GetRubygems()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, MetaDataValidationError{
-						field:  "Gitlab",
+						field:  "Rubygems",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -2994,30 +6215,30 @@ func (m *MetaData) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, MetaDataValidationError{
-						field:  "Gitlab",
+						field:  "Rubygems",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetGitlab()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetRubygems()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return MetaDataValidationError{
-					field:  "Gitlab",
+					field:  "Rubygems",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *MetaData_Jira:
+	case *MetaData_Maven:
 
 		if all {
-			switch v := interface{}(m.GetJira()).(type) {
+			switch v := interface{}(m.GetMaven()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, MetaDataValidationError{
-						field:  "Jira",
+						field:  "Maven",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3025,30 +6246,30 @@ func (m *MetaData) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, MetaDataValidationError{
-						field:  "Jira",
+						field:  "Maven",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetJira()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetMaven()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return MetaDataValidationError{
-					field:  "Jira",
+					field:  "Maven",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *MetaData_Npm:
+	case *MetaData_GoModule:
 
 		if all {
-			switch v := interface{}(m.GetNpm()).(type) {
+			switch v := interface{}(m.GetGoModule()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, MetaDataValidationError{
-						field:  "Npm",
+						field:  "GoModule",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3056,30 +6277,30 @@ func (m *MetaData) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, MetaDataValidationError{
-						field:  "Npm",
+						field:  "GoModule",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetNpm()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetGoModule()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return MetaDataValidationError{
-					field:  "Npm",
+					field:  "GoModule",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *MetaData_Pypi:
+	case *MetaData_Crates:
 
 		if all {
-			switch v := interface{}(m.GetPypi()).(type) {
+			switch v := interface{}(m.GetCrates()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, MetaDataValidationError{
-						field:  "Pypi",
+						field:  "Crates",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3087,30 +6308,30 @@ func (m *MetaData) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, MetaDataValidationError{
-						field:  "Pypi",
+						field:  "Crates",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetPypi()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetCrates()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return MetaDataValidationError{
-					field:  "Pypi",
+					field:  "Crates",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *MetaData_S3:
+	case *MetaData_TerraformState:
 
 		if all {
-			switch v := interface{}(m.GetS3()).(type) {
+			switch v := interface{}(m.GetTerraformState()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, MetaDataValidationError{
-						field:  "S3",
+						field:  "TerraformState",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3118,30 +6339,30 @@ func (m *MetaData) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, MetaDataValidationError{
-						field:  "S3",
+						field:  "TerraformState",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetS3()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetTerraformState()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return MetaDataValidationError{
-					field:  "S3",
+					field:  "TerraformState",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *MetaData_Slack:
+	case *MetaData_TerraformCloud:
 
 		if all {
-			switch v := interface{}(m.GetSlack()).(type) {
+			switch v := interface{}(m.GetTerraformCloud()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, MetaDataValidationError{
-						field:  "Slack",
+						field:  "TerraformCloud",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3149,30 +6370,30 @@ func (m *MetaData) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, MetaDataValidationError{
-						field:  "Slack",
+						field:  "TerraformCloud",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetSlack()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetTerraformCloud()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return MetaDataValidationError{
-					field:  "Slack",
+					field:  "TerraformCloud",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *MetaData_Filesystem:
+	case *MetaData_GithubActions:
 
 		if all {
-			switch v := interface{}(m.GetFilesystem()).(type) {
+			switch v := interface{}(m.GetGithubActions()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, MetaDataValidationError{
-						field:  "Filesystem",
+						field:  "GithubActions",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3180,30 +6401,30 @@ func (m *MetaData) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, MetaDataValidationError{
-						field:  "Filesystem",
+						field:  "GithubActions",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetFilesystem()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetGithubActions()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return MetaDataValidationError{
-					field:  "Filesystem",
+					field:  "GithubActions",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *MetaData_Git:
+	case *MetaData_AzurePipelines:
 
 		if all {
-			switch v := interface{}(m.GetGit()).(type) {
+			switch v := interface{}(m.GetAzurePipelines()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, MetaDataValidationError{
-						field:  "Git",
+						field:  "AzurePipelines",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3211,30 +6432,30 @@ func (m *MetaData) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, MetaDataValidationError{
-						field:  "Git",
+						field:  "AzurePipelines",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetGit()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetAzurePipelines()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return MetaDataValidationError{
-					field:  "Git",
+					field:  "AzurePipelines",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *MetaData_Test:
+	case *MetaData_Teamcity:
 
 		if all {
-			switch v := interface{}(m.GetTest()).(type) {
+			switch v := interface{}(m.GetTeamcity()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, MetaDataValidationError{
-						field:  "Test",
+						field:  "Teamcity",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3242,30 +6463,30 @@ func (m *MetaData) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, MetaDataValidationError{
-						field:  "Test",
+						field:  "Teamcity",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetTest()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetTeamcity()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return MetaDataValidationError{
-					field:  "Test",
+					field:  "Teamcity",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *MetaData_Buildkite:
+	case *MetaData_Postgres:
 
 		if all {
-			switch v := interface{}(m.GetBuildkite()).(type) {
+			switch v := interface{}(m.GetPostgres()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, MetaDataValidationError{
-						field:  "Buildkite",
+						field:  "Postgres",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3273,30 +6494,30 @@ func (m *MetaData) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, MetaDataValidationError{
-						field:  "Buildkite",
+						field:  "Postgres",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetBuildkite()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetPostgres()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return MetaDataValidationError{
-					field:  "Buildkite",
+					field:  "Postgres",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *MetaData_Gerrit:
+	case *MetaData_Mysql:
 
 		if all {
-			switch v := interface{}(m.GetGerrit()).(type) {
+			switch v := interface{}(m.GetMysql()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, MetaDataValidationError{
-						field:  "Gerrit",
+						field:  "Mysql",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3304,30 +6525,30 @@ func (m *MetaData) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, MetaDataValidationError{
-						field:  "Gerrit",
+						field:  "Mysql",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetGerrit()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetMysql()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return MetaDataValidationError{
-					field:  "Gerrit",
+					field:  "Mysql",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *MetaData_Jenkins:
+	case *MetaData_Mongodb:
 
 		if all {
-			switch v := interface{}(m.GetJenkins()).(type) {
+			switch v := interface{}(m.GetMongodb()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, MetaDataValidationError{
-						field:  "Jenkins",
+						field:  "Mongodb",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3335,30 +6556,30 @@ func (m *MetaData) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, MetaDataValidationError{
-						field:  "Jenkins",
+						field:  "Mongodb",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetJenkins()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetMongodb()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return MetaDataValidationError{
-					field:  "Jenkins",
+					field:  "Mongodb",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *MetaData_Teams:
+	case *MetaData_Redis:
 
 		if all {
-			switch v := interface{}(m.GetTeams()).(type) {
+			switch v := interface{}(m.GetRedis()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, MetaDataValidationError{
-						field:  "Teams",
+						field:  "Redis",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3366,30 +6587,30 @@ func (m *MetaData) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, MetaDataValidationError{
-						field:  "Teams",
+						field:  "Redis",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetTeams()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetRedis()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return MetaDataValidationError{
-					field:  "Teams",
+					field:  "Redis",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *MetaData_Artifactory:
+	case *MetaData_Dynamodb:
 
 		if all {
-			switch v := interface{}(m.GetArtifactory()).(type) {
+			switch v := interface{}(m.GetDynamodb()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, MetaDataValidationError{
-						field:  "Artifactory",
+						field:  "Dynamodb",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3397,30 +6618,30 @@ func (m *MetaData) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, MetaDataValidationError{
-						field:  "Artifactory",
+						field:  "Dynamodb",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetArtifactory()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetDynamodb()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return MetaDataValidationError{
-					field:  "Artifactory",
+					field:  "Dynamodb",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *MetaData_Syslog:
+	case *MetaData_Sqs:
 
 		if all {
-			switch v := interface{}(m.GetSyslog()).(type) {
+			switch v := interface{}(m.GetSqs()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, MetaDataValidationError{
-						field:  "Syslog",
+						field:  "Sqs",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3428,30 +6649,30 @@ func (m *MetaData) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, MetaDataValidationError{
-						field:  "Syslog",
+						field:  "Sqs",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetSyslog()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetSqs()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return MetaDataValidationError{
-					field:  "Syslog",
+					field:  "Sqs",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *MetaData_PublicEventMonitoring:
+	case *MetaData_Kafka:
 
 		if all {
-			switch v := interface{}(m.GetPublicEventMonitoring()).(type) {
+			switch v := interface{}(m.GetKafka()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, MetaDataValidationError{
-						field:  "PublicEventMonitoring",
+						field:  "Kafka",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3459,16 +6680,202 @@ func (m *MetaData) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, MetaDataValidationError{
-						field:  "PublicEventMonitoring",
+						field:  "Kafka",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetPublicEventMonitoring()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetKafka()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return MetaDataValidationError{
-					field:  "PublicEventMonitoring",
+					field:  "Kafka",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *MetaData_GcpLogging:
+
+		if all {
+			switch v := interface{}(m.GetGcpLogging()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "GcpLogging",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "GcpLogging",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetGcpLogging()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetaDataValidationError{
+					field:  "GcpLogging",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *MetaData_Journald:
+
+		if all {
+			switch v := interface{}(m.GetJournald()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Journald",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Journald",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetJournald()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetaDataValidationError{
+					field:  "Journald",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *MetaData_Website:
+
+		if all {
+			switch v := interface{}(m.GetWebsite()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Website",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Website",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetWebsite()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetaDataValidationError{
+					field:  "Website",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *MetaData_Smb:
+
+		if all {
+			switch v := interface{}(m.GetSmb()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Smb",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Smb",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSmb()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetaDataValidationError{
+					field:  "Smb",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *MetaData_DiskImage:
+
+		if all {
+			switch v := interface{}(m.GetDiskImage()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "DiskImage",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "DiskImage",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDiskImage()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetaDataValidationError{
+					field:  "DiskImage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *MetaData_ProcessEnvironment:
+
+		if all {
+			switch v := interface{}(m.GetProcessEnvironment()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "ProcessEnvironment",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "ProcessEnvironment",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetProcessEnvironment()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetaDataValidationError{
+					field:  "ProcessEnvironment",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -583,6 +583,37 @@ func (m *CircleCI) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if all {
+		switch v := interface{}(m.GetSince()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CircleCIValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CircleCIValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSince()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CircleCIValidationError{
+				field:  "Since",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for IncludeArtifacts
+
 	switch m.Credential.(type) {
 
 	case *CircleCI_Token:
@@ -705,6 +736,10 @@ func (m *Confluence) validate(all bool) error {
 
 	// no validation rules for InsecureSkipVerifyTls
 
+	// no validation rules for SkipHistory
+
+	// no validation rules for SkipAttachments
+
 	switch m.Credential.(type) {
 
 	case *Confluence_Unauthenticated:
@@ -1140,6 +1175,12 @@ func (m *Filesystem) validate(all bool) error {
 
 	var errors []error
 
+	// no validation rules for ScanGitHistory
+
+	// no validation rules for FollowSymlinks
+
+	// no validation rules for UseGitignore
+
 	if len(errors) > 0 {
 		return FilesystemMultiError(errors)
 	}
@@ -1550,6 +1591,63 @@ func (m *GitLab) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for TlsCa
+
+	// no validation rules for InsecureSkipVerifyTls
+
+	// no validation rules for MergeRequestProject
+
+	// no validation rules for MergeRequestIid
+
+	// no validation rules for IncludeSnippets
+
+	// no validation rules for IncludeWikis
+
+	// no validation rules for IncludeJobLogs
+
+	// no validation rules for MaxJobs
+
+	if all {
+		switch v := interface{}(m.GetJobLogsSince()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GitLabValidationError{
+					field:  "JobLogsSince",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GitLabValidationError{
+					field:  "JobLogsSince",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJobLogsSince()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GitLabValidationError{
+				field:  "JobLogsSince",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for IncludeIssueComments
+
+	// no validation rules for IncludeMrComments
+
+	// no validation rules for SshKey
+
+	// no validation rules for SshKeyPassphrase
+
+	// no validation rules for HttpCredentials
+
+	// no validation rules for StateFile
+
 	switch m.Credential.(type) {
 
 	case *GitLab_Token:
@@ -1737,6 +1835,73 @@ func (m *GitHub) validate(all bool) error {
 
 	// no validation rules for Base
 
+	// no validation rules for IncludeGists
+
+	// no validation rules for IncludeIssueComments
+
+	// no validation rules for IncludePullRequestComments
+
+	// no validation rules for IncludeWikis
+
+	// no validation rules for IncludeReleases
+
+	// no validation rules for MaxReleaseAssetSize
+
+	// no validation rules for UseGraphql
+
+	// no validation rules for ExcludeArchived
+
+	// no validation rules for MaxRepoSize
+
+	if all {
+		switch v := interface{}(m.GetPushedAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GitHubValidationError{
+					field:  "PushedAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GitHubValidationError{
+					field:  "PushedAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPushedAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GitHubValidationError{
+				field:  "PushedAfter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for TlsCa
+
+	// no validation rules for InsecureSkipVerifyTls
+
+	// no validation rules for AllBranches
+
+	// no validation rules for IncludeTags
+
+	// no validation rules for SshKey
+
+	// no validation rules for SshKeyPassphrase
+
+	// no validation rules for HttpCredentials
+
+	// no validation rules for StateFile
+
+	// no validation rules for RepoTimeoutSeconds
+
+	// no validation rules for Blame
+
 	switch m.Credential.(type) {
 
 	case *GitHub_GithubApp:
@@ -2388,6 +2553,20 @@ func (m *S3) validate(all bool) error {
 
 	var errors []error
 
+	// no validation rules for ExternalId
+
+	// no validation rules for DryRun
+
+	// no validation rules for Endpoint
+
+	// no validation rules for ForcePathStyle
+
+	// no validation rules for RequesterPays
+
+	// no validation rules for RestoreArchived
+
+	// no validation rules for StateFile
+
 	switch m.Credential.(type) {
 
 	case *S3_AccessKey:
@@ -2595,6 +2774,64 @@ func (m *Slack) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if all {
+		switch v := interface{}(m.GetSince()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SlackValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SlackValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSince()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SlackValidationError{
+				field:  "Since",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUntil()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SlackValidationError{
+					field:  "Until",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SlackValidationError{
+					field:  "Until",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUntil()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SlackValidationError{
+				field:  "Until",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	switch m.Credential.(type) {
 
 	case *Slack_Token:
@@ -3123,6 +3360,12 @@ func (m *Jenkins) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for MaxBuilds
+
+	// no validation rules for SkipArtifacts
+
+	// no validation rules for InsecureSkipVerifyTls
+
 	switch m.Credential.(type) {
 
 	case *Jenkins_BasicAuth:
@@ -3187,6 +3430,37 @@ func (m *Jenkins) validate(all bool) error {
 			}
 		}
 
+	case *Jenkins_Unauthenticated:
+
+		if all {
+			switch v := interface{}(m.GetUnauthenticated()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JenkinsValidationError{
+						field:  "Unauthenticated",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JenkinsValidationError{
+						field:  "Unauthenticated",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUnauthenticated()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JenkinsValidationError{
+					field:  "Unauthenticated",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	}
 
 	if len(errors) > 0 {
@@ -3301,6 +3575,10 @@ func (m *Teams) validate(all bool) error {
 
 	// no validation rules for TeamId
 
+	// no validation rules for IncludeChats
+
+	// no validation rules for DeltaStateFile
+
 	switch m.Credential.(type) {
 
 	case *Teams_Token:
@@ -3450,6 +3728,8 @@ func (m *Artifactory) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for MaxSize
+
 	switch m.Credential.(type) {
 
 	case *Artifactory_BasicAuth:
@@ -3596,6 +3876,8 @@ func (m *Syslog) validate(all bool) error {
 
 	// no validation rules for Format
 
+	// no validation rules for TlsClientCA
+
 	if len(errors) > 0 {
 		return SyslogMultiError(errors)
 	}
@@ -3977,3 +4259,3829 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = SlackRealtimeValidationError{}
+
+// Validate checks the field values on SharePoint with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SharePoint) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SharePoint with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SharePointMultiError, or
+// nil if none found.
+func (m *SharePoint) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SharePoint) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, err := url.Parse(m.GetEndpoint()); err != nil {
+		err = SharePointValidationError{
+			field:  "Endpoint",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for IncludeOnedrive
+
+	// no validation rules for DeltaStateFile
+
+	switch m.Credential.(type) {
+
+	case *SharePoint_Token:
+		// no validation rules for Token
+
+	case *SharePoint_Authenticated:
+
+		if all {
+			switch v := interface{}(m.GetAuthenticated()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SharePointValidationError{
+						field:  "Authenticated",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SharePointValidationError{
+						field:  "Authenticated",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAuthenticated()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SharePointValidationError{
+					field:  "Authenticated",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SharePointMultiError(errors)
+	}
+
+	return nil
+}
+
+// SharePointMultiError is an error wrapping multiple validation errors
+// returned by SharePoint.ValidateAll() if the designated constraints aren't met.
+type SharePointMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SharePointMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SharePointMultiError) AllErrors() []error { return m }
+
+// SharePointValidationError is the validation error returned by
+// SharePoint.Validate if the designated constraints aren't met.
+type SharePointValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SharePointValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SharePointValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SharePointValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SharePointValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SharePointValidationError) ErrorName() string { return "SharePointValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SharePointValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSharePoint.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SharePointValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SharePointValidationError{}
+
+// Validate checks the field values on Box with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Box) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Box with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in BoxMultiError, or nil if none found.
+func (m *Box) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Box) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, err := url.Parse(m.GetEndpoint()); err != nil {
+		err = BoxValidationError{
+			field:  "Endpoint",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AllUsers
+
+	// no validation rules for SkipVersions
+
+	switch m.Credential.(type) {
+
+	case *Box_Token:
+		// no validation rules for Token
+
+	case *Box_JwtConfig:
+		// no validation rules for JwtConfig
+
+	}
+
+	if len(errors) > 0 {
+		return BoxMultiError(errors)
+	}
+
+	return nil
+}
+
+// BoxMultiError is an error wrapping multiple validation errors returned by
+// Box.ValidateAll() if the designated constraints aren't met.
+type BoxMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BoxMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BoxMultiError) AllErrors() []error { return m }
+
+// BoxValidationError is the validation error returned by Box.Validate if the
+// designated constraints aren't met.
+type BoxValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BoxValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BoxValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BoxValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BoxValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BoxValidationError) ErrorName() string { return "BoxValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BoxValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBox.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BoxValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BoxValidationError{}
+
+// Validate checks the field values on NPM with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *NPM) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NPM with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in NPMMultiError, or nil if none found.
+func (m *NPM) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NPM) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, err := url.Parse(m.GetEndpoint()); err != nil {
+		err = NPMValidationError{
+			field:  "Endpoint",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for MaxVersions
+
+	switch m.Credential.(type) {
+
+	case *NPM_Unauthenticated:
+
+		if all {
+			switch v := interface{}(m.GetUnauthenticated()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, NPMValidationError{
+						field:  "Unauthenticated",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, NPMValidationError{
+						field:  "Unauthenticated",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUnauthenticated()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return NPMValidationError{
+					field:  "Unauthenticated",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *NPM_Token:
+		// no validation rules for Token
+
+	}
+
+	if len(errors) > 0 {
+		return NPMMultiError(errors)
+	}
+
+	return nil
+}
+
+// NPMMultiError is an error wrapping multiple validation errors returned by
+// NPM.ValidateAll() if the designated constraints aren't met.
+type NPMMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NPMMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NPMMultiError) AllErrors() []error { return m }
+
+// NPMValidationError is the validation error returned by NPM.Validate if the
+// designated constraints aren't met.
+type NPMValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NPMValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NPMValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NPMValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NPMValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NPMValidationError) ErrorName() string { return "NPMValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NPMValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNPM.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NPMValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NPMValidationError{}
+
+// Validate checks the field values on RubyGems with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RubyGems) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RubyGems with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RubyGemsMultiError, or nil
+// if none found.
+func (m *RubyGems) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RubyGems) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, err := url.Parse(m.GetEndpoint()); err != nil {
+		err = RubyGemsValidationError{
+			field:  "Endpoint",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for MaxVersions
+
+	switch m.Credential.(type) {
+
+	case *RubyGems_Unauthenticated:
+
+		if all {
+			switch v := interface{}(m.GetUnauthenticated()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RubyGemsValidationError{
+						field:  "Unauthenticated",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RubyGemsValidationError{
+						field:  "Unauthenticated",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUnauthenticated()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RubyGemsValidationError{
+					field:  "Unauthenticated",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *RubyGems_Token:
+		// no validation rules for Token
+
+	case *RubyGems_BasicAuth:
+
+		if all {
+			switch v := interface{}(m.GetBasicAuth()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RubyGemsValidationError{
+						field:  "BasicAuth",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RubyGemsValidationError{
+						field:  "BasicAuth",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetBasicAuth()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RubyGemsValidationError{
+					field:  "BasicAuth",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RubyGemsMultiError(errors)
+	}
+
+	return nil
+}
+
+// RubyGemsMultiError is an error wrapping multiple validation errors returned
+// by RubyGems.ValidateAll() if the designated constraints aren't met.
+type RubyGemsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RubyGemsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RubyGemsMultiError) AllErrors() []error { return m }
+
+// RubyGemsValidationError is the validation error returned by
+// RubyGems.Validate if the designated constraints aren't met.
+type RubyGemsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RubyGemsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RubyGemsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RubyGemsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RubyGemsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RubyGemsValidationError) ErrorName() string { return "RubyGemsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RubyGemsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRubyGems.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RubyGemsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RubyGemsValidationError{}
+
+// Validate checks the field values on Maven with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Maven) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Maven with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MavenMultiError, or nil if none found.
+func (m *Maven) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Maven) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, err := url.Parse(m.GetEndpoint()); err != nil {
+		err = MavenValidationError{
+			field:  "Endpoint",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for MaxVersions
+
+	switch m.Credential.(type) {
+
+	case *Maven_Unauthenticated:
+
+		if all {
+			switch v := interface{}(m.GetUnauthenticated()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MavenValidationError{
+						field:  "Unauthenticated",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MavenValidationError{
+						field:  "Unauthenticated",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUnauthenticated()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MavenValidationError{
+					field:  "Unauthenticated",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Maven_Token:
+		// no validation rules for Token
+
+	case *Maven_BasicAuth:
+
+		if all {
+			switch v := interface{}(m.GetBasicAuth()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MavenValidationError{
+						field:  "BasicAuth",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MavenValidationError{
+						field:  "BasicAuth",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetBasicAuth()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MavenValidationError{
+					field:  "BasicAuth",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MavenMultiError(errors)
+	}
+
+	return nil
+}
+
+// MavenMultiError is an error wrapping multiple validation errors returned by
+// Maven.ValidateAll() if the designated constraints aren't met.
+type MavenMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MavenMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MavenMultiError) AllErrors() []error { return m }
+
+// MavenValidationError is the validation error returned by Maven.Validate if
+// the designated constraints aren't met.
+type MavenValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MavenValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MavenValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MavenValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MavenValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MavenValidationError) ErrorName() string { return "MavenValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MavenValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMaven.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MavenValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MavenValidationError{}
+
+// Validate checks the field values on GoModuleProxy with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GoModuleProxy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GoModuleProxy with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GoModuleProxyMultiError, or
+// nil if none found.
+func (m *GoModuleProxy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GoModuleProxy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, err := url.Parse(m.GetEndpoint()); err != nil {
+		err = GoModuleProxyValidationError{
+			field:  "Endpoint",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for MaxVersions
+
+	switch m.Credential.(type) {
+
+	case *GoModuleProxy_Unauthenticated:
+
+		if all {
+			switch v := interface{}(m.GetUnauthenticated()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GoModuleProxyValidationError{
+						field:  "Unauthenticated",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GoModuleProxyValidationError{
+						field:  "Unauthenticated",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUnauthenticated()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GoModuleProxyValidationError{
+					field:  "Unauthenticated",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *GoModuleProxy_Token:
+		// no validation rules for Token
+
+	case *GoModuleProxy_BasicAuth:
+
+		if all {
+			switch v := interface{}(m.GetBasicAuth()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GoModuleProxyValidationError{
+						field:  "BasicAuth",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GoModuleProxyValidationError{
+						field:  "BasicAuth",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetBasicAuth()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GoModuleProxyValidationError{
+					field:  "BasicAuth",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GoModuleProxyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GoModuleProxyMultiError is an error wrapping multiple validation errors
+// returned by GoModuleProxy.ValidateAll() if the designated constraints
+// aren't met.
+type GoModuleProxyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GoModuleProxyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GoModuleProxyMultiError) AllErrors() []error { return m }
+
+// GoModuleProxyValidationError is the validation error returned by
+// GoModuleProxy.Validate if the designated constraints aren't met.
+type GoModuleProxyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GoModuleProxyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GoModuleProxyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GoModuleProxyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GoModuleProxyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GoModuleProxyValidationError) ErrorName() string { return "GoModuleProxyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GoModuleProxyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGoModuleProxy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GoModuleProxyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GoModuleProxyValidationError{}
+
+// Validate checks the field values on Crates with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Crates) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Crates with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in CratesMultiError, or nil if none found.
+func (m *Crates) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Crates) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, err := url.Parse(m.GetEndpoint()); err != nil {
+		err = CratesValidationError{
+			field:  "Endpoint",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for MaxVersions
+
+	switch m.Credential.(type) {
+
+	case *Crates_Unauthenticated:
+
+		if all {
+			switch v := interface{}(m.GetUnauthenticated()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CratesValidationError{
+						field:  "Unauthenticated",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CratesValidationError{
+						field:  "Unauthenticated",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUnauthenticated()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CratesValidationError{
+					field:  "Unauthenticated",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Crates_Token:
+		// no validation rules for Token
+
+	}
+
+	if len(errors) > 0 {
+		return CratesMultiError(errors)
+	}
+
+	return nil
+}
+
+// CratesMultiError is an error wrapping multiple validation errors returned by
+// Crates.ValidateAll() if the designated constraints aren't met.
+type CratesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CratesMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CratesMultiError) AllErrors() []error { return m }
+
+// CratesValidationError is the validation error returned by Crates.Validate if
+// the designated constraints aren't met.
+type CratesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CratesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CratesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CratesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CratesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CratesValidationError) ErrorName() string { return "CratesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CratesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCrates.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CratesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CratesValidationError{}
+
+// Validate checks the field values on TerraformState with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TerraformState) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TerraformState with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TerraformStateMultiError,
+// or nil if none found.
+func (m *TerraformState) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TerraformState) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ConsulToken
+
+	// no validation rules for AzureSasToken
+
+	switch m.Credential.(type) {
+
+	case *TerraformState_Unauthenticated:
+
+		if all {
+			switch v := interface{}(m.GetUnauthenticated()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TerraformStateValidationError{
+						field:  "Unauthenticated",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TerraformStateValidationError{
+						field:  "Unauthenticated",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUnauthenticated()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TerraformStateValidationError{
+					field:  "Unauthenticated",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TerraformState_CloudEnvironment:
+
+		if all {
+			switch v := interface{}(m.GetCloudEnvironment()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TerraformStateValidationError{
+						field:  "CloudEnvironment",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TerraformStateValidationError{
+						field:  "CloudEnvironment",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCloudEnvironment()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TerraformStateValidationError{
+					field:  "CloudEnvironment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TerraformStateMultiError(errors)
+	}
+
+	return nil
+}
+
+// TerraformStateMultiError is an error wrapping multiple validation errors
+// returned by TerraformState.ValidateAll() if the designated constraints
+// aren't met.
+type TerraformStateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TerraformStateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TerraformStateMultiError) AllErrors() []error { return m }
+
+// TerraformStateValidationError is the validation error returned by
+// TerraformState.Validate if the designated constraints aren't met.
+type TerraformStateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TerraformStateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TerraformStateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TerraformStateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TerraformStateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TerraformStateValidationError) ErrorName() string { return "TerraformStateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TerraformStateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTerraformState.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TerraformStateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TerraformStateValidationError{}
+
+// Validate checks the field values on TerraformCloud with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TerraformCloud) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TerraformCloud with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TerraformCloudMultiError,
+// or nil if none found.
+func (m *TerraformCloud) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TerraformCloud) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, err := url.Parse(m.GetEndpoint()); err != nil {
+		err = TerraformCloudValidationError{
+			field:  "Endpoint",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for MaxVersions
+
+	switch m.Credential.(type) {
+
+	case *TerraformCloud_Token:
+		// no validation rules for Token
+
+	}
+
+	if len(errors) > 0 {
+		return TerraformCloudMultiError(errors)
+	}
+
+	return nil
+}
+
+// TerraformCloudMultiError is an error wrapping multiple validation errors
+// returned by TerraformCloud.ValidateAll() if the designated constraints
+// aren't met.
+type TerraformCloudMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TerraformCloudMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TerraformCloudMultiError) AllErrors() []error { return m }
+
+// TerraformCloudValidationError is the validation error returned by
+// TerraformCloud.Validate if the designated constraints aren't met.
+type TerraformCloudValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TerraformCloudValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TerraformCloudValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TerraformCloudValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TerraformCloudValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TerraformCloudValidationError) ErrorName() string { return "TerraformCloudValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TerraformCloudValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTerraformCloud.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TerraformCloudValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TerraformCloudValidationError{}
+
+// Validate checks the field values on GitHubActions with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GitHubActions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GitHubActions with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GitHubActionsMultiError, or
+// nil if none found.
+func (m *GitHubActions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GitHubActions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, err := url.Parse(m.GetEndpoint()); err != nil {
+		err = GitHubActionsValidationError{
+			field:  "Endpoint",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for MaxRuns
+
+	// no validation rules for SkipArtifacts
+
+	switch m.Credential.(type) {
+
+	case *GitHubActions_Token:
+		// no validation rules for Token
+
+	}
+
+	if len(errors) > 0 {
+		return GitHubActionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// GitHubActionsMultiError is an error wrapping multiple validation errors
+// returned by GitHubActions.ValidateAll() if the designated constraints
+// aren't met.
+type GitHubActionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GitHubActionsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GitHubActionsMultiError) AllErrors() []error { return m }
+
+// GitHubActionsValidationError is the validation error returned by
+// GitHubActions.Validate if the designated constraints aren't met.
+type GitHubActionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GitHubActionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GitHubActionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GitHubActionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GitHubActionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GitHubActionsValidationError) ErrorName() string { return "GitHubActionsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GitHubActionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGitHubActions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GitHubActionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GitHubActionsValidationError{}
+
+// Validate checks the field values on AzurePipelines with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AzurePipelines) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AzurePipelines with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AzurePipelinesMultiError,
+// or nil if none found.
+func (m *AzurePipelines) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AzurePipelines) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, err := url.Parse(m.GetEndpoint()); err != nil {
+		err = AzurePipelinesValidationError{
+			field:  "Endpoint",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for MaxRuns
+
+	// no validation rules for SkipArtifacts
+
+	switch m.Credential.(type) {
+
+	case *AzurePipelines_Token:
+		// no validation rules for Token
+
+	}
+
+	if len(errors) > 0 {
+		return AzurePipelinesMultiError(errors)
+	}
+
+	return nil
+}
+
+// AzurePipelinesMultiError is an error wrapping multiple validation errors
+// returned by AzurePipelines.ValidateAll() if the designated constraints
+// aren't met.
+type AzurePipelinesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AzurePipelinesMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AzurePipelinesMultiError) AllErrors() []error { return m }
+
+// AzurePipelinesValidationError is the validation error returned by
+// AzurePipelines.Validate if the designated constraints aren't met.
+type AzurePipelinesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AzurePipelinesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AzurePipelinesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AzurePipelinesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AzurePipelinesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AzurePipelinesValidationError) ErrorName() string { return "AzurePipelinesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AzurePipelinesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAzurePipelines.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AzurePipelinesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AzurePipelinesValidationError{}
+
+// Validate checks the field values on TeamCity with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TeamCity) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TeamCity with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TeamCityMultiError, or nil
+// if none found.
+func (m *TeamCity) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TeamCity) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, err := url.Parse(m.GetEndpoint()); err != nil {
+		err = TeamCityValidationError{
+			field:  "Endpoint",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for MaxBuilds
+
+	// no validation rules for SkipArtifacts
+
+	// no validation rules for SkipVersionedSettings
+
+	// no validation rules for InsecureSkipVerifyTls
+
+	// no validation rules for VcsUsername
+
+	// no validation rules for VcsToken
+
+	switch m.Credential.(type) {
+
+	case *TeamCity_Token:
+		// no validation rules for Token
+
+	case *TeamCity_BasicAuth:
+
+		if all {
+			switch v := interface{}(m.GetBasicAuth()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TeamCityValidationError{
+						field:  "BasicAuth",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TeamCityValidationError{
+						field:  "BasicAuth",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetBasicAuth()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TeamCityValidationError{
+					field:  "BasicAuth",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TeamCity_Unauthenticated:
+
+		if all {
+			switch v := interface{}(m.GetUnauthenticated()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TeamCityValidationError{
+						field:  "Unauthenticated",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TeamCityValidationError{
+						field:  "Unauthenticated",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUnauthenticated()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TeamCityValidationError{
+					field:  "Unauthenticated",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TeamCityMultiError(errors)
+	}
+
+	return nil
+}
+
+// TeamCityMultiError is an error wrapping multiple validation errors returned
+// by TeamCity.ValidateAll() if the designated constraints aren't met.
+type TeamCityMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TeamCityMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TeamCityMultiError) AllErrors() []error { return m }
+
+// TeamCityValidationError is the validation error returned by
+// TeamCity.Validate if the designated constraints aren't met.
+type TeamCityValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TeamCityValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TeamCityValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TeamCityValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TeamCityValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TeamCityValidationError) ErrorName() string { return "TeamCityValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TeamCityValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTeamCity.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TeamCityValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TeamCityValidationError{}
+
+// Validate checks the field values on Postgres with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Postgres) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Postgres with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PostgresMultiError, or nil
+// if none found.
+func (m *Postgres) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Postgres) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MaxRows
+
+	if val := m.GetSamplePercent(); val < 0 || val > 100 {
+		err := PostgresValidationError{
+			field:  "SamplePercent",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	switch m.Credential.(type) {
+
+	case *Postgres_ConnectionString:
+		// no validation rules for ConnectionString
+
+	}
+
+	if len(errors) > 0 {
+		return PostgresMultiError(errors)
+	}
+
+	return nil
+}
+
+// PostgresMultiError is an error wrapping multiple validation errors returned
+// by Postgres.ValidateAll() if the designated constraints aren't met.
+type PostgresMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PostgresMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PostgresMultiError) AllErrors() []error { return m }
+
+// PostgresValidationError is the validation error returned by
+// Postgres.Validate if the designated constraints aren't met.
+type PostgresValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PostgresValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PostgresValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PostgresValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PostgresValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PostgresValidationError) ErrorName() string { return "PostgresValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PostgresValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPostgres.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PostgresValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PostgresValidationError{}
+
+// Validate checks the field values on MySQL with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MySQL) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MySQL with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MySQLMultiError, or nil if none found.
+func (m *MySQL) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MySQL) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MaxRows
+
+	if val := m.GetSamplePercent(); val < 0 || val > 100 {
+		err := MySQLValidationError{
+			field:  "SamplePercent",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	switch m.Credential.(type) {
+
+	case *MySQL_ConnectionString:
+		// no validation rules for ConnectionString
+
+	}
+
+	if len(errors) > 0 {
+		return MySQLMultiError(errors)
+	}
+
+	return nil
+}
+
+// MySQLMultiError is an error wrapping multiple validation errors returned by
+// MySQL.ValidateAll() if the designated constraints aren't met.
+type MySQLMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MySQLMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MySQLMultiError) AllErrors() []error { return m }
+
+// MySQLValidationError is the validation error returned by MySQL.Validate if
+// the designated constraints aren't met.
+type MySQLValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MySQLValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MySQLValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MySQLValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MySQLValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MySQLValidationError) ErrorName() string { return "MySQLValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MySQLValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMySQL.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MySQLValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MySQLValidationError{}
+
+// Validate checks the field values on MongoDB with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MongoDB) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MongoDB with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MongoDBMultiError, or nil if none found.
+func (m *MongoDB) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MongoDB) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MaxDepth
+
+	// no validation rules for MaxDocuments
+
+	switch m.Credential.(type) {
+
+	case *MongoDB_ConnectionString:
+		// no validation rules for ConnectionString
+
+	}
+
+	if len(errors) > 0 {
+		return MongoDBMultiError(errors)
+	}
+
+	return nil
+}
+
+// MongoDBMultiError is an error wrapping multiple validation errors returned
+// by MongoDB.ValidateAll() if the designated constraints aren't met.
+type MongoDBMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MongoDBMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MongoDBMultiError) AllErrors() []error { return m }
+
+// MongoDBValidationError is the validation error returned by MongoDB.Validate
+// if the designated constraints aren't met.
+type MongoDBValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MongoDBValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MongoDBValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MongoDBValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MongoDBValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MongoDBValidationError) ErrorName() string { return "MongoDBValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MongoDBValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMongoDB.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MongoDBValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MongoDBValidationError{}
+
+// Validate checks the field values on Redis with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Redis) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Redis with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in RedisMultiError, or nil if none found.
+func (m *Redis) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Redis) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MaxKeys
+
+	switch m.Credential.(type) {
+
+	case *Redis_ConnectionString:
+		// no validation rules for ConnectionString
+
+	}
+
+	if len(errors) > 0 {
+		return RedisMultiError(errors)
+	}
+
+	return nil
+}
+
+// RedisMultiError is an error wrapping multiple validation errors returned by
+// Redis.ValidateAll() if the designated constraints aren't met.
+type RedisMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RedisMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RedisMultiError) AllErrors() []error { return m }
+
+// RedisValidationError is the validation error returned by Redis.Validate if
+// the designated constraints aren't met.
+type RedisValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RedisValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RedisValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RedisValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RedisValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RedisValidationError) ErrorName() string { return "RedisValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RedisValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRedis.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RedisValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RedisValidationError{}
+
+// Validate checks the field values on DynamoDB with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DynamoDB) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DynamoDB with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DynamoDBMultiError, or nil
+// if none found.
+func (m *DynamoDB) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DynamoDB) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Region
+
+	// no validation rules for Segments
+
+	// no validation rules for MaxItems
+
+	// no validation rules for Endpoint
+
+	switch m.Credential.(type) {
+
+	case *DynamoDB_AccessKey:
+
+		if all {
+			switch v := interface{}(m.GetAccessKey()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DynamoDBValidationError{
+						field:  "AccessKey",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DynamoDBValidationError{
+						field:  "AccessKey",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAccessKey()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DynamoDBValidationError{
+					field:  "AccessKey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *DynamoDB_CloudEnvironment:
+
+		if all {
+			switch v := interface{}(m.GetCloudEnvironment()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DynamoDBValidationError{
+						field:  "CloudEnvironment",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DynamoDBValidationError{
+						field:  "CloudEnvironment",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCloudEnvironment()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DynamoDBValidationError{
+					field:  "CloudEnvironment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DynamoDBMultiError(errors)
+	}
+
+	return nil
+}
+
+// DynamoDBMultiError is an error wrapping multiple validation errors returned
+// by DynamoDB.ValidateAll() if the designated constraints aren't met.
+type DynamoDBMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DynamoDBMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DynamoDBMultiError) AllErrors() []error { return m }
+
+// DynamoDBValidationError is the validation error returned by
+// DynamoDB.Validate if the designated constraints aren't met.
+type DynamoDBValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DynamoDBValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DynamoDBValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DynamoDBValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DynamoDBValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DynamoDBValidationError) ErrorName() string { return "DynamoDBValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DynamoDBValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDynamoDB.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DynamoDBValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DynamoDBValidationError{}
+
+// Validate checks the field values on SQS with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *SQS) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SQS with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in SQSMultiError, or nil if none found.
+func (m *SQS) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SQS) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Region
+
+	// no validation rules for DeleteMessages
+
+	// no validation rules for VisibilityTimeout
+
+	// no validation rules for MaxMessages
+
+	// no validation rules for Endpoint
+
+	switch m.Credential.(type) {
+
+	case *SQS_AccessKey:
+
+		if all {
+			switch v := interface{}(m.GetAccessKey()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SQSValidationError{
+						field:  "AccessKey",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SQSValidationError{
+						field:  "AccessKey",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAccessKey()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SQSValidationError{
+					field:  "AccessKey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SQS_CloudEnvironment:
+
+		if all {
+			switch v := interface{}(m.GetCloudEnvironment()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SQSValidationError{
+						field:  "CloudEnvironment",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SQSValidationError{
+						field:  "CloudEnvironment",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCloudEnvironment()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SQSValidationError{
+					field:  "CloudEnvironment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SQSMultiError(errors)
+	}
+
+	return nil
+}
+
+// SQSMultiError is an error wrapping multiple validation errors returned by
+// SQS.ValidateAll() if the designated constraints aren't met.
+type SQSMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SQSMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SQSMultiError) AllErrors() []error { return m }
+
+// SQSValidationError is the validation error returned by SQS.Validate if the
+// designated constraints aren't met.
+type SQSValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SQSValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SQSValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SQSValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SQSValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SQSValidationError) ErrorName() string { return "SQSValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SQSValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSQS.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SQSValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SQSValidationError{}
+
+// Validate checks the field values on Kafka with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Kafka) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Kafka with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in KafkaMultiError, or nil if none found.
+func (m *Kafka) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Kafka) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StartOffset
+
+	// no validation rules for EndOffset
+
+	if all {
+		switch v := interface{}(m.GetSince()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, KafkaValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, KafkaValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSince()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return KafkaValidationError{
+				field:  "Since",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUntil()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, KafkaValidationError{
+					field:  "Until",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, KafkaValidationError{
+					field:  "Until",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUntil()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return KafkaValidationError{
+				field:  "Until",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Tls
+
+	// no validation rules for TlsCa
+
+	// no validation rules for TlsCert
+
+	// no validation rules for TlsKey
+
+	// no validation rules for InsecureSkipVerifyTls
+
+	// no validation rules for Tail
+
+	// no validation rules for MaxMessages
+
+	switch m.Credential.(type) {
+
+	case *Kafka_Unauthenticated:
+
+		if all {
+			switch v := interface{}(m.GetUnauthenticated()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, KafkaValidationError{
+						field:  "Unauthenticated",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, KafkaValidationError{
+						field:  "Unauthenticated",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUnauthenticated()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return KafkaValidationError{
+					field:  "Unauthenticated",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Kafka_SaslPlain:
+
+		if all {
+			switch v := interface{}(m.GetSaslPlain()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, KafkaValidationError{
+						field:  "SaslPlain",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, KafkaValidationError{
+						field:  "SaslPlain",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSaslPlain()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return KafkaValidationError{
+					field:  "SaslPlain",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Kafka_SaslScramSha256:
+
+		if all {
+			switch v := interface{}(m.GetSaslScramSha256()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, KafkaValidationError{
+						field:  "SaslScramSha256",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, KafkaValidationError{
+						field:  "SaslScramSha256",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSaslScramSha256()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return KafkaValidationError{
+					field:  "SaslScramSha256",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Kafka_SaslScramSha512:
+
+		if all {
+			switch v := interface{}(m.GetSaslScramSha512()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, KafkaValidationError{
+						field:  "SaslScramSha512",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, KafkaValidationError{
+						field:  "SaslScramSha512",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSaslScramSha512()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return KafkaValidationError{
+					field:  "SaslScramSha512",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return KafkaMultiError(errors)
+	}
+
+	return nil
+}
+
+// KafkaMultiError is an error wrapping multiple validation errors returned by
+// Kafka.ValidateAll() if the designated constraints aren't met.
+type KafkaMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KafkaMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KafkaMultiError) AllErrors() []error { return m }
+
+// KafkaValidationError is the validation error returned by Kafka.Validate if
+// the designated constraints aren't met.
+type KafkaValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KafkaValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KafkaValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KafkaValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KafkaValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KafkaValidationError) ErrorName() string { return "KafkaValidationError" }
+
+// Error satisfies the builtin error interface
+func (e KafkaValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKafka.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KafkaValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KafkaValidationError{}
+
+// Validate checks the field values on GCPLogging with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GCPLogging) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GCPLogging with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GCPLoggingMultiError, or
+// nil if none found.
+func (m *GCPLogging) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GCPLogging) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Filter
+
+	if all {
+		switch v := interface{}(m.GetSince()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GCPLoggingValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GCPLoggingValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSince()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GCPLoggingValidationError{
+				field:  "Since",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUntil()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GCPLoggingValidationError{
+					field:  "Until",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GCPLoggingValidationError{
+					field:  "Until",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUntil()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GCPLoggingValidationError{
+				field:  "Until",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MaxEntries
+
+	// no validation rules for Endpoint
+
+	switch m.Credential.(type) {
+
+	case *GCPLogging_JsonSa:
+		// no validation rules for JsonSa
+
+	case *GCPLogging_CloudEnvironment:
+
+		if all {
+			switch v := interface{}(m.GetCloudEnvironment()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GCPLoggingValidationError{
+						field:  "CloudEnvironment",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GCPLoggingValidationError{
+						field:  "CloudEnvironment",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCloudEnvironment()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GCPLoggingValidationError{
+					field:  "CloudEnvironment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GCPLoggingMultiError(errors)
+	}
+
+	return nil
+}
+
+// GCPLoggingMultiError is an error wrapping multiple validation errors
+// returned by GCPLogging.ValidateAll() if the designated constraints aren't met.
+type GCPLoggingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GCPLoggingMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GCPLoggingMultiError) AllErrors() []error { return m }
+
+// GCPLoggingValidationError is the validation error returned by
+// GCPLogging.Validate if the designated constraints aren't met.
+type GCPLoggingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GCPLoggingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GCPLoggingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GCPLoggingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GCPLoggingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GCPLoggingValidationError) ErrorName() string { return "GCPLoggingValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GCPLoggingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGCPLogging.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GCPLoggingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GCPLoggingValidationError{}
+
+// Validate checks the field values on Journald with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Journald) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Journald with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in JournaldMultiError, or nil
+// if none found.
+func (m *Journald) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Journald) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSince()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JournaldValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JournaldValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSince()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JournaldValidationError{
+				field:  "Since",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUntil()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JournaldValidationError{
+					field:  "Until",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JournaldValidationError{
+					field:  "Until",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUntil()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JournaldValidationError{
+				field:  "Until",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Directory
+
+	// no validation rules for Tail
+
+	if len(errors) > 0 {
+		return JournaldMultiError(errors)
+	}
+
+	return nil
+}
+
+// JournaldMultiError is an error wrapping multiple validation errors returned
+// by Journald.ValidateAll() if the designated constraints aren't met.
+type JournaldMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JournaldMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JournaldMultiError) AllErrors() []error { return m }
+
+// JournaldValidationError is the validation error returned by
+// Journald.Validate if the designated constraints aren't met.
+type JournaldValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JournaldValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JournaldValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JournaldValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JournaldValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JournaldValidationError) ErrorName() string { return "JournaldValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JournaldValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJournald.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JournaldValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JournaldValidationError{}
+
+// Validate checks the field values on Website with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Website) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Website with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in WebsiteMultiError, or nil if none found.
+func (m *Website) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Website) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MaxDepth
+
+	// no validation rules for IgnoreRobots
+
+	// no validation rules for MaxPages
+
+	// no validation rules for MaxSize
+
+	if len(errors) > 0 {
+		return WebsiteMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebsiteMultiError is an error wrapping multiple validation errors returned
+// by Website.ValidateAll() if the designated constraints aren't met.
+type WebsiteMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebsiteMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebsiteMultiError) AllErrors() []error { return m }
+
+// WebsiteValidationError is the validation error returned by Website.Validate
+// if the designated constraints aren't met.
+type WebsiteValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebsiteValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebsiteValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebsiteValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebsiteValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebsiteValidationError) ErrorName() string { return "WebsiteValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WebsiteValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebsite.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebsiteValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebsiteValidationError{}
+
+// Validate checks the field values on SMB with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *SMB) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SMB with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in SMBMultiError, or nil if none found.
+func (m *SMB) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SMB) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Address
+
+	// no validation rules for MaxSize
+
+	switch m.Credential.(type) {
+
+	case *SMB_Ntlm:
+
+		if all {
+			switch v := interface{}(m.GetNtlm()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SMBValidationError{
+						field:  "Ntlm",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SMBValidationError{
+						field:  "Ntlm",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetNtlm()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SMBValidationError{
+					field:  "Ntlm",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SMB_Kerberos:
+
+		if all {
+			switch v := interface{}(m.GetKerberos()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SMBValidationError{
+						field:  "Kerberos",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SMBValidationError{
+						field:  "Kerberos",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetKerberos()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SMBValidationError{
+					field:  "Kerberos",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SMB_Unauthenticated:
+
+		if all {
+			switch v := interface{}(m.GetUnauthenticated()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SMBValidationError{
+						field:  "Unauthenticated",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SMBValidationError{
+						field:  "Unauthenticated",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUnauthenticated()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SMBValidationError{
+					field:  "Unauthenticated",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SMBMultiError(errors)
+	}
+
+	return nil
+}
+
+// SMBMultiError is an error wrapping multiple validation errors returned by
+// SMB.ValidateAll() if the designated constraints aren't met.
+type SMBMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SMBMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SMBMultiError) AllErrors() []error { return m }
+
+// SMBValidationError is the validation error returned by SMB.Validate if the
+// designated constraints aren't met.
+type SMBValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SMBValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SMBValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SMBValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SMBValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SMBValidationError) ErrorName() string { return "SMBValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SMBValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSMB.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SMBValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SMBValidationError{}
+
+// Validate checks the field values on DiskImage with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DiskImage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiskImage with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DiskImageMultiError, or nil
+// if none found.
+func (m *DiskImage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiskImage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MaxSize
+
+	if len(errors) > 0 {
+		return DiskImageMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiskImageMultiError is an error wrapping multiple validation errors returned
+// by DiskImage.ValidateAll() if the designated constraints aren't met.
+type DiskImageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiskImageMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiskImageMultiError) AllErrors() []error { return m }
+
+// DiskImageValidationError is the validation error returned by
+// DiskImage.Validate if the designated constraints aren't met.
+type DiskImageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiskImageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiskImageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiskImageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiskImageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiskImageValidationError) ErrorName() string { return "DiskImageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DiskImageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiskImage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiskImageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiskImageValidationError{}
+
+// Validate checks the field values on ProcessEnvironment with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ProcessEnvironment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProcessEnvironment with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ProcessEnvironmentMultiError, or nil if none found.
+func (m *ProcessEnvironment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProcessEnvironment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProcPath
+
+	if len(errors) > 0 {
+		return ProcessEnvironmentMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProcessEnvironmentMultiError is an error wrapping multiple validation errors
+// returned by ProcessEnvironment.ValidateAll() if the designated constraints
+// aren't met.
+type ProcessEnvironmentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProcessEnvironmentMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProcessEnvironmentMultiError) AllErrors() []error { return m }
+
+// ProcessEnvironmentValidationError is the validation error returned by
+// ProcessEnvironment.Validate if the designated constraints aren't met.
+type ProcessEnvironmentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProcessEnvironmentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProcessEnvironmentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProcessEnvironmentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProcessEnvironmentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProcessEnvironmentValidationError) ErrorName() string {
+	return "ProcessEnvironmentValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ProcessEnvironmentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProcessEnvironment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProcessEnvironmentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProcessEnvironmentValidationError{}
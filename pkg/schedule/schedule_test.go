@@ -0,0 +1,44 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	start := time.Date(2023, time.January, 31, 10, 17, 30, 0, time.UTC) // A Tuesday.
+	tests := []struct {
+		spec    string
+		want    time.Time
+		wantErr bool
+	}{
+		{spec: "*/15 * * * *", want: time.Date(2023, time.January, 31, 10, 30, 0, 0, time.UTC)},
+		{spec: "0 */6 * * *", want: time.Date(2023, time.January, 31, 12, 0, 0, 0, time.UTC)},
+		{spec: "5,10 9-11 * * *", want: time.Date(2023, time.January, 31, 11, 5, 0, 0, time.UTC)},
+		{spec: "0 0 * * 7", want: time.Date(2023, time.February, 5, 0, 0, 0, 0, time.UTC)},
+		{spec: "0 0 29 * *", want: time.Date(2023, time.March, 29, 0, 0, 0, 0, time.UTC)},
+		// Either the day of month or the day of week matches.
+		{spec: "0 0 15 * 3", want: time.Date(2023, time.February, 1, 0, 0, 0, 0, time.UTC)},
+		{spec: "@daily", want: time.Date(2023, time.February, 1, 0, 0, 0, 0, time.UTC)},
+		{spec: "@every 6h", want: start.Add(6 * time.Hour)},
+		{spec: "0 0 30 2 *", want: time.Time{}},
+		{spec: "* * * *", wantErr: true},
+		{spec: "60 * * * *", wantErr: true},
+		{spec: "*/0 * * * *", wantErr: true},
+		{spec: "@every 1s", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			s, err := Parse(tt.spec)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got := s.Next(start); !got.Equal(tt.want) {
+				t.Errorf("Next() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
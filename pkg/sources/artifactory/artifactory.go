@@ -0,0 +1,363 @@
+package artifactory
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+
+	diskbufferreader "github.com/bill-rich/disk-buffer-reader"
+	"github.com/go-errors/errors"
+	"github.com/go-logr/logr"
+	"github.com/gobwas/glob"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const (
+	// defaultMaxSize is the largest artifact that will be downloaded and scanned
+	// unless configured otherwise.
+	defaultMaxSize = 100 * 1024 * 1024 // 100MB
+)
+
+type Source struct {
+	name     string
+	sourceId int64
+	jobId    int64
+	verify   bool
+	endpoint string
+	// auth sets the credentials on requests to Artifactory.
+	auth         func(*http.Request)
+	repositories []string
+	ignoreRepos  map[string]struct{}
+	packageTypes map[string]struct{}
+	includePaths []glob.Glob
+	ignorePaths  []glob.Glob
+	maxSize      int64
+	client       *http.Client
+	jobPool      *errgroup.Group
+	log          logr.Logger
+	sources.Progress
+}
+
+// Ensure the Source satisfies the interface at compile time.
+var _ sources.Source = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return sourcespb.SourceType_SOURCE_TYPE_JFROG_ARTIFACTORY
+}
+
+func (s *Source) SourceID() int64 {
+	return s.sourceId
+}
+
+func (s *Source) JobID() int64 {
+	return s.jobId
+}
+
+// Init returns an initialized Artifactory source.
+func (s *Source) Init(aCtx context.Context, name string, jobId, sourceId int64, verify bool, connection *anypb.Any, concurrency int) error {
+	s.log = context.WithValues(aCtx, "source", s.Type(), "name", name).Logger()
+
+	s.name = name
+	s.sourceId = sourceId
+	s.jobId = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	var conn sourcespb.Artifactory
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return errors.WrapPrefix(err, "error unmarshalling connection", 0)
+	}
+
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.Artifactory_AccessToken:
+		s.auth = func(req *http.Request) { req.Header.Set("Authorization", "Bearer "+cred.AccessToken) }
+	case *sourcespb.Artifactory_BasicAuth:
+		s.auth = func(req *http.Request) {
+			req.SetBasicAuth(cred.BasicAuth.GetUsername(), cred.BasicAuth.GetPassword())
+		}
+	default:
+		return errors.Errorf("invalid configuration given for %s source", name)
+	}
+
+	s.endpoint = strings.TrimSuffix(conn.GetEndpoint(), "/")
+	if s.endpoint == "" {
+		return errors.New("endpoint is required")
+	}
+	// Both the platform URL and the Artifactory service URL are accepted.
+	if !strings.HasSuffix(s.endpoint, "/artifactory") {
+		s.endpoint += "/artifactory"
+	}
+
+	s.repositories = conn.GetRepositories()
+	s.ignoreRepos = make(map[string]struct{})
+	for _, repo := range conn.GetIgnoreRepositories() {
+		s.ignoreRepos[repo] = struct{}{}
+	}
+	s.packageTypes = make(map[string]struct{})
+	for _, t := range conn.GetPackageTypes() {
+		s.packageTypes[strings.ToLower(t)] = struct{}{}
+	}
+
+	var err error
+	if s.includePaths, err = compileGlobs(conn.GetIncludePaths()); err != nil {
+		return err
+	}
+	if s.ignorePaths, err = compileGlobs(conn.GetIgnorePaths()); err != nil {
+		return err
+	}
+
+	s.maxSize = conn.GetMaxSize()
+	if s.maxSize <= 0 {
+		s.maxSize = defaultMaxSize
+	}
+	s.client = common.RetryableHttpClientTimeout(300)
+
+	return nil
+}
+
+func compileGlobs(patterns []string) ([]glob.Glob, error) {
+	globs := make([]glob.Glob, 0, len(patterns))
+	for _, pattern := range patterns {
+		g, err := glob.Compile(pattern, '/')
+		if err != nil {
+			return nil, errors.WrapPrefix(err, fmt.Sprintf("invalid path pattern %q", pattern), 0)
+		}
+		globs = append(globs, g)
+	}
+	return globs, nil
+}
+
+type repository struct {
+	Key         string `json:"key"`
+	Type        string `json:"type"`
+	PackageType string `json:"packageType"`
+}
+
+type item struct {
+	Repo       string `json:"repo"`
+	Path       string `json:"path"`
+	Name       string `json:"name"`
+	Size       int64  `json:"size"`
+	Modified   string `json:"modified"`
+	ModifiedBy string `json:"modified_by"`
+}
+
+// fullPath returns the path of an item relative to its repository.
+func (i item) fullPath() string {
+	if i.Path == "" || i.Path == "." {
+		return i.Name
+	}
+	return i.Path + "/" + i.Name
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) error {
+	repos := s.repositories
+	if len(repos) == 0 {
+		var err error
+		if repos, err = s.listRepositories(ctx); err != nil {
+			return fmt.Errorf("error listing repositories: %w", err)
+		}
+	}
+
+	var scanned uint64
+	for i, repo := range repos {
+		if common.IsDone(ctx) {
+			break
+		}
+		if _, ok := s.ignoreRepos[repo]; ok {
+			continue
+		}
+		s.SetProgressComplete(i, len(repos), fmt.Sprintf("Repository: %s", repo), "")
+
+		items, err := s.listItems(ctx, repo)
+		if err != nil {
+			s.log.Error(err, "could not list repository items", "repo", repo)
+			continue
+		}
+		for _, it := range items {
+			if !s.shouldScan(it) {
+				continue
+			}
+			it := it
+			s.jobPool.Go(func() error {
+				if err := s.scanItem(ctx, it, chunksChan); err != nil {
+					s.log.Error(err, "could not scan artifact", "repo", it.Repo, "path", it.fullPath())
+					return nil
+				}
+				atomic.AddUint64(&scanned, 1)
+				return nil
+			})
+		}
+	}
+	_ = s.jobPool.Wait()
+
+	s.SetProgressComplete(len(repos), len(repos), fmt.Sprintf("Completed scanning source %s. %d artifacts scanned.", s.name, scanned), "")
+	return nil
+}
+
+// listRepositories returns the keys of the repositories that store
+// artifacts. Remote repositories are scanned through their caches, and
+// virtual repositories only aggregate others, so both are skipped.
+func (s *Source) listRepositories(ctx context.Context) ([]string, error) {
+	var repos []repository
+	if err := s.do(ctx, http.MethodGet, "/api/repositories", nil, &repos); err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	for _, repo := range repos {
+		switch strings.ToUpper(repo.Type) {
+		case "LOCAL", "FEDERATED":
+		default:
+			continue
+		}
+		if len(s.packageTypes) > 0 {
+			if _, ok := s.packageTypes[strings.ToLower(repo.PackageType)]; !ok {
+				continue
+			}
+		}
+		keys = append(keys, repo.Key)
+	}
+	return keys, nil
+}
+
+// listItems uses AQL to find every file in a repository.
+func (s *Source) listItems(ctx context.Context, repo string) ([]item, error) {
+	repoJSON, err := json.Marshal(repo)
+	if err != nil {
+		return nil, err
+	}
+	query := fmt.Sprintf(`items.find({"repo":{"$eq":%s},"type":"file"}).include("repo","path","name","size","modified","modified_by")`, repoJSON)
+
+	var res struct {
+		Results []item `json:"results"`
+	}
+	if err := s.do(ctx, http.MethodPost, "/api/search/aql", strings.NewReader(query), &res); err != nil {
+		return nil, err
+	}
+	return res.Results, nil
+}
+
+func (s *Source) shouldScan(it item) bool {
+	if it.Size > s.maxSize {
+		s.log.V(2).Info("skipping large artifact", "repo", it.Repo, "path", it.fullPath(), "size", it.Size)
+		return false
+	}
+	path := it.fullPath()
+	for _, g := range s.ignorePaths {
+		if g.Match(path) {
+			return false
+		}
+	}
+	if len(s.includePaths) == 0 {
+		return true
+	}
+	for _, g := range s.includePaths {
+		if g.Match(path) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Source) scanItem(ctx context.Context, it item, chunksChan chan *sources.Chunk) error {
+	link := fmt.Sprintf("%s/%s/%s", s.endpoint, it.Repo, it.fullPath())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, link, nil)
+	if err != nil {
+		return err
+	}
+	s.auth(req)
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d", res.StatusCode)
+	}
+
+	reader, err := diskbufferreader.New(io.LimitReader(res.Body, s.maxSize))
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	chunkSkel := &sources.Chunk{
+		SourceType: s.Type(),
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Artifactory{
+				Artifactory: &source_metadatapb.Artifactory{
+					Repo:      sanitizer.UTF8(it.Repo),
+					Path:      sanitizer.UTF8(it.fullPath()),
+					Link:      sanitizer.UTF8(link),
+					Timestamp: it.Modified,
+					Username:  sanitizer.UTF8(it.ModifiedBy),
+				},
+			},
+		},
+		Verify: s.verify,
+	}
+	if handlers.HandleFile(ctx, reader, chunkSkel, chunksChan) {
+		return nil
+	}
+	if err := reader.Reset(); err != nil {
+		return err
+	}
+	reader.Stop()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+
+	// Plain files go through the chunker so large artifacts aren't sent as a single chunk.
+	chunk := *chunkSkel
+	chunk.Data = data
+	for c := range sources.Chunker(&chunk) {
+		select {
+		case chunksChan <- c:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+func (s *Source) do(ctx context.Context, method, path string, body io.Reader, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, method, s.endpoint+path, body)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "text/plain")
+	}
+	s.auth(req)
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d requesting %s", res.StatusCode, path)
+	}
+	return json.NewDecoder(res.Body).Decode(out)
+}
@@ -0,0 +1,79 @@
+package artifactory
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/sourcestest"
+)
+
+func TestSource_ShouldScan(t *testing.T) {
+	s := &Source{}
+	sourcestest.Init(t, s, &sourcespb.Artifactory{
+		Endpoint:     "https://acme.jfrog.io",
+		Credential:   &sourcespb.Artifactory_AccessToken{AccessToken: "token"},
+		IncludePaths: []string{"**.properties", "*.env"},
+		IgnorePaths:  []string{"test/**"},
+		MaxSize:      100,
+	}, func() *http.Client { return s.client })
+	assert.Equal(t, "https://acme.jfrog.io/artifactory", s.endpoint)
+	assert.True(t, s.shouldScan(item{Path: "com/acme", Name: "app.properties", Size: 10}))
+	assert.True(t, s.shouldScan(item{Path: ".", Name: "prod.env", Size: 10}))
+	assert.False(t, s.shouldScan(item{Path: "test/fixtures", Name: "app.properties", Size: 10}))
+	assert.False(t, s.shouldScan(item{Path: "com/acme", Name: "app.jar", Size: 10}))
+	assert.False(t, s.shouldScan(item{Path: "com/acme", Name: "app.properties", Size: 1000}))
+}
+
+func TestSource_Chunks(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://acme.jfrog.io").
+		Get("/artifactory/api/repositories").
+		MatchHeader("Authorization", "Bearer token").
+		Reply(200).
+		JSON([]map[string]string{
+			{"key": "libs-release", "type": "LOCAL", "packageType": "Maven"},
+			{"key": "npm-local", "type": "LOCAL", "packageType": "Npm"},
+			{"key": "maven-remote", "type": "REMOTE", "packageType": "Maven"},
+		})
+	gock.New("https://acme.jfrog.io").
+		Post("/artifactory/api/search/aql").
+		BodyString(`"repo":\{"\$eq":"libs-release"\}`).
+		Reply(200).
+		JSON(map[string]interface{}{"results": []map[string]interface{}{
+			{"repo": "libs-release", "path": "com/acme/app/1.0", "name": "application.properties", "size": 20, "modified_by": "deployer"},
+		}})
+	gock.New("https://acme.jfrog.io").
+		Get("/artifactory/libs-release/com/acme/app/1.0/application.properties").
+		Reply(200).
+		BodyString("db.password=hunter2")
+
+	s := &Source{}
+	sourcestest.Init(t, s, &sourcespb.Artifactory{
+		Endpoint:     "https://acme.jfrog.io/artifactory/",
+		Credential:   &sourcespb.Artifactory_AccessToken{AccessToken: "token"},
+		PackageTypes: []string{"maven"},
+	}, func() *http.Client { return s.client })
+
+	chunksCh := make(chan *sources.Chunk, 10)
+	assert.NoError(t, s.Chunks(context.Background(), chunksCh))
+	close(chunksCh)
+
+	var data []string
+	for chunk := range chunksCh {
+		meta := chunk.SourceMetadata.GetArtifactory()
+		assert.Equal(t, "libs-release", meta.Repo)
+		assert.Equal(t, "com/acme/app/1.0/application.properties", meta.Path)
+		assert.Equal(t, "deployer", meta.Username)
+		data = append(data, strings.TrimRight(string(chunk.Data), "\x00"))
+	}
+	assert.Equal(t, []string{"db.password=hunter2"}, data)
+	assert.True(t, gock.IsDone())
+}
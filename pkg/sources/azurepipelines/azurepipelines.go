@@ -0,0 +1,414 @@
+package azurepipelines
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	diskbufferreader "github.com/bill-rich/disk-buffer-reader"
+	"github.com/go-errors/errors"
+	"github.com/go-logr/logr"
+	"github.com/gobwas/glob"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const (
+	apiVersion = "7.0"
+	// maxDownloadSize is the largest log or artifact that will be scanned.
+	maxDownloadSize = 500 * 1024 * 1024 // 500MB
+)
+
+type Source struct {
+	name     string
+	sourceId int64
+	jobId    int64
+	verify   bool
+	// endpoint is the URL of the organization, or of the collection for
+	// Azure DevOps Server.
+	endpoint        string
+	token           string
+	projects        []glob.Glob
+	ignoreProjects  []glob.Glob
+	pipelines       []glob.Glob
+	ignorePipelines []glob.Glob
+	maxRuns         int
+	skipArtifacts   bool
+	client          *http.Client
+	jobPool         *errgroup.Group
+	log             logr.Logger
+	sources.Progress
+}
+
+// Ensure the Source satisfies the interface at compile time.
+var _ sources.Source = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return sourcespb.SourceType_SOURCE_TYPE_AZURE_PIPELINES
+}
+
+func (s *Source) SourceID() int64 {
+	return s.sourceId
+}
+
+func (s *Source) JobID() int64 {
+	return s.jobId
+}
+
+// Init returns an initialized Azure Pipelines source.
+func (s *Source) Init(aCtx context.Context, name string, jobId, sourceId int64, verify bool, connection *anypb.Any, concurrency int) error {
+	s.log = context.WithValues(aCtx, "source", s.Type(), "name", name).Logger()
+
+	s.name = name
+	s.sourceId = sourceId
+	s.jobId = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	var conn sourcespb.AzurePipelines
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return errors.WrapPrefix(err, "error unmarshalling connection", 0)
+	}
+
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.AzurePipelines_Token:
+		s.token = cred.Token
+	default:
+		return errors.Errorf("invalid configuration given for %s source", name)
+	}
+
+	s.endpoint = strings.TrimSuffix(conn.GetEndpoint(), "/")
+	if s.endpoint == "" {
+		return errors.New("organization URL is required")
+	}
+
+	var err error
+	if s.projects, err = compileGlobs(conn.GetProjects()); err != nil {
+		return err
+	}
+	if s.ignoreProjects, err = compileGlobs(conn.GetIgnoreProjects()); err != nil {
+		return err
+	}
+	if s.pipelines, err = compileGlobs(conn.GetPipelines()); err != nil {
+		return err
+	}
+	if s.ignorePipelines, err = compileGlobs(conn.GetIgnorePipelines()); err != nil {
+		return err
+	}
+	s.maxRuns = int(conn.GetMaxRuns())
+	s.skipArtifacts = conn.GetSkipArtifacts()
+
+	s.client = common.RetryableHttpClientTimeout(300)
+
+	return nil
+}
+
+func compileGlobs(patterns []string) ([]glob.Glob, error) {
+	var globs []glob.Glob
+	for _, pattern := range patterns {
+		g, err := glob.Compile(pattern, '/')
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+		globs = append(globs, g)
+	}
+	return globs, nil
+}
+
+// selected reports whether name matches one of include, or include is empty,
+// and doesn't match any of exclude.
+func selected(name string, include, exclude []glob.Glob) bool {
+	for _, g := range exclude {
+		if g.Match(name) {
+			return false
+		}
+	}
+	if len(include) == 0 {
+		return true
+	}
+	for _, g := range include {
+		if g.Match(name) {
+			return true
+		}
+	}
+	return false
+}
+
+type project struct {
+	Name string `json:"name"`
+}
+
+type definition struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+	Path string `json:"path"`
+}
+
+// fullName returns the folder and name of the pipeline, such as "infra/deploy".
+func (d definition) fullName() string {
+	folder := strings.Trim(strings.ReplaceAll(d.Path, `\`, "/"), "/")
+	if folder == "" {
+		return d.Name
+	}
+	return folder + "/" + d.Name
+}
+
+type build struct {
+	ID        int64     `json:"id"`
+	StartTime time.Time `json:"startTime"`
+	Links     struct {
+		Web struct {
+			Href string `json:"href"`
+		} `json:"web"`
+	} `json:"_links"`
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) error {
+	var projects []project
+	if err := s.list(ctx, s.endpoint+"/_apis/projects", nil, 0, &projects); err != nil {
+		return fmt.Errorf("error listing projects: %w", err)
+	}
+
+	var scanned uint64
+	for i, p := range projects {
+		if common.IsDone(ctx) {
+			break
+		}
+		if !selected(p.Name, s.projects, s.ignoreProjects) {
+			continue
+		}
+		s.SetProgressComplete(i, len(projects), fmt.Sprintf("Project: %s", p.Name), "")
+
+		projectURL := s.endpoint + "/" + url.PathEscape(p.Name)
+		var definitions []definition
+		if err := s.list(ctx, projectURL+"/_apis/build/definitions", nil, 0, &definitions); err != nil {
+			s.log.Error(err, "could not list pipelines", "project", p.Name)
+			continue
+		}
+		for _, d := range definitions {
+			if !selected(d.fullName(), s.pipelines, s.ignorePipelines) {
+				continue
+			}
+			var builds []build
+			query := url.Values{
+				"definitions": {strconv.FormatInt(d.ID, 10)},
+				"queryOrder":  {"queueTimeDescending"},
+			}
+			if err := s.list(ctx, projectURL+"/_apis/build/builds", query, s.maxRuns, &builds); err != nil {
+				s.log.Error(err, "could not list runs", "project", p.Name, "pipeline", d.fullName())
+				continue
+			}
+			for _, b := range builds {
+				projectName, d, b := p.Name, d, b
+				s.jobPool.Go(func() error {
+					if err := s.scanRun(ctx, projectName, d, b, chunksChan); err != nil {
+						s.log.Error(err, "could not scan run", "project", projectName, "pipeline", d.fullName(), "run", b.ID)
+						return nil
+					}
+					atomic.AddUint64(&scanned, 1)
+					return nil
+				})
+			}
+		}
+	}
+	_ = s.jobPool.Wait()
+
+	s.SetProgressComplete(len(projects), len(projects), fmt.Sprintf("Completed scanning source %s. %d pipeline runs scanned.", s.name, scanned), "")
+	return nil
+}
+
+func (s *Source) scanRun(ctx context.Context, project string, d definition, b build, chunksChan chan *sources.Chunk) error {
+	buildURL := fmt.Sprintf("%s/%s/_apis/build/builds/%d", s.endpoint, url.PathEscape(project), b.ID)
+	newMeta := func(file, link string) *source_metadatapb.AzurePipelines {
+		return &source_metadatapb.AzurePipelines{
+			Project:   sanitizer.UTF8(project),
+			Pipeline:  sanitizer.UTF8(d.fullName()),
+			RunId:     b.ID,
+			File:      sanitizer.UTF8(file),
+			Link:      sanitizer.UTF8(link),
+			Timestamp: b.StartTime.UTC().Format(time.RFC3339),
+		}
+	}
+
+	var logs []struct {
+		ID int64 `json:"id"`
+	}
+	if err := s.list(ctx, buildURL+"/logs", nil, 0, &logs); err != nil {
+		return err
+	}
+	for _, l := range logs {
+		logURL := fmt.Sprintf("%s/logs/%d?api-version=%s", buildURL, l.ID, apiVersion)
+		meta := newMeta(fmt.Sprintf("logs/%d", l.ID), b.Links.Web.Href)
+		if err := s.scanURL(ctx, logURL, meta, chunksChan); err != nil {
+			s.log.Error(err, "could not scan log", "run", b.ID, "log", l.ID)
+		}
+	}
+
+	if s.skipArtifacts {
+		return nil
+	}
+	var artifacts []struct {
+		Name     string `json:"name"`
+		Resource struct {
+			Type        string `json:"type"`
+			DownloadURL string `json:"downloadUrl"`
+		} `json:"resource"`
+	}
+	if err := s.list(ctx, buildURL+"/artifacts", nil, 0, &artifacts); err != nil {
+		return err
+	}
+	for _, artifact := range artifacts {
+		// Artifacts published to file shares or elsewhere can't be downloaded
+		// through the API.
+		if artifact.Resource.DownloadURL == "" {
+			continue
+		}
+		meta := newMeta(artifact.Name, b.Links.Web.Href+"&view=artifacts")
+		if err := s.scanURL(ctx, artifact.Resource.DownloadURL, meta, chunksChan); err != nil {
+			s.log.Error(err, "could not scan artifact", "run", b.ID, "artifact", artifact.Name)
+		}
+	}
+	return nil
+}
+
+// scanURL downloads and scans a log or an artifact.
+func (s *Source) scanURL(ctx context.Context, u string, meta *source_metadatapb.AzurePipelines, chunksChan chan *sources.Chunk) error {
+	res, err := s.get(ctx, u)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	reader, err := diskbufferreader.New(io.LimitReader(res.Body, maxDownloadSize))
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	chunkSkel := &sources.Chunk{
+		SourceType: s.Type(),
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_AzurePipelines{
+				AzurePipelines: meta,
+			},
+		},
+		Verify: s.verify,
+	}
+	if handlers.HandleFile(ctx, reader, chunkSkel, chunksChan) {
+		return nil
+	}
+	if err := reader.Reset(); err != nil {
+		return err
+	}
+	reader.Stop()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	chunk := *chunkSkel
+	chunk.Data = data
+	for c := range sources.Chunker(&chunk) {
+		select {
+		case chunksChan <- c:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// list fetches every page of a collection, or the first limit items when
+// limit is set, following continuation tokens.
+func (s *Source) list(ctx context.Context, u string, query url.Values, limit int, out interface{}) error {
+	if query == nil {
+		query = url.Values{}
+	}
+	query.Set("api-version", apiVersion)
+	if limit > 0 {
+		query.Set("$top", strconv.Itoa(limit))
+	}
+
+	var all []json.RawMessage
+	for {
+		res, err := s.get(ctx, u+"?"+query.Encode())
+		if err != nil {
+			return err
+		}
+		var page struct {
+			Value []json.RawMessage `json:"value"`
+		}
+		err = json.NewDecoder(res.Body).Decode(&page)
+		res.Body.Close()
+		if err != nil {
+			return err
+		}
+		all = append(all, page.Value...)
+
+		next := res.Header.Get("X-Ms-Continuationtoken")
+		if next == "" || (limit > 0 && len(all) >= limit) {
+			break
+		}
+		query.Set("continuationToken", next)
+	}
+	if limit > 0 && len(all) > limit {
+		all = all[:limit]
+	}
+
+	data, err := json.Marshal(all)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
+
+func (s *Source) get(ctx context.Context, u string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	if s.trusted(req.URL) {
+		req.SetBasicAuth("", s.token)
+	}
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		res.Body.Close()
+		return nil, fmt.Errorf("unexpected status code %d requesting %s", res.StatusCode, req.URL.Path)
+	}
+	return res, nil
+}
+
+// trusted reports whether the token may be sent to a URL. Pipeline artifacts
+// are served from artifact storage under visualstudio.com rather than the
+// organization URL.
+func (s *Source) trusted(u *url.URL) bool {
+	endpoint, err := url.Parse(s.endpoint)
+	if err != nil {
+		return false
+	}
+	return u.Host == endpoint.Host || strings.HasSuffix(u.Host, ".visualstudio.com")
+}
@@ -0,0 +1,129 @@
+package azurepipelines
+
+import (
+	"archive/zip"
+	"bytes"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/sourcestest"
+)
+
+func zipFile(t *testing.T, name, content string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	f, err := w.Create(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Write([]byte(content)); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestSource_Chunks(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://dev.azure.com").
+		Get("/acme/_apis/projects").
+		MatchHeader("Authorization", "^Basic OnBhdA==$").
+		Reply(200).
+		SetHeader("X-Ms-Continuationtoken", "next").
+		JSON(map[string]interface{}{"value": []map[string]string{{"name": "web"}}})
+	gock.New("https://dev.azure.com").
+		Get("/acme/_apis/projects").
+		MatchParam("continuationToken", "next").
+		Reply(200).
+		JSON(map[string]interface{}{"value": []map[string]string{{"name": "sandbox"}}})
+	gock.New("https://dev.azure.com").
+		Get("/acme/web/_apis/build/definitions").
+		Reply(200).
+		JSON(map[string]interface{}{"value": []map[string]interface{}{
+			{"id": 1, "name": "deploy", "path": `\infra`},
+			{"id": 2, "name": "nightly", "path": `\`},
+		}})
+	gock.New("https://dev.azure.com").
+		Get("/acme/web/_apis/build/builds$").
+		MatchParam("definitions", "^1$").
+		MatchParam("$top", "^1$").
+		Reply(200).
+		JSON(map[string]interface{}{"value": []map[string]interface{}{
+			{"id": 7, "startTime": "2022-12-02T16:53:20.123Z", "_links": map[string]interface{}{
+				"web": map[string]string{"href": "https://dev.azure.com/acme/web/_build/results?buildId=7"},
+			}},
+		}})
+	gock.New("https://dev.azure.com").
+		Get("/acme/web/_apis/build/builds/7/logs$").
+		Reply(200).
+		JSON(map[string]interface{}{"value": []map[string]int{{"id": 3}}})
+	gock.New("https://dev.azure.com").
+		Get("/acme/web/_apis/build/builds/7/logs/3").
+		Reply(200).
+		BodyString("curl -H 'X-Api-Key: hunter2' https://example.com")
+	gock.New("https://dev.azure.com").
+		Get("/acme/web/_apis/build/builds/7/artifacts").
+		Reply(200).
+		JSON(map[string]interface{}{"value": []map[string]interface{}{
+			{"name": "drop", "resource": map[string]string{"type": "PipelineArtifact", "downloadUrl": "https://artprodcus3.artifacts.visualstudio.com/drop/content?format=zip"}},
+			{"name": "share", "resource": map[string]string{"type": "FilePath"}},
+		}})
+	gock.New("https://artprodcus3.artifacts.visualstudio.com").
+		Get("/drop/content").
+		MatchHeader("Authorization", "^Basic OnBhdA==$").
+		Reply(200).
+		Body(bytes.NewReader(zipFile(t, "app.env", "TOKEN=hunter3")))
+
+	s := &Source{}
+	sourcestest.Init(t, s, &sourcespb.AzurePipelines{
+		Endpoint:        "https://dev.azure.com/acme/",
+		Credential:      &sourcespb.AzurePipelines_Token{Token: "pat"},
+		IgnoreProjects:  []string{"sandbox"},
+		Pipelines:       []string{"infra/*"},
+		IgnorePipelines: []string{"nightly"},
+		MaxRuns:         1,
+	}, func() *http.Client { return s.client })
+
+	chunksCh := make(chan *sources.Chunk, 10)
+	assert.NoError(t, s.Chunks(context.Background(), chunksCh))
+	close(chunksCh)
+
+	files := make(map[string]string)
+	for chunk := range chunksCh {
+		meta := chunk.SourceMetadata.GetAzurePipelines()
+		assert.Equal(t, "web", meta.Project)
+		assert.Equal(t, "infra/deploy", meta.Pipeline)
+		assert.Equal(t, int64(7), meta.RunId)
+		assert.Equal(t, "2022-12-02T16:53:20Z", meta.Timestamp)
+		files[meta.File] = strings.TrimRight(string(chunk.Data), "\x00")
+	}
+	assert.Equal(t, map[string]string{
+		"logs/3": "curl -H 'X-Api-Key: hunter2' https://example.com",
+		"drop":   "TOKEN=hunter3",
+	}, files)
+	assert.True(t, gock.IsDone())
+}
+
+func TestSource_Trusted(t *testing.T) {
+	s := &Source{endpoint: "https://dev.azure.com/acme"}
+	for u, want := range map[string]bool{
+		"https://dev.azure.com/acme/_apis/projects":           true,
+		"https://artprodcus3.artifacts.visualstudio.com/drop": true,
+		"https://example.com/?q=dev.azure.com":                false,
+		"https://evilvisualstudio.com/":                       false,
+	} {
+		req, _ := http.NewRequest(http.MethodGet, u, nil)
+		assert.Equal(t, want, s.trusted(req.URL), u)
+	}
+}
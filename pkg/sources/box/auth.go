@@ -0,0 +1,127 @@
+package box
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/youmark/pkcs8"
+	"golang.org/x/oauth2"
+)
+
+const (
+	defaultTokenURL = "https://api.box.com/oauth2/token"
+	jwtGrantType    = "urn:ietf:params:oauth:grant-type:jwt-bearer"
+)
+
+// jwtConfig is the configuration file downloaded from the Box developer
+// console for an app using server authentication with JWT.
+type jwtConfig struct {
+	BoxAppSettings struct {
+		ClientID     string `json:"clientID"`
+		ClientSecret string `json:"clientSecret"`
+		AppAuth      struct {
+			PublicKeyID string `json:"publicKeyID"`
+			PrivateKey  string `json:"privateKey"`
+			Passphrase  string `json:"passphrase"`
+		} `json:"appAuth"`
+	} `json:"boxAppSettings"`
+	EnterpriseID string `json:"enterpriseID"`
+}
+
+// jwtTokenSource exchanges signed assertions for enterprise access tokens.
+type jwtTokenSource struct {
+	config   jwtConfig
+	key      *rsa.PrivateKey
+	tokenURL string
+	client   *http.Client
+}
+
+func newJWTTokenSource(rawConfig, tokenURL string, client *http.Client) (*jwtTokenSource, error) {
+	var config jwtConfig
+	if err := json.Unmarshal([]byte(rawConfig), &config); err != nil {
+		return nil, fmt.Errorf("could not parse JWT config: %w", err)
+	}
+	if config.EnterpriseID == "" || config.BoxAppSettings.ClientID == "" {
+		return nil, fmt.Errorf("JWT config must contain an enterprise ID and client ID")
+	}
+
+	block, _ := pem.Decode([]byte(config.BoxAppSettings.AppAuth.PrivateKey))
+	if block == nil {
+		return nil, fmt.Errorf("could not decode private key")
+	}
+	var passphrase [][]byte
+	if config.BoxAppSettings.AppAuth.Passphrase != "" {
+		passphrase = append(passphrase, []byte(config.BoxAppSettings.AppAuth.Passphrase))
+	}
+	key, err := pkcs8.ParsePKCS8PrivateKeyRSA(block.Bytes, passphrase...)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse private key: %w", err)
+	}
+
+	return &jwtTokenSource{
+		config:   config,
+		key:      key,
+		tokenURL: tokenURL,
+		client:   client,
+	}, nil
+}
+
+// Token implements oauth2.TokenSource.
+func (j *jwtTokenSource) Token() (*oauth2.Token, error) {
+	jti := make([]byte, 16)
+	if _, err := rand.Read(jti); err != nil {
+		return nil, err
+	}
+	claims := jwt.MapClaims{
+		"iss":          j.config.BoxAppSettings.ClientID,
+		"sub":          j.config.EnterpriseID,
+		"box_sub_type": "enterprise",
+		"aud":          j.tokenURL,
+		"jti":          hex.EncodeToString(jti),
+		// Box rejects assertions that expire more than 60 seconds in the future.
+		"exp": time.Now().Add(45 * time.Second).Unix(),
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodRS512, claims)
+	token.Header["kid"] = j.config.BoxAppSettings.AppAuth.PublicKeyID
+	assertion, err := token.SignedString(j.key)
+	if err != nil {
+		return nil, fmt.Errorf("could not sign assertion: %w", err)
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", jwtGrantType)
+	form.Set("assertion", assertion)
+	form.Set("client_id", j.config.BoxAppSettings.ClientID)
+	form.Set("client_secret", j.config.BoxAppSettings.ClientSecret)
+	res, err := j.client.Post(j.tokenURL, "application/x-www-form-urlencoded", strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d requesting access token", res.StatusCode)
+	}
+
+	var body struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+		TokenType   string `json:"token_type"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+	return &oauth2.Token{
+		AccessToken: body.AccessToken,
+		TokenType:   body.TokenType,
+		Expiry:      time.Now().Add(time.Duration(body.ExpiresIn) * time.Second),
+	}, nil
+}
@@ -0,0 +1,355 @@
+package box
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+
+	diskbufferreader "github.com/bill-rich/disk-buffer-reader"
+	"github.com/go-errors/errors"
+	"github.com/go-logr/logr"
+	"golang.org/x/oauth2"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const (
+	defaultEndpoint = "https://api.box.com/2.0/"
+	// rootFolderID is the ID Box uses for the root folder of every user.
+	rootFolderID = "0"
+	pageSize     = 1000
+	// maxFileSize is the largest file that will be downloaded and scanned.
+	maxFileSize = 50 * 1024 * 1024 // 50MB
+)
+
+type Source struct {
+	name         string
+	sourceId     int64
+	jobId        int64
+	verify       bool
+	endpoint     string
+	folders      []string
+	allUsers     bool
+	skipVersions bool
+	client       *http.Client
+	jobPool      *errgroup.Group
+	log          logr.Logger
+	sources.Progress
+}
+
+// Ensure the Source satisfies the interface at compile time.
+var _ sources.Source = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return sourcespb.SourceType_SOURCE_TYPE_BOX
+}
+
+func (s *Source) SourceID() int64 {
+	return s.sourceId
+}
+
+func (s *Source) JobID() int64 {
+	return s.jobId
+}
+
+// Init returns an initialized Box source.
+func (s *Source) Init(aCtx context.Context, name string, jobId, sourceId int64, verify bool, connection *anypb.Any, concurrency int) error {
+	s.log = context.WithValues(aCtx, "source", s.Type(), "name", name).Logger()
+
+	s.name = name
+	s.sourceId = sourceId
+	s.jobId = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	var conn sourcespb.Box
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return errors.WrapPrefix(err, "error unmarshalling connection", 0)
+	}
+
+	s.endpoint = conn.GetEndpoint()
+	if s.endpoint == "" {
+		s.endpoint = defaultEndpoint
+	}
+	if !strings.HasSuffix(s.endpoint, "/") {
+		s.endpoint += "/"
+	}
+
+	base := common.RetryableHttpClientTimeout(60)
+	var ts oauth2.TokenSource
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.Box_Token:
+		ts = oauth2.StaticTokenSource(&oauth2.Token{AccessToken: cred.Token})
+	case *sourcespb.Box_JwtConfig:
+		tokenURL, err := tokenURLFor(s.endpoint)
+		if err != nil {
+			return errors.WrapPrefix(err, "invalid endpoint", 0)
+		}
+		jwtSource, err := newJWTTokenSource(cred.JwtConfig, tokenURL, base)
+		if err != nil {
+			return errors.WrapPrefix(err, "could not create JWT token source", 0)
+		}
+		ts = oauth2.ReuseTokenSource(nil, jwtSource)
+	default:
+		return errors.Errorf("invalid configuration given for %s source", name)
+	}
+	s.client = &http.Client{
+		Timeout:   base.Timeout,
+		Transport: &oauth2.Transport{Source: ts, Base: base.Transport},
+	}
+
+	s.folders = conn.GetFolders()
+	if len(s.folders) == 0 {
+		s.folders = []string{rootFolderID}
+	}
+	s.allUsers = conn.GetAllUsers()
+	s.skipVersions = conn.GetSkipVersions()
+
+	return nil
+}
+
+// tokenURLFor returns the OAuth token URL served by the same host as the API endpoint.
+func tokenURLFor(endpoint string) (string, error) {
+	if endpoint == defaultEndpoint {
+		return defaultTokenURL, nil
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s://%s/oauth2/token", u.Scheme, u.Host), nil
+}
+
+type user struct {
+	ID    string `json:"id"`
+	Login string `json:"login"`
+}
+
+type item struct {
+	ID         string `json:"id"`
+	Type       string `json:"type"`
+	Name       string `json:"name"`
+	Size       int64  `json:"size"`
+	ModifiedAt string `json:"modified_at"`
+	ModifiedBy struct {
+		Login string `json:"login"`
+	} `json:"modified_by"`
+}
+
+type page[T any] struct {
+	TotalCount int `json:"total_count"`
+	Entries    []T `json:"entries"`
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) error {
+	// An empty user ID scans as the authenticated user.
+	users := []user{{}}
+	if s.allUsers {
+		var err error
+		users, err = s.listUsers(ctx)
+		if err != nil {
+			return fmt.Errorf("error listing users: %w", err)
+		}
+	}
+
+	var scanned uint64
+	for i, u := range users {
+		for _, folder := range s.folders {
+			if common.IsDone(ctx) {
+				break
+			}
+			s.SetProgressComplete(i, len(users), fmt.Sprintf("User: %s, folder: %s", u.Login, folder), "")
+			if err := s.walkFolder(ctx, u, folder, "", chunksChan, &scanned); err != nil {
+				s.log.Error(err, "error scanning folder", "user", u.Login, "folder", folder)
+			}
+		}
+	}
+	_ = s.jobPool.Wait()
+
+	s.SetProgressComplete(len(users), len(users), fmt.Sprintf("Completed scanning source %s. %d files scanned.", s.name, scanned), "")
+	return nil
+}
+
+func (s *Source) listUsers(ctx context.Context) ([]user, error) {
+	var users []user
+	for offset := 0; ; offset += pageSize {
+		var p page[user]
+		path := fmt.Sprintf("users?user_type=managed&fields=id,login&limit=%d&offset=%d", pageSize, offset)
+		if err := s.getJSON(ctx, user{}, path, &p); err != nil {
+			return nil, err
+		}
+		users = append(users, p.Entries...)
+		if len(p.Entries) == 0 || offset+len(p.Entries) >= p.TotalCount {
+			return users, nil
+		}
+	}
+}
+
+// walkFolder recursively scans the files of a folder. Files are downloaded
+// concurrently while the folder tree is walked.
+func (s *Source) walkFolder(ctx context.Context, u user, folderID, path string, chunksChan chan *sources.Chunk, scanned *uint64) error {
+	for offset := 0; ; offset += pageSize {
+		if common.IsDone(ctx) {
+			return nil
+		}
+		var p page[item]
+		reqPath := fmt.Sprintf("folders/%s/items?fields=id,type,name,size,modified_at,modified_by&limit=%d&offset=%d", folderID, pageSize, offset)
+		if err := s.getJSON(ctx, u, reqPath, &p); err != nil {
+			return err
+		}
+		for _, it := range p.Entries {
+			it := it
+			itemPath := strings.TrimPrefix(path+"/"+it.Name, "/")
+			switch it.Type {
+			case "folder":
+				if err := s.walkFolder(ctx, u, it.ID, itemPath, chunksChan, scanned); err != nil {
+					s.log.Error(err, "error scanning folder", "user", u.Login, "folder", itemPath)
+				}
+			case "file":
+				s.jobPool.Go(func() error {
+					s.scanFile(ctx, u, it, itemPath, chunksChan)
+					atomic.AddUint64(scanned, 1)
+					return nil
+				})
+			}
+		}
+		if len(p.Entries) == 0 || offset+len(p.Entries) >= p.TotalCount {
+			return nil
+		}
+	}
+}
+
+// scanFile scans the current version of a file and, unless disabled, its
+// previous versions.
+func (s *Source) scanFile(ctx context.Context, u user, f item, path string, chunksChan chan *sources.Chunk) {
+	if f.Size > maxFileSize {
+		s.log.V(2).Info("skipping large file", "file", path, "size", f.Size)
+	} else if err := s.scanContent(ctx, u, fmt.Sprintf("files/%s/content", f.ID), s.metadata(f, path, ""), chunksChan); err != nil {
+		s.log.Error(err, "could not scan file", "file", path)
+	}
+	if s.skipVersions {
+		return
+	}
+
+	// Version history is only available on paid plans, so failures are expected.
+	var versions page[item]
+	if err := s.getJSON(ctx, u, fmt.Sprintf("files/%s/versions?fields=id,size,modified_at,modified_by", f.ID), &versions); err != nil {
+		s.log.V(2).Info("could not list file versions", "file", path, "error", err)
+		return
+	}
+	for _, v := range versions.Entries {
+		if v.Size > maxFileSize {
+			continue
+		}
+		meta := s.metadata(item{ID: f.ID, ModifiedAt: v.ModifiedAt, ModifiedBy: v.ModifiedBy}, path, v.ID)
+		if err := s.scanContent(ctx, u, fmt.Sprintf("files/%s/content?version=%s", f.ID, v.ID), meta, chunksChan); err != nil {
+			s.log.Error(err, "could not scan file version", "file", path, "version", v.ID)
+		}
+	}
+}
+
+func (s *Source) scanContent(ctx context.Context, u user, path string, meta *source_metadatapb.MetaData, chunksChan chan *sources.Chunk) error {
+	res, err := s.get(ctx, u, path)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	reader, err := diskbufferreader.New(io.LimitReader(res.Body, maxFileSize))
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	chunkSkel := &sources.Chunk{
+		SourceType:     s.Type(),
+		SourceName:     s.name,
+		SourceID:       s.SourceID(),
+		SourceMetadata: meta,
+		Verify:         s.verify,
+	}
+	if handlers.HandleFile(ctx, reader, chunkSkel, chunksChan) {
+		return nil
+	}
+	if err := reader.Reset(); err != nil {
+		return err
+	}
+	reader.Stop()
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	if len(data) == 0 {
+		return nil
+	}
+
+	chunk := *chunkSkel
+	chunk.Data = data
+	select {
+	case chunksChan <- &chunk:
+	case <-ctx.Done():
+	}
+	return nil
+}
+
+func (s *Source) metadata(f item, path, versionID string) *source_metadatapb.MetaData {
+	return &source_metadatapb.MetaData{
+		Data: &source_metadatapb.MetaData_Box{
+			Box: &source_metadatapb.Box{
+				FileId:    f.ID,
+				File:      sanitizer.UTF8(path),
+				VersionId: versionID,
+				Link:      fmt.Sprintf("https://app.box.com/file/%s", f.ID),
+				Email:     sanitizer.UTF8(f.ModifiedBy.Login),
+				Timestamp: f.ModifiedAt,
+			},
+		},
+	}
+}
+
+func (s *Source) getJSON(ctx context.Context, u user, path string, out interface{}) error {
+	res, err := s.get(ctx, u, path)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	return json.NewDecoder(res.Body).Decode(out)
+}
+
+// get requests a path relative to the API endpoint, acting as the given user
+// when it has an ID.
+func (s *Source) get(ctx context.Context, u user, path string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.endpoint+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if u.ID != "" {
+		req.Header.Set("As-User", u.ID)
+	}
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		res.Body.Close()
+		return nil, fmt.Errorf("unexpected status code %d requesting %s", res.StatusCode, path)
+	}
+	return res, nil
+}
@@ -0,0 +1,143 @@
+package box
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"testing"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/youmark/pkcs8"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/sourcestest"
+)
+
+func TestJWTTokenSource(t *testing.T) {
+	defer gock.Off()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	der, err := pkcs8.ConvertPrivateKeyToPKCS8(key, []byte("passphrase"))
+	assert.NoError(t, err)
+
+	var config jwtConfig
+	config.EnterpriseID = "12345"
+	config.BoxAppSettings.ClientID = "client"
+	config.BoxAppSettings.ClientSecret = "secret"
+	config.BoxAppSettings.AppAuth.PublicKeyID = "kid"
+	config.BoxAppSettings.AppAuth.PrivateKey = string(pem.EncodeToMemory(&pem.Block{Type: "ENCRYPTED PRIVATE KEY", Bytes: der}))
+	config.BoxAppSettings.AppAuth.Passphrase = "passphrase"
+	raw, err := json.Marshal(config)
+	assert.NoError(t, err)
+
+	client := &http.Client{}
+	gock.InterceptClient(client)
+	gock.New("https://api.box.com").
+		Post("/oauth2/token").
+		AddMatcher(func(req *http.Request, _ *gock.Request) (bool, error) {
+			if err := req.ParseForm(); err != nil {
+				return false, err
+			}
+			token, err := jwt.Parse(req.PostForm.Get("assertion"), func(*jwt.Token) (interface{}, error) {
+				return &key.PublicKey, nil
+			})
+			if err != nil {
+				return false, err
+			}
+			claims := token.Claims.(jwt.MapClaims)
+			return req.PostForm.Get("grant_type") == jwtGrantType &&
+				token.Header["kid"] == "kid" &&
+				claims["sub"] == "12345" &&
+				claims["box_sub_type"] == "enterprise", nil
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{"access_token": "access", "expires_in": 3600, "token_type": "bearer"})
+
+	ts, err := newJWTTokenSource(string(raw), defaultTokenURL, client)
+	assert.NoError(t, err)
+	token, err := ts.Token()
+	assert.NoError(t, err)
+	assert.Equal(t, "access", token.AccessToken)
+	assert.True(t, gock.IsDone())
+
+	_, err = newJWTTokenSource(`{"enterpriseID": "12345"}`, defaultTokenURL, client)
+	assert.Error(t, err)
+}
+
+func TestTokenURLFor(t *testing.T) {
+	u, err := tokenURLFor(defaultEndpoint)
+	assert.NoError(t, err)
+	assert.Equal(t, defaultTokenURL, u)
+
+	u, err = tokenURLFor("http://localhost:8080/2.0/")
+	assert.NoError(t, err)
+	assert.Equal(t, "http://localhost:8080/oauth2/token", u)
+}
+
+func TestSource_Chunks(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.box.com").
+		Get("/2.0/users").
+		Reply(200).
+		JSON(map[string]interface{}{"total_count": 1, "entries": []map[string]string{{"id": "u1", "login": "dev@example.com"}}})
+	gock.New("https://api.box.com").
+		Get("/2.0/folders/0/items").
+		MatchHeader("As-User", "u1").
+		Reply(200).
+		JSON(map[string]interface{}{"total_count": 1, "entries": []map[string]interface{}{
+			{"id": "10", "type": "folder", "name": "config"},
+		}})
+	gock.New("https://api.box.com").
+		Get("/2.0/folders/10/items").
+		MatchHeader("As-User", "u1").
+		Reply(200).
+		JSON(map[string]interface{}{"total_count": 1, "entries": []map[string]interface{}{
+			{"id": "20", "type": "file", "name": "prod.env", "size": 16, "modified_by": map[string]string{"login": "dev@example.com"}},
+		}})
+	gock.New("https://api.box.com").
+		Get("/2.0/files/20/content").
+		MatchHeader("As-User", "u1").
+		Reply(200).
+		BodyString("PASSWORD=hunter3")
+	gock.New("https://api.box.com").
+		Get("/2.0/files/20/versions").
+		Reply(200).
+		JSON(map[string]interface{}{"total_count": 1, "entries": []map[string]interface{}{
+			{"id": "30", "type": "file_version", "size": 16},
+		}})
+	gock.New("https://api.box.com").
+		Get("/2.0/files/20/content").
+		MatchParam("version", "30").
+		Reply(200).
+		BodyString("PASSWORD=hunter2")
+
+	s := &Source{}
+	sourcestest.Init(t, s, &sourcespb.Box{
+		Credential: &sourcespb.Box_Token{Token: "token"},
+		AllUsers:   true,
+	}, func() *http.Client { return s.client })
+
+	chunksCh := make(chan *sources.Chunk, 10)
+	assert.NoError(t, s.Chunks(context.Background(), chunksCh))
+	close(chunksCh)
+
+	var data, versions []string
+	for chunk := range chunksCh {
+		meta := chunk.SourceMetadata.GetBox()
+		assert.Equal(t, "config/prod.env", meta.File)
+		assert.Equal(t, "https://app.box.com/file/20", meta.Link)
+		data = append(data, string(chunk.Data))
+		versions = append(versions, meta.VersionId)
+	}
+	assert.Equal(t, []string{"PASSWORD=hunter3", "PASSWORD=hunter2"}, data)
+	assert.Equal(t, []string{"", "30"}, versions)
+	assert.True(t, gock.IsDone())
+}
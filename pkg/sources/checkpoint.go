@@ -0,0 +1,112 @@
+package sources
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// RepoCheckpoint persists the progress of the scans of the repos of a source
+// across runs. An interrupted scan resumes with the repos it didn't scan, and
+// once a scan completes the next one starts over with every repo but only
+// scans the commits added since the heads each repo was last scanned up to. A
+// RepoCheckpoint without a path is only kept in memory, and a nil one keeps
+// nothing.
+type RepoCheckpoint struct {
+	path  string
+	mu    sync.Mutex
+	Repos map[string]*RepoProgress `json:"repos"`
+}
+
+// RepoProgress is the progress of the scans of a repo.
+type RepoProgress struct {
+	// Heads are the objects the refs of the repo pointed to when it was last
+	// scanned.
+	Heads []string `json:"heads,omitempty"`
+	// Done is whether the repo was scanned by the current scan.
+	Done bool `json:"done,omitempty"`
+}
+
+// LoadRepoCheckpoint reads the checkpoint stored at path. A missing file
+// yields an empty checkpoint that will be written to path on save.
+func LoadRepoCheckpoint(path string) (*RepoCheckpoint, error) {
+	cp := &RepoCheckpoint{path: path, Repos: map[string]*RepoProgress{}}
+	if path == "" {
+		return cp, nil
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return cp, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, cp); err != nil {
+		return nil, fmt.Errorf("could not parse checkpoint %s: %w", path, err)
+	}
+	if cp.Repos == nil {
+		cp.Repos = map[string]*RepoProgress{}
+	}
+	return cp, nil
+}
+
+// Progress returns the progress of a repo.
+func (c *RepoCheckpoint) Progress(repo string) RepoProgress {
+	if c == nil {
+		return RepoProgress{}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if p, ok := c.Repos[repo]; ok {
+		return *p
+	}
+	return RepoProgress{}
+}
+
+// RepoScanned records that a repo was scanned up to heads and saves the
+// checkpoint.
+func (c *RepoCheckpoint) RepoScanned(repo string, heads []string) error {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.Repos == nil {
+		c.Repos = map[string]*RepoProgress{}
+	}
+	c.Repos[repo] = &RepoProgress{Heads: heads, Done: true}
+	return c.save()
+}
+
+// ScanCompleted records that every repo was scanned and saves the
+// checkpoint, so the next scan covers every repo again.
+func (c *RepoCheckpoint) ScanCompleted() error {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, p := range c.Repos {
+		p.Done = false
+	}
+	return c.save()
+}
+
+// save writes the checkpoint back to the file it was loaded from. It must be
+// called with the lock held.
+func (c *RepoCheckpoint) save() error {
+	if c.path == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	// Write then rename so an interrupted save doesn't lose the checkpoint.
+	tmp := c.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, c.path)
+}
@@ -0,0 +1,36 @@
+package sources
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepoCheckpoint(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "github.json")
+
+	cp, err := LoadRepoCheckpoint(path)
+	assert.NoError(t, err)
+	assert.Equal(t, RepoProgress{}, cp.Progress("https://github.com/org/a.git"))
+
+	assert.NoError(t, cp.RepoScanned("https://github.com/org/a.git", []string{"abc"}))
+
+	// An interrupted scan resumes with the repos it didn't scan.
+	cp, err = LoadRepoCheckpoint(path)
+	assert.NoError(t, err)
+	assert.Equal(t, RepoProgress{Heads: []string{"abc"}, Done: true}, cp.Progress("https://github.com/org/a.git"))
+	assert.Equal(t, RepoProgress{}, cp.Progress("https://github.com/org/b.git"))
+
+	// Completed scans keep the heads for the next scans.
+	assert.NoError(t, cp.RepoScanned("https://github.com/org/b.git", []string{"def"}))
+	assert.NoError(t, cp.ScanCompleted())
+	cp, err = LoadRepoCheckpoint(path)
+	assert.NoError(t, err)
+	assert.Equal(t, RepoProgress{Heads: []string{"abc"}}, cp.Progress("https://github.com/org/a.git"))
+	assert.Equal(t, RepoProgress{Heads: []string{"def"}}, cp.Progress("https://github.com/org/b.git"))
+
+	_, err = os.Stat(path + ".tmp")
+	assert.True(t, os.IsNotExist(err))
+}
@@ -7,6 +7,8 @@ import (
 	"io"
 
 	"github.com/sirupsen/logrus"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
 )
 
 const (
@@ -25,25 +27,67 @@ func Chunker(originalChunk *Chunk) chan *Chunk {
 			chunkChan <- originalChunk
 			return
 		}
-		r := bytes.NewReader(originalChunk.Data)
-		reader := bufio.NewReaderSize(bufio.NewReader(r), ChunkSize)
-		for {
-			chunkBytes := make([]byte, ChunkSize)
+		err := splitReader(bytes.NewReader(originalChunk.Data), func(data []byte) bool {
 			chunk := *originalChunk
-			n, err := reader.Read(chunkBytes)
-			if err != nil && !errors.Is(err, io.EOF) {
-				logrus.WithError(err).Error("Error chunking reader.")
-				break
-			}
-			peekData, _ := reader.Peek(PeekSize)
-			chunk.Data = append(chunkBytes[:n], peekData...)
-			if n > 0 {
-				chunkChan <- &chunk
-			}
-			if errors.Is(err, io.EOF) {
-				break
-			}
+			chunk.Data = data
+			chunkChan <- &chunk
+			return true
+		})
+		if err != nil {
+			logrus.WithError(err).Error("Error chunking reader.")
 		}
 	}()
 	return chunkChan
 }
+
+// ChunkReader reads r and sends its data to chunksChan as chunks of ChunkSize
+// copied from chunkSkel, so that large files are never held in memory at
+// once. It stops early when ctx is done.
+func ChunkReader(ctx context.Context, r io.Reader, chunkSkel *Chunk, chunksChan chan *Chunk) error {
+	send := func(data []byte) bool {
+		chunk := *chunkSkel
+		chunk.Data = data
+		select {
+		case chunksChan <- &chunk:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+	// Data that fits in a chunk is sent whole, like Chunker does.
+	reader := bufio.NewReaderSize(r, ChunkSize+PeekSize+1)
+	if data, err := reader.Peek(ChunkSize + PeekSize + 1); err != nil {
+		if !errors.Is(err, io.EOF) {
+			return err
+		}
+		if len(data) > 0 {
+			send(append([]byte(nil), data...))
+		}
+		return nil
+	}
+	return splitReader(reader, send)
+}
+
+// splitReader reads r in pieces of ChunkSize, each followed by a peek into
+// the next one, and calls send with each of them until it returns false.
+func splitReader(r io.Reader, send func([]byte) bool) error {
+	reader := bufio.NewReaderSize(bufio.NewReader(r), ChunkSize)
+	for {
+		chunkBytes := make([]byte, ChunkSize)
+		// Readers like the bodies of responses return less than asked for.
+		n, err := io.ReadFull(reader, chunkBytes)
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			err = io.EOF
+		}
+		if err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+		peekData, _ := reader.Peek(PeekSize)
+		if n > 0 && !send(append(chunkBytes[:n], peekData...)) {
+			return nil
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+	}
+}
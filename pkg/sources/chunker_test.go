@@ -6,8 +6,11 @@ import (
 	"errors"
 	"io"
 	"testing"
+	"testing/iotest"
 
 	diskbufferreader "github.com/bill-rich/disk-buffer-reader"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
 )
 
 func TestChunker(t *testing.T) {
@@ -72,3 +75,40 @@ func TestChunker(t *testing.T) {
 	}
 
 }
+
+func TestChunkReader(t *testing.T) {
+	for _, size := range []int{0, 100, ChunkSize + PeekSize, ChunkSize*9 + 123} {
+		data := make([]byte, size)
+		for i := range data {
+			data[i] = byte(i)
+		}
+		var want [][]byte
+		if size > 0 {
+			for chunk := range Chunker(&Chunk{Data: data}) {
+				want = append(want, chunk.Data)
+			}
+		}
+
+		// Readers returning less than asked for yield the same chunks.
+		chunksChan := make(chan *Chunk, 16)
+		if err := ChunkReader(context.Background(), iotest.HalfReader(bytes.NewReader(data)), &Chunk{SourceName: "test"}, chunksChan); err != nil {
+			t.Fatal(err)
+		}
+		close(chunksChan)
+		var got [][]byte
+		for chunk := range chunksChan {
+			if chunk.SourceName != "test" {
+				t.Errorf("chunk source name = %q, want the one of the skeleton", chunk.SourceName)
+			}
+			got = append(got, chunk.Data)
+		}
+		if len(got) != len(want) {
+			t.Fatalf("size %d: got %d chunks, want %d", size, len(got), len(want))
+		}
+		for i := range got {
+			if !bytes.Equal(got[i], want[i]) {
+				t.Errorf("size %d: chunk %d differs from the one of Chunker", size, i)
+			}
+		}
+	}
+}
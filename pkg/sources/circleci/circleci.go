@@ -6,10 +6,14 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
+	diskbufferreader "github.com/bill-rich/disk-buffer-reader"
 	"github.com/go-errors/errors"
+	"github.com/gobwas/glob"
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/protobuf/proto"
@@ -17,12 +21,19 @@ import (
 
 	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
 )
 
-const baseURL = "https://circleci.com/api/v1.1/"
+const (
+	baseURL = "https://circleci.com/api/v1.1/"
+	// buildsPageSize is the largest number of builds listed per request.
+	buildsPageSize = 100
+	// maxArtifactSize is the largest artifact that will be scanned.
+	maxArtifactSize = 500 * 1024 * 1024 // 500MB
+)
 
 type Source struct {
 	name     string
@@ -33,6 +44,12 @@ type Source struct {
 	jobPool  *errgroup.Group
 	sources.Progress
 	client *http.Client
+	// projects and ignoreProjects match "org/repo" project names.
+	projects         []glob.Glob
+	ignoreProjects   []glob.Glob
+	orgs             []string
+	since            time.Time
+	includeArtifacts bool
 }
 
 // Ensure the Source satisfies the interface at compile time.
@@ -72,9 +89,34 @@ func (s *Source) Init(_ context.Context, name string, jobId, sourceId int64, ver
 		s.token = conn.GetToken()
 	}
 
+	var err error
+	if s.projects, err = compileGlobs(conn.GetProjects()); err != nil {
+		return err
+	}
+	if s.ignoreProjects, err = compileGlobs(conn.GetIgnoreProjects()); err != nil {
+		return err
+	}
+	s.orgs = conn.GetOrgs()
+	if conn.GetSince() != nil {
+		s.since = conn.GetSince().AsTime()
+	}
+	s.includeArtifacts = conn.GetIncludeArtifacts()
+
 	return nil
 }
 
+func compileGlobs(patterns []string) ([]glob.Glob, error) {
+	var globs []glob.Glob
+	for _, pattern := range patterns {
+		g, err := glob.Compile(pattern, '/')
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+		globs = append(globs, g)
+	}
+	return globs, nil
+}
+
 // scanErrors is used to collect errors encountered while scanning.
 // It ensures that errors are collected in a thread-safe manner.
 type scanErrors struct {
@@ -96,10 +138,16 @@ func (s *scanErrors) add(err error) {
 
 // Chunks emits chunks of bytes over a channel.
 func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) error {
-	projects, err := s.projects(ctx)
+	allProjects, err := s.listProjects(ctx)
 	if err != nil {
 		return fmt.Errorf("error getting projects: %w", err)
 	}
+	var projects []project
+	for _, proj := range allProjects {
+		if s.selected(proj) {
+			projects = append(projects, proj)
+		}
+	}
 
 	var scanned uint64
 	scanErrs := newScanErrors(len(projects))
@@ -128,6 +176,20 @@ func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) err
 						}
 					}
 				}
+
+				if !s.includeArtifacts {
+					continue
+				}
+				artifacts, err := s.artifactsForBuild(ctx, proj, bld)
+				if err != nil {
+					scanErrs.add(fmt.Errorf("error getting artifacts for build %d: %w", bld.BuildNum, err))
+					continue
+				}
+				for _, art := range artifacts {
+					if err := s.chunkArtifact(ctx, proj, bld, art, chunksChan); err != nil {
+						scanErrs.add(fmt.Errorf("error chunking artifact %s of build %d: %w", art.Path, bld.BuildNum, err))
+					}
+				}
 			}
 
 			atomic.AddUint64(&scanned, 1)
@@ -150,7 +212,36 @@ type project struct {
 	RepoName string `json:"reponame"`
 }
 
-func (s *Source) projects(_ context.Context) ([]project, error) {
+// selected reports whether the project is in the scanned orgs and projects.
+func (s *Source) selected(proj project) bool {
+	if len(s.orgs) > 0 {
+		inOrg := false
+		for _, org := range s.orgs {
+			inOrg = inOrg || strings.EqualFold(org, proj.Username)
+		}
+		if !inOrg {
+			return false
+		}
+	}
+
+	name := proj.Username + "/" + proj.RepoName
+	for _, g := range s.ignoreProjects {
+		if g.Match(name) {
+			return false
+		}
+	}
+	if len(s.projects) == 0 {
+		return true
+	}
+	for _, g := range s.projects {
+		if g.Match(name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Source) listProjects(_ context.Context) ([]project, error) {
 	reqURL := fmt.Sprintf("%sprojects", baseURL)
 	req, err := http.NewRequest("GET", reqURL, nil)
 	if err != nil {
@@ -177,11 +268,40 @@ func (s *Source) projects(_ context.Context) ([]project, error) {
 }
 
 type build struct {
-	BuildNum int `json:"build_num"`
+	BuildNum  int       `json:"build_num"`
+	StartTime time.Time `json:"start_time"`
+}
+
+// buildsForProject returns the latest builds of the project, or every build
+// started since the beginning of the scanned window when it is set.
+func (s *Source) buildsForProject(ctx context.Context, proj project) ([]build, error) {
+	if s.since.IsZero() {
+		return s.buildsPage(ctx, proj, "")
+	}
+
+	var builds []build
+	for offset := 0; !common.IsDone(ctx); offset += buildsPageSize {
+		page, err := s.buildsPage(ctx, proj, fmt.Sprintf("?limit=%d&offset=%d", buildsPageSize, offset))
+		if err != nil {
+			return nil, err
+		}
+		for _, bld := range page {
+			// Builds are listed from the most recent one, and those that
+			// didn't start yet have no start time.
+			if !bld.StartTime.IsZero() && bld.StartTime.Before(s.since) {
+				return builds, nil
+			}
+			builds = append(builds, bld)
+		}
+		if len(page) < buildsPageSize {
+			break
+		}
+	}
+	return builds, nil
 }
 
-func (s *Source) buildsForProject(_ context.Context, proj project) ([]build, error) {
-	reqURL := fmt.Sprintf("%sproject/%s/%s/%s", baseURL, proj.VCS, proj.Username, proj.RepoName)
+func (s *Source) buildsPage(_ context.Context, proj project, query string) ([]build, error) {
+	reqURL := fmt.Sprintf("%sproject/%s/%s/%s%s", baseURL, proj.VCS, proj.Username, proj.RepoName, query)
 	req, err := http.NewRequest("GET", reqURL, nil)
 	if err != nil {
 		return nil, err
@@ -280,6 +400,97 @@ func (s *Source) chunkAction(_ context.Context, proj project, bld build, act act
 	return nil
 }
 
+type artifact struct {
+	Path string `json:"path"`
+	URL  string `json:"url"`
+}
+
+func (s *Source) artifactsForBuild(_ context.Context, proj project, bld build) ([]artifact, error) {
+	reqURL := fmt.Sprintf("%sproject/%s/%s/%s/%d/artifacts", baseURL, proj.VCS, proj.Username, proj.RepoName, bld.BuildNum)
+	req, err := http.NewRequest("GET", reqURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Circle-Token", s.token)
+	req.Header.Set("Accept", "application/json")
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	var artifacts []artifact
+	if err := json.NewDecoder(res.Body).Decode(&artifacts); err != nil {
+		return nil, err
+	}
+
+	return artifacts, nil
+}
+
+// chunkArtifact downloads and scans an artifact stored by a build.
+func (s *Source) chunkArtifact(ctx context.Context, proj project, bld build, art artifact, chunksChan chan *sources.Chunk) error {
+	req, err := http.NewRequest("GET", art.URL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Circle-Token", s.token)
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("unexpected status %d", res.StatusCode)
+	}
+
+	reader, err := diskbufferreader.New(io.LimitReader(res.Body, maxArtifactSize))
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	chunkSkel := &sources.Chunk{
+		SourceType: s.Type(),
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Circleci{
+				Circleci: &source_metadatapb.CircleCI{
+					VcsType:     proj.VCS,
+					Username:    proj.Username,
+					Repository:  proj.RepoName,
+					BuildNumber: int64(bld.BuildNum),
+					Artifact:    art.Path,
+					Link:        art.URL,
+				},
+			},
+		},
+		Verify: s.verify,
+	}
+	if handlers.HandleFile(ctx, reader, chunkSkel, chunksChan) {
+		return nil
+	}
+	if err := reader.Reset(); err != nil {
+		return err
+	}
+	reader.Stop()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	chunk := *chunkSkel
+	chunk.Data = data
+	for c := range sources.Chunker(&chunk) {
+		select {
+		case chunksChan <- c:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
 func removeCircleSha1Line(input []byte) []byte {
 	// Split the input slice into a slice of lines.
 	lines := bytes.Split(input, []byte("\n"))
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/kylelemons/godebug/pretty"
+	"github.com/stretchr/testify/assert"
 	"google.golang.org/protobuf/types/known/anypb"
 
 	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
@@ -91,3 +92,21 @@ func TestSource_Scan(t *testing.T) {
 		})
 	}
 }
+
+func TestSource_selected(t *testing.T) {
+	conn, err := anypb.New(&sourcespb.CircleCI{
+		Credential:     &sourcespb.CircleCI_Token{Token: "token"},
+		Orgs:           []string{"Acme"},
+		Projects:       []string{"acme/api-*", "acme/web"},
+		IgnoreProjects: []string{"acme/api-legacy"},
+	})
+	assert.NoError(t, err)
+	s := Source{}
+	assert.NoError(t, s.Init(context.Background(), "test", 0, 0, false, conn, 1))
+
+	assert.True(t, s.selected(project{Username: "acme", RepoName: "api-users"}))
+	assert.True(t, s.selected(project{Username: "acme", RepoName: "web"}))
+	assert.False(t, s.selected(project{Username: "acme", RepoName: "api-legacy"}))
+	assert.False(t, s.selected(project{Username: "acme", RepoName: "docs"}))
+	assert.False(t, s.selected(project{Username: "other", RepoName: "web"}))
+}
@@ -0,0 +1,442 @@
+package confluence
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	diskbufferreader "github.com/bill-rich/disk-buffer-reader"
+	"github.com/go-errors/errors"
+	"github.com/go-logr/logr"
+	"github.com/gobwas/glob"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const (
+	pageLimit = 50
+	// maxAttachmentSize is the largest attachment that will be downloaded and scanned.
+	maxAttachmentSize = 50 * 1024 * 1024 // 50MB
+)
+
+type Source struct {
+	name            string
+	sourceId        int64
+	jobId           int64
+	verify          bool
+	endpoint        string
+	username        string
+	password        string
+	token           string
+	spacesScope     sourcespb.Confluence_GetAllSpacesScope
+	spaces          []glob.Glob
+	ignoreSpaces    []glob.Glob
+	skipHistory     bool
+	skipAttachments bool
+	client          *http.Client
+	jobPool         *errgroup.Group
+	log             logr.Logger
+	sources.Progress
+}
+
+// Ensure the Source satisfies the interface at compile time.
+var _ sources.Source = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return sourcespb.SourceType_SOURCE_TYPE_CONFLUENCE
+}
+
+func (s *Source) SourceID() int64 {
+	return s.sourceId
+}
+
+func (s *Source) JobID() int64 {
+	return s.jobId
+}
+
+// Init returns an initialized Confluence source.
+func (s *Source) Init(aCtx context.Context, name string, jobId, sourceId int64, verify bool, connection *anypb.Any, concurrency int) error {
+	s.log = context.WithValues(aCtx, "source", s.Type(), "name", name).Logger()
+
+	s.name = name
+	s.sourceId = sourceId
+	s.jobId = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	var conn sourcespb.Confluence
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return errors.WrapPrefix(err, "error unmarshalling connection", 0)
+	}
+
+	if conn.GetEndpoint() == "" {
+		return errors.New("a Confluence endpoint is required")
+	}
+	s.endpoint = strings.TrimSuffix(conn.GetEndpoint(), "/")
+
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.Confluence_BasicAuth:
+		s.username = cred.BasicAuth.GetUsername()
+		s.password = cred.BasicAuth.GetPassword()
+	case *sourcespb.Confluence_Token:
+		s.token = cred.Token
+	case *sourcespb.Confluence_Unauthenticated:
+		// Only public spaces will be visible.
+	default:
+		return errors.Errorf("invalid configuration given for %s source", name)
+	}
+
+	var err error
+	if s.spaces, err = compileGlobs(conn.GetSpaces()); err != nil {
+		return err
+	}
+	if s.ignoreSpaces, err = compileGlobs(conn.GetIgnoreSpaces()); err != nil {
+		return err
+	}
+	s.spacesScope = conn.GetSpacesScope()
+	s.skipHistory = conn.GetSkipHistory()
+	s.skipAttachments = conn.GetSkipAttachments()
+
+	s.client = common.RetryableHttpClientTimeout(60)
+	if conn.GetInsecureSkipVerifyTls() {
+		s.client.Transport = common.NewCustomTransport(&http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		})
+	}
+
+	return nil
+}
+
+func compileGlobs(patterns []string) ([]glob.Glob, error) {
+	globs := make([]glob.Glob, 0, len(patterns))
+	for _, pattern := range patterns {
+		g, err := glob.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid space pattern %q: %w", pattern, err)
+		}
+		globs = append(globs, g)
+	}
+	return globs, nil
+}
+
+type space struct {
+	Key  string `json:"key"`
+	Name string `json:"name"`
+}
+
+type links struct {
+	WebUI    string `json:"webui"`
+	Download string `json:"download"`
+}
+
+type content struct {
+	ID      string `json:"id"`
+	Type    string `json:"type"`
+	Title   string `json:"title"`
+	Version struct {
+		Number int    `json:"number"`
+		When   string `json:"when"`
+		By     struct {
+			Email       string `json:"email"`
+			DisplayName string `json:"displayName"`
+		} `json:"by"`
+	} `json:"version"`
+	Body struct {
+		Storage struct {
+			Value string `json:"value"`
+		} `json:"storage"`
+	} `json:"body"`
+	Extensions struct {
+		FileSize  int64  `json:"fileSize"`
+		MediaType string `json:"mediaType"`
+	} `json:"extensions"`
+	Links links `json:"_links"`
+}
+
+type contentPage struct {
+	Results []content `json:"results"`
+	Size    int       `json:"size"`
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) error {
+	spaces, err := s.listSpaces(ctx)
+	if err != nil {
+		return fmt.Errorf("error listing spaces: %w", err)
+	}
+
+	var scanned uint64
+	for i, sp := range spaces {
+		if common.IsDone(ctx) {
+			break
+		}
+		sp := sp
+		s.SetProgressComplete(i, len(spaces), fmt.Sprintf("Space: %s", sp.Key), "")
+		s.jobPool.Go(func() error {
+			if err := s.scanSpace(ctx, sp, chunksChan); err != nil {
+				s.log.Error(err, "error scanning space", "space", sp.Key)
+				return nil
+			}
+			atomic.AddUint64(&scanned, 1)
+			return nil
+		})
+	}
+	_ = s.jobPool.Wait()
+	s.SetProgressComplete(len(spaces), len(spaces), fmt.Sprintf("Completed scanning source %s. %d spaces scanned.", s.name, scanned), "")
+	return nil
+}
+
+func (s *Source) listSpaces(ctx context.Context) ([]space, error) {
+	var spaces []space
+	for start := 0; ; start += pageLimit {
+		params := url.Values{}
+		params.Set("limit", strconv.Itoa(pageLimit))
+		params.Set("start", strconv.Itoa(start))
+		switch s.spacesScope {
+		case sourcespb.Confluence_GLOBAL:
+			params.Set("type", "global")
+		case sourcespb.Confluence_PERSONAL:
+			params.Set("type", "personal")
+		}
+
+		var res struct {
+			Results []space `json:"results"`
+			Size    int     `json:"size"`
+		}
+		if err := s.getJSON(ctx, "/rest/api/space?"+params.Encode(), &res); err != nil {
+			return nil, err
+		}
+		for _, sp := range res.Results {
+			if s.shouldScanSpace(sp.Key) {
+				spaces = append(spaces, sp)
+			} else {
+				s.log.V(2).Info("skipping space", "space", sp.Key)
+			}
+		}
+		if res.Size < pageLimit {
+			return spaces, nil
+		}
+	}
+}
+
+func (s *Source) shouldScanSpace(key string) bool {
+	for _, g := range s.ignoreSpaces {
+		if g.Match(key) {
+			return false
+		}
+	}
+	if len(s.spaces) == 0 {
+		return true
+	}
+	for _, g := range s.spaces {
+		if g.Match(key) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Source) scanSpace(ctx context.Context, sp space, chunksChan chan *sources.Chunk) error {
+	for _, contentType := range []string{"page", "blogpost"} {
+		for start := 0; ; start += pageLimit {
+			if common.IsDone(ctx) {
+				return nil
+			}
+			params := url.Values{}
+			params.Set("spaceKey", sp.Key)
+			params.Set("type", contentType)
+			params.Set("expand", "body.storage,version")
+			params.Set("limit", strconv.Itoa(pageLimit))
+			params.Set("start", strconv.Itoa(start))
+
+			var res contentPage
+			if err := s.getJSON(ctx, "/rest/api/content?"+params.Encode(), &res); err != nil {
+				return err
+			}
+			for _, c := range res.Results {
+				s.scanContent(ctx, sp, c, chunksChan)
+			}
+			if res.Size < pageLimit {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// scanContent scans a page or blog post along with its previous versions,
+// comments, and attachments.
+func (s *Source) scanContent(ctx context.Context, sp space, c content, chunksChan chan *sources.Chunk) {
+	link := s.endpoint + c.Links.WebUI
+	s.sendChunk(ctx, chunksChan, []byte(c.Body.Storage.Value), s.metadata(sp, c, c.Version.Number, link, c.Type))
+
+	if !s.skipHistory {
+		for version := 1; version < c.Version.Number; version++ {
+			params := url.Values{}
+			params.Set("status", "historical")
+			params.Set("version", strconv.Itoa(version))
+			params.Set("expand", "body.storage,version")
+			var old content
+			if err := s.getJSON(ctx, fmt.Sprintf("/rest/api/content/%s?%s", c.ID, params.Encode()), &old); err != nil {
+				s.log.Error(err, "could not get historical version", "page", c.Title, "version", version)
+				continue
+			}
+			old.Title = c.Title
+			s.sendChunk(ctx, chunksChan, []byte(old.Body.Storage.Value), s.metadata(sp, old, version, link, "history"))
+		}
+	}
+
+	params := url.Values{}
+	params.Set("expand", "body.storage,version")
+	params.Set("depth", "all")
+	params.Set("limit", "100")
+	var comments contentPage
+	if err := s.getJSON(ctx, fmt.Sprintf("/rest/api/content/%s/child/comment?%s", c.ID, params.Encode()), &comments); err != nil {
+		s.log.Error(err, "could not get comments", "page", c.Title)
+	}
+	for _, comment := range comments.Results {
+		comment.Title = c.Title
+		s.sendChunk(ctx, chunksChan, []byte(comment.Body.Storage.Value), s.metadata(sp, comment, comment.Version.Number, s.endpoint+comment.Links.WebUI, "comment"))
+	}
+
+	if s.skipAttachments {
+		return
+	}
+	var attachments contentPage
+	if err := s.getJSON(ctx, fmt.Sprintf("/rest/api/content/%s/child/attachment?limit=100", c.ID), &attachments); err != nil {
+		s.log.Error(err, "could not get attachments", "page", c.Title)
+	}
+	for _, attachment := range attachments.Results {
+		if attachment.Extensions.FileSize > maxAttachmentSize {
+			s.log.V(3).Info("skipping attachment over size limit", "attachment", attachment.Title, "size", attachment.Extensions.FileSize)
+			continue
+		}
+		if common.SkipFile(attachment.Title) {
+			continue
+		}
+		meta := s.metadata(sp, c, c.Version.Number, link, "attachment: "+attachment.Title)
+		if err := s.scanAttachment(ctx, attachment, meta, chunksChan); err != nil {
+			s.log.Error(err, "could not scan attachment", "page", c.Title, "attachment", attachment.Title)
+		}
+	}
+}
+
+func (s *Source) scanAttachment(ctx context.Context, attachment content, meta *source_metadatapb.MetaData, chunksChan chan *sources.Chunk) error {
+	res, err := s.get(ctx, attachment.Links.Download)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	reader, err := diskbufferreader.New(io.LimitReader(res.Body, maxAttachmentSize))
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	chunkSkel := &sources.Chunk{
+		SourceType:     s.Type(),
+		SourceName:     s.name,
+		SourceID:       s.SourceID(),
+		SourceMetadata: meta,
+		Verify:         s.verify,
+	}
+	if handlers.HandleFile(ctx, reader, chunkSkel, chunksChan) {
+		return nil
+	}
+	if err := reader.Reset(); err != nil {
+		return err
+	}
+	reader.Stop()
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	s.sendChunk(ctx, chunksChan, data, meta)
+	return nil
+}
+
+func (s *Source) metadata(sp space, c content, version int, link, location string) *source_metadatapb.MetaData {
+	return &source_metadatapb.MetaData{
+		Data: &source_metadatapb.MetaData_Confluence{
+			Confluence: &source_metadatapb.Confluence{
+				Page:      sanitizer.UTF8(c.Title),
+				Space:     sp.Key,
+				Version:   strconv.Itoa(version),
+				Link:      sanitizer.UTF8(link),
+				Email:     sanitizer.UTF8(c.Version.By.Email),
+				Timestamp: c.Version.When,
+				Location:  sanitizer.UTF8(location),
+			},
+		},
+	}
+}
+
+func (s *Source) sendChunk(ctx context.Context, chunksChan chan *sources.Chunk, data []byte, meta *source_metadatapb.MetaData) {
+	if len(data) == 0 {
+		return
+	}
+	chunk := &sources.Chunk{
+		SourceType:     s.Type(),
+		SourceName:     s.name,
+		SourceID:       s.SourceID(),
+		Data:           data,
+		SourceMetadata: meta,
+		Verify:         s.verify,
+	}
+	select {
+	case chunksChan <- chunk:
+	case <-ctx.Done():
+	}
+}
+
+func (s *Source) getJSON(ctx context.Context, path string, out interface{}) error {
+	res, err := s.get(ctx, path)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	return json.NewDecoder(res.Body).Decode(out)
+}
+
+func (s *Source) get(ctx context.Context, path string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.endpoint+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	switch {
+	case s.token != "":
+		req.Header.Set("Authorization", "Bearer "+s.token)
+	case s.username != "":
+		req.SetBasicAuth(s.username, s.password)
+	}
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		res.Body.Close()
+		return nil, fmt.Errorf("unexpected status code %d requesting %s", res.StatusCode, path)
+	}
+	return res, nil
+}
@@ -0,0 +1,110 @@
+package confluence
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/sourcestest"
+)
+
+func TestSource_ShouldScanSpace(t *testing.T) {
+	s := &Source{}
+	sourcestest.Init(t, s, &sourcespb.Confluence{
+		Endpoint:     "https://example.atlassian.net/wiki",
+		Credential:   &sourcespb.Confluence_Unauthenticated{Unauthenticated: &credentialspb.Unauthenticated{}},
+		Spaces:       []string{"ENG*"},
+		IgnoreSpaces: []string{"ENGARCHIVE"},
+	}, func() *http.Client { return s.client })
+	assert.True(t, s.shouldScanSpace("ENG"))
+	assert.False(t, s.shouldScanSpace("ENGARCHIVE"))
+	assert.False(t, s.shouldScanSpace("HR"))
+}
+
+func TestSource_Chunks(t *testing.T) {
+	defer gock.Off()
+
+	const base = "https://example.atlassian.net"
+	gock.New(base).
+		Get("/wiki/rest/api/space").
+		Reply(200).
+		JSON(map[string]interface{}{"results": []map[string]string{{"key": "ENG"}, {"key": "HR"}}, "size": 2})
+	gock.New(base).
+		Get("/wiki/rest/api/content").
+		MatchParam("type", "^page$").
+		Reply(200).
+		JSON(map[string]interface{}{
+			"size": 1,
+			"results": []map[string]interface{}{{
+				"id":      "1",
+				"type":    "page",
+				"title":   "Runbook",
+				"version": map[string]interface{}{"number": 2, "when": "2023-01-02", "by": map[string]string{"email": "a@example.com"}},
+				"body":    map[string]interface{}{"storage": map[string]string{"value": "current body"}},
+				"_links":  map[string]string{"webui": "/spaces/ENG/pages/1"},
+			}},
+		})
+	gock.New(base).
+		Get("/wiki/rest/api/content").
+		MatchParam("type", "blogpost").
+		Reply(200).
+		JSON(map[string]interface{}{"size": 0, "results": []interface{}{}})
+	gock.New(base).
+		Get("/wiki/rest/api/content/1").
+		MatchParam("status", "historical").
+		MatchParam("version", "1").
+		Reply(200).
+		JSON(map[string]interface{}{
+			"version": map[string]interface{}{"number": 1},
+			"body":    map[string]interface{}{"storage": map[string]string{"value": "old body"}},
+		})
+	gock.New(base).
+		Get("/wiki/rest/api/content/1/child/comment").
+		Reply(200).
+		JSON(map[string]interface{}{"size": 1, "results": []map[string]interface{}{{
+			"id":      "2",
+			"version": map[string]interface{}{"number": 1},
+			"body":    map[string]interface{}{"storage": map[string]string{"value": "a comment"}},
+		}}})
+	gock.New(base).
+		Get("/wiki/rest/api/content/1/child/attachment").
+		Reply(200).
+		JSON(map[string]interface{}{"size": 1, "results": []map[string]interface{}{{
+			"title":      "creds.txt",
+			"extensions": map[string]interface{}{"fileSize": 10},
+			"_links":     map[string]string{"download": "/download/attachments/1/creds.txt"},
+		}}})
+	gock.New(base).
+		Get("/wiki/download/attachments/1/creds.txt").
+		Reply(200).
+		BodyString("attachment")
+
+	s := &Source{}
+	sourcestest.Init(t, s, &sourcespb.Confluence{
+		Endpoint:     base + "/wiki",
+		Credential:   &sourcespb.Confluence_BasicAuth{BasicAuth: &credentialspb.BasicAuth{Username: "user", Password: "token"}},
+		IgnoreSpaces: []string{"HR"},
+	}, func() *http.Client { return s.client })
+
+	chunksCh := make(chan *sources.Chunk, 10)
+	assert.NoError(t, s.Chunks(context.Background(), chunksCh))
+	close(chunksCh)
+
+	var data, locations []string
+	for chunk := range chunksCh {
+		meta := chunk.SourceMetadata.GetConfluence()
+		assert.Equal(t, "ENG", meta.Space)
+		assert.Equal(t, "Runbook", meta.Page)
+		data = append(data, string(chunk.Data))
+		locations = append(locations, meta.Location)
+	}
+	assert.Equal(t, []string{"current body", "old body", "a comment", "attachment"}, data)
+	assert.Equal(t, []string{"page", "history", "comment", "attachment: creds.txt"}, locations)
+	assert.True(t, gock.IsDone())
+}
@@ -0,0 +1,273 @@
+package crates
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+
+	diskbufferreader "github.com/bill-rich/disk-buffer-reader"
+	"github.com/go-errors/errors"
+	"github.com/go-logr/logr"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const (
+	defaultEndpoint = "https://crates.io/"
+	// crates.io rejects requests without an identifying user agent.
+	userAgent = "trufflehog (https://github.com/trufflesecurity/trufflehog)"
+	pageSize  = 100
+	// maxCrateSize is the largest .crate file that will be downloaded and scanned.
+	maxCrateSize = 100 * 1024 * 1024 // 100MB
+)
+
+type Source struct {
+	name        string
+	sourceId    int64
+	jobId       int64
+	verify      bool
+	endpoint    string
+	token       string
+	crates      []string
+	owners      []string
+	maxVersions int
+	client      *http.Client
+	jobPool     *errgroup.Group
+	log         logr.Logger
+	sources.Progress
+}
+
+// Ensure the Source satisfies the interface at compile time.
+var _ sources.Source = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return sourcespb.SourceType_SOURCE_TYPE_CRATES
+}
+
+func (s *Source) SourceID() int64 {
+	return s.sourceId
+}
+
+func (s *Source) JobID() int64 {
+	return s.jobId
+}
+
+// Init returns an initialized crates.io source.
+func (s *Source) Init(aCtx context.Context, name string, jobId, sourceId int64, verify bool, connection *anypb.Any, concurrency int) error {
+	s.log = context.WithValues(aCtx, "source", s.Type(), "name", name).Logger()
+
+	s.name = name
+	s.sourceId = sourceId
+	s.jobId = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	var conn sourcespb.Crates
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return errors.WrapPrefix(err, "error unmarshalling connection", 0)
+	}
+
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.Crates_Unauthenticated:
+	case *sourcespb.Crates_Token:
+		s.token = cred.Token
+	default:
+		return errors.Errorf("invalid configuration given for %s source", name)
+	}
+
+	s.endpoint = conn.GetEndpoint()
+	if s.endpoint == "" {
+		s.endpoint = defaultEndpoint
+	}
+	if !strings.HasSuffix(s.endpoint, "/") {
+		s.endpoint += "/"
+	}
+	s.crates = conn.GetCrates()
+	s.owners = conn.GetOwners()
+	if len(s.crates) == 0 && len(s.owners) == 0 {
+		return errors.New("at least one crate or owner is required")
+	}
+	s.maxVersions = int(conn.GetMaxVersions())
+	s.client = common.RetryableHttpClientTimeout(120)
+
+	return nil
+}
+
+type version struct {
+	Num         string `json:"num"`
+	DLPath      string `json:"dl_path"`
+	Yanked      bool   `json:"yanked"`
+	PublishedBy *struct {
+		Login string `json:"login"`
+	} `json:"published_by"`
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) error {
+	crates := append([]string{}, s.crates...)
+	for _, owner := range s.owners {
+		owned, err := s.listOwner(ctx, owner)
+		if err != nil {
+			s.log.Error(err, "could not list owner crates", "owner", owner)
+			continue
+		}
+		crates = append(crates, owned...)
+	}
+
+	var scanned uint64
+	for i, crate := range crates {
+		if common.IsDone(ctx) {
+			break
+		}
+		s.SetProgressComplete(i, len(crates), fmt.Sprintf("Crate: %s", crate), "")
+
+		// Versions are returned newest first and include yanked versions,
+		// which are often yanked precisely because they leaked something.
+		var res struct {
+			Versions []version `json:"versions"`
+		}
+		if err := s.getJSON(ctx, fmt.Sprintf("api/v1/crates/%s/versions", url.PathEscape(crate)), &res); err != nil {
+			s.log.Error(err, "could not list crate versions", "crate", crate)
+			continue
+		}
+		versions := res.Versions
+		if s.maxVersions > 0 && len(versions) > s.maxVersions {
+			versions = versions[:s.maxVersions]
+		}
+		for _, v := range versions {
+			crate, v := crate, v
+			s.jobPool.Go(func() error {
+				if err := s.scanVersion(ctx, crate, v, chunksChan); err != nil {
+					s.log.Error(err, "could not scan crate", "crate", crate, "version", v.Num)
+					return nil
+				}
+				atomic.AddUint64(&scanned, 1)
+				return nil
+			})
+		}
+	}
+	_ = s.jobPool.Wait()
+
+	s.SetProgressComplete(len(crates), len(crates), fmt.Sprintf("Completed scanning source %s. %d crate versions scanned.", s.name, scanned), "")
+	return nil
+}
+
+// listOwner returns the names of the crates owned by a user.
+func (s *Source) listOwner(ctx context.Context, login string) ([]string, error) {
+	var user struct {
+		User struct {
+			ID int64 `json:"id"`
+		} `json:"user"`
+	}
+	if err := s.getJSON(ctx, "api/v1/users/"+url.PathEscape(login), &user); err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for page := 1; ; page++ {
+		var res struct {
+			Crates []struct {
+				Name string `json:"name"`
+			} `json:"crates"`
+			Meta struct {
+				Total int `json:"total"`
+			} `json:"meta"`
+		}
+		path := fmt.Sprintf("api/v1/crates?user_id=%d&per_page=%d&page=%d", user.User.ID, pageSize, page)
+		if err := s.getJSON(ctx, path, &res); err != nil {
+			return nil, err
+		}
+		for _, c := range res.Crates {
+			names = append(names, c.Name)
+		}
+		if len(res.Crates) == 0 || len(names) >= res.Meta.Total {
+			return names, nil
+		}
+	}
+}
+
+func (s *Source) scanVersion(ctx context.Context, crate string, v version, chunksChan chan *sources.Chunk) error {
+	res, err := s.get(ctx, strings.TrimPrefix(v.DLPath, "/"))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	reader, err := diskbufferreader.New(io.LimitReader(res.Body, maxCrateSize))
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	var publisher string
+	if v.PublishedBy != nil {
+		publisher = v.PublishedBy.Login
+	}
+	chunkSkel := &sources.Chunk{
+		SourceType: s.Type(),
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Crates{
+				Crates: &source_metadatapb.Crates{
+					File:      sanitizer.UTF8(fmt.Sprintf("%s-%s.crate", crate, v.Num)),
+					Package:   sanitizer.UTF8(crate),
+					Release:   sanitizer.UTF8(v.Num),
+					Link:      sanitizer.UTF8(fmt.Sprintf("%scrates/%s/%s", s.endpoint, crate, v.Num)),
+					Publisher: sanitizer.UTF8(publisher),
+					Yanked:    v.Yanked,
+				},
+			},
+		},
+		Verify: s.verify,
+	}
+	if !handlers.HandleFile(ctx, reader, chunkSkel, chunksChan) {
+		return fmt.Errorf("could not extract crate")
+	}
+	return nil
+}
+
+func (s *Source) getJSON(ctx context.Context, path string, out interface{}) error {
+	res, err := s.get(ctx, path)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	return json.NewDecoder(res.Body).Decode(out)
+}
+
+func (s *Source) get(ctx context.Context, path string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.endpoint+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", userAgent)
+	if s.token != "" {
+		req.Header.Set("Authorization", s.token)
+	}
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		res.Body.Close()
+		return nil, fmt.Errorf("unexpected status code %d requesting %s", res.StatusCode, path)
+	}
+	return res, nil
+}
@@ -0,0 +1,89 @@
+package crates
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/sourcestest"
+)
+
+func crateFile(t *testing.T, name, content string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	assert.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content))}))
+	_, err := tw.Write([]byte(content))
+	assert.NoError(t, err)
+	assert.NoError(t, tw.Close())
+	assert.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func TestSource_Chunks(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://crates.io").
+		Get("/api/v1/users/acme").
+		MatchHeader("User-Agent", "trufflehog").
+		Reply(200).
+		JSON(map[string]interface{}{"user": map[string]interface{}{"id": 42, "login": "acme"}})
+	gock.New("https://crates.io").
+		Get("/api/v1/crates").
+		MatchParam("user_id", "42").
+		Reply(200).
+		JSON(map[string]interface{}{
+			"crates": []map[string]string{{"name": "widgets"}},
+			"meta":   map[string]int{"total": 1},
+		})
+	gock.New("https://crates.io").
+		Get("/api/v1/crates/widgets/versions").
+		Reply(200).
+		JSON(map[string]interface{}{"versions": []map[string]interface{}{
+			{"num": "0.2.0", "dl_path": "/api/v1/crates/widgets/0.2.0/download", "yanked": false},
+			{"num": "0.1.0", "dl_path": "/api/v1/crates/widgets/0.1.0/download", "yanked": true, "published_by": map[string]string{"login": "dev"}},
+		}})
+	gock.New("https://crates.io").
+		Get("/api/v1/crates/widgets/0.2.0/download").
+		Reply(200).
+		Body(bytes.NewReader(crateFile(t, "widgets-0.2.0/src/lib.rs", "// nothing here")))
+	gock.New("https://crates.io").
+		Get("/api/v1/crates/widgets/0.1.0/download").
+		Reply(200).
+		Body(bytes.NewReader(crateFile(t, "widgets-0.1.0/.env", "TOKEN=secret")))
+
+	s := &Source{}
+	sourcestest.Init(t, s, &sourcespb.Crates{
+		Credential: &sourcespb.Crates_Unauthenticated{Unauthenticated: &credentialspb.Unauthenticated{}},
+		Owners:     []string{"acme"},
+	}, func() *http.Client { return s.client })
+
+	chunksCh := make(chan *sources.Chunk, 10)
+	assert.NoError(t, s.Chunks(context.Background(), chunksCh))
+	close(chunksCh)
+
+	var data []string
+	for chunk := range chunksCh {
+		meta := chunk.SourceMetadata.GetCrates()
+		assert.Equal(t, "widgets", meta.Package)
+		if meta.Release == "0.1.0" {
+			assert.True(t, meta.Yanked)
+			assert.Equal(t, "dev", meta.Publisher)
+			assert.Equal(t, "https://crates.io/crates/widgets/0.1.0", meta.Link)
+		}
+		data = append(data, strings.TrimRight(string(chunk.Data), "\x00"))
+	}
+	assert.Equal(t, []string{"// nothing here", "TOKEN=secret"}, data)
+	assert.True(t, gock.IsDone())
+}
@@ -0,0 +1,237 @@
+package diskimage
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"sync/atomic"
+	"time"
+
+	diskbufferreader "github.com/bill-rich/disk-buffer-reader"
+	"github.com/go-errors/errors"
+	"github.com/go-logr/logr"
+	"github.com/gobwas/glob"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const (
+	// defaultMaxSize is the size of the largest file that will be scanned.
+	defaultMaxSize = 250 * 1024 * 1024 // 250MB
+)
+
+type Source struct {
+	name         string
+	sourceId     int64
+	jobId        int64
+	verify       bool
+	paths        []string
+	excludePaths []glob.Glob
+	maxSize      int64
+	jobPool      *errgroup.Group
+	log          logr.Logger
+	sources.Progress
+}
+
+// Ensure the Source satisfies the interface at compile time.
+var _ sources.Source = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return sourcespb.SourceType_SOURCE_TYPE_DISK_IMAGE
+}
+
+func (s *Source) SourceID() int64 {
+	return s.sourceId
+}
+
+func (s *Source) JobID() int64 {
+	return s.jobId
+}
+
+// Init returns an initialized disk image source.
+func (s *Source) Init(aCtx context.Context, name string, jobId, sourceId int64, verify bool, connection *anypb.Any, concurrency int) error {
+	s.log = context.WithValues(aCtx, "source", s.Type(), "name", name).Logger()
+
+	s.name = name
+	s.sourceId = sourceId
+	s.jobId = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	var conn sourcespb.DiskImage
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return errors.WrapPrefix(err, "error unmarshalling connection", 0)
+	}
+
+	if len(conn.GetPaths()) == 0 {
+		return errors.New("at least one disk image is required")
+	}
+	s.paths = conn.GetPaths()
+	for _, pattern := range conn.GetExcludePaths() {
+		g, err := glob.Compile(pattern, '/')
+		if err != nil {
+			return fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+		s.excludePaths = append(s.excludePaths, g)
+	}
+	s.maxSize = conn.GetMaxSize()
+	if s.maxSize <= 0 {
+		s.maxSize = defaultMaxSize
+	}
+
+	return nil
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) error {
+	var scanned uint64
+	for i, path := range s.paths {
+		if common.IsDone(ctx) {
+			break
+		}
+		s.SetProgressComplete(i, len(s.paths), fmt.Sprintf("Image: %s", path), "")
+
+		path := path
+		s.jobPool.Go(func() error {
+			n, err := s.scanImage(ctx, path, chunksChan)
+			atomic.AddUint64(&scanned, n)
+			if err != nil {
+				s.log.Error(err, "could not scan disk image", "image", path)
+			}
+			return nil
+		})
+	}
+	_ = s.jobPool.Wait()
+
+	s.SetProgressComplete(len(s.paths), len(s.paths), fmt.Sprintf("Completed scanning source %s. %d files scanned.", s.name, scanned), "")
+	return nil
+}
+
+// excluded reports whether a path matches one of the excluded patterns.
+func (s *Source) excluded(path string) bool {
+	for _, g := range s.excludePaths {
+		if g.Match(path) {
+			return true
+		}
+	}
+	return false
+}
+
+// scanImage scans the files of the filesystems of each partition of an image.
+func (s *Source) scanImage(ctx context.Context, path string, chunksChan chan *sources.Chunk) (uint64, error) {
+	d, err := openImage(path)
+	if err != nil {
+		return 0, errors.WrapPrefix(err, "could not open disk image", 0)
+	}
+	defer d.Close()
+
+	var scanned uint64
+	for _, part := range partitions(d, d.size) {
+		if common.IsDone(ctx) {
+			return scanned, ctx.Err()
+		}
+		log := s.log.WithValues("image", path, "partition", part.number)
+
+		err := walkFilesystem(io.NewSectionReader(d, part.offset, part.size), func(f file) error {
+			if common.IsDone(ctx) {
+				return ctx.Err()
+			}
+			if s.excluded(f.path) {
+				if f.dir {
+					return fs.SkipDir
+				}
+				return nil
+			}
+			if f.dir {
+				return nil
+			}
+			if f.size > s.maxSize {
+				log.V(2).Info("skipping file larger than the maximum size", "path", f.path, "size", f.size)
+				return nil
+			}
+			if err := s.scanFile(ctx, path, part.number, f, chunksChan); err != nil {
+				if common.IsDone(ctx) {
+					return ctx.Err()
+				}
+				log.V(2).Info("could not scan file", "path", f.path, "error", err)
+				return nil
+			}
+			scanned++
+			return nil
+		})
+		switch {
+		case errors.Is(err, errUnknownFilesystem):
+			log.V(2).Info("skipping partition without a supported filesystem")
+		case err != nil && common.IsDone(ctx):
+			return scanned, err
+		case err != nil:
+			log.Error(err, "could not read filesystem")
+		}
+	}
+	return scanned, nil
+}
+
+func (s *Source) scanFile(ctx context.Context, image string, partition int, f file, chunksChan chan *sources.Chunk) error {
+	rc, err := f.open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	reader, err := diskbufferreader.New(rc)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	chunkSkel := &sources.Chunk{
+		SourceType: s.Type(),
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_DiskImage{
+				DiskImage: &source_metadatapb.DiskImage{
+					Image:     sanitizer.UTF8(image),
+					Partition: int64(partition),
+					File:      sanitizer.UTF8(f.path),
+					Timestamp: f.modified.UTC().Format(time.RFC3339),
+				},
+			},
+		},
+		Verify: s.verify,
+	}
+	if handlers.HandleFile(ctx, reader, chunkSkel, chunksChan) {
+		return nil
+	}
+	if err := reader.Reset(); err != nil {
+		return err
+	}
+	reader.Stop()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	chunk := *chunkSkel
+	chunk.Data = data
+	for c := range sources.Chunker(&chunk) {
+		select {
+		case chunksChan <- c:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
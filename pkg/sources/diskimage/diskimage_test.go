@@ -0,0 +1,81 @@
+package diskimage
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/sourcestest"
+)
+
+// testExtDisk returns a disk whose first partition holds an ext4 filesystem
+// populated with the files of root.
+func testExtDisk(t *testing.T, root string) []byte {
+	t.Helper()
+	mke2fs, err := exec.LookPath("mke2fs")
+	if err != nil {
+		t.Skip("mke2fs is not installed")
+	}
+	const partitionStart, partitionSectors = 2048, 16384
+	image := filepath.Join(t.TempDir(), "disk.img")
+	if err := os.WriteFile(image, make([]byte, (partitionStart+partitionSectors)*512), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	cmd := exec.Command(mke2fs, "-q", "-F", "-t", "ext4", "-b", "4096", "-E", "offset=1048576", "-d", root, image, "2048")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("mke2fs: %v: %s", err, out)
+	}
+	disk, err := os.ReadFile(image)
+	if err != nil {
+		t.Fatal(err)
+	}
+	putMBREntry(disk, 0, 0x83, partitionStart, partitionSectors)
+	return disk
+}
+
+func TestSource_Chunks(t *testing.T) {
+	root := t.TempDir()
+	for name, content := range map[string]string{
+		"etc/app/.env":               "AWS_SECRET_ACCESS_KEY=abc",
+		"home/user/notes.txt":        "nothing to see",
+		"var/cache/app/session.json": `{"token": "xyz"}`,
+	} {
+		path := filepath.Join(root, name)
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		assert.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+	assert.NoError(t, os.Symlink("/etc/app/.env", filepath.Join(root, "home/user/env")))
+
+	disk := testExtDisk(t, root)
+	dir := t.TempDir()
+	s := &Source{}
+	sourcestest.Init(t, s, &sourcespb.DiskImage{
+		Paths:        []string{writeTestFile(t, dir, "disk.img", disk), writeTestFile(t, dir, "disk.vhd", testVHD(disk))},
+		ExcludePaths: []string{"/var/cache"},
+	})
+	chunksCh := make(chan *sources.Chunk, 10)
+	assert.NoError(t, s.Chunks(context.Background(), chunksCh))
+	close(chunksCh)
+
+	var got []string
+	for c := range chunksCh {
+		meta := c.SourceMetadata.GetDiskImage()
+		assert.Equal(t, int64(1), meta.GetPartition())
+		assert.NotEmpty(t, meta.GetTimestamp())
+		got = append(got, filepath.Base(meta.GetImage())+" "+meta.GetFile()+" "+string(c.Data))
+	}
+	sort.Strings(got)
+	assert.Equal(t, []string{
+		"disk.img /etc/app/.env AWS_SECRET_ACCESS_KEY=abc",
+		"disk.img /home/user/notes.txt nothing to see",
+		"disk.vhd /etc/app/.env AWS_SECRET_ACCESS_KEY=abc",
+		"disk.vhd /home/user/notes.txt nothing to see",
+	}, got)
+}
@@ -0,0 +1,170 @@
+package diskimage
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/masahiro331/go-ext4-filesystem/ext4"
+	"www.velocidex.com/golang/go-ntfs/parser"
+)
+
+const (
+	filesystemExt  = "ext"
+	filesystemNTFS = "ntfs"
+
+	extMagic       = 0xef53
+	extModeType    = 0xf000
+	extModeRegular = 0x8000
+	ntfsRootRecord = 5
+)
+
+var errUnknownFilesystem = errors.New("unknown filesystem")
+
+// file is a file or directory of a filesystem.
+type file struct {
+	path     string
+	dir      bool
+	size     int64
+	modified time.Time
+	open     func() (io.ReadCloser, error)
+}
+
+// filesystemType returns the type of the filesystem of a partition, or an
+// empty string if it is unknown.
+func filesystemType(r *io.SectionReader) string {
+	boot := make([]byte, 1024+512)
+	n, _ := r.ReadAt(boot, 0)
+	boot = boot[:n]
+	switch {
+	case len(boot) >= 1024+58 && binary.LittleEndian.Uint16(boot[1024+56:]) == extMagic:
+		return filesystemExt
+	case len(boot) >= 11 && string(boot[3:11]) == "NTFS    ":
+		return filesystemNTFS
+	}
+	return ""
+}
+
+// walkFilesystem calls fn with the directories and regular files of the
+// filesystem of a partition. Returning fs.SkipDir for a directory skips it.
+func walkFilesystem(r *io.SectionReader, fn func(file) error) (err error) {
+	// The filesystem parsers can panic on corrupted filesystems.
+	defer func() {
+		if v := recover(); v != nil {
+			err = fmt.Errorf("panic reading filesystem: %v", v)
+		}
+	}()
+
+	switch filesystemType(r) {
+	case filesystemExt:
+		return walkExt(r, fn)
+	case filesystemNTFS:
+		return walkNTFS(r, fn)
+	}
+	return errUnknownFilesystem
+}
+
+func walkExt(r *io.SectionReader, fn func(file) error) error {
+	fsys, err := ext4.NewFS(*r, nil)
+	if err != nil {
+		return err
+	}
+	return fs.WalkDir(fsys, "/", func(p string, d fs.DirEntry, err error) error {
+		if err != nil || p == "/" {
+			return nil
+		}
+		if d.IsDir() {
+			return fn(file{path: p, dir: true})
+		}
+		info, err := d.Info()
+		// Modes hold the type of the inode instead of fs.FileMode bits.
+		// Symbolic links, devices, pipes and sockets are skipped.
+		if err != nil || info.Mode()&extModeType != extModeRegular {
+			return nil
+		}
+		return fn(file{
+			path:     p,
+			size:     info.Size(),
+			modified: info.ModTime(),
+			open: func() (io.ReadCloser, error) {
+				return fsys.Open(strings.TrimPrefix(p, "/"))
+			},
+		})
+	})
+}
+
+func walkNTFS(r *io.SectionReader, fn func(file) error) error {
+	ntfs, err := parser.GetNTFSContext(r, 0)
+	if err != nil {
+		return err
+	}
+	defer ntfs.Close()
+	root, err := ntfs.GetMFT(ntfsRootRecord)
+	if err != nil {
+		return err
+	}
+	return walkNTFSDir(ntfs, root, "/", fn)
+}
+
+func walkNTFSDir(ntfs *parser.NTFSContext, dir *parser.MFT_ENTRY, dirPath string, fn func(file) error) error {
+	seen := make(map[int64]bool)
+	for _, info := range parser.ListDir(ntfs, dir) {
+		// Metadata files such as $MFT are skipped, as are alternate data
+		// streams.
+		if info.Name == "." || strings.HasPrefix(info.Name, "$") || strings.Contains(info.Name, ":") {
+			continue
+		}
+		record, attrType, attrID, stream, err := parser.ParseMFTId(info.MFTId)
+		if err != nil || record == ntfsRootRecord || seen[record] {
+			continue
+		}
+		// Hard links list a file under each of its names.
+		seen[record] = true
+
+		p := path.Join(dirPath, info.Name)
+		if info.IsDir {
+			err := fn(file{path: p, dir: true})
+			if errors.Is(err, fs.SkipDir) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			entry, err := ntfs.GetMFT(record)
+			if err != nil {
+				continue
+			}
+			if err := walkNTFSDir(ntfs, entry, p, fn); err != nil {
+				return err
+			}
+			continue
+		}
+
+		size := info.Size
+		err = fn(file{
+			path:     p,
+			size:     size,
+			modified: info.Mtime,
+			open: func() (io.ReadCloser, error) {
+				entry, err := ntfs.GetMFT(record)
+				if err != nil {
+					return nil, err
+				}
+				data, err := parser.OpenStream(ntfs, entry, uint64(attrType), uint16(attrID), stream)
+				if err != nil {
+					return nil, err
+				}
+				return io.NopCloser(io.NewSectionReader(data, 0, size)), nil
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package diskimage
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// disk is the virtual disk of an image.
+type disk struct {
+	io.ReaderAt
+	size  int64
+	files []*os.File
+}
+
+// Close closes the files of the image.
+func (d *disk) Close() error {
+	var err error
+	for _, f := range d.files {
+		if closeErr := f.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}
+	return err
+}
+
+// openImage opens a disk image read-only, detecting its format.
+func openImage(path string) (*disk, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	d := &disk{files: []*os.File{f}}
+	if err := d.open(path, f); err != nil {
+		_ = d.Close()
+		return nil, err
+	}
+	return d, nil
+}
+
+func (d *disk) open(path string, f *os.File) error {
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	fileSize := info.Size()
+
+	header := make([]byte, 512)
+	n, err := f.ReadAt(header, 0)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	header = header[:n]
+
+	switch {
+	case bytes.HasPrefix(header, []byte(qcow2Magic)):
+		d.ReaderAt, d.size, err = openQCOW2(f)
+	case bytes.HasPrefix(header, []byte(vmdkMagic)):
+		d.ReaderAt, d.size, err = openVMDKSparse(f, fileSize)
+	case bytes.HasPrefix(header, []byte(vmdkDescriptorMagic)):
+		err = d.openVMDKDescriptor(path, f, fileSize)
+	case bytes.HasPrefix(header, []byte("vhdxfile")):
+		err = errors.New("VHDX images are not supported")
+	case isVHD(f, fileSize):
+		d.ReaderAt, d.size, err = openVHD(f, fileSize)
+	default:
+		d.ReaderAt, d.size = f, fileSize
+	}
+	return err
+}
+
+// readFull reads len(p) bytes at off, which aren't expected to be past the end
+// of the file.
+func readFull(r io.ReaderAt, p []byte, off int64) error {
+	n, err := r.ReadAt(p, off)
+	if n == len(p) {
+		return nil
+	}
+	if err == nil || errors.Is(err, io.EOF) {
+		return fmt.Errorf("unexpected end of image at offset %d", off+int64(n))
+	}
+	return err
+}
+
+// blockReader reads a virtual disk made of blocks of the same size, mapped to
+// the image by readBlock.
+type blockReader struct {
+	blockSize int64
+	size      int64
+	// readBlock fills p with the data at offset off within a block.
+	readBlock func(block int64, p []byte, off int64) error
+}
+
+func (r *blockReader) ReadAt(p []byte, off int64) (int, error) {
+	if off >= r.size {
+		return 0, io.EOF
+	}
+	n := 0
+	for n < len(p) && off < r.size {
+		block, blockOffset := off/r.blockSize, off%r.blockSize
+		length := min(int64(len(p)-n), r.blockSize-blockOffset, r.size-off)
+		if err := r.readBlock(block, p[n:n+int(length)], blockOffset); err != nil {
+			return n, err
+		}
+		n += int(length)
+		off += length
+	}
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// extent is a range of a virtual disk stored by a reader.
+type extent struct {
+	io.ReaderAt
+	start, size int64
+}
+
+// concatReader reads a virtual disk split into extents.
+type concatReader []extent
+
+func (r concatReader) ReadAt(p []byte, off int64) (int, error) {
+	n := 0
+	for _, e := range r {
+		if n == len(p) {
+			break
+		}
+		if off >= e.start+e.size {
+			continue
+		}
+		length := min(int64(len(p)-n), e.start+e.size-off)
+		if err := readFull(e, p[n:n+int(length)], off-e.start); err != nil {
+			return n, err
+		}
+		n += int(length)
+		off += length
+	}
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// zeroReader reads unallocated ranges of a virtual disk.
+type zeroReader struct{}
+
+func (zeroReader) ReadAt(p []byte, _ int64) (int, error) {
+	clear(p)
+	return len(p), nil
+}
@@ -0,0 +1,256 @@
+package diskimage
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/zlib"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testBlockSize = 4096
+
+// testDiskData returns the data of a virtual disk of blocks of 4KB, where
+// blocks listed in holes are zeroed.
+func testDiskData(blocks int, holes ...int) []byte {
+	data := make([]byte, blocks*testBlockSize)
+	for block := 0; block < blocks; block++ {
+		copy(data[block*testBlockSize:], bytes.Repeat([]byte(fmt.Sprintf("block %d ", block)), testBlockSize/8))
+	}
+	for _, hole := range holes {
+		clear(data[hole*testBlockSize : (hole+1)*testBlockSize])
+	}
+	return data
+}
+
+func isZero(b []byte) bool {
+	return bytes.Equal(b, make([]byte, len(b)))
+}
+
+func pad(b []byte, size int) []byte {
+	if rem := len(b) % size; rem != 0 {
+		b = append(b, make([]byte, size-rem)...)
+	}
+	return b
+}
+
+// testVHD returns a dynamic VHD with blocks of 4KB.
+func testVHD(data []byte) []byte {
+	blocks := len(data) / testBlockSize
+	footer := make([]byte, vhdFooterSize)
+	copy(footer, "conectix")
+	binary.BigEndian.PutUint64(footer[16:], 512)
+	binary.BigEndian.PutUint64(footer[48:], uint64(len(data)))
+	binary.BigEndian.PutUint32(footer[60:], vhdDynamic)
+
+	header := make([]byte, 1024)
+	copy(header, "cxsparse")
+	binary.BigEndian.PutUint64(header[16:], 1536)
+	binary.BigEndian.PutUint32(header[28:], uint32(blocks))
+	binary.BigEndian.PutUint32(header[32:], testBlockSize)
+
+	bat := make([]byte, 4*blocks)
+	image := append(append([]byte{}, footer...), header...)
+	sector := (1536 + len(pad(bat, 512))) / 512
+	var blockData []byte
+	for block := 0; block < blocks; block++ {
+		b := data[block*testBlockSize : (block+1)*testBlockSize]
+		if isZero(b) {
+			binary.BigEndian.PutUint32(bat[4*block:], vhdUnallocated)
+			continue
+		}
+		binary.BigEndian.PutUint32(bat[4*block:], uint32(sector))
+		// One sector of bitmap precedes the data of the block.
+		blockData = append(blockData, make([]byte, 512)...)
+		blockData = append(blockData, b...)
+		sector += (512 + testBlockSize) / 512
+	}
+	image = append(image, pad(bat, 512)...)
+	image = append(image, blockData...)
+	return append(image, footer...)
+}
+
+// testQCOW2 returns a QCOW2 image with clusters of 4KB, compressing the
+// clusters listed in compressed.
+func testQCOW2(data []byte, compressed ...int) []byte {
+	const clusterBits = 12
+	clusters := len(data) / testBlockSize
+	header := make([]byte, testBlockSize)
+	copy(header, qcow2Magic)
+	binary.BigEndian.PutUint32(header[4:], 3)
+	binary.BigEndian.PutUint32(header[20:], clusterBits)
+	binary.BigEndian.PutUint64(header[24:], uint64(len(data)))
+	binary.BigEndian.PutUint32(header[36:], 1)
+	binary.BigEndian.PutUint64(header[40:], testBlockSize)
+
+	// A single L2 table at cluster 2 maps 512 clusters.
+	l1 := make([]byte, testBlockSize)
+	binary.BigEndian.PutUint64(l1, 2*testBlockSize)
+	l2 := make([]byte, testBlockSize)
+	image := append(header, l1...)
+	var clusterData []byte
+	offset := int64(3 * testBlockSize)
+	for cluster := 0; cluster < clusters; cluster++ {
+		c := data[cluster*testBlockSize : (cluster+1)*testBlockSize]
+		if isZero(c) {
+			continue
+		}
+		isCompressed := false
+		for _, i := range compressed {
+			isCompressed = isCompressed || i == cluster
+		}
+		if !isCompressed {
+			binary.BigEndian.PutUint64(l2[8*cluster:], uint64(offset))
+			clusterData = append(clusterData, c...)
+			offset += testBlockSize
+			continue
+		}
+		var buf bytes.Buffer
+		w, _ := flate.NewWriter(&buf, flate.BestCompression)
+		_, _ = w.Write(c)
+		_ = w.Close()
+		sectors := (buf.Len() + 511) / 512
+		offsetBits := 62 - (clusterBits - 8)
+		binary.BigEndian.PutUint64(l2[8*cluster:], qcow2Compressed|uint64(sectors-1)<<offsetBits|uint64(offset))
+		clusterData = append(clusterData, pad(buf.Bytes(), 512)...)
+		offset += int64(sectors * 512)
+	}
+	image = append(image, l2...)
+	return append(image, clusterData...)
+}
+
+// testVMDK returns a hosted sparse extent with grains of 4KB. Compressed
+// extents are laid out like stream optimized images, with the grain
+// directory in a footer.
+func testVMDK(data []byte, compressed bool) []byte {
+	const gtEntries = 512
+	grains := len(data) / testBlockSize
+	header := make([]byte, 512)
+	copy(header, vmdkMagic)
+	binary.LittleEndian.PutUint32(header[4:], 3)
+	binary.LittleEndian.PutUint64(header[12:], uint64(len(data)/512))
+	binary.LittleEndian.PutUint64(header[20:], testBlockSize/512)
+	binary.LittleEndian.PutUint32(header[44:], gtEntries)
+	binary.LittleEndian.PutUint64(header[56:], 1)
+	if compressed {
+		binary.LittleEndian.PutUint32(header[8:], vmdkCompressedGrains)
+	}
+
+	gd := make([]byte, 512)
+	binary.LittleEndian.PutUint32(gd, 2)
+	gt := make([]byte, 4*gtEntries)
+	var grainData []byte
+	sector := 2 + len(gt)/512
+	for grain := 0; grain < grains; grain++ {
+		g := data[grain*testBlockSize : (grain+1)*testBlockSize]
+		if isZero(g) {
+			continue
+		}
+		binary.LittleEndian.PutUint32(gt[4*grain:], uint32(sector))
+		if !compressed {
+			grainData = append(grainData, g...)
+			sector += testBlockSize / 512
+			continue
+		}
+		var buf bytes.Buffer
+		w := zlib.NewWriter(&buf)
+		_, _ = w.Write(g)
+		_ = w.Close()
+		marker := binary.LittleEndian.AppendUint64(nil, uint64(grain*testBlockSize/512))
+		marker = binary.LittleEndian.AppendUint32(marker, uint32(buf.Len()))
+		marker = pad(append(marker, buf.Bytes()...), 512)
+		grainData = append(grainData, marker...)
+		sector += len(marker) / 512
+	}
+
+	if !compressed {
+		image := append(append(append([]byte{}, header...), gd...), gt...)
+		return append(image, grainData...)
+	}
+	footer := append([]byte{}, header...)
+	binary.LittleEndian.PutUint64(header[56:], vmdkGDAtEnd)
+	image := append(append(append([]byte{}, header...), gd...), gt...)
+	image = append(image, grainData...)
+	// The footer is followed by the end of stream marker.
+	return append(append(image, footer...), make([]byte, 512)...)
+}
+
+func writeTestFile(t *testing.T, dir, name string, data []byte) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestOpenImage(t *testing.T) {
+	data := testDiskData(6, 2, 5)
+	dir := t.TempDir()
+
+	writeTestFile(t, dir, "disk-flat.vmdk", data[:3*testBlockSize])
+	writeTestFile(t, dir, "disk-s002.vmdk", testVMDK(data[3*testBlockSize:], false))
+	descriptor := fmt.Sprintf(`# Disk DescriptorFile
+version=1
+CID=fffffffe
+parentCID=ffffffff
+createType="custom"
+
+# Extent description
+RW %d FLAT "disk-flat.vmdk" 0
+RW %d SPARSE "disk-s002.vmdk"
+`, 3*testBlockSize/512, 3*testBlockSize/512)
+
+	tests := map[string][]byte{
+		"disk.img":             data,
+		"disk.vhd":             testVHD(data),
+		"fixed.vhd":            append(append([]byte{}, data...), testVHD(data)[len(testVHD(data))-vhdFooterSize:]...),
+		"disk.qcow2":           testQCOW2(data),
+		"compressed.qcow2":     testQCOW2(data, 0, 1, 4),
+		"sparse.vmdk":          testVMDK(data, false),
+		"streamOptimized.vmdk": testVMDK(data, true),
+		"disk.vmdk":            []byte(descriptor),
+	}
+	for name, image := range tests {
+		t.Run(name, func(t *testing.T) {
+			if name == "fixed.vhd" {
+				binary.BigEndian.PutUint32(image[len(image)-vhdFooterSize+60:], vhdFixed)
+			}
+			d, err := openImage(writeTestFile(t, dir, name, image))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer d.Close()
+			assert.Equal(t, int64(len(data)), d.size)
+
+			got := make([]byte, len(data))
+			assert.NoError(t, readFull(d, got, 0))
+			assert.Equal(t, data, got)
+
+			// Reads can span blocks and start within one.
+			got = make([]byte, testBlockSize)
+			assert.NoError(t, readFull(d, got, testBlockSize+100))
+			assert.Equal(t, data[testBlockSize+100:2*testBlockSize+100], got)
+		})
+	}
+}
+
+func TestOpenImage_Unsupported(t *testing.T) {
+	dir := t.TempDir()
+
+	backing := testQCOW2(testDiskData(1))
+	binary.BigEndian.PutUint64(backing[8:], 512)
+	_, err := openImage(writeTestFile(t, dir, "overlay.qcow2", backing))
+	assert.ErrorContains(t, err, "backing file")
+
+	_, err = openImage(writeTestFile(t, dir, "disk.vhdx", []byte("vhdxfile")))
+	assert.ErrorContains(t, err, "VHDX")
+
+	_, err = openImage(writeTestFile(t, dir, "snapshot.vmdk", []byte("# Disk DescriptorFile\nparentCID=fffffffe\n")))
+	assert.ErrorContains(t, err, "parent disk")
+}
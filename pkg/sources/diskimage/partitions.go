@@ -0,0 +1,143 @@
+package diskimage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+)
+
+const (
+	gptSignature  = "EFI PART"
+	mbrProtective = 0xee
+	// maxLogicalPartitions bounds the chain of extended boot records.
+	maxLogicalPartitions = 128
+)
+
+// partition is a range of a disk that may hold a filesystem.
+type partition struct {
+	// number is the number of the partition in the partition table, as in
+	// sda1, or zero for a disk without a partition table.
+	number int
+	offset int64
+	size   int64
+}
+
+// partitions returns the partitions of a disk, or the whole disk when it has
+// no partition table.
+func partitions(r io.ReaderAt, size int64) []partition {
+	whole := []partition{{offset: 0, size: size}}
+	// Partition images hold a filesystem, whose boot sector can look like a
+	// master boot record.
+	if filesystemType(io.NewSectionReader(r, 0, size)) != "" {
+		return whole
+	}
+	for _, sectorSize := range []int64{512, 4096} {
+		if parts, ok := gptPartitions(r, size, sectorSize); ok {
+			return parts
+		}
+	}
+	if parts, ok := mbrPartitions(r, size); ok {
+		return parts
+	}
+	return whole
+}
+
+// gptPartitions reads a GUID partition table.
+func gptPartitions(r io.ReaderAt, size, sectorSize int64) ([]partition, bool) {
+	header := make([]byte, 92)
+	if readFull(r, header, sectorSize) != nil || string(header[:8]) != gptSignature {
+		return nil, false
+	}
+	entriesOffset := int64(binary.LittleEndian.Uint64(header[72:])) * sectorSize
+	count := int64(binary.LittleEndian.Uint32(header[80:]))
+	entrySize := int64(binary.LittleEndian.Uint32(header[84:]))
+	if entrySize < 56 || count > 1024 {
+		return nil, false
+	}
+	entries := make([]byte, count*entrySize)
+	if readFull(r, entries, entriesOffset) != nil {
+		return nil, false
+	}
+
+	var parts []partition
+	for i := int64(0); i < count; i++ {
+		entry := entries[i*entrySize:]
+		// Unused entries have a zero type.
+		if bytes.Equal(entry[:16], make([]byte, 16)) {
+			continue
+		}
+		first := int64(binary.LittleEndian.Uint64(entry[32:]))
+		last := int64(binary.LittleEndian.Uint64(entry[40:]))
+		if last < first || (last+1)*sectorSize > size {
+			continue
+		}
+		parts = append(parts, partition{
+			number: int(i) + 1,
+			offset: first * sectorSize,
+			size:   (last - first + 1) * sectorSize,
+		})
+	}
+	return parts, true
+}
+
+// mbrPartitions reads a master boot record and the extended boot records of
+// its logical partitions.
+func mbrPartitions(r io.ReaderAt, size int64) ([]partition, bool) {
+	mbr := make([]byte, 512)
+	if readFull(r, mbr, 0) != nil || mbr[510] != 0x55 || mbr[511] != 0xaa {
+		return nil, false
+	}
+
+	var parts []partition
+	for i := 0; i < 4; i++ {
+		entry := mbr[446+16*i:]
+		switch entry[4] {
+		case 0, mbrProtective:
+			continue
+		case 0x05, 0x0f, 0x85:
+			parts = append(parts, logicalPartitions(r, size, int64(binary.LittleEndian.Uint32(entry[8:])))...)
+			continue
+		}
+		if p, ok := mbrPartition(entry, 0, i+1, size); ok {
+			parts = append(parts, p)
+		}
+	}
+	return parts, true
+}
+
+// logicalPartitions follows the chain of extended boot records starting at a
+// sector. Logical partitions are numbered from 5.
+func logicalPartitions(r io.ReaderAt, size, extendedStart int64) []partition {
+	var parts []partition
+	ebrSector := extendedStart
+	for number := 5; number < 5+maxLogicalPartitions; number++ {
+		ebr := make([]byte, 512)
+		if readFull(r, ebr, ebrSector*512) != nil || ebr[510] != 0x55 || ebr[511] != 0xaa {
+			break
+		}
+		if p, ok := mbrPartition(ebr[446:], ebrSector, number, size); ok {
+			parts = append(parts, p)
+		}
+		next := ebr[446+16:]
+		if next[4] == 0 {
+			break
+		}
+		ebrSector = extendedStart + int64(binary.LittleEndian.Uint32(next[8:]))
+	}
+	return parts
+}
+
+func mbrPartition(entry []byte, baseSector int64, number int, size int64) (partition, bool) {
+	if entry[4] == 0 {
+		return partition{}, false
+	}
+	p := partition{
+		number: number,
+		offset: (baseSector + int64(binary.LittleEndian.Uint32(entry[8:]))) * 512,
+		size:   int64(binary.LittleEndian.Uint32(entry[12:])) * 512,
+	}
+	if p.size == 0 || p.offset+p.size > size {
+		return partition{}, false
+	}
+	return p, true
+}
@@ -0,0 +1,71 @@
+package diskimage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// putMBREntry writes a partition entry of a master or extended boot record.
+func putMBREntry(sector []byte, i int, partitionType byte, start, sectors uint32) {
+	entry := sector[446+16*i:]
+	entry[4] = partitionType
+	binary.LittleEndian.PutUint32(entry[8:], start)
+	binary.LittleEndian.PutUint32(entry[12:], sectors)
+	sector[510], sector[511] = 0x55, 0xaa
+}
+
+func TestPartitions_MBR(t *testing.T) {
+	disk := make([]byte, 1000*512)
+	putMBREntry(disk, 0, 0x83, 10, 100)
+	putMBREntry(disk, 1, 0x05, 200, 800)
+	// The first logical partition starts 10 sectors after its EBR, and
+	// the next EBR is 300 sectors after the start of the extended partition.
+	putMBREntry(disk[200*512:], 0, 0x83, 10, 200)
+	putMBREntry(disk[200*512:], 1, 0x05, 300, 500)
+	putMBREntry(disk[500*512:], 0, 0x07, 20, 400)
+
+	assert.Equal(t, []partition{
+		{number: 1, offset: 10 * 512, size: 100 * 512},
+		{number: 5, offset: 210 * 512, size: 200 * 512},
+		{number: 6, offset: 520 * 512, size: 400 * 512},
+	}, partitions(bytes.NewReader(disk), int64(len(disk))))
+}
+
+func TestPartitions_GPT(t *testing.T) {
+	disk := make([]byte, 1000*512)
+	putMBREntry(disk, 0, mbrProtective, 1, 999)
+	header := disk[512:]
+	copy(header, gptSignature)
+	binary.LittleEndian.PutUint64(header[72:], 2)
+	binary.LittleEndian.PutUint32(header[80:], 128)
+	binary.LittleEndian.PutUint32(header[84:], 128)
+	entries := disk[2*512:]
+	for i, bounds := range [][2]uint64{{34, 99}, {0, 0}, {100, 899}} {
+		if bounds[1] == 0 {
+			continue
+		}
+		entry := entries[128*i:]
+		copy(entry, "partition type g")
+		binary.LittleEndian.PutUint64(entry[32:], bounds[0])
+		binary.LittleEndian.PutUint64(entry[40:], bounds[1])
+	}
+
+	assert.Equal(t, []partition{
+		{number: 1, offset: 34 * 512, size: 66 * 512},
+		{number: 3, offset: 100 * 512, size: 800 * 512},
+	}, partitions(bytes.NewReader(disk), int64(len(disk))))
+}
+
+func TestPartitions_Filesystem(t *testing.T) {
+	// NTFS boot sectors end with the signature of a boot record.
+	disk := make([]byte, 100*512)
+	copy(disk[3:], "NTFS    ")
+	putMBREntry(disk, 0, 0x07, 10, 10)
+	assert.Equal(t, []partition{{offset: 0, size: int64(len(disk))}}, partitions(bytes.NewReader(disk), int64(len(disk))))
+
+	unpartitioned := make([]byte, 100*512)
+	assert.Equal(t, []partition{{offset: 0, size: int64(len(unpartitioned))}}, partitions(bytes.NewReader(unpartitioned), int64(len(unpartitioned))))
+}
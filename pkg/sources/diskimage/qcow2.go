@@ -0,0 +1,139 @@
+package diskimage
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+)
+
+const (
+	qcow2Magic = "QFI\xfb"
+
+	qcow2OffsetMask = 0x00fffffffffffe00
+	qcow2Compressed = 1 << 62
+	qcow2ZeroFlag   = 1
+	// qcow2KnownFeatures are the incompatible features that don't change how
+	// clusters are read: the dirty and corrupt bits.
+	qcow2KnownFeatures = 0x3
+)
+
+type qcow2 struct {
+	r           io.ReaderAt
+	clusterBits uint64
+	l1          []uint64
+
+	mu sync.Mutex
+	// cachedCluster is the last decompressed cluster, since compressed
+	// clusters are read in small pieces.
+	cachedCluster int64
+	cached        []byte
+}
+
+// openQCOW2 returns a reader of the virtual disk of a QCOW2 image.
+func openQCOW2(r io.ReaderAt) (io.ReaderAt, int64, error) {
+	header := make([]byte, 72)
+	if err := readFull(r, header, 0); err != nil {
+		return nil, 0, err
+	}
+	version := binary.BigEndian.Uint32(header[4:])
+	if version != 2 && version != 3 {
+		return nil, 0, fmt.Errorf("unsupported QCOW2 version %d", version)
+	}
+	if binary.BigEndian.Uint64(header[8:]) != 0 {
+		return nil, 0, errors.New("QCOW2 images with a backing file are not supported")
+	}
+	if binary.BigEndian.Uint32(header[32:]) != 0 {
+		return nil, 0, errors.New("encrypted QCOW2 images are not supported")
+	}
+	if version == 3 {
+		features := make([]byte, 8)
+		if err := readFull(r, features, 72); err != nil {
+			return nil, 0, err
+		}
+		if binary.BigEndian.Uint64(features)&^qcow2KnownFeatures != 0 {
+			return nil, 0, errors.New("QCOW2 image uses unsupported features")
+		}
+	}
+
+	clusterBits := uint64(binary.BigEndian.Uint32(header[20:]))
+	if clusterBits < 9 || clusterBits > 21 {
+		return nil, 0, fmt.Errorf("invalid QCOW2 cluster size 2^%d", clusterBits)
+	}
+	size := int64(binary.BigEndian.Uint64(header[24:]))
+	l1Size := int64(binary.BigEndian.Uint32(header[36:]))
+	if l1Size > size>>(2*clusterBits-3)+1 {
+		return nil, 0, errors.New("invalid QCOW2 L1 table size")
+	}
+	l1Data := make([]byte, 8*l1Size)
+	if err := readFull(r, l1Data, int64(binary.BigEndian.Uint64(header[40:]))); err != nil {
+		return nil, 0, err
+	}
+
+	q := &qcow2{r: r, clusterBits: clusterBits, l1: make([]uint64, l1Size), cachedCluster: -1}
+	for i := range q.l1 {
+		q.l1[i] = binary.BigEndian.Uint64(l1Data[8*i:])
+	}
+	return &blockReader{blockSize: 1 << clusterBits, size: size, readBlock: q.readCluster}, size, nil
+}
+
+func (q *qcow2) readCluster(cluster int64, p []byte, off int64) error {
+	l2Entries := int64(1) << (q.clusterBits - 3)
+	l1Index := cluster / l2Entries
+	if l1Index >= int64(len(q.l1)) {
+		clear(p)
+		return nil
+	}
+	l2Offset := int64(q.l1[l1Index] & qcow2OffsetMask)
+	if l2Offset == 0 {
+		clear(p)
+		return nil
+	}
+	entryData := make([]byte, 8)
+	if err := readFull(q.r, entryData, l2Offset+8*(cluster%l2Entries)); err != nil {
+		return err
+	}
+	entry := binary.BigEndian.Uint64(entryData)
+
+	if entry&qcow2Compressed != 0 {
+		return q.readCompressed(cluster, entry, p, off)
+	}
+	hostOffset := int64(entry & qcow2OffsetMask)
+	if hostOffset == 0 || entry&qcow2ZeroFlag != 0 {
+		clear(p)
+		return nil
+	}
+	return readFull(q.r, p, hostOffset+off)
+}
+
+// readCompressed reads a cluster compressed with deflate.
+func (q *qcow2) readCompressed(cluster int64, entry uint64, p []byte, off int64) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.cachedCluster != cluster {
+		offsetBits := 62 - (q.clusterBits - 8)
+		hostOffset := int64(entry & (1<<offsetBits - 1))
+		sectors := int64(entry>>offsetBits&(1<<(q.clusterBits-8)-1)) + 1
+		compressed := make([]byte, sectors*512-hostOffset%512)
+		// The last compressed cluster can end before the last sector.
+		n, err := q.r.ReadAt(compressed, hostOffset)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+
+		data := make([]byte, 1<<q.clusterBits)
+		n, err = io.ReadFull(flate.NewReader(bytes.NewReader(compressed[:n])), data)
+		if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return err
+		}
+		clear(data[n:])
+		q.cached = data
+	}
+	q.cachedCluster = cluster
+	copy(p, q.cached[off:])
+	return nil
+}
@@ -0,0 +1,82 @@
+package diskimage
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+const (
+	vhdFooterSize = 512
+
+	vhdFixed        = 2
+	vhdDynamic      = 3
+	vhdDifferencing = 4
+
+	vhdUnallocated = 0xffffffff
+)
+
+// isVHD reports whether a file ends with a VHD footer.
+func isVHD(r io.ReaderAt, fileSize int64) bool {
+	if fileSize < vhdFooterSize {
+		return false
+	}
+	cookie := make([]byte, 8)
+	return readFull(r, cookie, fileSize-vhdFooterSize) == nil && string(cookie) == "conectix"
+}
+
+// openVHD returns a reader of the virtual disk of a fixed or dynamic VHD.
+func openVHD(r io.ReaderAt, fileSize int64) (io.ReaderAt, int64, error) {
+	footer := make([]byte, vhdFooterSize)
+	if err := readFull(r, footer, fileSize-vhdFooterSize); err != nil {
+		return nil, 0, err
+	}
+	size := int64(binary.BigEndian.Uint64(footer[48:]))
+
+	switch binary.BigEndian.Uint32(footer[60:]) {
+	case vhdFixed:
+		return io.NewSectionReader(r, 0, size), size, nil
+	case vhdDynamic:
+	case vhdDifferencing:
+		return nil, 0, errors.New("differencing VHD images are not supported")
+	default:
+		return nil, 0, errors.New("unknown VHD disk type")
+	}
+
+	header := make([]byte, 1024)
+	if err := readFull(r, header, int64(binary.BigEndian.Uint64(footer[16:]))); err != nil {
+		return nil, 0, err
+	}
+	if string(header[:8]) != "cxsparse" {
+		return nil, 0, errors.New("invalid VHD dynamic disk header")
+	}
+	tableOffset := int64(binary.BigEndian.Uint64(header[16:]))
+	entries := int64(binary.BigEndian.Uint32(header[28:]))
+	blockSize := int64(binary.BigEndian.Uint32(header[32:]))
+	if blockSize == 0 || blockSize%512 != 0 || entries > size/blockSize+1 {
+		return nil, 0, errors.New("invalid VHD block allocation table")
+	}
+	bat := make([]byte, 4*entries)
+	if err := readFull(r, bat, tableOffset); err != nil {
+		return nil, 0, err
+	}
+	// Each block starts with a bitmap of its sectors, padded to a sector.
+	bitmapSize := (blockSize/512/8 + 511) / 512 * 512
+
+	return &blockReader{
+		blockSize: blockSize,
+		size:      size,
+		readBlock: func(block int64, p []byte, off int64) error {
+			if block >= entries {
+				clear(p)
+				return nil
+			}
+			sector := binary.BigEndian.Uint32(bat[4*block:])
+			if sector == vhdUnallocated {
+				clear(p)
+				return nil
+			}
+			return readFull(r, p, int64(sector)*512+bitmapSize+off)
+		},
+	}, size, nil
+}
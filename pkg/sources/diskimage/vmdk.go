@@ -0,0 +1,243 @@
+package diskimage
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	vmdkMagic           = "KDMV"
+	vmdkDescriptorMagic = "# Disk DescriptorFile"
+
+	// vmdkGDAtEnd is the grain directory offset of stream optimized images,
+	// whose header is repeated in a footer once the grain directory is
+	// written.
+	vmdkGDAtEnd           = 0xffffffffffffffff
+	vmdkCompressedGrains  = 1 << 16
+	vmdkMaxDescriptorSize = 64 * 1024
+)
+
+type vmdkSparse struct {
+	r          io.ReaderAt
+	gd         []uint32
+	gtEntries  int64
+	grainSize  int64
+	compressed bool
+
+	mu sync.Mutex
+	// cachedGrain is the last decompressed grain, since compressed grains
+	// are read in small pieces.
+	cachedGrain int64
+	cached      []byte
+}
+
+// openVMDKSparse returns a reader of the virtual disk of a hosted sparse
+// extent, used by monolithic sparse, split sparse and stream optimized VMDK
+// images.
+func openVMDKSparse(r io.ReaderAt, fileSize int64) (io.ReaderAt, int64, error) {
+	header := make([]byte, 512)
+	if err := readFull(r, header, 0); err != nil {
+		return nil, 0, err
+	}
+	if binary.LittleEndian.Uint64(header[56:]) == vmdkGDAtEnd {
+		if err := readFull(r, header, fileSize-1024); err != nil {
+			return nil, 0, err
+		}
+		if string(header[:4]) != vmdkMagic {
+			return nil, 0, errors.New("invalid VMDK footer")
+		}
+	}
+	flags := binary.LittleEndian.Uint32(header[8:])
+	capacity := int64(binary.LittleEndian.Uint64(header[12:]))
+	grainSectors := int64(binary.LittleEndian.Uint64(header[20:]))
+	gtEntries := int64(binary.LittleEndian.Uint32(header[44:]))
+	gdOffset := int64(binary.LittleEndian.Uint64(header[56:]))
+	if grainSectors <= 0 || grainSectors > 1<<16 || gtEntries <= 0 || capacity < 0 {
+		return nil, 0, errors.New("invalid VMDK header")
+	}
+
+	grains := (capacity + grainSectors - 1) / grainSectors
+	gdData := make([]byte, 4*((grains+gtEntries-1)/gtEntries))
+	if err := readFull(r, gdData, gdOffset*512); err != nil {
+		return nil, 0, err
+	}
+	v := &vmdkSparse{
+		r:           r,
+		gd:          make([]uint32, len(gdData)/4),
+		gtEntries:   gtEntries,
+		grainSize:   grainSectors * 512,
+		compressed:  flags&vmdkCompressedGrains != 0,
+		cachedGrain: -1,
+	}
+	for i := range v.gd {
+		v.gd[i] = binary.LittleEndian.Uint32(gdData[4*i:])
+	}
+	size := capacity * 512
+	return &blockReader{blockSize: v.grainSize, size: size, readBlock: v.readGrain}, size, nil
+}
+
+func (v *vmdkSparse) readGrain(grain int64, p []byte, off int64) error {
+	gdIndex := grain / v.gtEntries
+	if gdIndex >= int64(len(v.gd)) || v.gd[gdIndex] == 0 {
+		clear(p)
+		return nil
+	}
+	entryData := make([]byte, 4)
+	if err := readFull(v.r, entryData, int64(v.gd[gdIndex])*512+4*(grain%v.gtEntries)); err != nil {
+		return err
+	}
+	// Grains at sector 0 are unallocated and at sector 1 are zeroed.
+	sector := int64(binary.LittleEndian.Uint32(entryData))
+	if sector <= 1 {
+		clear(p)
+		return nil
+	}
+	if !v.compressed {
+		return readFull(v.r, p, sector*512+off)
+	}
+	return v.readCompressed(grain, sector, p, off)
+}
+
+// readCompressed reads a grain compressed with zlib, which is preceded by its
+// sector number and its compressed size.
+func (v *vmdkSparse) readCompressed(grain, sector int64, p []byte, off int64) error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.cachedGrain != grain {
+		marker := make([]byte, 12)
+		if err := readFull(v.r, marker, sector*512); err != nil {
+			return err
+		}
+		compressed := make([]byte, binary.LittleEndian.Uint32(marker[8:]))
+		if int64(len(compressed)) > 2*v.grainSize {
+			return errors.New("invalid VMDK compressed grain size")
+		}
+		if err := readFull(v.r, compressed, sector*512+12); err != nil {
+			return err
+		}
+		zr, err := zlib.NewReader(bytes.NewReader(compressed))
+		if err != nil {
+			return err
+		}
+		data := make([]byte, v.grainSize)
+		n, err := io.ReadFull(zr, data)
+		if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return err
+		}
+		clear(data[n:])
+		v.cached = data
+	}
+	v.cachedGrain = grain
+	copy(p, v.cached[off:])
+	return nil
+}
+
+// openVMDKDescriptor opens the extents listed by a VMDK descriptor, such as
+// the flat extents of ESXi images or the split extents of VMware Workstation
+// images.
+func (d *disk) openVMDKDescriptor(path string, f *os.File, fileSize int64) error {
+	if fileSize > vmdkMaxDescriptorSize {
+		return errors.New("VMDK descriptor is too large")
+	}
+	descriptor := make([]byte, fileSize)
+	if err := readFull(f, descriptor, 0); err != nil {
+		return err
+	}
+
+	var extents concatReader
+	var start int64
+	scanner := bufio.NewScanner(bytes.NewReader(descriptor))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if parent, ok := strings.CutPrefix(line, "parentCID="); ok && parent != "ffffffff" {
+			return errors.New("VMDK images with a parent disk are not supported")
+		}
+		access, _, _ := strings.Cut(line, " ")
+		if access != "RW" && access != "RDONLY" && access != "NOACCESS" {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < 3 {
+			return fmt.Errorf("invalid VMDK extent %q", line)
+		}
+		sectors, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid VMDK extent %q", line)
+		}
+		e := extent{start: start, size: sectors * 512}
+		start += e.size
+
+		if fields[2] == "ZERO" {
+			e.ReaderAt = zeroReader{}
+			extents = append(extents, e)
+			continue
+		}
+		name, offset, err := vmdkExtentFile(line)
+		if err != nil {
+			return err
+		}
+		extentFile, err := os.Open(filepath.Join(filepath.Dir(path), name))
+		if err != nil {
+			return err
+		}
+		d.files = append(d.files, extentFile)
+
+		switch fields[2] {
+		case "FLAT", "VMFS":
+			e.ReaderAt = io.NewSectionReader(extentFile, offset*512, e.size)
+		case "SPARSE":
+			info, err := extentFile.Stat()
+			if err != nil {
+				return err
+			}
+			if e.ReaderAt, _, err = openVMDKSparse(extentFile, info.Size()); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unsupported VMDK extent type %s", fields[2])
+		}
+		extents = append(extents, e)
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	if len(extents) == 0 {
+		return errors.New("VMDK descriptor has no extents")
+	}
+	d.ReaderAt, d.size = extents, start
+	return nil
+}
+
+// vmdkExtentFile returns the quoted file name and the optional offset in
+// sectors of an extent line.
+func vmdkExtentFile(line string) (string, int64, error) {
+	_, rest, ok := strings.Cut(line, `"`)
+	if !ok {
+		return "", 0, fmt.Errorf("invalid VMDK extent %q", line)
+	}
+	name, rest, ok := strings.Cut(rest, `"`)
+	if !ok {
+		return "", 0, fmt.Errorf("invalid VMDK extent %q", line)
+	}
+	rest = strings.TrimSpace(rest)
+	if rest == "" {
+		return name, 0, nil
+	}
+	offset, err := strconv.ParseInt(rest, 10, 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid VMDK extent %q", line)
+	}
+	return name, offset, nil
+}
@@ -0,0 +1,330 @@
+package dynamodb
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/go-errors/errors"
+	"github.com/go-logr/logr"
+	"github.com/gobwas/glob"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const defaultRegion = "us-east-1"
+
+type Source struct {
+	name         string
+	sourceId     int64
+	jobId        int64
+	verify       bool
+	region       string
+	tables       []string
+	ignoreTables []glob.Glob
+	attributes   []string
+	segments     int64
+	maxItems     int64
+	httpClient   *http.Client
+	client       *dynamodb.DynamoDB
+	jobPool      *errgroup.Group
+	log          logr.Logger
+	sources.Progress
+}
+
+// Ensure the Source satisfies the interface at compile time.
+var _ sources.Source = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return sourcespb.SourceType_SOURCE_TYPE_DYNAMODB
+}
+
+func (s *Source) SourceID() int64 {
+	return s.sourceId
+}
+
+func (s *Source) JobID() int64 {
+	return s.jobId
+}
+
+// Init returns an initialized DynamoDB source.
+func (s *Source) Init(aCtx context.Context, name string, jobId, sourceId int64, verify bool, connection *anypb.Any, concurrency int) error {
+	s.log = context.WithValues(aCtx, "source", s.Type(), "name", name).Logger()
+
+	s.name = name
+	s.sourceId = sourceId
+	s.jobId = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	var conn sourcespb.DynamoDB
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return errors.WrapPrefix(err, "error unmarshalling connection", 0)
+	}
+
+	s.region = conn.GetRegion()
+	if s.region == "" {
+		s.region = defaultRegion
+	}
+	cfg := aws.NewConfig()
+	cfg.CredentialsChainVerboseErrors = aws.Bool(true)
+	cfg.Region = aws.String(s.region)
+	if conn.GetEndpoint() != "" {
+		cfg.Endpoint = aws.String(conn.GetEndpoint())
+	}
+	// The SDK retries requests itself, and needs the default transport to
+	// load custom CA bundles.
+	s.httpClient = &http.Client{Timeout: time.Minute}
+	cfg.HTTPClient = s.httpClient
+
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.DynamoDB_AccessKey:
+		cfg.Credentials = credentials.NewStaticCredentials(cred.AccessKey.Key, cred.AccessKey.Secret, "")
+	case *sourcespb.DynamoDB_CloudEnvironment:
+		// The default credential chain is used.
+	default:
+		return errors.Errorf("invalid configuration given for %s source", name)
+	}
+
+	sess, err := session.NewSessionWithOptions(session.Options{
+		SharedConfigState: session.SharedConfigEnable,
+		Config:            *cfg,
+	})
+	if err != nil {
+		return err
+	}
+	s.client = dynamodb.New(sess)
+
+	s.tables = conn.GetTables()
+	for _, pattern := range conn.GetIgnoreTables() {
+		g, err := glob.Compile(pattern)
+		if err != nil {
+			return fmt.Errorf("invalid table pattern %q: %w", pattern, err)
+		}
+		s.ignoreTables = append(s.ignoreTables, g)
+	}
+	s.attributes = conn.GetAttributes()
+	s.segments = conn.GetSegments()
+	if s.segments <= 0 {
+		s.segments = int64(concurrency)
+	}
+	s.maxItems = conn.GetMaxItems()
+
+	return nil
+}
+
+func (s *Source) ignored(table string) bool {
+	for _, g := range s.ignoreTables {
+		if g.Match(table) {
+			return true
+		}
+	}
+	return false
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) error {
+	tables := s.tables
+	if len(tables) == 0 {
+		err := s.client.ListTablesPagesWithContext(ctx, &dynamodb.ListTablesInput{}, func(page *dynamodb.ListTablesOutput, _ bool) bool {
+			tables = append(tables, aws.StringValueSlice(page.TableNames)...)
+			return true
+		})
+		if err != nil {
+			return fmt.Errorf("error listing tables: %w", err)
+		}
+	}
+
+	var scanned uint64
+	for i, table := range tables {
+		if common.IsDone(ctx) {
+			break
+		}
+		if s.ignored(table) {
+			continue
+		}
+		s.SetProgressComplete(i, len(tables), fmt.Sprintf("Table: %s", table), "")
+
+		if err := s.scanTable(ctx, table, &scanned, chunksChan); err != nil {
+			s.log.Error(err, "could not scan table", "table", table)
+		}
+	}
+
+	s.SetProgressComplete(len(tables), len(tables), fmt.Sprintf("Completed scanning source %s. %d items scanned.", s.name, scanned), "")
+	return nil
+}
+
+// scanTable scans a table with a parallel scan, one segment per job.
+func (s *Source) scanTable(ctx context.Context, table string, scanned *uint64, chunksChan chan *sources.Chunk) error {
+	desc, err := s.client.DescribeTableWithContext(ctx, &dynamodb.DescribeTableInput{TableName: aws.String(table)})
+	if err != nil {
+		return err
+	}
+	var key []string
+	for _, k := range desc.Table.KeySchema {
+		key = append(key, aws.StringValue(k.AttributeName))
+	}
+
+	input := &dynamodb.ScanInput{
+		TableName:     aws.String(table),
+		TotalSegments: aws.Int64(s.segments),
+	}
+	if len(s.attributes) > 0 {
+		// The key attributes are needed to report where findings are.
+		input.ProjectionExpression, input.ExpressionAttributeNames = projection(append(append([]string{}, key...), s.attributes...))
+	}
+
+	var items int64
+	for segment := int64(0); segment < s.segments; segment++ {
+		segmentInput := *input
+		segmentInput.Segment = aws.Int64(segment)
+		s.jobPool.Go(func() error {
+			err := s.client.ScanPagesWithContext(ctx, &segmentInput, func(page *dynamodb.ScanOutput, _ bool) bool {
+				for _, item := range page.Items {
+					if s.maxItems > 0 && atomic.AddInt64(&items, 1) > s.maxItems {
+						return false
+					}
+					if err := s.scanItem(ctx, table, key, item, chunksChan); err != nil {
+						return false
+					}
+					atomic.AddUint64(scanned, 1)
+				}
+				return true
+			})
+			if err != nil {
+				s.log.Error(err, "could not scan segment", "table", table, "segment", *segmentInput.Segment)
+			}
+			return nil
+		})
+	}
+	return s.jobPool.Wait()
+}
+
+// projection returns a projection expression of attributes, with names
+// substituted so that reserved words can be used.
+func projection(attributes []string) (*string, map[string]*string) {
+	seen := make(map[string]bool)
+	names := make(map[string]*string)
+	var exprs []string
+	for _, attr := range attributes {
+		if seen[attr] {
+			continue
+		}
+		seen[attr] = true
+		placeholder := "#a" + strconv.Itoa(len(exprs))
+		names[placeholder] = aws.String(attr)
+		exprs = append(exprs, placeholder)
+	}
+	return aws.String(strings.Join(exprs, ", ")), names
+}
+
+func (s *Source) scanItem(ctx context.Context, table string, key []string, item map[string]*dynamodb.AttributeValue, chunksChan chan *sources.Chunk) error {
+	data := render(item)
+	if len(data) == 0 {
+		return nil
+	}
+	chunk := &sources.Chunk{
+		SourceType: s.Type(),
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		Data:       data,
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Dynamodb{
+				Dynamodb: &source_metadatapb.DynamoDB{
+					Region: s.region,
+					Table:  sanitizer.UTF8(table),
+					Key:    sanitizer.UTF8(itemKey(key, item)),
+				},
+			},
+		},
+		Verify: s.verify,
+	}
+	for c := range sources.Chunker(chunk) {
+		select {
+		case chunksChan <- c:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// itemKey formats the primary key of an item, such as "pk=user#1,sk=profile".
+func itemKey(key []string, item map[string]*dynamodb.AttributeValue) string {
+	parts := make([]string, len(key))
+	for i, name := range key {
+		v := item[name]
+		value := ""
+		switch {
+		case v == nil:
+		case v.S != nil:
+			value = *v.S
+		case v.N != nil:
+			value = *v.N
+		case v.B != nil:
+			value = fmt.Sprintf("%x", v.B)
+		}
+		parts[i] = name + "=" + value
+	}
+	return strings.Join(parts, ",")
+}
+
+// render formats the string attributes of an item as "path = value" lines,
+// descending into maps and lists.
+func render(item map[string]*dynamodb.AttributeValue) []byte {
+	var b strings.Builder
+	var walk func(path string, v *dynamodb.AttributeValue)
+	walk = func(path string, v *dynamodb.AttributeValue) {
+		switch {
+		case v == nil:
+		case v.S != nil:
+			if *v.S != "" {
+				b.WriteString(path + " = " + *v.S + "\n")
+			}
+		case v.SS != nil:
+			for _, s := range v.SS {
+				b.WriteString(path + " = " + aws.StringValue(s) + "\n")
+			}
+		case v.M != nil:
+			for _, k := range sortedKeys(v.M) {
+				walk(path+"."+k, v.M[k])
+			}
+		case v.L != nil:
+			for i, e := range v.L {
+				walk(path+"."+strconv.Itoa(i), e)
+			}
+		}
+	}
+	for _, k := range sortedKeys(item) {
+		walk(k, item[k])
+	}
+	return []byte(b.String())
+}
+
+func sortedKeys(m map[string]*dynamodb.AttributeValue) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
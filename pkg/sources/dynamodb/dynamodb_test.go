@@ -0,0 +1,99 @@
+package dynamodb
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/anypb"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// bodyContains matches requests whose body contains every substring.
+func bodyContains(substrs ...string) func(*http.Request) bool {
+	return func(req *http.Request) bool {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			return false
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		for _, substr := range substrs {
+			if !strings.Contains(string(body), substr) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+func TestSource_Chunks(t *testing.T) {
+	defer gock.Off()
+
+	target := func(op string) string { return "DynamoDB_20120810." + op }
+	gock.New("https://dynamodb.eu-west-1.amazonaws.com").
+		Post("/").
+		MatchHeader("X-Amz-Target", target("ListTables")).
+		Reply(200).
+		JSON(map[string]interface{}{"TableNames": []string{"sessions", "tmp-cache"}})
+	gock.New("https://dynamodb.eu-west-1.amazonaws.com").
+		Post("/").
+		MatchHeader("X-Amz-Target", target("DescribeTable")).
+		Reply(200).
+		JSON(map[string]interface{}{"Table": map[string]interface{}{
+			"TableName": "sessions",
+			"KeySchema": []map[string]string{
+				{"AttributeName": "pk", "KeyType": "HASH"},
+				{"AttributeName": "sk", "KeyType": "RANGE"},
+			},
+		}})
+	gock.New("https://dynamodb.eu-west-1.amazonaws.com").
+		Post("/").
+		MatchHeader("X-Amz-Target", target("Scan")).
+		Filter(bodyContains(`"ProjectionExpression":"#a0, #a1, #a2"`, `"Segment":0`, `"TotalSegments":1`)).
+		Reply(200).
+		JSON(map[string]interface{}{"Items": []map[string]interface{}{{
+			"pk":    map[string]string{"S": "user#1"},
+			"sk":    map[string]string{"N": "7"},
+			"oauth": map[string]interface{}{"M": map[string]interface{}{"refresh": map[string]string{"S": "hunter2"}}},
+		}}})
+
+	s := &Source{}
+	a, err := anypb.New(&sourcespb.DynamoDB{
+		Credential:   &sourcespb.DynamoDB_AccessKey{AccessKey: &credentialspb.KeySecret{Key: "AKIAEXAMPLE", Secret: "secret"}},
+		Region:       "eu-west-1",
+		IgnoreTables: []string{"tmp-*"},
+		Attributes:   []string{"oauth"},
+		Segments:     1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Init(context.Background(), "test - dynamodb", 0, 0, false, a, 1); err != nil {
+		t.Fatal(err)
+	}
+	gock.InterceptClient(s.httpClient)
+
+	chunksCh := make(chan *sources.Chunk, 10)
+	assert.NoError(t, s.Chunks(context.Background(), chunksCh))
+	close(chunksCh)
+
+	var chunks []*sources.Chunk
+	for chunk := range chunksCh {
+		chunks = append(chunks, chunk)
+	}
+	if assert.Len(t, chunks, 1) {
+		meta := chunks[0].SourceMetadata.GetDynamodb()
+		assert.Equal(t, "sessions", meta.Table)
+		assert.Equal(t, "pk=user#1,sk=7", meta.Key)
+		assert.Equal(t, "oauth.refresh = hunter2\npk = user#1", strings.TrimSpace(string(chunks[0].Data)))
+	}
+	assert.True(t, gock.IsDone())
+}
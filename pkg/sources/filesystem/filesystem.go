@@ -13,12 +13,14 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/git"
 )
 
 const (
@@ -35,6 +37,15 @@ type Source struct {
 	verify   bool
 	paths    []string
 	log      *log.Entry
+	// concurrency is that of the scans of git repos.
+	concurrency int
+	gitHistory  bool
+	// followSymlinks scans the files and walks the directories symlinks
+	// point to.
+	followSymlinks bool
+	// useGitignore skips the files ignored by .gitignore files.
+	useGitignore bool
+	filter       *common.Filter
 	sources.Progress
 }
 
@@ -56,7 +67,7 @@ func (s *Source) JobID() int64 {
 }
 
 // Init returns an initialized Filesystem source.
-func (s *Source) Init(aCtx context.Context, name string, jobId, sourceId int64, verify bool, connection *anypb.Any, _ int) error {
+func (s *Source) Init(aCtx context.Context, name string, jobId, sourceId int64, verify bool, connection *anypb.Any, concurrency int) error {
 	s.log = log.WithField("source", s.Type()).WithField("name", name)
 
 	s.name = name
@@ -69,103 +80,370 @@ func (s *Source) Init(aCtx context.Context, name string, jobId, sourceId int64,
 		return errors.WrapPrefix(err, "error unmarshalling connection", 0)
 	}
 
-	s.paths = conn.Directories
+	s.paths = append(conn.Directories, conn.Paths...)
+	s.concurrency = concurrency
+	s.gitHistory = conn.ScanGitHistory
+	s.followSymlinks = conn.FollowSymlinks
+	s.useGitignore = conn.UseGitignore
 
 	return nil
 }
 
+// WithFilter sets the filter the paths of the scanned files must pass.
+func (s *Source) WithFilter(filter *common.Filter) {
+	s.filter = filter
+}
+
+// specialFileModes are the types of files that aren't scanned, since reading
+// them can block forever, like FIFOs, or never end, like devices.
+const specialFileModes = fs.ModeDevice | fs.ModeCharDevice | fs.ModeNamedPipe | fs.ModeSocket | fs.ModeIrregular
+
 // Chunks emits chunks of bytes over a channel.
 func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) error {
 	for i, path := range s.paths {
 		s.SetProgressComplete(i, len(s.paths), fmt.Sprintf("Path: %s", path), "")
 
-		cleanPath := filepath.Clean(path)
 		done := false
 		go func() {
 			<-ctx.Done()
 			done = true
 		}()
 
-		err := fs.WalkDir(os.DirFS(cleanPath), ".", func(relativePath string, d fs.DirEntry, err error) error {
+		paths := []string{filepath.Clean(path)}
+		if isGlob(path) {
+			var err error
+			paths, err = expandGlob(path)
 			if err != nil {
+				log.WithError(err).Warnf("unable to expand glob: %s", path)
+				continue
+			}
+			if len(paths) == 0 {
+				log.Warnf("no file matches glob: %s", path)
+			}
+		}
+		for _, path := range paths {
+			err := s.scanPath(ctx, path, chunksChan)
+			if err != nil && err != io.EOF {
+				return errors.New(err)
+			}
+			if done {
 				return nil
 			}
+		}
 
-			path := filepath.Join(cleanPath, relativePath)
+		if done {
+			return nil
+		}
 
-			fileStat, err := os.Stat(path)
-			if err != nil {
-				log.WithError(err).Warnf("unable to stat file: %s", path)
+	}
+	return nil
+}
+
+// scanPath scans a file, or the files of a directory.
+func (s *Source) scanPath(ctx context.Context, path string, chunksChan chan *sources.Chunk) error {
+	fileStat, err := os.Stat(path)
+	if err != nil {
+		log.WithError(err).Warnf("unable to stat file: %s", path)
+		return nil
+	}
+
+	switch {
+	case fileStat.IsDir():
+		// The real paths of the directories walked, so that following
+		// symlinks neither walks them again nor loops forever.
+		visited := map[string]bool{}
+		if realPath, err := filepath.EvalSymlinks(path); err == nil {
+			visited[realPath] = true
+		}
+		return s.scanDir(ctx, path, visited, chunksChan)
+	case fileStat.Mode().IsRegular():
+		return s.scanFile(ctx, path, chunksChan)
+	}
+	log.WithField("path", path).Debug("skipping special file")
+	return nil
+}
+
+// scanDir walks a directory and scans its files.
+func (s *Source) scanDir(ctx context.Context, dir string, visited map[string]bool, chunksChan chan *sources.Chunk) error {
+	ignore := &ignoreRules{}
+	return fs.WalkDir(os.DirFS(dir), ".", func(relativePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+
+		path := filepath.Join(dir, relativePath)
+
+		if s.useGitignore {
+			if relativePath != "." && ignore.ignored(relativePath, d.IsDir()) {
+				log.WithField("path", path).Trace("skipping ignored path")
+				if d.IsDir() {
+					return fs.SkipDir
+				}
 				return nil
 			}
-			if !fileStat.Mode().IsRegular() {
-				return nil
+			if d.IsDir() {
+				if err := ignore.load(path, relativePath); err != nil {
+					log.WithError(err).Warnf("unable to read .gitignore of: %s", path)
+				}
 			}
+		}
 
-			inputFile, err := os.Open(path)
-			if err != nil {
-				log.Warn(err)
-				return nil
-			}
-			defer inputFile.Close()
-			log.WithField("file_path", path).Trace("scanning file")
+		// Directories are matched with a trailing separator, so that
+		// rules like "node_modules/" skip them without walking them.
+		if d.IsDir() && relativePath != "." && s.filter.Excluded(path+string(filepath.Separator)) {
+			log.WithField("path", path).Debug("skipping excluded directory")
+			return fs.SkipDir
+		}
 
-			reReader, err := diskbufferreader.New(inputFile)
-			if err != nil {
-				log.WithError(err).Error("Could not create re-readable reader.")
+		// The objects of bare repos are compressed, so their history is
+		// scanned instead.
+		if d.IsDir() && git.IsBareRepo(path) {
+			if err := s.scanBareRepo(ctx, path, chunksChan); err != nil {
+				log.WithError(err).Warnf("unable to scan bare repo: %s", path)
 			}
-			defer reReader.Close()
-
-			chunkSkel := &sources.Chunk{
-				SourceType: s.Type(),
-				SourceName: s.name,
-				SourceID:   s.SourceID(),
-				SourceMetadata: &source_metadatapb.MetaData{
-					Data: &source_metadatapb.MetaData_Filesystem{
-						Filesystem: &source_metadatapb.Filesystem{
-							File: sanitizer.UTF8(path),
-						},
-					},
-				},
-				Verify: s.verify,
-			}
-			if handlers.HandleFile(ctx, reReader, chunkSkel, chunksChan) {
-				return nil
+			return fs.SkipDir
+		}
+		// The files of the worktree are scanned with the others.
+		if d.IsDir() && d.Name() == ".git" && s.gitHistory {
+			if err := s.scanGitHistory(ctx, filepath.Dir(path), chunksChan); err != nil {
+				log.WithError(err).Warnf("unable to scan git history: %s", path)
 			}
+			return fs.SkipDir
+		}
+
+		if d.Type()&fs.ModeSymlink != 0 {
+			return s.scanSymlink(ctx, path, visited, chunksChan)
+		}
+		if d.Type()&specialFileModes != 0 {
+			log.WithField("path", path).Debug("skipping special file")
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		return s.scanFile(ctx, path, chunksChan)
+	})
+}
+
+// scanSymlink scans the file or walks the directory a symlink points to, when
+// symlinks are followed.
+func (s *Source) scanSymlink(ctx context.Context, path string, visited map[string]bool, chunksChan chan *sources.Chunk) error {
+	if !s.followSymlinks {
+		log.WithField("path", path).Debug("skipping symlink")
+		return nil
+	}
+	target, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		log.WithError(err).Warnf("unable to resolve symlink: %s", path)
+		return nil
+	}
+	fileStat, err := os.Stat(target)
+	if err != nil {
+		log.WithError(err).Warnf("unable to stat file: %s", target)
+		return nil
+	}
+
+	switch {
+	case fileStat.IsDir():
+		if visited[target] || s.filter.Excluded(path+string(filepath.Separator)) {
+			log.WithField("path", path).Debug("skipping symlink to a walked or excluded directory")
+			return nil
+		}
+		visited[target] = true
+		return s.scanDir(ctx, path, visited, chunksChan)
+	case fileStat.Mode().IsRegular():
+		return s.scanFile(ctx, path, chunksChan)
+	}
+	log.WithField("path", path).Debug("skipping symlink to a special file")
+	return nil
+}
+
+// scanFile scans a regular file.
+func (s *Source) scanFile(ctx context.Context, path string, chunksChan chan *sources.Chunk) error {
+	if !s.filter.Pass(path) {
+		return nil
+	}
+
+	inputFile, err := os.Open(path)
+	if err != nil {
+		log.Warn(err)
+		return nil
+	}
+	defer inputFile.Close()
+	log.WithField("file_path", path).Trace("scanning file")
 
-			if err := reReader.Reset(); err != nil {
+	chunkSkel := &sources.Chunk{
+		SourceType: s.Type(),
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Filesystem{
+				Filesystem: &source_metadatapb.Filesystem{
+					File: sanitizer.UTF8(path),
+				},
+			},
+		},
+		Verify: s.verify,
+	}
+
+	// Large files are mapped in memory, which makes them re-readable without
+	// buffering them. Those that can't be read through their mapping anymore,
+	// such as when truncated during the scan, are streamed instead, from
+	// where the scan of their mapping stopped.
+	if data, unmap, err := sources.MapFile(inputFile); err == nil {
+		defer unmap()
+		scan := &mappedScan{}
+		err := s.scanMappedFile(ctx, data, chunkSkel, chunksChan, scan)
+		if !errors.Is(err, sources.ErrMappedFault) {
+			return err
+		}
+		log.WithField("file_path", path).WithError(err).Warn("could not read mapped file, streaming the rest of it")
+		if scan.chunking {
+			// The chunks of a file start every ChunkSize bytes.
+			if _, err := inputFile.Seek(int64(scan.sent)*sources.ChunkSize, io.SeekStart); err != nil {
 				return err
 			}
-			reReader.Stop()
-			data, err := io.ReadAll(reReader)
-			if err != nil {
-				return err
+			return sources.ChunkReader(ctx, inputFile, chunkSkel, chunksChan)
+		}
+		// The handlers can't resume where they stopped, but send the same
+		// chunks again, which are skipped.
+		if _, err := inputFile.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		if scan.sent > 0 {
+			out, wait := forwardChunks(ctx, scan.sent, chunksChan)
+			defer wait()
+			chunksChan = out
+		}
+	}
+
+	reReader, err := diskbufferreader.New(inputFile)
+	if err != nil {
+		log.WithError(err).Error("Could not create re-readable reader.")
+	}
+	defer reReader.Close()
+	if handlers.HandleFile(ctx, reReader, chunkSkel, chunksChan) {
+		return nil
+	}
+
+	if err := reReader.Reset(); err != nil {
+		return err
+	}
+	reReader.Stop()
+	// Large files are streamed rather than read in memory at once.
+	return sources.ChunkReader(ctx, reReader, chunkSkel, chunksChan)
+}
+
+// mappedScan is the progress of the scan of a mapped file, from which it
+// resumes when the mapping can't be read anymore.
+type mappedScan struct {
+	// chunking is whether the file is split in chunks by ChunkReader rather
+	// than scanned by the handlers.
+	chunking bool
+	// sent is the number of chunks sent so far.
+	sent int
+}
+
+// scanMappedFile scans the data of a mapped file. It returns
+// sources.ErrMappedFault if the data couldn't be read entirely, with the
+// progress of the scan in scan.
+func (s *Source) scanMappedFile(ctx context.Context, data []byte, chunkSkel *sources.Chunk, chunksChan chan *sources.Chunk, scan *mappedScan) error {
+	reader := sources.NewMappedReader(data)
+	out, wait := forwardChunks(ctx, 0, chunksChan)
+	handled := handlers.HandleFile(ctx, reader, chunkSkel, out)
+	scan.sent = wait()
+	// The handlers log the errors of their reads rather than returning them.
+	if reader.Faulted() {
+		return sources.ErrMappedFault
+	}
+	if handled {
+		return nil
+	}
+	if _, err := reader.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	scan.chunking = true
+	out, wait = forwardChunks(ctx, 0, chunksChan)
+	err := sources.ChunkReader(ctx, reader, chunkSkel, out)
+	scan.sent = wait()
+	return err
+}
+
+// forwardChunks returns a channel whose chunks are forwarded to chunksChan,
+// but for the first skip ones. wait closes the channel and returns the number
+// of chunks sent to it once they are forwarded.
+func forwardChunks(ctx context.Context, skip int, chunksChan chan *sources.Chunk) (out chan *sources.Chunk, wait func() int) {
+	out = make(chan *sources.Chunk)
+	sent := make(chan int)
+	go func() {
+		var n int
+		for chunk := range out {
+			n++
+			if n <= skip {
+				continue
 			}
-			chunksChan <- &sources.Chunk{
-				SourceType: s.Type(),
-				SourceName: s.name,
-				SourceID:   s.SourceID(),
-				Data:       data,
-				SourceMetadata: &source_metadatapb.MetaData{
-					Data: &source_metadatapb.MetaData_Filesystem{
-						Filesystem: &source_metadatapb.Filesystem{
-							File: sanitizer.UTF8(path),
-						},
-					},
-				},
-				Verify: s.verify,
+			select {
+			case chunksChan <- chunk:
+			case <-ctx.Done():
 			}
-			return nil
-		})
-
-		if err != nil && err != io.EOF {
-			return errors.New(err)
 		}
+		sent <- n
+	}()
+	return out, func() int {
+		close(out)
+		return <-sent
+	}
+}
 
-		if done {
-			return nil
-		}
+// scanBareRepo scans the history of a bare repo.
+func (s *Source) scanBareRepo(ctx context.Context, path string, chunksChan chan *sources.Chunk) error {
+	repo, err := git.RepoFromPath(path)
+	if err != nil {
+		return err
+	}
+	log.WithField("repo", path).Debug("scanning bare repo")
+	return s.gitSource(path).ScanRepo(ctx, repo, path, s.gitScanOptions(), chunksChan)
+}
 
+// scanGitHistory scans the commits of the repo of a worktree, but not its
+// unstaged changes, which are scanned as files of the worktree.
+func (s *Source) scanGitHistory(ctx context.Context, path string, chunksChan chan *sources.Chunk) error {
+	repo, err := git.RepoFromPath(path)
+	if err != nil {
+		return err
 	}
-	return nil
+	log.WithField("repo", path).Debug("scanning git history")
+	return s.gitSource(path).ScanCommits(ctx, repo, path, s.gitScanOptions(), chunksChan)
+}
+
+// gitScanOptions returns the options of the scans of git repos, whose files
+// must pass the filter of the source.
+func (s *Source) gitScanOptions() *git.ScanOptions {
+	if s.filter == nil {
+		return git.NewScanOptions()
+	}
+	return git.NewScanOptions(git.ScanOptionFilter(s.filter))
+}
+
+// gitSource returns a git source whose results are attributed to the path of
+// the repo when it has no origin remote.
+func (s *Source) gitSource(path string) *git.Git {
+	return git.NewGit(s.Type(), s.jobId, s.sourceId, s.name, s.verify, s.concurrency,
+		func(file, email, commit, timestamp, repository string, line int64) *source_metadatapb.MetaData {
+			if repository == "" {
+				repository = path
+			}
+			return &source_metadatapb.MetaData{
+				Data: &source_metadatapb.MetaData_Git{
+					Git: &source_metadatapb.Git{
+						Commit:     sanitizer.UTF8(commit),
+						File:       sanitizer.UTF8(file),
+						Email:      sanitizer.UTF8(email),
+						Repository: sanitizer.UTF8(repository),
+						Timestamp:  sanitizer.UTF8(timestamp),
+						Line:       line,
+					},
+				},
+			}
+		})
 }
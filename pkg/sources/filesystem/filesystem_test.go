@@ -1,13 +1,20 @@
 package filesystem
 
 import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/kylelemons/godebug/pretty"
 	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
 	"google.golang.org/protobuf/types/known/anypb"
 
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
@@ -81,3 +88,261 @@ func TestSource_Scan(t *testing.T) {
 		})
 	}
 }
+
+func TestSource_BareRepo(t *testing.T) {
+	dir := t.TempDir()
+	work := filepath.Join(dir, "work")
+	run := func(args ...string) {
+		cmd := exec.Command("git", append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+		out, err := cmd.CombinedOutput()
+		assert.NoError(t, err, string(out))
+	}
+	run("init", "-q", work)
+	assert.NoError(t, os.WriteFile(filepath.Join(work, "config.txt"), []byte("token=abc123\n"), 0644))
+	run("-C", work, "add", "config.txt")
+	run("-C", work, "commit", "-q", "-m", "config")
+	// The bare repo is the only thing in the scanned directory.
+	run("clone", "-q", "--bare", work, filepath.Join(dir, "repos", "foo.git"))
+
+	conn, err := anypb.New(&sourcespb.Filesystem{Directories: []string{filepath.Join(dir, "repos")}})
+	assert.NoError(t, err)
+	s := Source{}
+	assert.NoError(t, s.Init(context.Background(), "test", 0, 0, false, conn, 1))
+
+	chunksCh := make(chan *sources.Chunk, 16)
+	assert.NoError(t, s.Chunks(context.Background(), chunksCh))
+	close(chunksCh)
+
+	var chunks []*sources.Chunk
+	for chunk := range chunksCh {
+		chunks = append(chunks, chunk)
+	}
+	assert.Len(t, chunks, 1)
+	assert.Equal(t, "config.txt", chunks[0].SourceMetadata.GetGit().GetFile())
+	assert.Contains(t, string(chunks[0].Data), "token=abc123")
+}
+
+func TestSource_GitHistory(t *testing.T) {
+	dir := t.TempDir()
+	run := func(args ...string) {
+		cmd := exec.Command("git", append([]string{"-C", dir, "-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+		out, err := cmd.CombinedOutput()
+		assert.NoError(t, err, string(out))
+	}
+	run("init", "-q")
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "removed.txt"), []byte("token=abc123\n"), 0644))
+	run("add", "removed.txt")
+	run("commit", "-q", "-m", "add")
+	run("rm", "-q", "removed.txt")
+	run("commit", "-q", "-m", "remove")
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "readme.txt"), []byte("hello\n"), 0644))
+
+	conn, err := anypb.New(&sourcespb.Filesystem{Directories: []string{dir}, ScanGitHistory: true})
+	assert.NoError(t, err)
+	s := Source{}
+	assert.NoError(t, s.Init(context.Background(), "test", 0, 0, false, conn, 1))
+
+	chunksCh := make(chan *sources.Chunk, 16)
+	assert.NoError(t, s.Chunks(context.Background(), chunksCh))
+	close(chunksCh)
+
+	var files, commits []string
+	for chunk := range chunksCh {
+		if git := chunk.SourceMetadata.GetGit(); git != nil {
+			assert.Equal(t, dir, git.Repository)
+			commits = append(commits, git.File)
+		} else {
+			files = append(files, chunk.SourceMetadata.GetFilesystem().GetFile())
+		}
+	}
+	// The files of the .git directory aren't scanned.
+	assert.Equal(t, []string{filepath.Join(dir, "readme.txt")}, files)
+	assert.Equal(t, []string{"removed.txt"}, commits)
+}
+
+func TestSource_Filter(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"src/main.go", "src/notes.txt", "src/build.log", "node_modules/dep/index.go"} {
+		path := filepath.Join(dir, name)
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		assert.NoError(t, os.WriteFile(path, []byte("token=abc123\n"), 0644))
+	}
+	include := filepath.Join(t.TempDir(), "include.txt")
+	assert.NoError(t, os.WriteFile(include, []byte("\\.go$\n\\.txt$\n"), 0644))
+	exclude := filepath.Join(t.TempDir(), "exclude.txt")
+	assert.NoError(t, os.WriteFile(exclude, []byte("# dependencies\nnode_modules/$\nnotes\\.txt$\n"), 0644))
+	filter, err := common.FilterFromFiles(include, exclude)
+	assert.NoError(t, err)
+
+	conn, err := anypb.New(&sourcespb.Filesystem{Directories: []string{dir}})
+	assert.NoError(t, err)
+	s := Source{}
+	assert.NoError(t, s.Init(context.Background(), "test", 0, 0, false, conn, 1))
+	s.WithFilter(filter)
+
+	chunksCh := make(chan *sources.Chunk, 16)
+	assert.NoError(t, s.Chunks(context.Background(), chunksCh))
+	close(chunksCh)
+
+	var files []string
+	for chunk := range chunksCh {
+		files = append(files, chunk.SourceMetadata.GetFilesystem().GetFile())
+	}
+	assert.Equal(t, []string{filepath.Join(dir, "src", "main.go")}, files)
+}
+
+func TestSource_Symlinks(t *testing.T) {
+	dir := t.TempDir()
+	outside := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "config.txt"), []byte("token=abc123\n"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(outside, "linked.txt"), []byte("token=abc123\n"), 0644))
+	assert.NoError(t, os.Symlink(filepath.Join(outside, "linked.txt"), filepath.Join(dir, "file-link")))
+	assert.NoError(t, os.Symlink(outside, filepath.Join(dir, "dir-link")))
+	// Following the cycle must not walk dir again.
+	assert.NoError(t, os.Symlink(dir, filepath.Join(outside, "loop")))
+	// Reading a FIFO without a writer blocks forever.
+	if err := exec.Command("mkfifo", filepath.Join(dir, "pipe")).Run(); err != nil {
+		t.Log("could not create FIFO:", err)
+	}
+
+	scan := func(follow bool) []string {
+		conn, err := anypb.New(&sourcespb.Filesystem{Directories: []string{dir}, FollowSymlinks: follow})
+		assert.NoError(t, err)
+		s := Source{}
+		assert.NoError(t, s.Init(context.Background(), "test", 0, 0, false, conn, 1))
+
+		chunksCh := make(chan *sources.Chunk, 16)
+		assert.NoError(t, s.Chunks(context.Background(), chunksCh))
+		close(chunksCh)
+
+		var files []string
+		for chunk := range chunksCh {
+			files = append(files, chunk.SourceMetadata.GetFilesystem().GetFile())
+		}
+		return files
+	}
+
+	assert.Equal(t, []string{filepath.Join(dir, "config.txt")}, scan(false))
+	assert.ElementsMatch(t, []string{
+		filepath.Join(dir, "config.txt"),
+		filepath.Join(dir, "dir-link", "linked.txt"),
+		filepath.Join(dir, "file-link"),
+	}, scan(true))
+}
+
+func TestSource_UseGitignore(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		".gitignore":       "# outputs\nbuild/\n*.log\n!keep.log\n",
+		"sub/.gitignore":   "secret.txt\n",
+		"build/out.txt":    "token=abc123\n",
+		"app.log":          "token=abc123\n",
+		"keep.log":         "token=abc123\n",
+		"main.txt":         "token=abc123\n",
+		"sub/secret.txt":   "token=abc123\n",
+		"sub/other.txt":    "token=abc123\n",
+		"other/secret.txt": "token=abc123\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		assert.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+
+	conn, err := anypb.New(&sourcespb.Filesystem{Directories: []string{dir}, UseGitignore: true})
+	assert.NoError(t, err)
+	s := Source{}
+	assert.NoError(t, s.Init(context.Background(), "test", 0, 0, false, conn, 1))
+
+	chunksCh := make(chan *sources.Chunk, 16)
+	assert.NoError(t, s.Chunks(context.Background(), chunksCh))
+	close(chunksCh)
+
+	var scanned []string
+	for chunk := range chunksCh {
+		rel, err := filepath.Rel(dir, chunk.SourceMetadata.GetFilesystem().GetFile())
+		assert.NoError(t, err)
+		scanned = append(scanned, filepath.ToSlash(rel))
+	}
+	assert.ElementsMatch(t, []string{".gitignore", "keep.log", "main.txt", "other/secret.txt", "sub/.gitignore", "sub/other.txt"}, scanned)
+}
+
+func TestSource_scanFile_truncated(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "large.txt")
+	var data bytes.Buffer
+	for i := 0; data.Len() < 2*sources.MmapThreshold; i++ {
+		fmt.Fprintf(&data, "%015d\n", i)
+	}
+	assert.NoError(t, os.WriteFile(path, data.Bytes(), 0644))
+	f, err := os.Open(path)
+	assert.NoError(t, err)
+	_, unmap, err := sources.MapFile(f)
+	f.Close()
+	if err != nil {
+		t.Skip(err)
+	}
+	assert.NoError(t, unmap())
+
+	conn, err := anypb.New(&sourcespb.Filesystem{Paths: []string{path}})
+	assert.NoError(t, err)
+	s := Source{}
+	assert.NoError(t, s.Init(context.Background(), "test", 0, 0, false, conn, 1))
+
+	chunksCh := make(chan *sources.Chunk)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- s.scanFile(context.Background(), path, chunksCh)
+		close(chunksCh)
+	}()
+
+	// The file is truncated while its mapping is read, and the rest of it is
+	// streamed without sending the chunks already sent again.
+	size := sources.MmapThreshold / 2
+	var scanned, last []byte
+	for chunk := range chunksCh {
+		if len(scanned) == 5*sources.ChunkSize {
+			assert.NoError(t, os.Truncate(path, int64(size)))
+		}
+		// The chunks overlap with the next ones but for the last one.
+		if len(last) > sources.ChunkSize {
+			last = last[:sources.ChunkSize]
+		}
+		scanned = append(scanned, last...)
+		last = chunk.Data
+	}
+	scanned = append(scanned, last...)
+	assert.NoError(t, <-errCh)
+	assert.Equal(t, size, len(scanned))
+	assert.True(t, bytes.Equal(data.Bytes()[:size], scanned), "the scanned data differs from the file")
+}
+
+func TestSource_Paths(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"single.txt", "etc/app.conf", "etc/nginx/site.conf", "etc/readme.txt"} {
+		path := filepath.Join(dir, name)
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		assert.NoError(t, os.WriteFile(path, []byte("token=abc123\n"), 0644))
+	}
+
+	conn, err := anypb.New(&sourcespb.Filesystem{Paths: []string{
+		filepath.Join(dir, "single.txt"),
+		filepath.Join(dir, "etc", "**", "*.conf"),
+	}})
+	assert.NoError(t, err)
+	s := Source{}
+	assert.NoError(t, s.Init(context.Background(), "test", 0, 0, false, conn, 1))
+
+	chunksCh := make(chan *sources.Chunk, 16)
+	assert.NoError(t, s.Chunks(context.Background(), chunksCh))
+	close(chunksCh)
+
+	var files []string
+	for chunk := range chunksCh {
+		files = append(files, chunk.SourceMetadata.GetFilesystem().GetFile())
+	}
+	assert.ElementsMatch(t, []string{
+		filepath.Join(dir, "single.txt"),
+		filepath.Join(dir, "etc", "app.conf"),
+		filepath.Join(dir, "etc", "nginx", "site.conf"),
+	}, files)
+}
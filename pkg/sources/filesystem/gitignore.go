@@ -0,0 +1,58 @@
+package filesystem
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/format/gitignore"
+)
+
+// ignoreRules are the patterns of the .gitignore files of the directories
+// walked. Patterns only match paths under their directory, so the patterns of
+// every directory walked so far can be matched together.
+type ignoreRules struct {
+	patterns []gitignore.Pattern
+}
+
+// load reads the .gitignore file of a directory, whose path relative to the
+// walked directory is relativePath.
+func (r *ignoreRules) load(dir, relativePath string) error {
+	file, err := os.Open(filepath.Join(dir, ".gitignore"))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	domain := splitPath(relativePath)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "#") || strings.TrimSpace(line) == "" {
+			continue
+		}
+		r.patterns = append(r.patterns, gitignore.ParsePattern(line, domain))
+	}
+	return scanner.Err()
+}
+
+// ignored reports whether the path relative to the walked directory is
+// ignored.
+func (r *ignoreRules) ignored(relativePath string, isDir bool) bool {
+	if len(r.patterns) == 0 {
+		return false
+	}
+	return gitignore.NewMatcher(r.patterns).Match(splitPath(relativePath), isDir)
+}
+
+// splitPath splits a slash separated path relative to the walked directory.
+func splitPath(relativePath string) []string {
+	if relativePath == "." {
+		return nil
+	}
+	return strings.Split(relativePath, "/")
+}
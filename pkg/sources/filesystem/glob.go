@@ -0,0 +1,89 @@
+package filesystem
+
+import (
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// isGlob reports whether the path is a shell-style glob.
+func isGlob(p string) bool {
+	return strings.ContainsAny(p, "*?[")
+}
+
+// expandGlob returns the files and directories matching a shell-style glob,
+// whose "**" segments match any number of directories.
+func expandGlob(pattern string) ([]string, error) {
+	segments := strings.Split(filepath.ToSlash(filepath.Clean(pattern)), "/")
+	for _, segment := range segments {
+		if _, err := path.Match(segment, ""); err != nil {
+			return nil, err
+		}
+	}
+
+	// The directory walked is the part of the glob before its first pattern.
+	i := 0
+	for i < len(segments) && !isGlob(segments[i]) {
+		i++
+	}
+	root := filepath.FromSlash(strings.Join(segments[:i], "/"))
+	switch {
+	case i == 1 && segments[0] == "":
+		root = string(filepath.Separator)
+	case root == "":
+		root = "."
+	}
+	rest := segments[i:]
+	recursive := false
+	for _, segment := range rest {
+		recursive = recursive || segment == "**"
+	}
+
+	var matches []string
+	err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || p == root {
+			return nil
+		}
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return nil
+		}
+		name := strings.Split(filepath.ToSlash(rel), "/")
+		if matchSegments(rest, name) {
+			matches = append(matches, p)
+			// The files of matched directories are scanned with them.
+			if d.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() && !recursive && len(name) >= len(rest) {
+			return fs.SkipDir
+		}
+		return nil
+	})
+	return matches, err
+}
+
+// matchSegments reports whether the segments of a path match those of a glob.
+func matchSegments(pattern, name []string) bool {
+	if len(pattern) == 0 {
+		return len(name) == 0
+	}
+	if pattern[0] == "**" {
+		for i := 0; i <= len(name); i++ {
+			if matchSegments(pattern[1:], name[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(name) == 0 {
+		return false
+	}
+	if ok, _ := path.Match(pattern[0], name[0]); !ok {
+		return false
+	}
+	return matchSegments(pattern[1:], name[1:])
+}
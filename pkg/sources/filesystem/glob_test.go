@@ -0,0 +1,57 @@
+package filesystem
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchSegments(t *testing.T) {
+	tests := []struct {
+		pattern string
+		name    string
+		want    bool
+	}{
+		{"*.conf", "app.conf", true},
+		{"*.conf", "sub/app.conf", false},
+		{"**/*.conf", "app.conf", true},
+		{"**/*.conf", "a/b/app.conf", true},
+		{"a/**/b", "a/b", true},
+		{"a/**/b", "a/x/y/b", true},
+		{"a/**/b", "a/x/y/c", false},
+		{"**", "a/b", true},
+		{"conf.?", "conf.d", true},
+	}
+	for _, tt := range tests {
+		got := matchSegments(strings.Split(tt.pattern, "/"), strings.Split(tt.name, "/"))
+		assert.Equal(t, tt.want, got, "%s matching %s", tt.pattern, tt.name)
+	}
+}
+
+func TestExpandGlob(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"app.conf", "nginx/site.conf", "nginx/conf.d/extra.conf", "nginx/readme.txt", "ssl.d/key.pem"} {
+		path := filepath.Join(dir, name)
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		assert.NoError(t, os.WriteFile(path, nil, 0644))
+	}
+
+	got, err := expandGlob(filepath.Join(dir, "**", "*.conf"))
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{
+		filepath.Join(dir, "app.conf"),
+		filepath.Join(dir, "nginx", "site.conf"),
+		filepath.Join(dir, "nginx", "conf.d", "extra.conf"),
+	}, got)
+
+	// Matched directories are scanned as a whole.
+	got, err = expandGlob(filepath.Join(dir, "*.d"))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "ssl.d")}, got)
+
+	_, err = expandGlob(filepath.Join(dir, "[a"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,343 @@
+package gcplogging
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/go-logr/logr"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const (
+	defaultEndpoint = "https://logging.googleapis.com"
+	scope           = "https://www.googleapis.com/auth/logging.read"
+	pageSize        = 1000
+)
+
+type Source struct {
+	name       string
+	sourceId   int64
+	jobId      int64
+	verify     bool
+	endpoint   string
+	resources  []string
+	filter     string
+	maxEntries int64
+	client     *http.Client
+	// tokens is created on first use when using the application default
+	// credentials, which may query the metadata server.
+	tokens   oauth2.TokenSource
+	tokensMu sync.Mutex
+	jobPool  *errgroup.Group
+	log      logr.Logger
+	sources.Progress
+}
+
+// Ensure the Source satisfies the interface at compile time.
+var _ sources.Source = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return sourcespb.SourceType_SOURCE_TYPE_GCP_LOGGING
+}
+
+func (s *Source) SourceID() int64 {
+	return s.sourceId
+}
+
+func (s *Source) JobID() int64 {
+	return s.jobId
+}
+
+// Init returns an initialized GCP Cloud Logging source.
+func (s *Source) Init(aCtx context.Context, name string, jobId, sourceId int64, verify bool, connection *anypb.Any, concurrency int) error {
+	s.log = context.WithValues(aCtx, "source", s.Type(), "name", name).Logger()
+
+	s.name = name
+	s.sourceId = sourceId
+	s.jobId = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	var conn sourcespb.GCPLogging
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return errors.WrapPrefix(err, "error unmarshalling connection", 0)
+	}
+
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.GCPLogging_JsonSa:
+		creds, err := google.CredentialsFromJSON(aCtx, []byte(cred.JsonSa), scope)
+		if err != nil {
+			return errors.WrapPrefix(err, "could not parse service account", 0)
+		}
+		s.tokens = creds.TokenSource
+	case *sourcespb.GCPLogging_CloudEnvironment:
+	default:
+		return errors.Errorf("invalid configuration given for %s source", name)
+	}
+
+	if len(conn.GetResources()) == 0 {
+		return errors.New("at least one project is required")
+	}
+	for _, resource := range conn.GetResources() {
+		if !strings.Contains(resource, "/") {
+			resource = "projects/" + resource
+		}
+		s.resources = append(s.resources, resource)
+	}
+
+	var since, until time.Time
+	if conn.GetSince() != nil {
+		since = conn.GetSince().AsTime()
+	}
+	if conn.GetUntil() != nil {
+		until = conn.GetUntil().AsTime()
+	}
+	s.filter = buildFilter(conn.GetFilter(), since, until)
+	s.maxEntries = conn.GetMaxEntries()
+	s.endpoint = strings.TrimSuffix(conn.GetEndpoint(), "/")
+	if s.endpoint == "" {
+		s.endpoint = defaultEndpoint
+	}
+	s.client = common.RetryableHttpClientTimeout(60)
+
+	return nil
+}
+
+// buildFilter combines the user's logging query with the time range.
+func buildFilter(filter string, since, until time.Time) string {
+	var terms []string
+	if filter != "" {
+		terms = append(terms, "("+filter+")")
+	}
+	if !since.IsZero() {
+		terms = append(terms, fmt.Sprintf("timestamp >= %q", since.UTC().Format(time.RFC3339)))
+	}
+	if !until.IsZero() {
+		terms = append(terms, fmt.Sprintf("timestamp < %q", until.UTC().Format(time.RFC3339)))
+	}
+	return strings.Join(terms, " AND ")
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) error {
+	var scanned uint64
+	for i, resource := range s.resources {
+		if common.IsDone(ctx) {
+			break
+		}
+		s.SetProgressComplete(i, len(s.resources), fmt.Sprintf("Resource: %s", resource), "")
+
+		resource := resource
+		s.jobPool.Go(func() error {
+			n, err := s.scanResource(ctx, resource, chunksChan)
+			atomic.AddUint64(&scanned, n)
+			if err != nil {
+				s.log.Error(err, "could not scan log entries", "resource", resource)
+			}
+			return nil
+		})
+	}
+	_ = s.jobPool.Wait()
+
+	s.SetProgressComplete(len(s.resources), len(s.resources), fmt.Sprintf("Completed scanning source %s. %d log entries scanned.", s.name, scanned), "")
+	return nil
+}
+
+type entry struct {
+	LogName   string `json:"logName"`
+	InsertID  string `json:"insertId"`
+	Timestamp string `json:"timestamp"`
+	Severity  string `json:"severity"`
+	Resource  struct {
+		Type string `json:"type"`
+	} `json:"resource"`
+	TextPayload  string            `json:"textPayload"`
+	JSONPayload  json.RawMessage   `json:"jsonPayload"`
+	ProtoPayload json.RawMessage   `json:"protoPayload"`
+	Labels       map[string]string `json:"labels"`
+	HTTPRequest  *struct {
+		RequestURL string `json:"requestUrl"`
+		Referer    string `json:"referer"`
+	} `json:"httpRequest"`
+}
+
+// scanResource pages through the log entries of a resource, oldest first.
+func (s *Source) scanResource(ctx context.Context, resource string, chunksChan chan *sources.Chunk) (uint64, error) {
+	var scanned uint64
+	pageToken := ""
+	for {
+		if common.IsDone(ctx) {
+			return scanned, ctx.Err()
+		}
+		size := int64(pageSize)
+		if s.maxEntries > 0 && s.maxEntries-int64(scanned) < size {
+			size = s.maxEntries - int64(scanned)
+		}
+		if size <= 0 {
+			return scanned, nil
+		}
+
+		req := map[string]any{
+			"resourceNames": []string{resource},
+			"orderBy":       "timestamp asc",
+			"pageSize":      size,
+		}
+		if s.filter != "" {
+			req["filter"] = s.filter
+		}
+		if pageToken != "" {
+			req["pageToken"] = pageToken
+		}
+		var res struct {
+			Entries       []entry `json:"entries"`
+			NextPageToken string  `json:"nextPageToken"`
+		}
+		if err := s.post(ctx, "/v2/entries:list", req, &res); err != nil {
+			return scanned, err
+		}
+
+		for _, e := range res.Entries {
+			if err := s.scanEntry(ctx, resource, e, chunksChan); err != nil {
+				return scanned, err
+			}
+			scanned++
+		}
+		if res.NextPageToken == "" {
+			return scanned, nil
+		}
+		pageToken = res.NextPageToken
+	}
+}
+
+func (s *Source) post(ctx context.Context, path string, body, out any) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint+path, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if err := s.authorize(ctx, req); err != nil {
+		return errors.WrapPrefix(err, "could not get access token", 0)
+	}
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("unexpected status code %d for %s: %s", res.StatusCode, path, bytes.TrimSpace(msg))
+	}
+	return json.NewDecoder(res.Body).Decode(out)
+}
+
+// authorize sets the access token of the service account, or of the
+// application default credentials.
+func (s *Source) authorize(ctx context.Context, req *http.Request) error {
+	s.tokensMu.Lock()
+	defer s.tokensMu.Unlock()
+	if s.tokens == nil {
+		ts, err := google.DefaultTokenSource(ctx, scope)
+		if err != nil {
+			return err
+		}
+		s.tokens = ts
+	}
+	token, err := s.tokens.Token()
+	if err != nil {
+		return err
+	}
+	token.SetAuthHeader(req)
+	return nil
+}
+
+func (s *Source) scanEntry(ctx context.Context, resource string, e entry, chunksChan chan *sources.Chunk) error {
+	chunk := &sources.Chunk{
+		SourceType: s.Type(),
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		Data:       render(e),
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_GcpLogging{
+				GcpLogging: &source_metadatapb.GCPLogging{
+					Resource:     resource,
+					LogName:      sanitizer.UTF8(e.LogName),
+					InsertId:     sanitizer.UTF8(e.InsertID),
+					Timestamp:    e.Timestamp,
+					Severity:     e.Severity,
+					ResourceType: e.Resource.Type,
+				},
+			},
+		},
+		Verify: s.verify,
+	}
+	for c := range sources.Chunker(chunk) {
+		select {
+		case chunksChan <- c:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// render formats the payload of an entry followed by the URLs of its HTTP
+// request and its labels as "name = value" lines.
+func render(e entry) []byte {
+	var b strings.Builder
+	switch {
+	case e.TextPayload != "":
+		b.WriteString(e.TextPayload)
+	case len(e.JSONPayload) > 0:
+		b.Write(e.JSONPayload)
+	case len(e.ProtoPayload) > 0:
+		b.Write(e.ProtoPayload)
+	}
+	b.WriteString("\n")
+
+	if e.HTTPRequest != nil {
+		if e.HTTPRequest.RequestURL != "" {
+			b.WriteString("httpRequest.requestUrl = " + e.HTTPRequest.RequestURL + "\n")
+		}
+		if e.HTTPRequest.Referer != "" {
+			b.WriteString("httpRequest.referer = " + e.HTTPRequest.Referer + "\n")
+		}
+	}
+
+	names := make([]string, 0, len(e.Labels))
+	for name := range e.Labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		b.WriteString("labels." + name + " = " + e.Labels[name] + "\n")
+	}
+	return []byte(b.String())
+}
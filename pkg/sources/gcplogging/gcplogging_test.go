@@ -0,0 +1,107 @@
+package gcplogging
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/oauth2"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/sourcestest"
+)
+
+// matchBody matches requests whose JSON body has the given page token.
+func matchBody(t *testing.T, pageToken string) func(*http.Request) bool {
+	return func(req *http.Request) bool {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		var data map[string]any
+		if err := json.Unmarshal(body, &data); err != nil {
+			t.Fatal(err)
+		}
+		got, _ := data["pageToken"].(string)
+		return got == pageToken &&
+			data["filter"] == `(resource.type="gce_instance") AND timestamp >= "2023-01-01T00:00:00Z"` &&
+			data["resourceNames"].([]any)[0] == "projects/acme"
+	}
+}
+
+func TestSource_Chunks(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://logging.googleapis.com").
+		Post("/v2/entries:list").
+		MatchHeader("Authorization", "^Bearer token$").
+		Filter(matchBody(t, "")).
+		Reply(200).
+		JSON(map[string]any{
+			"entries": []map[string]any{{
+				"logName":     "projects/acme/logs/syslog",
+				"insertId":    "1",
+				"timestamp":   "2023-01-02T00:00:00Z",
+				"severity":    "INFO",
+				"resource":    map[string]any{"type": "gce_instance"},
+				"textPayload": "export AWS_SECRET_ACCESS_KEY=abc",
+			}},
+			"nextPageToken": "next",
+		})
+	gock.New("https://logging.googleapis.com").
+		Post("/v2/entries:list").
+		Filter(matchBody(t, "next")).
+		Reply(200).
+		JSON(map[string]any{
+			"entries": []map[string]any{{
+				"logName":     "projects/acme/logs/requests",
+				"insertId":    "2",
+				"jsonPayload": map[string]any{"password": "hunter2"},
+				"labels":      map[string]string{"env": "prod"},
+				"httpRequest": map[string]any{"requestUrl": "https://example.com/?token=xyz"},
+			}},
+		})
+
+	s := &Source{}
+	sourcestest.Init(t, s, &sourcespb.GCPLogging{
+		Credential: &sourcespb.GCPLogging_CloudEnvironment{CloudEnvironment: &credentialspb.CloudEnvironment{}},
+		Resources:  []string{"acme"},
+		Filter:     `resource.type="gce_instance"`,
+		Since:      timestamppb.New(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)),
+	}, func() *http.Client { return s.client })
+	s.tokens = oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "token"})
+	chunksCh := make(chan *sources.Chunk, 10)
+	assert.NoError(t, s.Chunks(context.Background(), chunksCh))
+	close(chunksCh)
+
+	var chunks []*sources.Chunk
+	for c := range chunksCh {
+		chunks = append(chunks, c)
+	}
+	if assert.Len(t, chunks, 2) {
+		assert.Equal(t, "export AWS_SECRET_ACCESS_KEY=abc\n", string(chunks[0].Data))
+		meta := chunks[0].SourceMetadata.GetGcpLogging()
+		assert.Equal(t, "projects/acme/logs/syslog", meta.GetLogName())
+		assert.Equal(t, "gce_instance", meta.GetResourceType())
+		assert.Equal(t, "INFO", meta.GetSeverity())
+
+		assert.Equal(t, "{\"password\":\"hunter2\"}\nhttpRequest.requestUrl = https://example.com/?token=xyz\nlabels.env = prod\n", string(chunks[1].Data))
+	}
+	assert.True(t, gock.IsDone())
+}
+
+func TestBuildFilter(t *testing.T) {
+	until := time.Date(2023, 2, 1, 12, 0, 0, 0, time.FixedZone("", 3600))
+	assert.Equal(t, "", buildFilter("", time.Time{}, time.Time{}))
+	assert.Equal(t, `(severity>=ERROR) AND timestamp < "2023-02-01T11:00:00Z"`, buildFilter("severity>=ERROR", time.Time{}, until))
+}
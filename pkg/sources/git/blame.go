@@ -0,0 +1,133 @@
+package git
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/gitparse"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+)
+
+// BlameLine is the commit that introduced a line.
+type BlameLine struct {
+	Commit string
+	Author string
+	Email  string
+	Time   time.Time
+}
+
+// Blame returns the commits that introduced count lines of a file from line
+// start, as of commit, or of the working tree when commit is empty.
+func Blame(ctx context.Context, repoPath, commit, file string, start, count int64) ([]*BlameLine, error) {
+	args := []string{"-C", repoPath, "blame", "--porcelain", "-L", fmt.Sprintf("%d,+%d", start, count)}
+	if commit != "" {
+		args = append(args, commit)
+	}
+	args = append(args, "--", file)
+	out, err := exec.CommandContext(ctx, "git", args...).Output()
+	if err != nil {
+		return nil, fmt.Errorf("could not blame %s:%d: %w", file, start, err)
+	}
+	return parseBlame(out)
+}
+
+// parseBlame parses the output of git blame --porcelain, which only has the
+// author of a commit the first time one of its lines is listed.
+func parseBlame(out []byte) ([]*BlameLine, error) {
+	var lines []*BlameLine
+	commits := map[string]*BlameLine{}
+	var current *BlameLine
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		text := scanner.Text()
+		// The content of a line ends its entry.
+		if strings.HasPrefix(text, "\t") {
+			if current == nil {
+				return nil, fmt.Errorf("invalid blame output")
+			}
+			lines = append(lines, current)
+			current = nil
+			continue
+		}
+		key, value, _ := strings.Cut(text, " ")
+		if current == nil {
+			// An entry starts with "<commit> <original line> <final line>".
+			if !plumbing.IsHash(key) {
+				return nil, fmt.Errorf("invalid blame entry %q", text)
+			}
+			if current = commits[key]; current == nil {
+				current = &BlameLine{Commit: key}
+				commits[key] = current
+			}
+			continue
+		}
+		switch key {
+		case "author":
+			current.Author = value
+		case "author-mail":
+			current.Email = strings.Trim(value, "<>")
+		case "author-time":
+			seconds, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid blame author time: %w", err)
+			}
+			current.Time = time.Unix(seconds, 0)
+		}
+	}
+	if len(lines) == 0 {
+		return nil, fmt.Errorf("empty blame output")
+	}
+	return lines, scanner.Err()
+}
+
+// blameDiff returns the commits that introduced the lines of diff, as of
+// commit, or of the working tree when commit is empty. The lines of a diff
+// are those of the file from its LineStart, its removed lines left out.
+func blameDiff(ctx context.Context, repoPath, commit string, diff *gitparse.Diff) []*BlameLine {
+	count := bytes.Count(diff.Content.Bytes(), []byte("\n"))
+	if diff.LineStart == 0 || count == 0 {
+		return nil
+	}
+	blames, err := Blame(ctx, repoPath, commit, diff.PathB, int64(diff.LineStart), int64(count))
+	if err != nil {
+		ctx.Logger().V(1).Info("could not blame diff", "error", err, "file", diff.PathB, "commit", commit)
+		return nil
+	}
+	return blames
+}
+
+// setBlame attributes the chunk of metadata to the commit that introduced its
+// lines, and to the age of that commit.
+func setBlame(metadata *source_metadatapb.MetaData, blame *BlameLine, now time.Time) {
+	timestamp := blame.Time.String()
+	age := int64(now.Sub(blame.Time).Hours() / 24)
+	switch data := metadata.GetData().(type) {
+	case *source_metadatapb.MetaData_Git:
+		data.Git.BlameCommit = blame.Commit
+		data.Git.BlameAuthor = blame.Author
+		data.Git.BlameEmail = blame.Email
+		data.Git.BlameTimestamp = timestamp
+		data.Git.BlameAgeDays = age
+	case *source_metadatapb.MetaData_Github:
+		data.Github.BlameCommit = blame.Commit
+		data.Github.BlameAuthor = blame.Author
+		data.Github.BlameEmail = blame.Email
+		data.Github.BlameTimestamp = timestamp
+		data.Github.BlameAgeDays = age
+	case *source_metadatapb.MetaData_Gitlab:
+		data.Gitlab.BlameCommit = blame.Commit
+		data.Gitlab.BlameAuthor = blame.Author
+		data.Gitlab.BlameEmail = blame.Email
+		data.Gitlab.BlameTimestamp = timestamp
+		data.Gitlab.BlameAgeDays = age
+	}
+}
@@ -0,0 +1,138 @@
+package git
+
+import (
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+// SSHCloneArgs returns the arguments of git clone that make it authenticate
+// with the PEM encoded private key keyPEM, decrypted with passphrase when it
+// is set since ssh would prompt for it. The key is written to a temporary
+// file that cleanup removes.
+func SSHCloneArgs(keyPEM, passphrase string) (args []string, cleanup func(), err error) {
+	cleanup = func() {}
+	if keyPEM == "" {
+		return nil, cleanup, nil
+	}
+	key := []byte(keyPEM)
+	if passphrase != "" {
+		if key, err = decryptSSHKey(key, passphrase); err != nil {
+			return nil, cleanup, err
+		}
+	}
+
+	// ssh refuses keys that other users can read, which CreateTemp prevents.
+	keyFile, err := os.CreateTemp("", "trufflehog-ssh-*")
+	if err != nil {
+		return nil, cleanup, err
+	}
+	defer keyFile.Close()
+	cleanup = func() { os.Remove(keyFile.Name()) }
+	if _, err := keyFile.Write(key); err != nil {
+		cleanup()
+		return nil, func() {}, err
+	}
+	// The command is run by a shell, so the path is quoted.
+	sshCommand := fmt.Sprintf("ssh -i '%s' -o IdentitiesOnly=yes", keyFile.Name())
+	return []string{"-c", "core.sshCommand=" + sshCommand}, cleanup, nil
+}
+
+// decryptSSHKey returns the PEM encoding of an encrypted private key.
+func decryptSSHKey(key []byte, passphrase string) ([]byte, error) {
+	raw, err := ssh.ParseRawPrivateKeyWithPassphrase(key, []byte(passphrase))
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt SSH key: %w", err)
+	}
+	// OpenSSH keys are parsed to a pointer, which PKCS #8 doesn't support.
+	if edKey, ok := raw.(*ed25519.PrivateKey); ok {
+		raw = *edKey
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(raw)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode SSH key: %w", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
+
+// ParseHTTPCredentials parses credentials formatted as username:password.
+// A credential without a username, like a token, is used as the password.
+func ParseHTTPCredentials(credentials string) (*url.Userinfo, error) {
+	if credentials == "" {
+		return nil, fmt.Errorf("empty HTTP credentials")
+	}
+	username, password, ok := strings.Cut(credentials, ":")
+	if !ok {
+		// Git hosts ignore the username when the password is a token.
+		return url.UserPassword("placeholder", credentials), nil
+	}
+	return url.UserPassword(username, password), nil
+}
+
+// URIWithHTTPCredentials returns the HTTP repo URI with credentials formatted
+// as username:password, unless it already has some. Other URIs are returned
+// as is.
+func URIWithHTTPCredentials(uri, credentials string) (string, error) {
+	u, err := gitURLParse(uri)
+	if err != nil {
+		return "", err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.User != nil {
+		return uri, nil
+	}
+	if u.User, err = ParseHTTPCredentials(credentials); err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
+
+// SSHURL returns the SSH URL of a repo cloned over HTTP, such as
+// ssh://git@github.com/org/repo.git for https://github.com/org/repo.git.
+func SSHURL(repoURL string) (string, error) {
+	u, err := url.Parse(repoURL)
+	if err != nil {
+		return "", err
+	}
+	switch u.Scheme {
+	case "ssh":
+		return repoURL, nil
+	case "http", "https":
+	default:
+		return "", fmt.Errorf("unsupported repo URL: %s", repoURL)
+	}
+	// The port of HTTP isn't that of SSH.
+	sshURL := url.URL{Scheme: "ssh", User: url.User("git"), Host: u.Hostname(), Path: u.Path}
+	return sshURL.String(), nil
+}
+
+// CloneRepoOverSSH clones the HTTP repo repoURL over SSH, with args from
+// SSHCloneArgs. repoURL is kept as the URL of the origin of the clone, since
+// results are reported and linked with it.
+func CloneRepoOverSSH(ctx context.Context, repoURL string, args ...string) (string, *git.Repository, error) {
+	sshURL, err := SSHURL(repoURL)
+	if err != nil {
+		return "", nil, err
+	}
+	path, _, err := CloneRepoUsingSSH(ctx, sshURL, args...)
+	if err != nil {
+		return path, nil, err
+	}
+	if out, err := exec.Command("git", "-C", path, "remote", "set-url", "origin", repoURL).CombinedOutput(); err != nil {
+		return path, nil, fmt.Errorf("could not set the URL of origin: %w: %s", err, out)
+	}
+	repo, err := git.PlainOpen(path)
+	if err != nil {
+		return path, nil, fmt.Errorf("could not open cloned repo: %w", err)
+	}
+	return path, repo, nil
+}
@@ -11,6 +11,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"runtime"
+	"sort"
 	"strings"
 	"time"
 
@@ -25,6 +26,7 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/gitparse"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
@@ -193,25 +195,22 @@ func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) err
 		if len(u) == 0 {
 			continue
 		}
-		if !strings.HasSuffix(u, "git") {
-			// try paths instead of url
-			repo, err := RepoFromPath(u)
-			if err != nil {
-				return err
-			}
-
-			err = func(repoPath string) error {
-				if strings.HasPrefix(repoPath, filepath.Join(os.TempDir(), "trufflehog")) {
-					defer os.RemoveAll(repoPath)
-				}
+		// Directories may be bare repos, like those named *.git on git servers.
+		repo, err := RepoFromPath(u)
+		if err != nil {
+			return err
+		}
 
-				return s.git.ScanRepo(ctx, repo, repoPath, NewScanOptions(), chunksChan)
-			}(u)
-			if err != nil {
-				return err
+		err = func(repoPath string) error {
+			if strings.HasPrefix(repoPath, filepath.Join(os.TempDir(), "trufflehog")) {
+				defer os.RemoveAll(repoPath)
 			}
-		}
 
+			return s.git.ScanRepo(ctx, repo, repoPath, NewScanOptions(), chunksChan)
+		}(u)
+		if err != nil {
+			return err
+		}
 	}
 
 	ctx.Logger().V(1).Info("Git source finished scanning", "repo-count", len(s.conn.Repositories))
@@ -223,6 +222,20 @@ func RepoFromPath(path string) (*git.Repository, error) {
 	return git.PlainOpen(path)
 }
 
+// IsBareRepo reports whether path is a bare repo, named like those of git
+// servers such as /srv/git/foo.git. The .git directories of worktrees aren't.
+func IsBareRepo(path string) bool {
+	if !strings.HasSuffix(path, ".git") || filepath.Base(path) == ".git" {
+		return false
+	}
+	for _, name := range []string{"HEAD", "objects", "refs"} {
+		if _, err := os.Stat(filepath.Join(path, name)); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
 func CleanOnError(err *error, path string) {
 	if *err != nil {
 		os.RemoveAll(path)
@@ -263,7 +276,7 @@ func CloneRepo(ctx context.Context, userInfo *url.Userinfo, gitUrl string, args
 
 	gitArgs := []string{"clone", cloneURL.String(), clonePath}
 	gitArgs = append(gitArgs, args...)
-	cloneCmd := exec.Command("git", gitArgs...)
+	cloneCmd := exec.CommandContext(ctx, "git", gitArgs...)
 
 	safeUrl, err := stripPassword(gitUrl)
 	if err != nil {
@@ -291,6 +304,12 @@ func CloneRepo(ctx context.Context, userInfo *url.Userinfo, gitUrl string, args
 		return "", nil, fmt.Errorf("could not clone repo: %s, %w", safeUrl, err)
 	}
 
+	// Notes aren't fetched by git clone, and most repos have none.
+	fetchCmd := exec.CommandContext(ctx, "git", "-C", clonePath, "fetch", "-q", "origin", "refs/notes/*:refs/notes/*")
+	if output, err := fetchCmd.CombinedOutput(); err != nil {
+		logger.V(2).Info("could not fetch notes", "error", err, "output", string(output))
+	}
+
 	repo, err := git.PlainOpen(clonePath)
 	if err != nil {
 		return "", nil, fmt.Errorf("could not open cloned repo: %w", err)
@@ -317,6 +336,31 @@ func CloneRepoUsingSSH(ctx context.Context, gitUrl string, args ...string) (stri
 	return CloneRepo(ctx, userInfo, gitUrl, args...)
 }
 
+// TLSCloneArgs returns the arguments of git clone that make it trust the PEM
+// encoded CA certificates in caPEM, and skip the verification of certificates
+// if insecure is set. The certificates are written to a temporary file that
+// cleanup removes.
+func TLSCloneArgs(caPEM string, insecure bool) (args []string, cleanup func(), err error) {
+	cleanup = func() {}
+	if insecure {
+		args = append(args, "-c", "http.sslVerify=false")
+	}
+	if caPEM == "" {
+		return args, cleanup, nil
+	}
+	caFile, err := os.CreateTemp("", "trufflehog-ca-*.pem")
+	if err != nil {
+		return nil, cleanup, err
+	}
+	defer caFile.Close()
+	cleanup = func() { os.Remove(caFile.Name()) }
+	if _, err := caFile.WriteString(caPEM); err != nil {
+		cleanup()
+		return nil, func() {}, err
+	}
+	return append(args, "-c", "http.sslCAInfo="+caFile.Name()), cleanup, nil
+}
+
 // gitCmdCheck checks if git is installed.
 func gitCmdCheck() error {
 	if errors.Is(exec.Command("git").Run(), exec.ErrNotFound) {
@@ -330,14 +374,39 @@ func (s *Git) ScanCommits(ctx context.Context, repo *git.Repository, path string
 		return err
 	}
 
-	commitChan, err := gitparse.RepoPath(ctx, path, scanOptions.HeadHash)
+	// Scan exactly the commits between the base and the head when both are
+	// set, rather than walking the history of the head until the base.
+	revision := scanOptions.HeadHash
+	logArgs := append(scanOptions.logArgs(), scanOptions.excludeArgs(repo)...)
+	if scanOptions.BaseHash != "" {
+		if revision != "" {
+			revision = scanOptions.BaseHash + ".." + revision
+		} else {
+			// The author and date filters can leave the base commit out of
+			// the log, so its history is excluded rather than relying on
+			// the walk stopping at it.
+			logArgs = append(logArgs, "^"+scanOptions.BaseHash)
+		}
+	}
+	var commitChan chan gitparse.Commit
+	var err error
+	if scanOptions.AllBranches && revision == "" {
+		commitChan, err = gitparse.AllBranches(ctx, path, logArgs...)
+	} else {
+		commitChan, err = gitparse.RepoPath(ctx, path, revision, logArgs...)
+	}
 	if err != nil {
 		return err
 	}
 	if commitChan == nil {
 		return nil
 	}
+	return s.scanCommitChan(ctx, repo, path, commitChan, scanOptions, repoBlobs(repo), chunksChan)
+}
 
+// scanCommitChan chunks the diffs of the commits parsed from git log of the
+// repo at path. The binary files they change are read whole from blobs.
+func (s *Git) scanCommitChan(ctx context.Context, repo *git.Repository, path string, commitChan chan gitparse.Commit, scanOptions *ScanOptions, blobs openBlob, chunksChan chan *sources.Chunk) error {
 	// get the URL metadata for reporting (may be empty)
 	urlMetadata := getSafeRemoteURL(repo, "origin")
 
@@ -346,6 +415,11 @@ func (s *Git) ScanCommits(ctx context.Context, repo *git.Repository, path string
 	logger := ctx.Logger().WithValues("repo", urlMetadata)
 	logger.V(1).Info("scanning repo", "base", scanOptions.BaseHash, "head", scanOptions.HeadHash)
 	for commit := range commitChan {
+		// The commits parsed before git was stopped by the context are
+		// drained without being scanned.
+		if common.IsDone(ctx) {
+			continue
+		}
 		if len(scanOptions.BaseHash) > 0 {
 			if commit.Hash == scanOptions.BaseHash {
 				logger.V(1).Info("reached base commit", "commit", commit.Hash)
@@ -371,11 +445,12 @@ func (s *Git) ScanCommits(ctx context.Context, repo *git.Repository, path string
 			email = commit.Author
 			hash = commit.Hash
 			when = commit.Date.String()
+			branch := branchName(commit.Ref)
 
 			// Handle binary files by reading the entire file rather than using the diff.
 			if diff.IsBinary {
 				commitHash := plumbing.NewHash(hash)
-				metadata := s.sourceMetadataFunc(fileName, email, hash, when, urlMetadata, 0)
+				metadata := s.commitMetadata(fileName, email, hash, when, urlMetadata, branch, 0)
 				chunkSkel := &sources.Chunk{
 					SourceName:     s.sourceName,
 					SourceID:       s.sourceID,
@@ -383,17 +458,21 @@ func (s *Git) ScanCommits(ctx context.Context, repo *git.Repository, path string
 					SourceMetadata: metadata,
 					Verify:         s.verify,
 				}
-				if err := handleBinary(ctx, repo, chunksChan, chunkSkel, commitHash, fileName); err != nil {
+				if err := handleBinary(ctx, blobs, chunksChan, chunkSkel, commitHash, fileName); err != nil {
 					logger.V(1).Info("error handling binary file", "error", err, "filename", fileName, "commit", commitHash, "file", diff.PathB)
 				}
 				continue
 			}
 
-			if diff.Content.Len() > sources.ChunkSize+sources.PeekSize {
-				s.gitChunk(ctx, diff, fileName, email, hash, when, urlMetadata, chunksChan)
+			var blames []*BlameLine
+			if scanOptions.Blame {
+				blames = blameDiff(ctx, path, hash, &diff)
+			}
+			if blames != nil || diff.Content.Len() > sources.ChunkSize+sources.PeekSize {
+				s.gitChunk(ctx, diff, fileName, email, hash, when, urlMetadata, branch, blames, chunksChan)
 				continue
 			}
-			metadata := s.sourceMetadataFunc(fileName, email, hash, when, urlMetadata, int64(diff.LineStart))
+			metadata := s.commitMetadata(fileName, email, hash, when, urlMetadata, branch, int64(diff.LineStart))
 			chunksChan <- &sources.Chunk{
 				SourceName:     s.sourceName,
 				SourceID:       s.sourceID,
@@ -407,7 +486,24 @@ func (s *Git) ScanCommits(ctx context.Context, repo *git.Repository, path string
 	return nil
 }
 
-func (s *Git) gitChunk(ctx context.Context, diff gitparse.Diff, fileName, email, hash, when, urlMetadata string, chunksChan chan *sources.Chunk) {
+// gitChunk splits the content of a diff into chunks of at most ChunkSize.
+// With the blames of its lines, the chunks are also split where the commit
+// that introduced the lines changes, and attributed to it.
+func (s *Git) gitChunk(ctx context.Context, diff gitparse.Diff, fileName, email, hash, when, urlMetadata, branch string, blames []*BlameLine, chunksChan chan *sources.Chunk) {
+	now := time.Now()
+	blameAt := func(offset int) *BlameLine {
+		if offset < len(blames) {
+			return blames[offset]
+		}
+		return nil
+	}
+	metadataAt := func(offset int) *source_metadatapb.MetaData {
+		metadata := s.commitMetadata(fileName, email, hash, when, urlMetadata, branch, int64(diff.LineStart+offset))
+		if blame := blameAt(offset); blame != nil {
+			setBlame(metadata, blame, now)
+		}
+		return metadata
+	}
 	originalChunk := bufio.NewScanner(&diff.Content)
 	newChunkBuffer := bytes.Buffer{}
 	lastOffset := 0
@@ -415,16 +511,27 @@ func (s *Git) gitChunk(ctx context.Context, diff gitparse.Diff, fileName, email,
 		line := make([]byte, len(originalChunk.Bytes())+1)
 		copy(line, originalChunk.Bytes())
 		line[len(line)-1] = byte('\n')
+		if newChunkBuffer.Len() > 0 && blameAt(offset) != blameAt(lastOffset) {
+			chunksChan <- &sources.Chunk{
+				SourceName:     s.sourceName,
+				SourceID:       s.sourceID,
+				SourceType:     s.sourceType,
+				SourceMetadata: metadataAt(lastOffset),
+				Data:           append([]byte{}, newChunkBuffer.Bytes()...),
+				Verify:         s.verify,
+			}
+			newChunkBuffer.Reset()
+			lastOffset = offset
+		}
 		if len(line) > sources.ChunkSize || len(line)+newChunkBuffer.Len() > sources.ChunkSize {
 			// Add oversize chunk info
 			if newChunkBuffer.Len() > 0 {
 				// Send the existing fragment.
-				metadata := s.sourceMetadataFunc(fileName, email, hash, when, urlMetadata, int64(diff.LineStart+lastOffset))
 				chunksChan <- &sources.Chunk{
 					SourceName:     s.sourceName,
 					SourceID:       s.sourceID,
 					SourceType:     s.sourceType,
-					SourceMetadata: metadata,
+					SourceMetadata: metadataAt(lastOffset),
 					Data:           append([]byte{}, newChunkBuffer.Bytes()...),
 					Verify:         s.verify,
 				}
@@ -433,12 +540,11 @@ func (s *Git) gitChunk(ctx context.Context, diff gitparse.Diff, fileName, email,
 			}
 			if len(line) > sources.ChunkSize {
 				// Send the oversize line.
-				metadata := s.sourceMetadataFunc(fileName, email, hash, when, urlMetadata, int64(diff.LineStart+offset))
 				chunksChan <- &sources.Chunk{
 					SourceName:     s.sourceName,
 					SourceID:       s.sourceID,
 					SourceType:     s.sourceType,
-					SourceMetadata: metadata,
+					SourceMetadata: metadataAt(offset),
 					Data:           line,
 					Verify:         s.verify,
 				}
@@ -452,20 +558,52 @@ func (s *Git) gitChunk(ctx context.Context, diff gitparse.Diff, fileName, email,
 	}
 	// Send anything still in the new chunk buffer
 	if newChunkBuffer.Len() > 0 {
-		metadata := s.sourceMetadataFunc(fileName, email, hash, when, urlMetadata, int64(diff.LineStart+lastOffset))
 		chunksChan <- &sources.Chunk{
 			SourceName:     s.sourceName,
 			SourceID:       s.sourceID,
 			SourceType:     s.sourceType,
-			SourceMetadata: metadata,
+			SourceMetadata: metadataAt(lastOffset),
 			Data:           append([]byte{}, newChunkBuffer.Bytes()...),
 			Verify:         s.verify,
 		}
 	}
 }
 
+// commitMetadata returns the metadata of a chunk of a commit, along with the
+// branch the commit was found on when it is known.
+func (s *Git) commitMetadata(file, email, commit, timestamp, repository, branch string, line int64) *source_metadatapb.MetaData {
+	metadata := s.sourceMetadataFunc(file, email, commit, timestamp, repository, line)
+	if branch == "" {
+		return metadata
+	}
+	switch data := metadata.GetData().(type) {
+	case *source_metadatapb.MetaData_Git:
+		data.Git.Branch = branch
+	case *source_metadatapb.MetaData_Github:
+		data.Github.Branch = branch
+	case *source_metadatapb.MetaData_Gitlab:
+		data.Gitlab.Branch = branch
+	}
+	return metadata
+}
+
+// branchName returns the name of the branch of a ref like
+// refs/remotes/origin/main, or the ref itself when it isn't a branch.
+func branchName(ref string) string {
+	for _, prefix := range []string{"refs/heads/", "refs/remotes/origin/"} {
+		if name, ok := strings.CutPrefix(ref, prefix); ok {
+			return name
+		}
+	}
+	return ref
+}
+
 // ScanUnstaged chunks unstaged changes.
 func (s *Git) ScanUnstaged(ctx context.Context, repo *git.Repository, path string, scanOptions *ScanOptions, chunksChan chan *sources.Chunk) error {
+	if !scanOptions.includesUnstaged(time.Now()) {
+		ctx.Logger().V(1).Info("skipping unstaged changes excluded by the author and date filters", "path", path)
+		return nil
+	}
 	// get the URL metadata for reporting (may be empty)
 	urlMetadata := getSafeRemoteURL(repo, "origin")
 
@@ -525,12 +663,19 @@ func (s *Git) ScanUnstaged(ctx context.Context, repo *git.Repository, path strin
 					SourceMetadata: metadata,
 					Verify:         s.verify,
 				}
-				if err := handleBinary(ctx, repo, chunksChan, chunkSkel, commitHash, fileName); err != nil {
+				if err := handleBinary(ctx, repoBlobs(repo), chunksChan, chunkSkel, commitHash, fileName); err != nil {
 					logger.V(1).Info("error handling binary file", "error", err, "filename", fileName)
 				}
 				continue
 			}
 
+			// Unstaged changes are blamed in the working tree.
+			if scanOptions.Blame {
+				if blames := blameDiff(ctx, path, "", &diff); blames != nil {
+					s.gitChunk(ctx, diff, fileName, email, "Unstaged", when, urlMetadata, "", blames, chunksChan)
+					continue
+				}
+			}
 			metadata := s.sourceMetadataFunc(fileName, email, "Unstaged", when, urlMetadata, int64(diff.LineStart))
 			chunksChan <- &sources.Chunk{
 				SourceName:     s.sourceName,
@@ -545,6 +690,71 @@ func (s *Git) ScanUnstaged(ctx context.Context, repo *git.Repository, path strin
 	return nil
 }
 
+// ScanStaged chunks the changes staged in the index, which are those a
+// pre-commit hook is about to commit.
+func (s *Git) ScanStaged(ctx context.Context, repo *git.Repository, path string, scanOptions *ScanOptions, chunksChan chan *sources.Chunk) error {
+	if scanOptions == nil {
+		scanOptions = NewScanOptions()
+	}
+	// get the URL metadata for reporting (may be empty)
+	urlMetadata := getSafeRemoteURL(repo, "origin")
+
+	commitChan, err := gitparse.Staged(ctx, path)
+	if err != nil {
+		return err
+	}
+
+	ctx.Logger().V(1).Info("scanning staged changes", "path", path)
+	for commit := range commitChan {
+		for _, diff := range commit.Diffs {
+			fileName := diff.PathB
+			if fileName == "" || !scanOptions.Filter.Pass(fileName) {
+				continue
+			}
+			if diff.IsBinary {
+				ctx.Logger().V(2).Info("skipping staged binary file", "filename", fileName)
+				continue
+			}
+
+			metadata := s.sourceMetadataFunc(fileName, "", "Staged", "", urlMetadata, int64(diff.LineStart))
+			chunksChan <- &sources.Chunk{
+				SourceName:     s.sourceName,
+				SourceID:       s.sourceID,
+				SourceType:     s.sourceType,
+				SourceMetadata: metadata,
+				Data:           diff.Content.Bytes(),
+				Verify:         s.verify,
+			}
+		}
+	}
+	return nil
+}
+
+// StagedData returns the lines added by the changes staged in the local
+// repository of the file:// URI, which are the data ScanStaged chunks.
+func StagedData(ctx context.Context, uriString string) ([]byte, error) {
+	uri, err := gitURLParse(uriString)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse Git URI: %s", err)
+	}
+	if uri.Scheme != "file" {
+		return nil, fmt.Errorf("staged changes can only be read from a local repository")
+	}
+	commitChan, err := gitparse.Staged(ctx, uri.Host+uri.Path)
+	if err != nil {
+		return nil, err
+	}
+	var data bytes.Buffer
+	for commit := range commitChan {
+		for _, diff := range commit.Diffs {
+			if !diff.IsBinary {
+				data.Write(diff.Content.Bytes())
+			}
+		}
+	}
+	return data.Bytes(), nil
+}
+
 func (s *Git) ScanRepo(ctx context.Context, repo *git.Repository, repoPath string, scanOptions *ScanOptions, chunksChan chan *sources.Chunk) error {
 	if scanOptions == nil {
 		scanOptions = NewScanOptions()
@@ -552,12 +762,50 @@ func (s *Git) ScanRepo(ctx context.Context, repo *git.Repository, repoPath strin
 	if err := normalizeConfig(scanOptions, repo); err != nil {
 		return err
 	}
+	// A repo scanned past its timeout is left with the chunks sent so far.
+	repoCtx := ctx
+	if scanOptions.Timeout > 0 {
+		var cancel func()
+		repoCtx, cancel = context.WithTimeout(ctx, scanOptions.Timeout)
+		defer cancel()
+	}
+	err := s.scanRepo(repoCtx, repo, repoPath, scanOptions, chunksChan)
+	// Only the timeout ends the context of the repo before its parent's.
+	if repoCtx.Err() != nil && ctx.Err() == nil {
+		return fmt.Errorf("scan of repo %s timed out after %s, its results are partial", repoPath, scanOptions.Timeout)
+	}
+	return err
+}
+
+// scanRepo scans the commits and the other objects of a repo.
+func (s *Git) scanRepo(ctx context.Context, repo *git.Repository, repoPath string, scanOptions *ScanOptions, chunksChan chan *sources.Chunk) error {
 	start := time.Now().UnixNano()
 	if err := s.ScanCommits(ctx, repo, repoPath, scanOptions, chunksChan); err != nil {
 		return err
 	}
-	if err := s.ScanUnstaged(ctx, repo, repoPath, scanOptions, chunksChan); err != nil {
-		ctx.Logger().V(1).Info("error scanning unstaged changes", "error", err)
+	// Bare repos have no working tree to have unstaged changes in.
+	if _, err := repo.Worktree(); err != git.ErrIsBareRepository {
+		if err := s.ScanUnstaged(ctx, repo, repoPath, scanOptions, chunksChan); err != nil {
+			ctx.Logger().V(1).Info("error scanning unstaged changes", "error", err)
+		}
+	}
+	if err := s.ScanNotes(ctx, repo, chunksChan); err != nil {
+		ctx.Logger().Error(err, "error scanning notes")
+	}
+	if scanOptions.IncludeUnreachable {
+		if err := s.ScanUnreachable(ctx, repo, repoPath, scanOptions, chunksChan); err != nil {
+			ctx.Logger().Error(err, "error scanning unreachable objects")
+		}
+	}
+	if scanOptions.IncludeTags {
+		if err := s.ScanTags(ctx, repo, chunksChan); err != nil {
+			ctx.Logger().Error(err, "error scanning tags")
+		}
+	}
+	if scanOptions.RecurseSubmodules {
+		if err := s.ScanSubmodules(ctx, repo, repoPath, scanOptions, chunksChan); err != nil {
+			ctx.Logger().Error(err, "error scanning submodules")
+		}
 	}
 
 	// We're logging time, but the repoPath is usally a dynamically generated folder in /tmp
@@ -672,10 +920,34 @@ func TryAdditionalBaseRefs(repo *git.Repository, base string) (*plumbing.Hash, e
 	return nil, fmt.Errorf("no base refs succeeded for base: %q", base)
 }
 
+// RefHeads returns the sorted, distinct objects the refs of a repo point to,
+// which exclude the history scanned up to now from the next scans with
+// ScanOptionExcludeRevs.
+func RefHeads(repo *git.Repository) ([]string, error) {
+	refs, err := repo.References()
+	if err != nil {
+		return nil, err
+	}
+	seen := map[string]bool{}
+	var heads []string
+	err = refs.ForEach(func(ref *plumbing.Reference) error {
+		if ref.Type() != plumbing.HashReference || ref.Name().IsNote() {
+			return nil
+		}
+		if hash := ref.Hash().String(); !seen[hash] {
+			seen[hash] = true
+			heads = append(heads, hash)
+		}
+		return nil
+	})
+	sort.Strings(heads)
+	return heads, err
+}
+
 // PrepareRepoSinceCommit clones a repo starting at the given commitHash and returns the cloned repo path.
-func PrepareRepoSinceCommit(ctx context.Context, uriString, commitHash string) (string, bool, error) {
+func PrepareRepoSinceCommit(ctx context.Context, uriString, commitHash string, args ...string) (string, bool, error) {
 	if commitHash == "" {
-		return PrepareRepo(ctx, uriString)
+		return PrepareRepo(ctx, uriString, args...)
 	}
 	// TODO: refactor with PrepareRepo to remove duplicated logic
 
@@ -690,13 +962,13 @@ func PrepareRepoSinceCommit(ctx context.Context, uriString, commitHash string) (
 	}
 
 	if uri.Scheme == "file" || uri.Host != "github.com" {
-		return PrepareRepo(ctx, uriString)
+		return PrepareRepo(ctx, uriString, args...)
 	}
 
 	uriPath := strings.TrimPrefix(uri.Path, "/")
 	owner, repoName, found := strings.Cut(uriPath, "/")
 	if !found {
-		return PrepareRepo(ctx, uriString)
+		return PrepareRepo(ctx, uriString, args...)
 	}
 
 	client := github.NewClient(nil)
@@ -710,13 +982,13 @@ func PrepareRepoSinceCommit(ctx context.Context, uriString, commitHash string) (
 
 	commit, _, err := client.Git.GetCommit(context.Background(), owner, repoName, commitHash)
 	if err != nil {
-		return PrepareRepo(ctx, uriString)
+		return PrepareRepo(ctx, uriString, args...)
 	}
 	var timestamp string
 	{
 		author := commit.GetAuthor()
 		if author == nil {
-			return PrepareRepo(ctx, uriString)
+			return PrepareRepo(ctx, uriString, args...)
 		}
 		timestamp = author.GetDate().Format(time.RFC3339)
 	}
@@ -730,13 +1002,13 @@ func PrepareRepoSinceCommit(ctx context.Context, uriString, commitHash string) (
 		if !ok {
 			return "", true, fmt.Errorf("password must be included in Git repo URL when username is provided")
 		}
-		path, _, err = CloneRepoUsingToken(ctx, password, remotePath, uri.User.Username(), "--shallow-since", timestamp)
+		path, _, err = CloneRepoUsingToken(ctx, password, remotePath, uri.User.Username(), append([]string{"--shallow-since", timestamp}, args...)...)
 		if err != nil {
 			return path, true, fmt.Errorf("failed to clone authenticated Git repo (%s): %s", remotePath, err)
 		}
 	default:
 		ctx.Logger().V(1).Info("cloning repo without authentication", "uri", uri)
-		path, _, err = CloneRepoUsingUnauthenticated(ctx, remotePath, "--shallow-since", timestamp)
+		path, _, err = CloneRepoUsingUnauthenticated(ctx, remotePath, append([]string{"--shallow-since", timestamp}, args...)...)
 		if err != nil {
 			return path, true, fmt.Errorf("failed to clone unauthenticated Git repo (%s): %s", remotePath, err)
 		}
@@ -747,7 +1019,8 @@ func PrepareRepoSinceCommit(ctx context.Context, uriString, commitHash string) (
 }
 
 // PrepareRepo clones a repo if possible and returns the cloned repo path.
-func PrepareRepo(ctx context.Context, uriString string) (string, bool, error) {
+// args are passed to git clone.
+func PrepareRepo(ctx context.Context, uriString string, args ...string) (string, bool, error) {
 	var path string
 	uri, err := gitURLParse(uriString)
 	if err != nil {
@@ -768,13 +1041,13 @@ func PrepareRepo(ctx context.Context, uriString string) (string, bool, error) {
 			if !ok {
 				return "", remote, fmt.Errorf("password must be included in Git repo URL when username is provided")
 			}
-			path, _, err = CloneRepoUsingToken(ctx, password, remotePath, uri.User.Username())
+			path, _, err = CloneRepoUsingToken(ctx, password, remotePath, uri.User.Username(), args...)
 			if err != nil {
 				return path, remote, fmt.Errorf("failed to clone authenticated Git repo (%s): %s", remotePath, err)
 			}
 		default:
 			ctx.Logger().V(1).Info("cloning repo without authentication", "uri", uri)
-			path, _, err = CloneRepoUsingUnauthenticated(ctx, remotePath)
+			path, _, err = CloneRepoUsingUnauthenticated(ctx, remotePath, args...)
 			if err != nil {
 				return path, remote, fmt.Errorf("failed to clone unauthenticated Git repo (%s): %s", remotePath, err)
 			}
@@ -782,7 +1055,7 @@ func PrepareRepo(ctx context.Context, uriString string) (string, bool, error) {
 	case "ssh":
 		remotePath := uri.String()
 		remote = true
-		path, _, err = CloneRepoUsingSSH(ctx, remotePath)
+		path, _, err = CloneRepoUsingSSH(ctx, remotePath, args...)
 		if err != nil {
 			return path, remote, fmt.Errorf("failed to clone unauthenticated Git repo (%s): %s", remotePath, err)
 		}
@@ -817,19 +1090,28 @@ func getSafeRemoteURL(repo *git.Repository, preferred string) string {
 	return safeURL
 }
 
-func handleBinary(ctx context.Context, repo *git.Repository, chunksChan chan *sources.Chunk, chunkSkel *sources.Chunk, commitHash plumbing.Hash, path string) error {
-	ctx.Logger().V(5).Info("handling binary file", "path", path)
-	commit, err := repo.CommitObject(commitHash)
-	if err != nil {
-		return err
-	}
+// openBlob opens the content of the file at path in a commit.
+type openBlob func(commitHash plumbing.Hash, path string) (io.ReadCloser, error)
 
-	file, err := commit.File(path)
-	if err != nil {
-		return err
+// repoBlobs opens the blobs of repo with go-git.
+func repoBlobs(repo *git.Repository) openBlob {
+	return func(commitHash plumbing.Hash, path string) (io.ReadCloser, error) {
+		commit, err := repo.CommitObject(commitHash)
+		if err != nil {
+			return nil, err
+		}
+
+		file, err := commit.File(path)
+		if err != nil {
+			return nil, err
+		}
+		return file.Reader()
 	}
+}
 
-	fileReader, err := file.Reader()
+func handleBinary(ctx context.Context, blobs openBlob, chunksChan chan *sources.Chunk, chunkSkel *sources.Chunk, commitHash plumbing.Hash, path string) error {
+	ctx.Logger().V(5).Info("handling binary file", "path", path)
+	fileReader, err := blobs(commitHash, path)
 	if err != nil {
 		return err
 	}
@@ -851,14 +1133,6 @@ func handleBinary(ctx context.Context, repo *git.Repository, chunksChan chan *so
 	}
 	reader.Stop()
 
-	chunkData, err := io.ReadAll(reader)
-	if err != nil {
-		return err
-	}
-
-	chunk := *chunkSkel
-	chunk.Data = chunkData
-	chunksChan <- &chunk
-
-	return nil
+	// Large blobs are streamed rather than read in memory at once.
+	return sources.ChunkReader(ctx, reader, chunkSkel, chunksChan)
 }
@@ -0,0 +1,63 @@
+package git
+
+import (
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// ScanNotes chunks the notes of the refs under refs/notes. Each note is
+// attributed to the object it annotates and to its notes ref.
+func (s *Git) ScanNotes(ctx context.Context, repo *git.Repository, chunksChan chan *sources.Chunk) error {
+	// get the URL metadata for reporting (may be empty)
+	urlMetadata := getSafeRemoteURL(repo, "origin")
+
+	refs, err := repo.References()
+	if err != nil {
+		return err
+	}
+	defer refs.Close()
+
+	return refs.ForEach(func(ref *plumbing.Reference) error {
+		if ctx.Err() != nil {
+			return storer.ErrStop
+		}
+		if !strings.HasPrefix(ref.Name().String(), "refs/notes/") {
+			return nil
+		}
+		commit, err := repo.CommitObject(ref.Hash())
+		if err != nil {
+			ctx.Logger().V(1).Info("could not read notes ref", "ref", ref.Name(), "error", err)
+			return nil
+		}
+		tree, err := commit.Tree()
+		if err != nil {
+			return err
+		}
+		return tree.Files().ForEach(func(file *object.File) error {
+			content, err := file.Contents()
+			if err != nil {
+				return err
+			}
+			// Notes are named after the object they annotate, split in
+			// directories when there are many of them.
+			annotated := strings.ReplaceAll(file.Name, "/", "")
+			metadata := s.commitMetadata("", commit.Author.String(), annotated, commit.Author.When.String(), urlMetadata, ref.Name().String(), 0)
+			chunksChan <- &sources.Chunk{
+				SourceName:     s.sourceName,
+				SourceID:       s.sourceID,
+				SourceType:     s.sourceType,
+				SourceMetadata: metadata,
+				Data:           []byte(content),
+				Verify:         s.verify,
+			}
+			return nil
+		})
+	})
+}
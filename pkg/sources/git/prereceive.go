@@ -0,0 +1,196 @@
+package git
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/gitparse"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// RefUpdate is a ref update received by a pre-receive hook.
+type RefUpdate struct {
+	OldRev string
+	NewRev string
+	Ref    string
+}
+
+// Deleted returns whether the update deletes the ref, which pushes no commits.
+// The new revision of a deleted ref is all zeros.
+func (u RefUpdate) Deleted() bool {
+	return strings.Trim(u.NewRev, "0") == ""
+}
+
+// ParseRefUpdates reads the "<old-rev> <new-rev> <ref>" lines a pre-receive
+// hook receives on its standard input.
+func ParseRefUpdates(r io.Reader) ([]RefUpdate, error) {
+	var updates []RefUpdate
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("invalid ref update line: %q", line)
+		}
+		updates = append(updates, RefUpdate{OldRev: fields[0], NewRev: fields[1], Ref: fields[2]})
+	}
+	return updates, scanner.Err()
+}
+
+// PushedRevs returns the new revisions of the refs an update doesn't delete.
+func PushedRevs(updates []RefUpdate) []string {
+	var revs []string
+	for _, u := range updates {
+		if !u.Deleted() {
+			revs = append(revs, u.NewRev)
+		}
+	}
+	return revs
+}
+
+// PushedCommits returns the hashes of the commits a ref update pushes, that
+// no ref of the repository at path points to yet.
+func PushedCommits(path string, update RefUpdate) ([]string, error) {
+	if update.Deleted() {
+		return nil, nil
+	}
+	cmd := exec.Command("git", "-C", path, "rev-list", update.NewRev, "--not", "--all")
+	// Keep the environment so that git reads the objects of the push from
+	// its quarantine directory.
+	cmd.Env = os.Environ()
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("could not list the commits pushed to %s: %w", update.Ref, err)
+	}
+	return strings.Fields(string(out)), nil
+}
+
+// ResetPushedRef moves the ref of an update back to its old revision in a
+// clone of the repository at path, or removes it when the update created the
+// ref or its old revision isn't in the clone anymore, so that ScanPushed only
+// scans the commits the update pushed. The remote-tracking branch of a branch
+// is reset too.
+func ResetPushedRef(path string, update RefUpdate) error {
+	refs := []string{update.Ref}
+	if branch := strings.TrimPrefix(update.Ref, "refs/heads/"); branch != update.Ref {
+		refs = append(refs, "refs/remotes/origin/"+branch)
+	}
+	created := strings.Trim(update.OldRev, "0") == ""
+	for _, ref := range refs {
+		if !created {
+			if err := exec.Command("git", "-C", path, "update-ref", ref, update.OldRev).Run(); err == nil {
+				continue
+			}
+		}
+		if out, err := exec.Command("git", "-C", path, "update-ref", "-d", ref).CombinedOutput(); err != nil {
+			return fmt.Errorf("could not remove %s: %w: %s", ref, err, out)
+		}
+	}
+	return nil
+}
+
+// ScanPushed chunks the commits reachable from revs that no ref points to
+// yet, which are the commits being pushed when run from a pre-receive hook.
+func (s *Git) ScanPushed(ctx context.Context, repo *git.Repository, path string, revs []string, scanOptions *ScanOptions, chunksChan chan *sources.Chunk) error {
+	if scanOptions == nil {
+		scanOptions = NewScanOptions()
+	}
+	if len(revs) == 0 {
+		return nil
+	}
+	if err := gitCmdCheck(); err != nil {
+		return err
+	}
+
+	commitChan, err := gitparse.Pushed(ctx, path, revs)
+	if err != nil {
+		return err
+	}
+	blobs, err := newCatFile(ctx, path)
+	if err != nil {
+		return err
+	}
+	defer blobs.Close()
+	return s.scanCommitChan(ctx, repo, path, commitChan, scanOptions, blobs.Open, chunksChan)
+}
+
+// catFile reads blobs with a git cat-file --batch process. Run with the
+// environment of a pre-receive hook, git reads the objects of the push from
+// their quarantine directory, which go-git doesn't know of.
+type catFile struct {
+	cmd    *exec.Cmd
+	stdin  io.WriteCloser
+	stdout *bufio.Reader
+}
+
+func newCatFile(ctx context.Context, path string) (*catFile, error) {
+	cmd := exec.CommandContext(ctx, "git", "-C", path, "cat-file", "--batch")
+	cmd.Env = os.Environ()
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("could not run git cat-file: %w", err)
+	}
+	return &catFile{cmd: cmd, stdin: stdin, stdout: bufio.NewReader(stdout)}, nil
+}
+
+// Open returns the content of the file at path in a commit. It must be closed
+// before the next blob is opened.
+func (c *catFile) Open(commitHash plumbing.Hash, path string) (io.ReadCloser, error) {
+	if _, err := fmt.Fprintf(c.stdin, "%s:%s\n", commitHash, path); err != nil {
+		return nil, err
+	}
+	header, err := c.stdout.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	// The header is "<oid> <type> <size>", or "<object> missing".
+	fields := strings.Fields(header)
+	if len(fields) != 3 {
+		return nil, fmt.Errorf("could not read %s:%s: %s", commitHash, path, strings.TrimSpace(header))
+	}
+	size, err := strconv.ParseInt(fields[2], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid git cat-file header %q: %w", header, err)
+	}
+	return &catFileBlob{Reader: io.LimitReader(c.stdout, size), stdout: c.stdout}, nil
+}
+
+func (c *catFile) Close() error {
+	_ = c.stdin.Close()
+	return c.cmd.Wait()
+}
+
+// catFileBlob is the content of a blob in the output of git cat-file, which
+// is followed by a newline.
+type catFileBlob struct {
+	io.Reader
+	stdout *bufio.Reader
+}
+
+// Close skips the rest of the blob, up to the header of the next one.
+func (b *catFileBlob) Close() error {
+	if _, err := io.Copy(io.Discard, b.Reader); err != nil {
+		return err
+	}
+	_, err := b.stdout.Discard(1)
+	return err
+}
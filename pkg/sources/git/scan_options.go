@@ -1,7 +1,10 @@
 package git
 
 import (
+	"time"
+
 	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
 )
 
@@ -11,6 +14,26 @@ type ScanOptions struct {
 	HeadHash   string
 	MaxDepth   int64
 	LogOptions *git.LogOptions
+	// AllBranches attributes the commits of every branch to their branch.
+	AllBranches bool
+	// IncludeTags scans the messages of annotated tags.
+	IncludeTags bool
+	// IncludeUnreachable scans the commits and blobs no ref points to.
+	IncludeUnreachable bool
+	// RecurseSubmodules scans the commits submodules are pinned to.
+	RecurseSubmodules bool
+	// Author limits the scan to the commits whose author matches this
+	// pattern, like the --author option of git log.
+	Author string
+	// ExcludeRevs are commits whose history is not scanned, such as the
+	// heads reached by a previous scan of the repo.
+	ExcludeRevs []string
+	// Timeout is the deadline to scan a repo, after which the rest of it is
+	// skipped and the scan fails. Zero means no deadline.
+	Timeout time.Duration
+	// Blame attributes the chunks of diffs to the commits that introduced
+	// their lines, found with git blame.
+	Blame bool
 }
 
 type ScanOption func(*ScanOptions)
@@ -45,6 +68,104 @@ func ScanOptionLogOptions(logOptions *git.LogOptions) ScanOption {
 	}
 }
 
+func ScanOptionAllBranches(allBranches bool) ScanOption {
+	return func(scanOptions *ScanOptions) {
+		scanOptions.AllBranches = allBranches
+	}
+}
+
+func ScanOptionIncludeTags(includeTags bool) ScanOption {
+	return func(scanOptions *ScanOptions) {
+		scanOptions.IncludeTags = includeTags
+	}
+}
+
+func ScanOptionIncludeUnreachable(includeUnreachable bool) ScanOption {
+	return func(scanOptions *ScanOptions) {
+		scanOptions.IncludeUnreachable = includeUnreachable
+	}
+}
+
+func ScanOptionRecurseSubmodules(recurseSubmodules bool) ScanOption {
+	return func(scanOptions *ScanOptions) {
+		scanOptions.RecurseSubmodules = recurseSubmodules
+	}
+}
+
+func ScanOptionAuthor(author string) ScanOption {
+	return func(scanOptions *ScanOptions) {
+		scanOptions.Author = author
+	}
+}
+
+func ScanOptionExcludeRevs(revs ...string) ScanOption {
+	return func(scanOptions *ScanOptions) {
+		scanOptions.ExcludeRevs = revs
+	}
+}
+
+func ScanOptionTimeout(timeout time.Duration) ScanOption {
+	return func(scanOptions *ScanOptions) {
+		scanOptions.Timeout = timeout
+	}
+}
+
+func ScanOptionBlame(blame bool) ScanOption {
+	return func(scanOptions *ScanOptions) {
+		scanOptions.Blame = blame
+	}
+}
+
+// logArgs returns the arguments of git log that limit the commits walked to
+// those of the author and within the Since and Until of the log options.
+func (scanOptions *ScanOptions) logArgs() []string {
+	var args []string
+	if scanOptions.Author != "" {
+		args = append(args, "--author="+scanOptions.Author)
+	}
+	if logOptions := scanOptions.LogOptions; logOptions != nil {
+		if logOptions.Since != nil {
+			args = append(args, "--since="+logOptions.Since.Format(time.RFC3339))
+		}
+		if logOptions.Until != nil {
+			args = append(args, "--until="+logOptions.Until.Format(time.RFC3339))
+		}
+	}
+	return args
+}
+
+// includesUnstaged reports whether the unstaged changes of a repo pass the
+// author and date filters of logArgs. They have no author yet, and are dated
+// now.
+func (scanOptions *ScanOptions) includesUnstaged(now time.Time) bool {
+	if scanOptions.Author != "" {
+		return false
+	}
+	if logOptions := scanOptions.LogOptions; logOptions != nil {
+		if logOptions.Since != nil && now.Before(*logOptions.Since) {
+			return false
+		}
+		if logOptions.Until != nil && now.After(*logOptions.Until) {
+			return false
+		}
+	}
+	return true
+}
+
+// excludeArgs returns the arguments of git log excluding the history of the
+// ExcludeRevs the repo has. Revs it lacks, such as commits dropped by a force
+// push, would make git log fail.
+func (scanOptions *ScanOptions) excludeArgs(repo *git.Repository) []string {
+	var args []string
+	for _, rev := range scanOptions.ExcludeRevs {
+		if repo == nil || !plumbing.IsHash(rev) || repo.Storer.HasEncodedObject(plumbing.NewHash(rev)) != nil {
+			continue
+		}
+		args = append(args, "^"+rev)
+	}
+	return args
+}
+
 func NewScanOptions(options ...ScanOption) *ScanOptions {
 	scanOptions := &ScanOptions{
 		Filter:   common.FilterEmpty(),
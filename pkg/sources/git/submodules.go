@@ -0,0 +1,92 @@
+package git
+
+import (
+	"os/exec"
+	"path"
+	"path/filepath"
+
+	"github.com/go-errors/errors"
+	"github.com/go-git/go-git/v5"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// ScanSubmodules clones the submodules of a repo and scans the history of the
+// commits they are pinned to. Their results are attributed to the URL of the
+// submodule and to files under its path in the repo.
+func (s *Git) ScanSubmodules(ctx context.Context, repo *git.Repository, repoPath string, scanOptions *ScanOptions, chunksChan chan *sources.Chunk) error {
+	worktree, err := repo.Worktree()
+	if err != nil {
+		// Bare repos have no submodules checked out.
+		return nil
+	}
+	submodules, err := worktree.Submodules()
+	if err != nil {
+		return err
+	}
+	if len(submodules) == 0 {
+		return nil
+	}
+
+	// Nested submodules are initialized when their parent is scanned.
+	cmd := exec.Command("git", "-C", repoPath, "submodule", "update", "--init")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return errors.WrapPrefix(errors.New(string(out)), "error running 'git submodule update'", 0)
+	}
+
+	for _, submodule := range submodules {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		subPath := submodule.Config().Path
+		logger := ctx.Logger().WithValues("submodule", subPath)
+
+		status, err := submodule.Status()
+		if err != nil {
+			logger.V(1).Info("could not get submodule status", "error", err)
+			continue
+		}
+		subRepo, err := submodule.Repository()
+		if err != nil {
+			logger.V(1).Info("could not open submodule", "error", err)
+			continue
+		}
+
+		options := *scanOptions
+		options.BaseHash = ""
+		options.HeadHash = status.Expected.String()
+		// The branches of the submodule aren't those of the repo.
+		options.AllBranches = false
+
+		sub := *s
+		sub.sourceMetadataFunc = submoduleMetadataFunc(s.sourceMetadataFunc, subPath)
+		logger.V(1).Info("scanning submodule", "url", submodule.Config().URL, "commit", options.HeadHash)
+		if err := sub.ScanRepo(ctx, subRepo, filepath.Join(repoPath, subPath), &options, chunksChan); err != nil {
+			logger.Error(err, "error scanning submodule")
+		}
+	}
+	return nil
+}
+
+// submoduleMetadataFunc prefixes the files of the metadata with the path of
+// the submodule. Links are still generated from the path within the submodule,
+// since they point to the repo of the submodule.
+func submoduleMetadataFunc(metadataFunc func(file, email, commit, timestamp, repository string, line int64) *source_metadatapb.MetaData, subPath string) func(file, email, commit, timestamp, repository string, line int64) *source_metadatapb.MetaData {
+	return func(file, email, commit, timestamp, repository string, line int64) *source_metadatapb.MetaData {
+		metadata := metadataFunc(file, email, commit, timestamp, repository, line)
+		if file == "" {
+			return metadata
+		}
+		switch data := metadata.GetData().(type) {
+		case *source_metadatapb.MetaData_Git:
+			data.Git.File = path.Join(subPath, data.Git.File)
+		case *source_metadatapb.MetaData_Github:
+			data.Github.File = path.Join(subPath, data.Github.File)
+		case *source_metadatapb.MetaData_Gitlab:
+			data.Gitlab.File = path.Join(subPath, data.Gitlab.File)
+		}
+		return metadata
+	}
+}
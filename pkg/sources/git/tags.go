@@ -0,0 +1,44 @@
+package git
+
+import (
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// ScanTags chunks the messages of the annotated tags of a repo. The commits
+// reachable from tags are scanned with the others, since the history of every
+// ref is walked unless a branch is set.
+func (s *Git) ScanTags(ctx context.Context, repo *git.Repository, chunksChan chan *sources.Chunk) error {
+	// get the URL metadata for reporting (may be empty)
+	urlMetadata := getSafeRemoteURL(repo, "origin")
+
+	tags, err := repo.TagObjects()
+	if err != nil {
+		return err
+	}
+	defer tags.Close()
+
+	return tags.ForEach(func(tag *object.Tag) error {
+		if ctx.Err() != nil {
+			return storer.ErrStop
+		}
+		if tag.Message == "" {
+			return nil
+		}
+		// Tags are attributed to the object they point to and to their ref.
+		metadata := s.commitMetadata("", tag.Tagger.String(), tag.Target.String(), tag.Tagger.When.String(), urlMetadata, "refs/tags/"+tag.Name, 0)
+		chunksChan <- &sources.Chunk{
+			SourceName:     s.sourceName,
+			SourceID:       s.sourceID,
+			SourceType:     s.sourceType,
+			SourceMetadata: metadata,
+			Data:           []byte(tag.Name + "\n" + tag.Message),
+			Verify:         s.verify,
+		}
+		return nil
+	})
+}
@@ -0,0 +1,99 @@
+package git
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/gitparse"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// fsckObjects returns the hashes of the commits and blobs git fsck reports
+// with the given flag, like --unreachable or --dangling. Reflogs are ignored
+// so that the commits only they point to are reported too.
+func fsckObjects(path, flag string) (commits, blobs []string, err error) {
+	cmd := exec.Command("git", "-C", path, "fsck", "--no-reflogs", "--no-progress", flag)
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not run git fsck: %w", err)
+	}
+	for _, line := range strings.Split(string(out), "\n") {
+		// Lines look like "unreachable commit <hash>".
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			continue
+		}
+		switch fields[1] {
+		case "commit":
+			commits = append(commits, fields[2])
+		case "blob":
+			blobs = append(blobs, fields[2])
+		}
+	}
+	return commits, blobs, nil
+}
+
+// ScanUnreachable chunks the commits no ref points to anymore, such as those
+// only left in reflogs after a reset or a rebase, and the dangling blobs that
+// were staged but never committed. They survive until git garbage collects them.
+func (s *Git) ScanUnreachable(ctx context.Context, repo *git.Repository, path string, scanOptions *ScanOptions, chunksChan chan *sources.Chunk) error {
+	commits, _, err := fsckObjects(path, "--unreachable")
+	if err != nil {
+		return err
+	}
+	// Blobs of unreachable commits are unreachable too, but not dangling.
+	_, blobs, err := fsckObjects(path, "--dangling")
+	if err != nil {
+		return err
+	}
+	ctx.Logger().V(1).Info("scanning unreachable objects", "commits", len(commits), "blobs", len(blobs))
+
+	if len(commits) > 0 {
+		commitChan, err := gitparse.Commits(ctx, path, commits)
+		if err != nil {
+			return err
+		}
+		// The commits aren't part of a history, so neither the base nor the
+		// depth of the scan apply.
+		options := NewScanOptions(ScanOptionFilter(scanOptions.Filter))
+		if err := s.scanCommitChan(ctx, repo, path, commitChan, options, repoBlobs(repo), chunksChan); err != nil {
+			return err
+		}
+	}
+
+	urlMetadata := getSafeRemoteURL(repo, "origin")
+	for _, hash := range blobs {
+		if common.IsDone(ctx) {
+			return ctx.Err()
+		}
+		blob, err := repo.BlobObject(plumbing.NewHash(hash))
+		if err != nil {
+			ctx.Logger().V(1).Info("could not read dangling blob", "blob", hash, "error", err)
+			continue
+		}
+		reader, err := blob.Reader()
+		if err != nil {
+			continue
+		}
+		// Dangling blobs have neither a commit nor a file name.
+		chunkSkel := &sources.Chunk{
+			SourceName:     s.sourceName,
+			SourceID:       s.sourceID,
+			SourceType:     s.sourceType,
+			SourceMetadata: s.sourceMetadataFunc("", "", hash, "", urlMetadata, 0),
+			Verify:         s.verify,
+		}
+		err = sources.ChunkReader(ctx, reader, chunkSkel, chunksChan)
+		reader.Close()
+		if err != nil {
+			ctx.Logger().V(1).Info("could not read dangling blob", "blob", hash, "error", err)
+		}
+	}
+	return nil
+}
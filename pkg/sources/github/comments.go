@@ -0,0 +1,167 @@
+package github
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v42/github"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// repoOwnerAndName returns the owner and name of a repository from its clone URL.
+// Gists have no owner in their URL and are not supported.
+func repoOwnerAndName(repoURL string) (string, string, bool) {
+	u, err := url.Parse(repoURL)
+	if err != nil {
+		return "", "", false
+	}
+	parts := strings.Split(strings.TrimSuffix(u.Path, ".git"), "/")
+	if len(parts) != 3 || parts[1] == "" || parts[2] == "" {
+		return "", "", false
+	}
+	return parts[1], parts[2], true
+}
+
+// listPages calls list for each page of results until there are no pages left.
+func listPages[T any](list func(opts github.ListOptions) ([]T, *github.Response, error), fn func(T) error) error {
+	opts := github.ListOptions{PerPage: defaultPagination}
+	for {
+		items, res, err := list(opts)
+		if err == nil {
+			res.Body.Close()
+		}
+		if handled := HandleRateLimit(err, res); handled {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		for _, item := range items {
+			if err := fn(item); err != nil {
+				return err
+			}
+		}
+		if res == nil || res.NextPage == 0 {
+			return nil
+		}
+		opts.Page = res.NextPage
+	}
+}
+
+// apiChunk returns a chunk of content fetched from the API rather than from a clone.
+func (s *Source) apiChunk(meta *source_metadatapb.Github, data []byte) *sources.Chunk {
+	return &sources.Chunk{
+		SourceType: s.Type(),
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		Data:       data,
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Github{
+				Github: meta,
+			},
+		},
+		Verify: s.verify,
+	}
+}
+
+func (s *Source) sendChunk(ctx context.Context, chunk *sources.Chunk, chunksChan chan *sources.Chunk) error {
+	for c := range sources.Chunker(chunk) {
+		select {
+		case chunksChan <- c:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// scanComments scans the issues, pull requests and comments of a repository,
+// where pasted credentials live outside of its git history.
+func (s *Source) scanComments(ctx context.Context, repoURL string, chunksChan chan *sources.Chunk) error {
+	owner, name, ok := repoOwnerAndName(repoURL)
+	if !ok {
+		return nil
+	}
+	visibility := s.visibilityOf(repoURL)
+	send := func(link, user string, timestamp time.Time, data string) error {
+		if strings.TrimSpace(data) == "" {
+			return nil
+		}
+		return s.sendChunk(ctx, s.apiChunk(&source_metadatapb.Github{
+			Link:       sanitizer.UTF8(link),
+			Username:   sanitizer.UTF8(user),
+			Repository: sanitizer.UTF8(repoURL),
+			Timestamp:  sanitizer.UTF8(timestamp.String()),
+			Visibility: visibility,
+		}, []byte(data)), chunksChan)
+	}
+
+	if s.conn.IncludeIssueComments {
+		// Pull requests are issues too, their descriptions are scanned with
+		// the pull requests.
+		err := listPages(func(opts github.ListOptions) ([]*github.Issue, *github.Response, error) {
+			return s.apiClient.Issues.ListByRepo(ctx, owner, name, &github.IssueListByRepoOptions{State: "all", ListOptions: opts})
+		}, func(issue *github.Issue) error {
+			if issue.IsPullRequest() {
+				return nil
+			}
+			return send(issue.GetHTMLURL(), issue.GetUser().GetLogin(), issue.GetCreatedAt(), issue.GetTitle()+"\n"+issue.GetBody())
+		})
+		if err != nil {
+			return fmt.Errorf("could not list issues for repo %s: %w", repoURL, err)
+		}
+	}
+
+	// Comments on the conversation of a pull request are issue comments.
+	if s.conn.IncludeIssueComments || s.conn.IncludePullRequestComments {
+		err := listPages(func(opts github.ListOptions) ([]*github.IssueComment, *github.Response, error) {
+			return s.apiClient.Issues.ListComments(ctx, owner, name, 0, &github.IssueListCommentsOptions{ListOptions: opts})
+		}, func(comment *github.IssueComment) error {
+			isPullRequest := strings.Contains(comment.GetHTMLURL(), "/pull/")
+			if (isPullRequest && !s.conn.IncludePullRequestComments) || (!isPullRequest && !s.conn.IncludeIssueComments) {
+				return nil
+			}
+			return send(comment.GetHTMLURL(), comment.GetUser().GetLogin(), comment.GetCreatedAt(), comment.GetBody())
+		})
+		if err != nil {
+			return fmt.Errorf("could not list issue comments for repo %s: %w", repoURL, err)
+		}
+	}
+
+	if !s.conn.IncludePullRequestComments {
+		return nil
+	}
+	err := listPages(func(opts github.ListOptions) ([]*github.PullRequest, *github.Response, error) {
+		return s.apiClient.PullRequests.List(ctx, owner, name, &github.PullRequestListOptions{State: "all", ListOptions: opts})
+	}, func(pr *github.PullRequest) error {
+		return send(pr.GetHTMLURL(), pr.GetUser().GetLogin(), pr.GetCreatedAt(), pr.GetTitle()+"\n"+pr.GetBody())
+	})
+	if err != nil {
+		return fmt.Errorf("could not list pull requests for repo %s: %w", repoURL, err)
+	}
+
+	err = listPages(func(opts github.ListOptions) ([]*github.PullRequestComment, *github.Response, error) {
+		return s.apiClient.PullRequests.ListComments(ctx, owner, name, 0, &github.PullRequestListCommentsOptions{ListOptions: opts})
+	}, func(comment *github.PullRequestComment) error {
+		return send(comment.GetHTMLURL(), comment.GetUser().GetLogin(), comment.GetCreatedAt(), comment.GetBody())
+	})
+	if err != nil {
+		return fmt.Errorf("could not list review comments for repo %s: %w", repoURL, err)
+	}
+
+	err = listPages(func(opts github.ListOptions) ([]*github.RepositoryComment, *github.Response, error) {
+		return s.apiClient.Repositories.ListComments(ctx, owner, name, &opts)
+	}, func(comment *github.RepositoryComment) error {
+		return send(comment.GetHTMLURL(), comment.GetUser().GetLogin(), comment.GetCreatedAt(), comment.GetBody())
+	})
+	if err != nil {
+		return fmt.Errorf("could not list commit comments for repo %s: %w", repoURL, err)
+	}
+	return nil
+}
@@ -40,6 +40,10 @@ const (
 	unauthGithubOrgRateLimt = 30
 	defaultPagination       = 100
 	membersAppPagination    = 500
+	// installationTokenRefreshMargin is how long before they expire
+	// installation tokens are refreshed, so clones don't start with a token
+	// about to expire.
+	installationTokenRefreshMargin = 10 * time.Minute
 )
 
 type Source struct {
@@ -52,18 +56,24 @@ type Source struct {
 	repos,
 	orgs,
 	members,
+	users,
 	includeRepos,
 	ignoreRepos []string
-	git             *git.Git
-	httpClient      *http.Client
-	log             *log.Entry
-	conn            *sourcespb.GitHub
-	jobPool         *errgroup.Group
-	resumeInfoMutex sync.Mutex
-	resumeInfoSlice []string
-	apiClient       *github.Client
-	mu              sync.Mutex
-	publicMap       map[string]source_metadatapb.Visibility
+	git               *git.Git
+	httpClient        *http.Client
+	downloadClient    *http.Client
+	cloneArgs         []string
+	log               *log.Entry
+	conn              *sourcespb.GitHub
+	jobPool           *errgroup.Group
+	resumeInfoMutex   sync.Mutex
+	resumeInfoSlice   []string
+	checkpoint        *sources.RepoCheckpoint
+	apiClient         *github.Client
+	mu                sync.Mutex
+	publicMap         map[string]source_metadatapb.Visibility
+	tokenMu           sync.Mutex
+	installationToken *github.InstallationToken
 	sources.Progress
 }
 
@@ -94,13 +104,20 @@ func (s *Source) UserAndToken(ctx context.Context, installationClient *github.Cl
 		if err != nil {
 			return "", "", errors.New(err)
 		}
+		s.tokenMu.Lock()
+		defer s.tokenMu.Unlock()
+		if s.installationToken != nil && time.Until(s.installationToken.GetExpiresAt()) > installationTokenRefreshMargin {
+			return "x-access-token", s.installationToken.GetToken(), nil
+		}
 		// TODO: Check rate limit for this call.
 		token, _, err := installationClient.Apps.CreateInstallationToken(
 			ctx, id, &github.InstallationTokenOptions{})
 		if err != nil {
 			return "", "", errors.WrapPrefix(err, "unable to create installation token", 0)
 		}
-		return "x-access-token", token.GetToken(), nil // TODO: multiple workers request this, track the TTL
+		s.log.Debugf("created installation token expiring at %s", token.GetExpiresAt())
+		s.installationToken = token
+		return "x-access-token", token.GetToken(), nil
 	case *sourcespb.GitHub_Token:
 		var (
 			ghUser *github.User
@@ -109,7 +126,7 @@ func (s *Source) UserAndToken(ctx context.Context, installationClient *github.Cl
 		)
 		for {
 			ghUser, resp, err = s.apiClient.Users.Get(context.TODO(), "")
-			if handled := handleRateLimit(err, resp); handled {
+			if handled := HandleRateLimit(err, resp); handled {
 				continue
 			}
 			if err != nil {
@@ -136,6 +153,7 @@ func (s *Source) Init(aCtx context.Context, name string, jobID, sourceID int64,
 
 	s.httpClient = common.RetryableHttpClientTimeout(60)
 	s.apiClient = github.NewClient(s.httpClient)
+	s.downloadClient = common.RetryableHttpClientTimeout(300)
 
 	var conn sourcespb.GitHub
 	err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{})
@@ -144,8 +162,27 @@ func (s *Source) Init(aCtx context.Context, name string, jobID, sourceID int64,
 	}
 	s.conn = &conn
 
+	if s.checkpoint, err = sources.LoadRepoCheckpoint(s.conn.StateFile); err != nil {
+		return errors.WrapPrefix(err, "could not load checkpoint", 0)
+	}
+
+	if s.conn.TlsCa != "" || s.conn.InsecureSkipVerifyTls {
+		if s.httpClient, err = common.RetryableHttpClientTLS(60, s.conn.TlsCa, s.conn.InsecureSkipVerifyTls); err != nil {
+			return err
+		}
+		if s.downloadClient, err = common.RetryableHttpClientTLS(300, s.conn.TlsCa, s.conn.InsecureSkipVerifyTls); err != nil {
+			return err
+		}
+		s.apiClient = github.NewClient(s.httpClient)
+	}
+
+	if _, unauthenticated := s.conn.GetCredential().(*sourcespb.GitHub_Unauthenticated); unauthenticated && s.conn.UseGraphql {
+		return fmt.Errorf("enumerating repositories with GraphQL requires authentication")
+	}
+
 	s.repos = s.conn.Repositories
 	s.orgs = s.conn.Organizations
+	s.users = s.conn.Users
 	s.includeRepos = s.conn.IncludeRepos
 	s.ignoreRepos = s.conn.IgnoreRepos
 
@@ -208,7 +245,7 @@ func (s *Source) visibilityOf(repoURL string) (visibility source_metadatapb.Visi
 		repoName = strings.TrimSuffix(repoName, ".git")
 		for {
 			gist, resp, err = s.apiClient.Gists.Get(context.TODO(), repoName)
-			if !handleRateLimit(err, resp) {
+			if !HandleRateLimit(err, resp) {
 				break
 			}
 		}
@@ -230,7 +267,7 @@ func (s *Source) visibilityOf(repoURL string) (visibility source_metadatapb.Visi
 		repoName = strings.TrimSuffix(repoName, ".git")
 		for {
 			repo, resp, err = s.apiClient.Repositories.Get(context.TODO(), owner, repoName)
-			if !handleRateLimit(err, resp) {
+			if !HandleRateLimit(err, resp) {
 				break
 			}
 		}
@@ -266,10 +303,12 @@ func (s *Source) enumerateUnauthenticated(ctx context.Context) {
 			log.WithError(err).Errorf("error fetching repos for org or user: %s", org)
 		}
 
-		if s.conn.ScanUsers {
+		if s.conn.ScanUsers || s.conn.IncludeGists {
 			log.Warn("Enumerating unauthenticated does not support scanning organization members")
 		}
 	}
+
+	s.addUsers(ctx)
 }
 
 func (s *Source) enumerateWithToken(ctx context.Context, apiEndpoint, token string) error {
@@ -303,7 +342,7 @@ func (s *Source) enumerateWithToken(ctx context.Context, apiEndpoint, token stri
 
 	specificScope := false
 
-	if len(s.repos) > 0 {
+	if len(s.repos) > 0 || len(s.users) > 0 {
 		specificScope = true
 	}
 
@@ -313,7 +352,7 @@ func (s *Source) enumerateWithToken(ctx context.Context, apiEndpoint, token stri
 	)
 	for {
 		ghUser, resp, err = s.apiClient.Users.Get(context.TODO(), "")
-		if handled := handleRateLimit(err, resp); handled {
+		if handled := HandleRateLimit(err, resp); handled {
 			continue
 		}
 		if err != nil {
@@ -321,6 +360,8 @@ func (s *Source) enumerateWithToken(ctx context.Context, apiEndpoint, token stri
 		}
 		break
 	}
+	// Secret gists are only listed for the authenticated user.
+	s.githubUser = ghUser.GetLogin()
 
 	if len(s.orgs) > 0 {
 		specificScope = true
@@ -329,7 +370,7 @@ func (s *Source) enumerateWithToken(ctx context.Context, apiEndpoint, token stri
 				log.WithError(err).Errorf("error fetching repos for org: %s", org)
 			}
 
-			if s.conn.ScanUsers {
+			if s.conn.ScanUsers || s.conn.IncludeGists {
 				err := s.addMembersByOrg(ctx, org)
 				if err != nil {
 					log.WithError(err).Infof("Unable to add members by org for org %s", org)
@@ -367,7 +408,7 @@ func (s *Source) enumerateWithToken(ctx context.Context, apiEndpoint, token stri
 				log.WithError(err).Errorf("error fetching gists by org: %s", org)
 			}
 
-			if s.conn.ScanUsers {
+			if s.conn.ScanUsers || s.conn.IncludeGists {
 				err := s.addMembersByOrg(ctx, org)
 				if err != nil {
 					log.WithError(err).Infof("Unable to add members by org for org %s", org)
@@ -383,12 +424,19 @@ func (s *Source) enumerateWithToken(ctx context.Context, apiEndpoint, token stri
 		}
 	}
 
+	s.addUsers(ctx)
+
 	if s.conn.ScanUsers {
 		log.Infof("Adding repos from %d members in %d organizations.", len(s.members), len(s.orgs))
 		s.addReposForMembers(ctx)
 		return nil
 	}
 
+	if s.conn.IncludeGists {
+		log.Infof("Adding gists from %d members in %d organizations.", len(s.members), len(s.orgs))
+		s.addGistsForMembers(ctx)
+	}
+
 	return nil
 }
 
@@ -440,11 +488,13 @@ func (s *Source) enumerateWithApp(ctx context.Context, apiEndpoint string, app *
 		}
 
 		// Check if we need to find user repos.
-		if s.conn.ScanUsers {
+		if s.conn.ScanUsers || s.conn.IncludeGists {
 			err := s.addMembersByApp(ctx, installationClient)
 			if err != nil {
 				return nil, err
 			}
+		}
+		if s.conn.ScanUsers {
 			log.Infof("Scanning repos from %v organization members.", len(s.members))
 			for _, member := range s.members {
 				if err = s.addGistsByUser(ctx, member); err != nil {
@@ -454,9 +504,14 @@ func (s *Source) enumerateWithApp(ctx context.Context, apiEndpoint string, app *
 					log.WithError(err).Error("error fetching repos by user")
 				}
 			}
+		} else if s.conn.IncludeGists {
+			log.Infof("Scanning gists from %v organization members.", len(s.members))
+			s.addGistsForMembers(ctx)
 		}
 	}
 
+	s.addUsers(ctx)
+
 	return installationClient, nil
 }
 
@@ -470,6 +525,18 @@ func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) err
 	var installationClient *github.Client
 	var err error
 
+	var cleanup func()
+	if s.cloneArgs, cleanup, err = git.TLSCloneArgs(s.conn.TlsCa, s.conn.InsecureSkipVerifyTls); err != nil {
+		return err
+	}
+	defer cleanup()
+	sshArgs, sshCleanup, err := git.SSHCloneArgs(s.conn.SshKey, s.conn.SshKeyPassphrase)
+	if err != nil {
+		return err
+	}
+	defer sshCleanup()
+	s.cloneArgs = append(s.cloneArgs, sshArgs...)
+
 	switch cred := s.conn.GetCredential().(type) {
 	case *sourcespb.GitHub_Unauthenticated:
 		s.enumerateUnauthenticated(ctx)
@@ -510,10 +577,17 @@ func (s *Source) scan(ctx context.Context, installationClient *github.Client, ch
 	var scanErrs []error
 	for i, repoURL := range s.repos {
 		i, repoURL := i, repoURL
+		// Don't start clones, and the API requests that come with them, while rate limited.
+		waitRateLimit(ctx)
 		s.jobPool.Go(func() error {
 			if common.IsDone(ctx) {
 				return nil
 			}
+			progress := s.checkpoint.Progress(repoURL)
+			if progress.Done {
+				s.log.WithField("repo", repoURL).Debug("skipping repo scanned before the scan was interrupted")
+				return nil
+			}
 
 			// TODO: set progress complete is being called concurrently with i
 			s.setProgressCompleteWithRepo(i, progressIndexOffset, repoURL)
@@ -547,12 +621,41 @@ func (s *Source) scan(ctx context.Context, installationClient *github.Client, ch
 			scanOptions := git.NewScanOptions(
 				git.ScanOptionBaseHash(s.conn.Base),
 				git.ScanOptionHeadCommit(s.conn.Head),
+				git.ScanOptionAllBranches(s.conn.AllBranches),
+				git.ScanOptionIncludeTags(s.conn.IncludeTags),
+				git.ScanOptionBlame(s.conn.Blame),
+				git.ScanOptionExcludeRevs(progress.Heads...),
+				git.ScanOptionTimeout(time.Duration(s.conn.RepoTimeoutSeconds)*time.Second),
 			)
 
 			if err = s.git.ScanRepo(ctx, repo, path, scanOptions, chunksChan); err != nil {
 				log.WithError(err).Errorf("unable to scan repo, continuing")
 				return nil
 			}
+			if s.conn.IncludeWikis {
+				s.scanWiki(ctx, repoURL, installationClient, chunksChan)
+			}
+			if s.conn.IncludeIssueComments || s.conn.IncludePullRequestComments {
+				if err = s.scanComments(ctx, repoURL, chunksChan); err != nil {
+					log.WithError(err).Errorf("unable to scan comments, continuing")
+				}
+			}
+			if s.conn.IncludeReleases {
+				if err = s.scanReleases(ctx, repoURL, chunksChan); err != nil {
+					log.WithError(err).Errorf("unable to scan releases, continuing")
+				}
+			}
+			// Repos whose scan was cancelled are scanned again on resume.
+			if common.IsDone(ctx) {
+				return nil
+			}
+			heads, err := git.RefHeads(repo)
+			if err == nil {
+				err = s.checkpoint.RepoScanned(repoURL, heads)
+			}
+			if err != nil {
+				log.WithError(err).Error("could not save checkpoint")
+			}
 			atomic.AddUint64(&scanned, 1)
 			log.Debugf("scanned %d/%d repos", scanned, len(s.repos))
 
@@ -564,18 +667,75 @@ func (s *Source) scan(ctx context.Context, installationClient *github.Client, ch
 	if len(scanErrs) == 0 {
 		s.SetProgressComplete(len(s.repos), len(s.repos), "Completed Github scan", "")
 	}
+	if !common.IsDone(ctx) {
+		if err := s.checkpoint.ScanCompleted(); err != nil {
+			log.WithError(err).Error("could not save checkpoint")
+		}
+	}
 
 	return scanErrs
 }
 
+// wikiURL returns the clone URL of the wiki of a repository.
+func wikiURL(repoURL string) (string, bool) {
+	if _, _, ok := repoOwnerAndName(repoURL); !ok {
+		return "", false
+	}
+	return strings.TrimSuffix(repoURL, ".git") + ".wiki.git", true
+}
+
+// scanWiki clones and scans the wiki of a repository. Wikis are separate git
+// repositories that only exist once their first page is created.
+func (s *Source) scanWiki(ctx context.Context, repoURL string, installationClient *github.Client, chunksChan chan *sources.Chunk) {
+	wiki, ok := wikiURL(repoURL)
+	if !ok {
+		return
+	}
+	// Wikis share the visibility of their repository.
+	visibility := s.visibilityOf(repoURL)
+	s.mu.Lock()
+	s.publicMap[wiki] = visibility
+	s.mu.Unlock()
+
+	path, repo, err := s.cloneRepo(ctx, wiki, installationClient)
+	defer os.RemoveAll(path)
+	if err != nil {
+		s.log.WithError(err).Debugf("unable to clone wiki of %s, it may not exist", repoURL)
+		return
+	}
+	if err = s.git.ScanRepo(ctx, repo, path, git.NewScanOptions(), chunksChan); err != nil {
+		log.WithError(err).Errorf("unable to scan wiki of %s, continuing", repoURL)
+	}
+}
+
 func (s *Source) cloneRepo(ctx context.Context, repoURL string, installationClient *github.Client) (string, *gogit.Repository, error) {
 	var path string
 	var repo *gogit.Repository
 	var err error
 
+	// Credentials dedicated to cloning take precedence over those of the API.
+	switch {
+	case s.conn.SshKey != "":
+		path, repo, err = git.CloneRepoOverSSH(ctx, repoURL, s.cloneArgs...)
+		if err != nil {
+			return "", nil, fmt.Errorf("error cloning repo %s: %w", repoURL, err)
+		}
+		return path, repo, nil
+	case s.conn.HttpCredentials != "":
+		userInfo, err := git.ParseHTTPCredentials(s.conn.HttpCredentials)
+		if err != nil {
+			return "", nil, err
+		}
+		path, repo, err = git.CloneRepo(ctx, userInfo, repoURL, s.cloneArgs...)
+		if err != nil {
+			return "", nil, fmt.Errorf("error cloning repo %s: %w", repoURL, err)
+		}
+		return path, repo, nil
+	}
+
 	switch s.conn.GetCredential().(type) {
 	case *sourcespb.GitHub_Unauthenticated:
-		path, repo, err = git.CloneRepoUsingUnauthenticated(ctx, repoURL)
+		path, repo, err = git.CloneRepoUsingUnauthenticated(ctx, repoURL, s.cloneArgs...)
 		if err != nil {
 			return "", nil, fmt.Errorf("error cloning repo %s: %w", repoURL, err)
 		}
@@ -586,7 +746,7 @@ func (s *Source) cloneRepo(ctx context.Context, repoURL string, installationClie
 			return "", nil, fmt.Errorf("error getting token for repo %s: %w", repoURL, err)
 		}
 
-		path, repo, err = git.CloneRepoUsingToken(ctx, s.githubToken, repoURL, s.githubUser)
+		path, repo, err = git.CloneRepoUsingToken(ctx, s.githubToken, repoURL, s.githubUser, s.cloneArgs...)
 		if err != nil {
 			return "", nil, fmt.Errorf("error cloning repo %s: %w", repoURL, err)
 		}
@@ -599,7 +759,7 @@ func (s *Source) cloneRepo(ctx context.Context, repoURL string, installationClie
 				return "", nil, fmt.Errorf("error getting token for repo %s: %w", repoURL, err)
 			}
 		}
-		path, repo, err = git.CloneRepoUsingToken(ctx, s.githubToken, repoURL, s.githubUser)
+		path, repo, err = git.CloneRepoUsingToken(ctx, s.githubToken, repoURL, s.githubUser, s.cloneArgs...)
 		if err != nil {
 			return "", nil, fmt.Errorf("error cloning repo %s: %w", repoURL, err)
 		}
@@ -607,43 +767,183 @@ func (s *Source) cloneRepo(ctx context.Context, repoURL string, installationClie
 	return path, repo, nil
 }
 
-// handleRateLimit returns true if a rate limit was handled
+// rateLimitGate holds back the API requests and clones of every scan while
+// one of them waits for a rate limit to reset.
+var rateLimitGate struct {
+	mu    sync.Mutex
+	until time.Time
+}
+
+// pauseUntil makes waitRateLimit block until t.
+func pauseUntil(t time.Time) {
+	rateLimitGate.mu.Lock()
+	defer rateLimitGate.mu.Unlock()
+	if t.After(rateLimitGate.until) {
+		rateLimitGate.until = t
+	}
+}
+
+// waitRateLimit blocks until the rate limits hit so far are reset.
+func waitRateLimit(ctx context.Context) {
+	rateLimitGate.mu.Lock()
+	wait := time.Until(rateLimitGate.until)
+	rateLimitGate.mu.Unlock()
+	if wait <= 0 {
+		return
+	}
+	log.WithField("resumeTime", time.Now().Add(wait).String()).Debug("waiting for rate limit reset")
+	select {
+	case <-ctx.Done():
+	case <-time.After(wait):
+	}
+}
+
+// logQuota periodically logs the remaining quota of the API.
+func logQuota(res *github.Response) {
+	if res == nil || res.Rate.Limit == 0 || res.Rate.Remaining%500 != 0 {
+		return
+	}
+	log.WithField("remaining", res.Rate.Remaining).
+		WithField("limit", res.Rate.Limit).
+		WithField("reset", res.Rate.Reset.String()).
+		Info("GitHub API quota")
+}
+
+// HandleRateLimit returns true if a rate limit was handled
 // Unauthenticated access to most github endpoints has a rate limit of 60 requests per hour.
 // This will likely only be exhausted if many users/orgs are scanned without auth
-func handleRateLimit(errIn error, res *github.Response) bool {
-	limit, ok := errIn.(*github.RateLimitError)
-	if !ok {
+// Secondary rate limits, hit when making too many concurrent requests, are
+// waited out as well. Other scans are paused until the limit is reset.
+func HandleRateLimit(errIn error, res *github.Response) bool {
+	var duration time.Duration
+	switch limit := errIn.(type) {
+	case *github.RateLimitError:
+		duration = rateLimitWait(res)
+		if duration == 0 {
+			log.WithField("retry-after", limit.Message).Debug("handling rate limit (5 minutes retry)")
+			duration = time.Minute * 5
+		}
+	case *github.AbuseRateLimitError:
+		duration = limit.GetRetryAfter()
+		if duration == 0 {
+			duration = time.Minute
+		}
+		log.WithField("retry-after", duration.String()).Debug("handling secondary rate limit")
+	default:
+		logQuota(res)
 		return false
 	}
 
-	if res != nil {
-		knownWait := true
-		remaining, err := strconv.Atoi(res.Header.Get("x-ratelimit-remaining"))
-		if err != nil {
-			knownWait = false
-		}
-		resetTime, err := strconv.Atoi(res.Header.Get("x-ratelimit-reset"))
-		if err != nil || resetTime == 0 {
-			knownWait = false
-		}
+	resumeTime := time.Now().Add(duration)
+	log.WithField("resumeTime", resumeTime.String()).Infof("rate limited, pausing for %s", duration)
+	pauseUntil(resumeTime)
+	time.Sleep(duration)
+	return true
+}
 
-		if knownWait && remaining == 0 {
-			waitTime := int64(resetTime) - time.Now().Unix()
-			if waitTime > 0 {
-				duration := time.Duration(waitTime+1) * time.Second
-				log.WithField("resumeTime", time.Now().Add(duration).String()).Debugf("rate limited")
-				time.Sleep(duration)
-				return true
-			}
-		}
+// rateLimitWait returns how long to wait for the rate limit of a response to
+// be reset, or zero if it is not known.
+func rateLimitWait(res *github.Response) time.Duration {
+	if res == nil {
+		return 0
+	}
+	remaining, err := strconv.Atoi(res.Header.Get("x-ratelimit-remaining"))
+	if err != nil || remaining != 0 {
+		return 0
+	}
+	resetTime, err := strconv.Atoi(res.Header.Get("x-ratelimit-reset"))
+	if err != nil || resetTime == 0 {
+		return 0
+	}
+	waitTime := int64(resetTime) - time.Now().Unix()
+	if waitTime <= 0 {
+		return 0
+	}
+	return time.Duration(waitTime+1) * time.Second
+}
+
+// repoInfo holds the attributes of an enumerated repo used to filter it,
+// whichever API it was listed with.
+type repoInfo struct {
+	fullName string
+	cloneURL string
+	fork     bool
+	archived bool
+	empty    bool
+	language string
+	// sizeKB is the size of the repo in kilobytes, as reported by GitHub.
+	sizeKB   int64
+	pushedAt time.Time
+}
+
+func newRepoInfo(r *github.Repository) repoInfo {
+	return repoInfo{
+		fullName: r.GetFullName(),
+		cloneURL: r.GetCloneURL(),
+		fork:     r.GetFork(),
+		archived: r.GetArchived(),
+		// The size is only known to be zero if the API returned it.
+		empty:    r.Size != nil && r.GetSize() == 0,
+		language: r.GetLanguage(),
+		sizeKB:   int64(r.GetSize()),
+		pushedAt: r.GetPushedAt().Time,
+	}
+}
+
+// skipRepo returns true if an enumerated repo should not be scanned.
+func (s *Source) skipRepo(r repoInfo) bool {
+	if s.ignoreRepo(r.fullName) || !s.includeRepo(r.fullName) {
+		return true
+	}
+	if r.fork && !s.conn.IncludeForks {
+		return true
+	}
+	if r.empty {
+		s.log.WithField("repo", r.fullName).Info("skipping empty repository")
+		return true
+	}
+	if r.archived && s.conn.ExcludeArchived {
+		s.log.WithField("repo", r.fullName).Info("skipping archived repository")
+		return true
 	}
+	return !s.matchesRepoFilters(r)
+}
 
-	log.WithField("retry-after", limit.Message).Debug("handling rate limit (5 minutes retry)")
-	time.Sleep(time.Minute * 5)
+// matchesRepoFilters returns true if a repo has one of the configured languages,
+// is not larger than the size limit and was pushed to after the configured time.
+func (s *Source) matchesRepoFilters(r repoInfo) bool {
+	logger := s.log.WithField("repo", r.fullName)
+	if len(s.conn.Languages) > 0 && !containsFold(s.conn.Languages, r.language) {
+		logger.Debugf("skipping repository in language %q", r.language)
+		return false
+	}
+	if maxSize := s.conn.GetMaxRepoSize(); maxSize > 0 && r.sizeKB*1024 > maxSize {
+		logger.Debugf("skipping repository of %d KB", r.sizeKB)
+		return false
+	}
+	if after := s.conn.GetPushedAfter(); after != nil && !r.pushedAt.IsZero() && r.pushedAt.Before(after.AsTime()) {
+		logger.Debugf("skipping repository last pushed at %s", r.pushedAt)
+		return false
+	}
 	return true
 }
 
+func containsFold(values []string, v string) bool {
+	for _, value := range values {
+		if strings.EqualFold(value, v) {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *Source) getReposByOrg(ctx context.Context, org string) ([]string, error) {
+	if len(s.conn.Teams) > 0 {
+		return s.getReposByTeams(ctx, org)
+	}
+	if s.conn.UseGraphql {
+		return s.getReposGraphQL(ctx, org)
+	}
 	logger := s.log.WithField("org", org)
 
 	var repos []string
@@ -659,7 +959,7 @@ func (s *Source) getReposByOrg(ctx context.Context, org string) ([]string, error
 		if err == nil {
 			res.Body.Close()
 		}
-		if handled := handleRateLimit(err, res); handled {
+		if handled := HandleRateLimit(err, res); handled {
 			continue
 		}
 		if err != nil {
@@ -671,21 +971,15 @@ func (s *Source) getReposByOrg(ctx context.Context, org string) ([]string, error
 
 		s.log.Debugf("Listed repos for org %s page %d/%d", org, opts.Page, res.LastPage)
 		for _, r := range someRepos {
-			if s.ignoreRepo(r.GetFullName()) {
-				continue
-			}
-			if !s.includeRepo(r.GetFullName()) {
-				continue
-			}
-
+			info := newRepoInfo(r)
 			numRepos++
-			if r.GetFork() {
+			if info.fork {
 				numForks++
-				if !s.conn.IncludeForks {
-					continue
-				}
 			}
-			repos = append(repos, r.GetCloneURL())
+			if s.skipRepo(info) {
+				continue
+			}
+			repos = append(repos, info.cloneURL)
 		}
 		if res.NextPage == 0 {
 			break
@@ -696,6 +990,31 @@ func (s *Source) getReposByOrg(ctx context.Context, org string) ([]string, error
 	return repos, nil
 }
 
+// getReposByTeams lists the repos of an org that the configured teams have access to.
+// Teams are looked up in every org, so a team missing from one of them is skipped.
+func (s *Source) getReposByTeams(ctx context.Context, org string) ([]string, error) {
+	var repos []string
+	for _, team := range s.conn.Teams {
+		logger := s.log.WithField("org", org).WithField("team", team)
+		numRepos := 0
+		err := listPages(func(opts github.ListOptions) ([]*github.Repository, *github.Response, error) {
+			return s.apiClient.Teams.ListTeamReposBySlug(ctx, org, team, &opts)
+		}, func(r *github.Repository) error {
+			numRepos++
+			if info := newRepoInfo(r); !s.skipRepo(info) {
+				repos = append(repos, info.cloneURL)
+			}
+			return nil
+		})
+		if err != nil {
+			logger.WithError(err).Errorf("could not list repos for team %s in org %s", team, org)
+			continue
+		}
+		logger.Debugf("found %d repos", numRepos)
+	}
+	return repos, nil
+}
+
 func (s *Source) addRepos(ctx context.Context, entity string, getRepos func(context.Context, string) ([]string, error)) error {
 	repos, err := getRepos(ctx, entity)
 	if err != nil {
@@ -709,6 +1028,9 @@ func (s *Source) addRepos(ctx context.Context, entity string, getRepos func(cont
 }
 
 func (s *Source) getReposByUser(ctx context.Context, user string) ([]string, error) {
+	if s.conn.UseGraphql {
+		return s.getReposGraphQL(ctx, user)
+	}
 	var repos []string
 	opts := &github.RepositoryListOptions{
 		ListOptions: github.ListOptions{
@@ -721,7 +1043,7 @@ func (s *Source) getReposByUser(ctx context.Context, user string) ([]string, err
 		if err == nil {
 			res.Body.Close()
 		}
-		if handled := handleRateLimit(err, res); handled {
+		if handled := HandleRateLimit(err, res); handled {
 			continue
 		}
 		if err != nil {
@@ -733,17 +1055,9 @@ func (s *Source) getReposByUser(ctx context.Context, user string) ([]string, err
 
 		s.log.Debugf("Listed repos for user %s page %d/%d", user, opts.Page, res.LastPage)
 		for _, r := range someRepos {
-			if s.ignoreRepo(r.GetFullName()) {
-				continue
-			}
-			if !s.includeRepo(r.GetFullName()) {
-				continue
-			}
-
-			if r.GetFork() && !s.conn.IncludeForks {
-				continue
+			if info := newRepoInfo(r); !s.skipRepo(info) {
+				repos = append(repos, info.cloneURL)
 			}
-			repos = append(repos, r.GetCloneURL())
 		}
 		if res.NextPage == 0 {
 			break
@@ -788,14 +1102,21 @@ func (s *Source) ignoreRepo(r string) bool {
 }
 
 func (s *Source) getGistsByUser(ctx context.Context, user string) ([]string, error) {
+	// Listing the gists of the authenticated user, rather than the user by
+	// name, includes its secret gists.
+	listUser := user
+	if s.conn.GetIncludeGists() && user != "" && user == s.githubUser {
+		listUser = ""
+	}
+
 	var gistURLs []string
 	gistOpts := &github.GistListOptions{}
 	for {
-		gists, res, err := s.apiClient.Gists.List(ctx, user, gistOpts)
+		gists, res, err := s.apiClient.Gists.List(ctx, listUser, gistOpts)
 		if err == nil {
 			res.Body.Close()
 		}
-		if handled := handleRateLimit(err, res); handled {
+		if handled := HandleRateLimit(err, res); handled {
 			continue
 		}
 		if err != nil {
@@ -855,7 +1176,7 @@ func (s *Source) addReposByApp(ctx context.Context) error {
 		if err == nil {
 			res.Body.Close()
 		}
-		if handled := handleRateLimit(err, res); handled {
+		if handled := HandleRateLimit(err, res); handled {
 			continue
 		}
 		if err != nil {
@@ -896,7 +1217,7 @@ func (s *Source) addAllVisibleOrgs(ctx context.Context) {
 		if err == nil {
 			resp.Body.Close()
 		}
-		if handled := handleRateLimit(err, resp); handled {
+		if handled := HandleRateLimit(err, resp); handled {
 			continue
 		}
 		if err != nil {
@@ -934,7 +1255,7 @@ func (s *Source) addOrgsByUser(ctx context.Context, user string) {
 		if err == nil {
 			resp.Body.Close()
 		}
-		if handled := handleRateLimit(err, resp); handled {
+		if handled := HandleRateLimit(err, resp); handled {
 			continue
 		}
 		if err != nil {
@@ -976,7 +1297,7 @@ func (s *Source) addMembersByOrg(ctx context.Context, org string) error {
 		if err == nil {
 			defer res.Body.Close()
 		}
-		if handled := handleRateLimit(err, res); handled {
+		if handled := HandleRateLimit(err, res); handled {
 			continue
 		}
 		if err != nil || len(members) == 0 {
@@ -1016,6 +1337,31 @@ func (s *Source) addReposForMembers(ctx context.Context) {
 	}
 }
 
+func (s *Source) addGistsForMembers(ctx context.Context) {
+	log.Infof("Fetching gists from %d members", len(s.members))
+	for _, member := range s.members {
+		if err := s.addGistsByUser(ctx, member); err != nil {
+			log.WithError(err).Infof("Unable to fetch gists by user %s", member)
+		}
+	}
+}
+
+// addUsers adds the repos of the users specified in the connection, and their
+// gists if gists are included.
+func (s *Source) addUsers(ctx context.Context) {
+	for _, user := range s.users {
+		if err := s.addRepos(ctx, user, s.getReposByUser); err != nil {
+			log.WithError(err).Errorf("error fetching repos for user: %s", user)
+		}
+		if !s.conn.IncludeGists {
+			continue
+		}
+		if err := s.addGistsByUser(ctx, user); err != nil {
+			log.WithError(err).Errorf("error fetching gists for user: %s", user)
+		}
+	}
+}
+
 func (s *Source) normalizeRepos(ctx context.Context) {
 	// TODO: Add check/fix for repos that are missing scheme
 	normalizedRepos := map[string]struct{}{}
@@ -1,6 +1,7 @@
 package github
 
 import (
+	"archive/zip"
 	"bytes"
 	"crypto/rand"
 	"crypto/rsa"
@@ -8,6 +9,7 @@ import (
 	"encoding/pem"
 	"io"
 	"net/http"
+	"net/url"
 	"reflect"
 	"sort"
 	"strconv"
@@ -19,11 +21,14 @@ import (
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"gopkg.in/h2non/gock.v1"
 
 	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
 )
 
 func createTestSource(src *sourcespb.GitHub) (*Source, *anypb.Any) {
@@ -105,6 +110,117 @@ func TestAddReposByOrg_IncludeRepos(t *testing.T) {
 	assert.True(t, gock.IsDone())
 }
 
+func TestAddReposByOrg_ArchivedAndEmpty(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/orgs/super-secret-org/repos").
+		Reply(200).
+		JSON([]map[string]interface{}{
+			{"clone_url": "super-secret-repo", "full_name": "secret/super-secret-repo", "size": 42},
+			{"clone_url": "archived-repo", "full_name": "secret/archived-repo", "size": 42, "archived": true},
+			{"clone_url": "empty-repo", "full_name": "secret/empty-repo", "size": 0},
+		})
+
+	s := initTestSource(&sourcespb.GitHub{ExcludeArchived: true})
+	err := s.addRepos(context.TODO(), "super-secret-org", s.getReposByOrg)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"super-secret-repo"}, s.repos)
+	assert.True(t, gock.IsDone())
+}
+
+func TestAddReposByOrg_RepoFilters(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/orgs/super-secret-org/repos").
+		Reply(200).
+		JSON([]map[string]interface{}{
+			{"clone_url": "super-secret-repo", "full_name": "secret/super-secret-repo", "language": "Go", "size": 10, "pushed_at": "2023-01-01T00:00:00Z"},
+			{"clone_url": "python-repo", "full_name": "secret/python-repo", "language": "Python", "size": 10, "pushed_at": "2023-01-01T00:00:00Z"},
+			{"clone_url": "large-repo", "full_name": "secret/large-repo", "language": "go", "size": 2048, "pushed_at": "2023-01-01T00:00:00Z"},
+			{"clone_url": "stale-repo", "full_name": "secret/stale-repo", "language": "Go", "size": 10, "pushed_at": "2020-01-01T00:00:00Z"},
+		})
+
+	s := initTestSource(&sourcespb.GitHub{
+		Languages:   []string{"go"},
+		MaxRepoSize: 1024 * 1024,
+		PushedAfter: timestamppb.New(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+	})
+	err := s.addRepos(context.TODO(), "super-secret-org", s.getReposByOrg)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"super-secret-repo"}, s.repos)
+	assert.True(t, gock.IsDone())
+}
+
+func TestAddReposByOrg_Teams(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/orgs/super-secret-org/teams/security/repos").
+		Reply(200).
+		JSON([]map[string]string{
+			{"clone_url": "super-secret-repo", "full_name": "super-secret-org/super-secret-repo"},
+		})
+	gock.New("https://api.github.com").
+		Get("/orgs/super-secret-org/teams/missing/repos").
+		Reply(404).
+		JSON(map[string]string{"message": "Not Found"})
+
+	s := initTestSource(&sourcespb.GitHub{Teams: []string{"security", "missing"}})
+	err := s.addRepos(context.TODO(), "super-secret-org", s.getReposByOrg)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"super-secret-repo"}, s.repos)
+	assert.True(t, gock.IsDone())
+}
+
+func TestAddReposByOrg_GraphQL(t *testing.T) {
+	defer gock.Off()
+
+	page := func(hasNextPage bool, cursor string, nodes ...map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{"data": map[string]interface{}{"repositoryOwner": map[string]interface{}{
+			"repositories": map[string]interface{}{
+				"pageInfo": map[string]interface{}{"hasNextPage": hasNextPage, "endCursor": cursor},
+				"nodes":    nodes,
+			},
+		}}}
+	}
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`"cursor":null`).
+		Reply(200).
+		JSON(page(true, "abc",
+			map[string]interface{}{"nameWithOwner": "super-secret-org/super-secret-repo", "url": "https://github.com/super-secret-org/super-secret-repo"},
+			map[string]interface{}{"nameWithOwner": "super-secret-org/forked-repo", "url": "https://github.com/super-secret-org/forked-repo", "isFork": true},
+		))
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`"cursor":"abc"`).
+		Reply(200).
+		JSON(page(false, "",
+			map[string]interface{}{"nameWithOwner": "super-secret-org/ignored-repo", "url": "https://github.com/super-secret-org/ignored-repo"},
+		))
+
+	s := initTestSource(&sourcespb.GitHub{UseGraphql: true})
+	s.ignoreRepos = []string{"super-secret-org/ignored-*"}
+	err := s.addRepos(context.TODO(), "super-secret-org", s.getReposByOrg)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"https://github.com/super-secret-org/super-secret-repo.git"}, s.repos)
+	assert.True(t, gock.IsDone())
+}
+
+func TestGraphQLEndpoint(t *testing.T) {
+	tests := map[string]string{
+		"https://api.github.com/":            "https://api.github.com/graphql",
+		"https://github.example.com/api/v3/": "https://github.example.com/api/graphql",
+	}
+	for baseURL, want := range tests {
+		u, err := url.Parse(baseURL)
+		assert.Nil(t, err)
+		assert.Equal(t, want, graphQLEndpoint(u))
+	}
+}
+
 func TestAddReposByUser(t *testing.T) {
 	defer gock.Off()
 
@@ -282,13 +398,27 @@ func TestNormalizeRepos(t *testing.T) {
 }
 
 func TestHandleRateLimit(t *testing.T) {
-	assert.False(t, handleRateLimit(nil, nil))
+	assert.False(t, HandleRateLimit(nil, nil))
 
 	err := &github.RateLimitError{}
 	res := &github.Response{Response: &http.Response{Header: make(http.Header)}}
 	res.Header.Set("x-ratelimit-remaining", "0")
 	res.Header.Set("x-ratelimit-reset", strconv.FormatInt(time.Now().Unix()+1, 10))
-	assert.True(t, handleRateLimit(err, res))
+	assert.True(t, HandleRateLimit(err, res))
+}
+
+func TestHandleRateLimit_SecondaryRateLimit(t *testing.T) {
+	retryAfter := time.Second
+	err := &github.AbuseRateLimitError{RetryAfter: &retryAfter}
+	start := time.Now()
+	assert.True(t, HandleRateLimit(err, nil))
+	assert.GreaterOrEqual(t, time.Since(start), retryAfter)
+
+	// Other requests are paused until the limit is reset.
+	pauseUntil(time.Now().Add(100 * time.Millisecond))
+	start = time.Now()
+	waitRateLimit(context.TODO())
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
 }
 
 func TestEnumerateUnauthenticated(t *testing.T) {
@@ -333,6 +463,54 @@ func TestEnumerateWithToken(t *testing.T) {
 	assert.True(t, gock.IsDone())
 }
 
+func TestEnumerateWithToken_IncludeGists(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/user").
+		Reply(200).
+		JSON(map[string]string{"login": "super-secret-user"})
+
+	gock.New("https://api.github.com").
+		Get("/orgs/super-secret-org/repos").
+		Reply(200).
+		JSON([]map[string]string{{"clone_url": "super-secret-repo"}})
+
+	gock.New("https://api.github.com").
+		Get("/orgs/super-secret-org/members").
+		Reply(200).
+		JSON([]map[string]string{{"login": "super-secret-user"}, {"login": "super-secret-member"}})
+
+	gock.New("https://api.github.com").
+		Get("/users/super-secret-member/gists").
+		Reply(200).
+		JSON([]map[string]string{{"git_pull_url": "super-secret-member-gist"}})
+
+	gock.New("https://api.github.com").
+		Get("/users/other-user/repos").
+		Reply(200).
+		JSON([]map[string]string{{"clone_url": "other-user-repo"}})
+
+	gock.New("https://api.github.com").
+		Get("/users/other-user/gists").
+		Reply(200).
+		JSON([]map[string]string{{"git_pull_url": "other-user-gist"}})
+
+	// The gists of the authenticated user are listed without a username to
+	// include its secret gists.
+	gock.New("https://api.github.com").
+		Get("/gists").
+		Reply(200).
+		JSON([]map[string]interface{}{{"git_pull_url": "super-secret-gist", "public": false}})
+
+	s := initTestSource(&sourcespb.GitHub{IncludeGists: true, Users: []string{"other-user"}})
+	s.orgs = []string{"super-secret-org"}
+	err := s.enumerateWithToken(context.TODO(), "https://api.github.com", "token")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"super-secret-repo", "other-user-repo", "other-user-gist", "super-secret-gist", "super-secret-member-gist"}, s.repos)
+	assert.True(t, gock.IsDone())
+}
+
 func TestEnumerateWithToken_IncludeRepos(t *testing.T) {
 	defer gock.Off()
 
@@ -529,3 +707,180 @@ func Test_scan_SetProgressComplete(t *testing.T) {
 		})
 	}
 }
+
+func TestScanComments(t *testing.T) {
+	defer gock.Off()
+
+	const repoURL = "https://github.com/super-secret-org/super-secret-repo.git"
+	api := "/repos/super-secret-org/super-secret-repo"
+	gock.New("https://api.github.com").
+		Get(api).
+		Reply(200).
+		JSON(map[string]bool{"private": true})
+	gock.New("https://api.github.com").
+		Get(api + "/issues/comments").
+		Reply(200).
+		JSON([]map[string]string{
+			{"html_url": "https://github.com/super-secret-org/super-secret-repo/issues/1#issuecomment-1", "body": "issue comment"},
+			{"html_url": "https://github.com/super-secret-org/super-secret-repo/pull/2#issuecomment-2", "body": "pr comment"},
+		})
+	gock.New("https://api.github.com").
+		Get(api + "/issues").
+		Reply(200).
+		JSON([]map[string]interface{}{
+			{"html_url": "https://github.com/super-secret-org/super-secret-repo/issues/1", "title": "issue", "body": "issue body", "user": map[string]string{"login": "reporter"}},
+			{"html_url": "https://github.com/super-secret-org/super-secret-repo/pull/2", "title": "pr", "pull_request": map[string]string{}},
+		})
+
+	s := initTestSource(&sourcespb.GitHub{IncludeIssueComments: true})
+	chunksCh := make(chan *sources.Chunk, 10)
+	assert.NoError(t, s.scanComments(context.TODO(), repoURL, chunksCh))
+	close(chunksCh)
+
+	var got []string
+	for c := range chunksCh {
+		meta := c.SourceMetadata.GetGithub()
+		assert.Equal(t, repoURL, meta.GetRepository())
+		assert.Equal(t, source_metadatapb.Visibility_private, meta.GetVisibility())
+		got = append(got, meta.GetLink()+" "+meta.GetUsername()+" "+string(c.Data))
+	}
+	assert.Equal(t, []string{
+		"https://github.com/super-secret-org/super-secret-repo/issues/1 reporter issue\nissue body",
+		"https://github.com/super-secret-org/super-secret-repo/issues/1#issuecomment-1  issue comment",
+	}, got)
+	assert.True(t, gock.IsDone())
+}
+
+func TestScanComments_PullRequests(t *testing.T) {
+	defer gock.Off()
+
+	api := "/repos/super-secret-org/super-secret-repo"
+	gock.New("https://api.github.com").
+		Get(api).
+		Reply(200).
+		JSON(map[string]bool{"private": false})
+	gock.New("https://api.github.com").
+		Get(api + "/issues/comments").
+		Reply(200).
+		JSON([]map[string]string{
+			{"html_url": "https://github.com/super-secret-org/super-secret-repo/issues/1#issuecomment-1", "body": "issue comment"},
+			{"html_url": "https://github.com/super-secret-org/super-secret-repo/pull/2#issuecomment-2", "body": "pr comment"},
+		})
+	gock.New("https://api.github.com").
+		Get(api + "/pulls/comments").
+		Reply(200).
+		JSON([]map[string]string{{"html_url": "https://github.com/super-secret-org/super-secret-repo/pull/2#discussion_r3", "body": "review comment"}})
+	gock.New("https://api.github.com").
+		Get(api + "/pulls").
+		Reply(200).
+		JSON([]map[string]string{{"html_url": "https://github.com/super-secret-org/super-secret-repo/pull/2", "title": "pr", "body": "description"}})
+	gock.New("https://api.github.com").
+		Get(api + "/comments").
+		Reply(200).
+		JSON([]map[string]string{{"html_url": "https://github.com/super-secret-org/super-secret-repo/commit/abc#commitcomment-4", "body": "commit comment"}})
+
+	s := initTestSource(&sourcespb.GitHub{IncludePullRequestComments: true})
+	chunksCh := make(chan *sources.Chunk, 10)
+	assert.NoError(t, s.scanComments(context.TODO(), "https://github.com/super-secret-org/super-secret-repo.git", chunksCh))
+	close(chunksCh)
+
+	var got []string
+	for c := range chunksCh {
+		got = append(got, string(c.Data))
+	}
+	assert.Equal(t, []string{"pr comment", "pr\ndescription", "review comment", "commit comment"}, got)
+	assert.True(t, gock.IsDone())
+
+	// Gists have no issues or pull requests.
+	assert.NoError(t, s.scanComments(context.TODO(), "https://gist.github.com/aa5a315d61ae9438b18d.git", chunksCh))
+}
+
+func TestWikiURL(t *testing.T) {
+	wiki, ok := wikiURL("https://github.com/super-secret-org/super-secret-repo.git")
+	assert.True(t, ok)
+	assert.Equal(t, "https://github.com/super-secret-org/super-secret-repo.wiki.git", wiki)
+
+	_, ok = wikiURL("https://gist.github.com/aa5a315d61ae9438b18d.git")
+	assert.False(t, ok)
+}
+
+func TestScanReleases(t *testing.T) {
+	defer gock.Off()
+
+	var archive bytes.Buffer
+	zw := zip.NewWriter(&archive)
+	w, err := zw.Create("config/.env")
+	assert.NoError(t, err)
+	_, _ = w.Write([]byte("AWS_SECRET_ACCESS_KEY=abc"))
+	assert.NoError(t, zw.Close())
+
+	api := "/repos/super-secret-org/super-secret-repo"
+	gock.New("https://api.github.com").
+		Get(api + "/releases/assets/1").
+		Reply(302).
+		SetHeader("Location", "https://objects.githubusercontent.com/release-asset-1")
+	gock.New("https://objects.githubusercontent.com").
+		Get("/release-asset-1").
+		Reply(200).
+		Body(bytes.NewReader(archive.Bytes()))
+	gock.New("https://api.github.com").
+		Get(api + "/releases").
+		Reply(200).
+		JSON([]map[string]interface{}{{
+			"html_url": "https://github.com/super-secret-org/super-secret-repo/releases/tag/v1.0.0",
+			"name":     "v1.0.0",
+			"body":     "release notes",
+			"assets": []map[string]interface{}{
+				{"id": 1, "name": "bundle.zip", "size": archive.Len()},
+				{"id": 2, "name": "image.iso", "size": defaultMaxReleaseAssetSize + 1},
+			},
+		}})
+	gock.New("https://api.github.com").
+		Get(api).
+		Reply(200).
+		JSON(map[string]bool{"private": false})
+
+	s := initTestSource(&sourcespb.GitHub{IncludeReleases: true})
+	gock.InterceptClient(s.downloadClient)
+	chunksCh := make(chan *sources.Chunk, 10)
+	assert.NoError(t, s.scanReleases(context.TODO(), "https://github.com/super-secret-org/super-secret-repo.git", chunksCh))
+	close(chunksCh)
+
+	var got []string
+	for c := range chunksCh {
+		meta := c.SourceMetadata.GetGithub()
+		// The archive handler pads its chunks.
+		got = append(got, meta.GetFile()+" "+string(bytes.TrimRight(c.Data, "\x00")))
+	}
+	assert.Equal(t, []string{" v1.0.0\nrelease notes", "bundle.zip AWS_SECRET_ACCESS_KEY=abc"}, got)
+	assert.True(t, gock.IsDone())
+}
+
+func TestUserAndToken_InstallationTokenRefresh(t *testing.T) {
+	defer gock.Off()
+
+	s := initTestSource(&sourcespb.GitHub{
+		Credential: &sourcespb.GitHub_GithubApp{
+			GithubApp: &credentialspb.GitHubApp{InstallationId: "1337", AppId: "4141"},
+		},
+	})
+	installationClient := github.NewClient(s.httpClient)
+
+	gock.New("https://api.github.com").
+		Post("/app/installations/1337/access_tokens").
+		Reply(200).
+		JSON(map[string]interface{}{"token": "expiring", "expires_at": time.Now().Add(time.Minute)})
+	gock.New("https://api.github.com").
+		Post("/app/installations/1337/access_tokens").
+		Reply(200).
+		JSON(map[string]interface{}{"token": "dontlook", "expires_at": time.Now().Add(time.Hour)})
+
+	// Tokens about to expire are refreshed, others are reused.
+	for _, want := range []string{"expiring", "dontlook", "dontlook"} {
+		user, token, err := s.UserAndToken(context.TODO(), installationClient)
+		assert.NoError(t, err)
+		assert.Equal(t, "x-access-token", user)
+		assert.Equal(t, want, token)
+	}
+	assert.True(t, gock.IsDone())
+}
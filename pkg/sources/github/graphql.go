@@ -0,0 +1,146 @@
+package github
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+// reposQuery lists the repositories owned by an organization or a user. A
+// single query returns a page of 100 repos and costs a single point of the
+// GraphQL rate limit, whereas the REST API pages are at most 100 repos with
+// their full representation.
+const reposQuery = `query($login: String!, $cursor: String) {
+  repositoryOwner(login: $login) {
+    repositories(first: 100, after: $cursor) {
+      pageInfo {
+        hasNextPage
+        endCursor
+      }
+      nodes {
+        nameWithOwner
+        url
+        isFork
+        isArchived
+        isEmpty
+        primaryLanguage {
+          name
+        }
+        diskUsage
+        pushedAt
+      }
+    }
+  }
+}`
+
+type graphQLRequest struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables"`
+}
+
+type graphQLError struct {
+	Message string `json:"message"`
+}
+
+type reposQueryResponse struct {
+	Data struct {
+		RepositoryOwner *struct {
+			Repositories struct {
+				PageInfo struct {
+					HasNextPage bool   `json:"hasNextPage"`
+					EndCursor   string `json:"endCursor"`
+				} `json:"pageInfo"`
+				Nodes []struct {
+					NameWithOwner   string `json:"nameWithOwner"`
+					URL             string `json:"url"`
+					IsFork          bool   `json:"isFork"`
+					IsArchived      bool   `json:"isArchived"`
+					IsEmpty         bool   `json:"isEmpty"`
+					DiskUsage       int64  `json:"diskUsage"`
+					PrimaryLanguage *struct {
+						Name string `json:"name"`
+					} `json:"primaryLanguage"`
+					PushedAt time.Time `json:"pushedAt"`
+				} `json:"nodes"`
+			} `json:"repositories"`
+		} `json:"repositoryOwner"`
+	} `json:"data"`
+	Errors []graphQLError `json:"errors"`
+}
+
+// graphQLEndpoint returns the GraphQL endpoint of the API the client talks to.
+// GitHub Enterprise serves it at /api/graphql next to the /api/v3/ REST API.
+func graphQLEndpoint(baseURL *url.URL) string {
+	u := *baseURL
+	if strings.HasSuffix(u.Path, "/api/v3/") {
+		u.Path = strings.TrimSuffix(u.Path, "v3/") + "graphql"
+	} else {
+		u.Path = strings.TrimSuffix(u.Path, "/") + "/graphql"
+	}
+	return u.String()
+}
+
+// getReposGraphQL lists the repositories of an organization or a user with
+// the GraphQL API.
+func (s *Source) getReposGraphQL(ctx context.Context, owner string) ([]string, error) {
+	logger := s.log.WithField("owner", owner)
+	endpoint := graphQLEndpoint(s.apiClient.BaseURL)
+
+	var repos []string
+	var numRepos, numForks int
+	variables := map[string]any{"login": owner, "cursor": nil}
+	for {
+		req, err := s.apiClient.NewRequest("POST", endpoint, graphQLRequest{Query: reposQuery, Variables: variables})
+		if err != nil {
+			return nil, err
+		}
+		var resp reposQueryResponse
+		res, err := s.apiClient.Do(ctx, req, &resp)
+		if handled := HandleRateLimit(err, res); handled {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not list repos for %s: %w", owner, err)
+		}
+		if len(resp.Errors) > 0 {
+			return nil, fmt.Errorf("could not list repos for %s: %s", owner, resp.Errors[0].Message)
+		}
+		if resp.Data.RepositoryOwner == nil {
+			return nil, fmt.Errorf("could not list repos for %s: no such organization or user", owner)
+		}
+
+		repositories := resp.Data.RepositoryOwner.Repositories
+		logger.Debugf("Listed %d repos for %s", len(repositories.Nodes), owner)
+		for _, r := range repositories.Nodes {
+			info := repoInfo{
+				fullName: r.NameWithOwner,
+				cloneURL: r.URL + ".git",
+				fork:     r.IsFork,
+				archived: r.IsArchived,
+				empty:    r.IsEmpty,
+				sizeKB:   r.DiskUsage,
+				pushedAt: r.PushedAt,
+			}
+			if r.PrimaryLanguage != nil {
+				info.language = r.PrimaryLanguage.Name
+			}
+			numRepos++
+			if info.fork {
+				numForks++
+			}
+			if s.skipRepo(info) {
+				continue
+			}
+			repos = append(repos, info.cloneURL)
+		}
+		if !repositories.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = repositories.PageInfo.EndCursor
+	}
+	logger.Debugf("found %d repos (%d forks)", numRepos, numForks)
+	return repos, nil
+}
@@ -0,0 +1,121 @@
+package github
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	diskbufferreader "github.com/bill-rich/disk-buffer-reader"
+	"github.com/google/go-github/v42/github"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// defaultMaxReleaseAssetSize is the largest release asset that is scanned
+// unless the connection sets another limit.
+const defaultMaxReleaseAssetSize = 100 * 1024 * 1024 // 100MB
+
+// scanReleases scans the notes and the assets of the releases of a repository.
+// Packaged artifacts can embed credentials that are not in the repository.
+func (s *Source) scanReleases(ctx context.Context, repoURL string, chunksChan chan *sources.Chunk) error {
+	owner, name, ok := repoOwnerAndName(repoURL)
+	if !ok {
+		return nil
+	}
+	visibility := s.visibilityOf(repoURL)
+	maxSize := s.conn.GetMaxReleaseAssetSize()
+	if maxSize <= 0 {
+		maxSize = defaultMaxReleaseAssetSize
+	}
+
+	return listPages(func(opts github.ListOptions) ([]*github.RepositoryRelease, *github.Response, error) {
+		return s.apiClient.Repositories.ListReleases(ctx, owner, name, &opts)
+	}, func(release *github.RepositoryRelease) error {
+		notes := release.GetName() + "\n" + release.GetBody()
+		meta := &source_metadatapb.Github{
+			Link:       sanitizer.UTF8(release.GetHTMLURL()),
+			Username:   sanitizer.UTF8(release.GetAuthor().GetLogin()),
+			Repository: sanitizer.UTF8(repoURL),
+			Timestamp:  sanitizer.UTF8(release.GetCreatedAt().String()),
+			Visibility: visibility,
+		}
+		if err := s.sendChunk(ctx, s.apiChunk(meta, []byte(notes)), chunksChan); err != nil {
+			return err
+		}
+
+		for _, asset := range release.Assets {
+			if asset.GetSize() > int(maxSize) {
+				s.log.Debugf("skipping release asset %s larger than %d bytes", asset.GetBrowserDownloadURL(), maxSize)
+				continue
+			}
+			meta := &source_metadatapb.Github{
+				Link:       sanitizer.UTF8(asset.GetBrowserDownloadURL()),
+				Username:   sanitizer.UTF8(asset.GetUploader().GetLogin()),
+				Repository: sanitizer.UTF8(repoURL),
+				File:       sanitizer.UTF8(asset.GetName()),
+				Timestamp:  sanitizer.UTF8(asset.GetCreatedAt().String()),
+				Visibility: visibility,
+			}
+			if err := s.scanReleaseAsset(ctx, owner, name, asset.GetID(), maxSize, meta, chunksChan); err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				s.log.WithError(err).Errorf("unable to scan release asset %s, continuing", asset.GetBrowserDownloadURL())
+			}
+		}
+		return nil
+	})
+}
+
+// scanReleaseAsset downloads a release asset and scans it, extracting it when
+// it is an archive.
+func (s *Source) scanReleaseAsset(ctx context.Context, owner, repo string, id, maxSize int64, meta *source_metadatapb.Github, chunksChan chan *sources.Chunk) error {
+	// Assets are usually redirects to pre-signed URLs, which are downloaded
+	// without the credentials of the API.
+	rc, redirectURL, err := s.apiClient.Repositories.DownloadReleaseAsset(ctx, owner, repo, id, nil)
+	if err != nil {
+		return err
+	}
+	if rc == nil {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, redirectURL, nil)
+		if err != nil {
+			return err
+		}
+		res, err := s.downloadClient.Do(req)
+		if err != nil {
+			return err
+		}
+		if res.StatusCode != http.StatusOK {
+			res.Body.Close()
+			return fmt.Errorf("unexpected status code %d downloading %s", res.StatusCode, meta.File)
+		}
+		rc = res.Body
+	}
+	defer rc.Close()
+
+	reader, err := diskbufferreader.New(io.LimitReader(rc, maxSize))
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	chunkSkel := s.apiChunk(meta, nil)
+	if handlers.HandleFile(ctx, reader, chunkSkel, chunksChan) {
+		return nil
+	}
+	if err := reader.Reset(); err != nil {
+		return err
+	}
+	reader.Stop()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	chunkSkel.Data = data
+	return s.sendChunk(ctx, chunkSkel, chunksChan)
+}
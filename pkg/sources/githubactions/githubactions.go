@@ -0,0 +1,347 @@
+package githubactions
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	diskbufferreader "github.com/bill-rich/disk-buffer-reader"
+	"github.com/go-errors/errors"
+	"github.com/go-logr/logr"
+	"github.com/gobwas/glob"
+	"github.com/google/go-github/v42/github"
+	"golang.org/x/oauth2"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	ghsource "github.com/trufflesecurity/trufflehog/v3/pkg/sources/github"
+)
+
+const (
+	defaultEndpoint = "https://api.github.com"
+	pageSize        = 100
+	// maxDownloadSize is the largest log archive or artifact that will be scanned.
+	maxDownloadSize = 500 * 1024 * 1024 // 500MB
+)
+
+type Source struct {
+	name          string
+	sourceId      int64
+	jobId         int64
+	verify        bool
+	repos         []string
+	orgs          []string
+	ignoreRepos   []glob.Glob
+	maxRuns       int
+	skipArtifacts bool
+	httpClient    *http.Client
+	apiClient     *github.Client
+	// downloadClient fetches logs and artifacts from the pre-signed URLs
+	// the API redirects to, which must not receive the token.
+	downloadClient *http.Client
+	jobPool        *errgroup.Group
+	log            logr.Logger
+	sources.Progress
+}
+
+// Ensure the Source satisfies the interface at compile time.
+var _ sources.Source = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return sourcespb.SourceType_SOURCE_TYPE_GITHUB_ACTIONS
+}
+
+func (s *Source) SourceID() int64 {
+	return s.sourceId
+}
+
+func (s *Source) JobID() int64 {
+	return s.jobId
+}
+
+// Init returns an initialized GitHub Actions source.
+func (s *Source) Init(aCtx context.Context, name string, jobId, sourceId int64, verify bool, connection *anypb.Any, concurrency int) error {
+	s.log = context.WithValues(aCtx, "source", s.Type(), "name", name).Logger()
+
+	s.name = name
+	s.sourceId = sourceId
+	s.jobId = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	var conn sourcespb.GitHubActions
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return errors.WrapPrefix(err, "error unmarshalling connection", 0)
+	}
+
+	s.httpClient = common.RetryableHttpClientTimeout(60)
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.GitHubActions_Token:
+		s.httpClient.Transport = &oauth2.Transport{
+			Base:   s.httpClient.Transport,
+			Source: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: cred.Token}),
+		}
+	default:
+		return errors.Errorf("invalid configuration given for %s source", name)
+	}
+
+	endpoint := strings.TrimSuffix(conn.GetEndpoint(), "/")
+	if endpoint == "" || endpoint == defaultEndpoint {
+		s.apiClient = github.NewClient(s.httpClient)
+	} else {
+		var err error
+		if s.apiClient, err = github.NewEnterpriseClient(endpoint, endpoint, s.httpClient); err != nil {
+			return errors.New(err)
+		}
+	}
+	s.downloadClient = common.RetryableHttpClientTimeout(300)
+
+	s.repos = conn.GetRepositories()
+	s.orgs = conn.GetOrganizations()
+	if len(s.repos) == 0 && len(s.orgs) == 0 {
+		return errors.New("at least one repository or organization is required")
+	}
+	for _, pattern := range conn.GetIgnoreRepos() {
+		g, err := glob.Compile(pattern)
+		if err != nil {
+			return fmt.Errorf("invalid repository pattern %q: %w", pattern, err)
+		}
+		s.ignoreRepos = append(s.ignoreRepos, g)
+	}
+	s.maxRuns = int(conn.GetMaxRuns())
+	s.skipArtifacts = conn.GetSkipArtifacts()
+
+	return nil
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) error {
+	repos := append([]string{}, s.repos...)
+	for _, org := range s.orgs {
+		orgRepos, err := s.listOrgRepos(ctx, org)
+		if err != nil {
+			s.log.Error(err, "could not list organization repositories", "org", org)
+			continue
+		}
+		repos = append(repos, orgRepos...)
+	}
+
+	var scanned uint64
+	for i, repo := range repos {
+		if common.IsDone(ctx) {
+			break
+		}
+		if s.ignored(repo) {
+			continue
+		}
+		s.SetProgressComplete(i, len(repos), fmt.Sprintf("Repository: %s", repo), "")
+
+		owner, name, ok := strings.Cut(repo, "/")
+		if !ok {
+			s.log.Error(fmt.Errorf("expected owner/name"), "invalid repository", "repo", repo)
+			continue
+		}
+		runs, err := s.listRuns(ctx, owner, name)
+		if err != nil {
+			s.log.Error(err, "could not list workflow runs", "repo", repo)
+			continue
+		}
+		for _, run := range runs {
+			repo, run := repo, run
+			s.jobPool.Go(func() error {
+				if err := s.scanRun(ctx, owner, name, run, chunksChan); err != nil {
+					s.log.Error(err, "could not scan workflow run", "repo", repo, "run", run.GetID())
+					return nil
+				}
+				atomic.AddUint64(&scanned, 1)
+				return nil
+			})
+		}
+	}
+	_ = s.jobPool.Wait()
+
+	s.SetProgressComplete(len(repos), len(repos), fmt.Sprintf("Completed scanning source %s. %d workflow runs scanned.", s.name, scanned), "")
+	return nil
+}
+
+func (s *Source) ignored(repo string) bool {
+	for _, g := range s.ignoreRepos {
+		if g.Match(repo) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Source) listOrgRepos(ctx context.Context, org string) ([]string, error) {
+	var repos []string
+	opts := &github.RepositoryListByOrgOptions{ListOptions: github.ListOptions{PerPage: pageSize}}
+	for {
+		page, res, err := s.apiClient.Repositories.ListByOrg(ctx, org, opts)
+		if ghsource.HandleRateLimit(err, res) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range page {
+			repos = append(repos, r.GetFullName())
+		}
+		if res.NextPage == 0 {
+			return repos, nil
+		}
+		opts.Page = res.NextPage
+	}
+}
+
+// listRuns returns the workflow runs of a repository from newest to oldest,
+// limited to maxRuns when it is set.
+func (s *Source) listRuns(ctx context.Context, owner, repo string) ([]*github.WorkflowRun, error) {
+	var runs []*github.WorkflowRun
+	opts := &github.ListWorkflowRunsOptions{ListOptions: github.ListOptions{PerPage: pageSize}}
+	for {
+		page, res, err := s.apiClient.Actions.ListRepositoryWorkflowRuns(ctx, owner, repo, opts)
+		if ghsource.HandleRateLimit(err, res) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, run := range page.WorkflowRuns {
+			runs = append(runs, run)
+			if s.maxRuns > 0 && len(runs) >= s.maxRuns {
+				return runs, nil
+			}
+		}
+		if res.NextPage == 0 {
+			return runs, nil
+		}
+		opts.Page = res.NextPage
+	}
+}
+
+func (s *Source) scanRun(ctx context.Context, owner, repo string, run *github.WorkflowRun, chunksChan chan *sources.Chunk) error {
+	newMeta := func(file, link string) *source_metadatapb.GitHubActions {
+		return &source_metadatapb.GitHubActions{
+			Repository: sanitizer.UTF8(owner + "/" + repo),
+			Workflow:   sanitizer.UTF8(run.GetName()),
+			RunId:      run.GetID(),
+			File:       sanitizer.UTF8(file),
+			Link:       sanitizer.UTF8(link),
+			Timestamp:  run.GetCreatedAt().Format(time.RFC3339),
+		}
+	}
+
+	// Logs of runs that are still in progress or were deleted aren't available.
+	logs, res, err := s.apiClient.Actions.GetWorkflowRunLogs(ctx, owner, repo, run.GetID(), true)
+	if ghsource.HandleRateLimit(err, res) {
+		logs, _, err = s.apiClient.Actions.GetWorkflowRunLogs(ctx, owner, repo, run.GetID(), true)
+	}
+	if err != nil {
+		s.log.V(2).Info("could not get workflow run logs", "repo", owner+"/"+repo, "run", run.GetID(), "error", err.Error())
+	} else if err := s.scanURL(ctx, logs.String(), newMeta("logs", run.GetHTMLURL()), chunksChan); err != nil {
+		return err
+	}
+
+	if s.skipArtifacts {
+		return nil
+	}
+	opts := &github.ListOptions{PerPage: pageSize}
+	for {
+		artifacts, res, err := s.apiClient.Actions.ListWorkflowRunArtifacts(ctx, owner, repo, run.GetID(), opts)
+		if ghsource.HandleRateLimit(err, res) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		for _, artifact := range artifacts.Artifacts {
+			if artifact.GetExpired() || artifact.GetSizeInBytes() > maxDownloadSize {
+				continue
+			}
+			u, _, err := s.apiClient.Actions.DownloadArtifact(ctx, owner, repo, artifact.GetID(), true)
+			if err != nil {
+				s.log.Error(err, "could not get artifact", "artifact", artifact.GetName())
+				continue
+			}
+			meta := newMeta(artifact.GetName(), run.GetHTMLURL()+"#artifacts")
+			if err := s.scanURL(ctx, u.String(), meta, chunksChan); err != nil {
+				s.log.Error(err, "could not scan artifact", "artifact", artifact.GetName())
+			}
+		}
+		if res.NextPage == 0 {
+			return nil
+		}
+		opts.Page = res.NextPage
+	}
+}
+
+// scanURL downloads and scans a log archive or artifact.
+func (s *Source) scanURL(ctx context.Context, u string, meta *source_metadatapb.GitHubActions, chunksChan chan *sources.Chunk) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	res, err := s.downloadClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d downloading %s", res.StatusCode, meta.File)
+	}
+
+	reader, err := diskbufferreader.New(io.LimitReader(res.Body, maxDownloadSize))
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	chunkSkel := &sources.Chunk{
+		SourceType: s.Type(),
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_GithubActions{
+				GithubActions: meta,
+			},
+		},
+		Verify: s.verify,
+	}
+	if handlers.HandleFile(ctx, reader, chunkSkel, chunksChan) {
+		return nil
+	}
+	if err := reader.Reset(); err != nil {
+		return err
+	}
+	reader.Stop()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	chunk := *chunkSkel
+	chunk.Data = data
+	for c := range sources.Chunker(&chunk) {
+		select {
+		case chunksChan <- c:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package githubactions
+
+import (
+	"archive/zip"
+	"bytes"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/sourcestest"
+)
+
+func zipFile(t *testing.T, name, content string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	w, err := zw.Create(name)
+	assert.NoError(t, err)
+	_, err = w.Write([]byte(content))
+	assert.NoError(t, err)
+	assert.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func TestSource_Chunks(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/orgs/acme/repos").
+		Reply(200).
+		JSON([]map[string]string{{"full_name": "acme/api"}, {"full_name": "acme/sandbox"}})
+	gock.New("https://api.github.com").
+		Get("/repos/acme/api/actions/runs").
+		Reply(200).
+		JSON(map[string]interface{}{"total_count": 2, "workflow_runs": []map[string]interface{}{
+			{"id": 2, "name": "deploy", "html_url": "https://github.com/acme/api/actions/runs/2", "created_at": "2022-12-01T10:00:00Z"},
+			{"id": 1, "name": "deploy", "html_url": "https://github.com/acme/api/actions/runs/1", "created_at": "2022-11-30T10:00:00Z"},
+		}})
+	gock.New("https://api.github.com").
+		Get("/repos/acme/api/actions/runs/2/logs").
+		Reply(302).
+		SetHeader("Location", "https://pipelines.actions.githubusercontent.com/logs/2.zip")
+	gock.New("https://pipelines.actions.githubusercontent.com").
+		Get("/logs/2.zip").
+		Reply(200).
+		Body(bytes.NewReader(zipFile(t, "build/3_Deploy.txt", "echo c2VjcmV0PWh1bnRlcjI=")))
+	gock.New("https://api.github.com").
+		Get("/repos/acme/api/actions/runs/2/artifacts").
+		Reply(200).
+		JSON(map[string]interface{}{"total_count": 2, "artifacts": []map[string]interface{}{
+			{"id": 10, "name": "env", "size_in_bytes": 100, "expired": false},
+			{"id": 11, "name": "old", "size_in_bytes": 100, "expired": true},
+		}})
+	gock.New("https://api.github.com").
+		Get("/repos/acme/api/actions/artifacts/10/zip").
+		Reply(302).
+		SetHeader("Location", "https://pipelines.actions.githubusercontent.com/artifacts/10.zip")
+	gock.New("https://pipelines.actions.githubusercontent.com").
+		Get("/artifacts/10.zip").
+		Reply(200).
+		Body(bytes.NewReader(zipFile(t, ".env", "TOKEN=hunter3")))
+
+	s := &Source{}
+	sourcestest.Init(t, s, &sourcespb.GitHubActions{
+		Credential:    &sourcespb.GitHubActions_Token{Token: "ghp_token"},
+		Organizations: []string{"acme"},
+		IgnoreRepos:   []string{"acme/sand*"},
+		MaxRuns:       1,
+	}, func() *http.Client { return s.httpClient }, func() *http.Client { return s.downloadClient })
+
+	chunksCh := make(chan *sources.Chunk, 10)
+	assert.NoError(t, s.Chunks(context.Background(), chunksCh))
+	close(chunksCh)
+
+	files := make(map[string]string)
+	for chunk := range chunksCh {
+		meta := chunk.SourceMetadata.GetGithubActions()
+		assert.Equal(t, "acme/api", meta.Repository)
+		assert.Equal(t, "deploy", meta.Workflow)
+		assert.Equal(t, int64(2), meta.RunId)
+		assert.Equal(t, "2022-12-01T10:00:00Z", meta.Timestamp)
+		files[meta.File] = strings.TrimRight(string(chunk.Data), "\x00")
+	}
+	assert.Equal(t, map[string]string{
+		"logs": "echo c2VjcmV0PWh1bnRlcjI=",
+		"env":  "TOKEN=hunter3",
+	}, files)
+	assert.True(t, gock.IsDone())
+}
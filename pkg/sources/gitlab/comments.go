@@ -0,0 +1,147 @@
+package gitlab
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// listPages calls list for each page of results until there are no pages left.
+func listPages[T any](list func(opts gitlab.ListOptions) ([]T, *gitlab.Response, error), fn func(T) error) error {
+	opts := gitlab.ListOptions{PerPage: 100}
+	for {
+		items, res, err := list(opts)
+		if err != nil {
+			return err
+		}
+		for _, item := range items {
+			if err := fn(item); err != nil {
+				return err
+			}
+		}
+		if res.NextPage == 0 {
+			return nil
+		}
+		opts.Page = res.NextPage
+	}
+}
+
+// noteURL returns the link to a note of an issue or a merge request.
+func noteURL(webURL string, note *gitlab.Note) string {
+	return fmt.Sprintf("%s#note_%d", webURL, note.ID)
+}
+
+// scanComments scans the issues, merge requests and their discussions of the
+// given projects, where pasted credentials live outside of their git history.
+func (s *Source) scanComments(ctx context.Context, apiClient *gitlab.Client, projects []string, chunksChan chan *sources.Chunk) {
+	for _, project := range projects {
+		if err := s.scanProjectComments(ctx, apiClient, project, chunksChan); err != nil {
+			// Issues or merge requests may be disabled for the project.
+			log.WithError(err).WithField("project", project).Warn("could not scan project comments")
+		}
+	}
+}
+
+func (s *Source) scanProjectComments(ctx context.Context, apiClient *gitlab.Client, project string, chunksChan chan *sources.Chunk) error {
+	send := func(link, user string, timestamp *time.Time, data string) error {
+		if strings.TrimSpace(data) == "" {
+			return nil
+		}
+		var ts string
+		if timestamp != nil {
+			ts = timestamp.Format(time.RFC3339)
+		}
+		chunk := &sources.Chunk{
+			SourceName: s.name,
+			SourceID:   s.SourceID(),
+			SourceType: s.Type(),
+			SourceMetadata: &source_metadatapb.MetaData{
+				Data: &source_metadatapb.MetaData_Gitlab{
+					Gitlab: &source_metadatapb.Gitlab{
+						Link:       sanitizer.UTF8(link),
+						Email:      sanitizer.UTF8(user),
+						Repository: sanitizer.UTF8(project),
+						Timestamp:  sanitizer.UTF8(ts),
+					},
+				},
+			},
+			Data:   []byte(data),
+			Verify: s.verify,
+		}
+		select {
+		case chunksChan <- chunk:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	sendNote := func(webURL string, note *gitlab.Note) error {
+		// System notes are generated by GitLab, like "changed the description".
+		if note.System {
+			return nil
+		}
+		return send(noteURL(webURL, note), note.Author.Username, note.CreatedAt, note.Body)
+	}
+
+	if s.issueComments {
+		err := listPages(func(opts gitlab.ListOptions) ([]*gitlab.Issue, *gitlab.Response, error) {
+			return apiClient.Issues.ListProjectIssues(project, &gitlab.ListProjectIssuesOptions{ListOptions: opts})
+		}, func(issue *gitlab.Issue) error {
+			var author string
+			if issue.Author != nil {
+				author = issue.Author.Username
+			}
+			if err := send(issue.WebURL, author, issue.CreatedAt, issue.Title+"\n"+issue.Description); err != nil {
+				return err
+			}
+			return listPages(func(opts gitlab.ListOptions) ([]*gitlab.Note, *gitlab.Response, error) {
+				return apiClient.Notes.ListIssueNotes(project, issue.IID, &gitlab.ListIssueNotesOptions{ListOptions: opts})
+			}, func(note *gitlab.Note) error {
+				return sendNote(issue.WebURL, note)
+			})
+		})
+		if err != nil {
+			return fmt.Errorf("could not list issues of project %s: %w", project, err)
+		}
+	}
+
+	if !s.mrComments {
+		return nil
+	}
+	err := listPages(func(opts gitlab.ListOptions) ([]*gitlab.MergeRequest, *gitlab.Response, error) {
+		return apiClient.MergeRequests.ListProjectMergeRequests(project, &gitlab.ListProjectMergeRequestsOptions{ListOptions: opts})
+	}, func(mr *gitlab.MergeRequest) error {
+		var author string
+		if mr.Author != nil {
+			author = mr.Author.Username
+		}
+		if err := send(mr.WebURL, author, mr.CreatedAt, mr.Title+"\n"+mr.Description); err != nil {
+			return err
+		}
+		// Discussions hold both the threads of the overview and the
+		// comments on the diff.
+		return listPages(func(opts gitlab.ListOptions) ([]*gitlab.Discussion, *gitlab.Response, error) {
+			listOptions := gitlab.ListMergeRequestDiscussionsOptions(opts)
+			return apiClient.Discussions.ListMergeRequestDiscussions(project, mr.IID, &listOptions)
+		}, func(discussion *gitlab.Discussion) error {
+			for _, note := range discussion.Notes {
+				if err := sendNote(mr.WebURL, note); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("could not list merge requests of project %s: %w", project, err)
+	}
+	return nil
+}
@@ -2,12 +2,14 @@ package gitlab
 
 import (
 	"fmt"
+	"net/http"
 	"net/url"
 	"os"
 	"runtime"
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
@@ -39,12 +41,30 @@ type Source struct {
 	password        string
 	token           string
 	url             string
+	httpClient      *http.Client
+	tlsCA           string
+	insecureTLS     bool
+	sshKey          string
+	sshPassphrase   string
+	httpCredentials string
 	repos           []string
+	groups          []string
+	includeRepos    []string
 	ignoreRepos     []string
+	mrProject       string
+	mrIID           int
+	snippets        bool
+	wikis           bool
+	jobLogs         bool
+	maxJobs         int
+	jobLogsSince    time.Time
+	issueComments   bool
+	mrComments      bool
 	git             *git.Git
 	scanOptions     *git.ScanOptions
 	resumeInfoSlice []string
 	resumeInfoMutex sync.Mutex
+	checkpoint      *sources.RepoCheckpoint
 	jobSem          *semaphore.Weighted
 	sources.Progress
 }
@@ -81,9 +101,39 @@ func (s *Source) Init(_ context.Context, name string, jobId, sourceId int64, ver
 		return errors.WrapPrefix(err, "error unmarshalling connection", 0)
 	}
 
+	if s.checkpoint, err = sources.LoadRepoCheckpoint(conn.StateFile); err != nil {
+		return errors.WrapPrefix(err, "could not load checkpoint", 0)
+	}
+
 	s.repos = conn.Repositories
+	s.groups = conn.Groups
+	s.includeRepos = conn.IncludeRepos
 	s.ignoreRepos = conn.IgnoreRepos
+	s.mrProject = conn.MergeRequestProject
+	s.mrIID = int(conn.MergeRequestIid)
+	if (s.mrProject == "") != (s.mrIID == 0) {
+		return errors.Errorf("a merge request needs both a project and an IID")
+	}
+	s.snippets = conn.IncludeSnippets
+	s.wikis = conn.IncludeWikis
+	s.jobLogs = conn.IncludeJobLogs
+	s.maxJobs = int(conn.MaxJobs)
+	if conn.JobLogsSince != nil {
+		s.jobLogsSince = conn.JobLogsSince.AsTime()
+	}
+	s.issueComments = conn.IncludeIssueComments
+	s.mrComments = conn.IncludeMrComments
 	s.url = conn.Endpoint
+	s.tlsCA = conn.TlsCa
+	s.insecureTLS = conn.InsecureSkipVerifyTls
+	s.sshKey = conn.SshKey
+	s.sshPassphrase = conn.SshKeyPassphrase
+	s.httpCredentials = conn.HttpCredentials
+	if s.tlsCA != "" || s.insecureTLS {
+		if s.httpClient, err = common.RetryableHttpClientTLS(60, s.tlsCA, s.insecureTLS); err != nil {
+			return err
+		}
+	}
 
 	if conn.Endpoint != "" && !strings.HasSuffix(s.url, "/") {
 		s.url = s.url + "/"
@@ -135,14 +185,14 @@ func (s *Source) newClient() (*gitlab.Client, error) {
 	// Initialize a new api instance.
 	switch s.authMethod {
 	case "OAUTH":
-		apiClient, err := gitlab.NewOAuthClient(s.token, gitlab.WithBaseURL(s.url))
+		apiClient, err := gitlab.NewOAuthClient(s.token, s.clientOptions()...)
 		if err != nil {
 			return nil, fmt.Errorf("could not create Gitlab OAUTH client for %s. Error: %v", s.url, err)
 		}
 		return apiClient, nil
 
 	case "BASIC_AUTH":
-		apiClient, err := gitlab.NewBasicAuthClient(s.user, s.password, gitlab.WithBaseURL(s.url))
+		apiClient, err := gitlab.NewBasicAuthClient(s.user, s.password, s.clientOptions()...)
 		if err != nil {
 			return nil, fmt.Errorf("could not create Gitlab BASICAUTH client for %s. Error: %v", s.url, err)
 		}
@@ -155,7 +205,7 @@ func (s *Source) newClient() (*gitlab.Client, error) {
 		}
 		fallthrough
 	case "TOKEN":
-		apiClient, err := gitlab.NewOAuthClient(s.token, gitlab.WithBaseURL(s.url))
+		apiClient, err := gitlab.NewOAuthClient(s.token, s.clientOptions()...)
 		if err != nil {
 			return nil, fmt.Errorf("could not create Gitlab TOKEN client for %s. Error: %v", s.url, err)
 		}
@@ -166,6 +216,15 @@ func (s *Source) newClient() (*gitlab.Client, error) {
 	}
 }
 
+// clientOptions returns the options of the API client, its URL included.
+func (s *Source) clientOptions() []gitlab.ClientOptionFunc {
+	opts := []gitlab.ClientOptionFunc{gitlab.WithBaseURL(s.url)}
+	if s.httpClient != nil {
+		opts = append(opts, gitlab.WithHTTPClient(s.httpClient))
+	}
+	return opts
+}
+
 func (s *Source) getAllProjects(apiClient *gitlab.Client) ([]*gitlab.Project, error) {
 	// Projects without repo will get user projects, groups projects, and subgroup projects.
 	user, _, err := apiClient.Users.CurrentUser()
@@ -249,6 +308,38 @@ func (s *Source) getAllProjects(apiClient *gitlab.Client) ([]*gitlab.Project, er
 	return projectList, nil
 }
 
+// getGroupProjects returns the projects of the configured groups and of all
+// their subgroups.
+func (s *Source) getGroupProjects(apiClient *gitlab.Client) ([]*gitlab.Project, error) {
+	projects := map[int]*gitlab.Project{}
+	for _, group := range s.groups {
+		listGroupProjectOptions := &gitlab.ListGroupProjectsOptions{
+			OrderBy:          gitlab.String("last_activity_at"),
+			IncludeSubGroups: gitlab.Bool(true),
+		}
+		for {
+			grpPrjs, res, err := apiClient.Groups.ListGroupProjects(group, listGroupProjectOptions)
+			if err != nil {
+				return nil, errors.WrapPrefix(err, fmt.Sprintf("received error on listing projects of group %s", group), 0)
+			}
+			for _, prj := range grpPrjs {
+				projects[prj.ID] = prj
+			}
+			listGroupProjectOptions.Page = res.NextPage
+			if res.NextPage == 0 {
+				break
+			}
+		}
+	}
+	log.Debugf("Enumerated %d GitLab projects in %d groups", len(projects), len(s.groups))
+
+	var projectList []*gitlab.Project
+	for _, project := range projects {
+		projectList = append(projectList, project)
+	}
+	return projectList, nil
+}
+
 func (s *Source) getRepos() ([]string, []error) {
 	if len(s.repos) == 0 {
 		return nil, nil
@@ -273,6 +364,18 @@ func (s *Source) scanRepos(ctx context.Context, chunksChan chan *sources.Chunk)
 	var errs []error
 	var errsMut sync.Mutex
 
+	cloneArgs, cleanup, err := git.TLSCloneArgs(s.tlsCA, s.insecureTLS)
+	if err != nil {
+		return []error{err}
+	}
+	defer cleanup()
+	sshArgs, sshCleanup, err := git.SSHCloneArgs(s.sshKey, s.sshPassphrase)
+	if err != nil {
+		return []error{err}
+	}
+	defer sshCleanup()
+	cloneArgs = append(cloneArgs, sshArgs...)
+
 	// If there is resume information available, limit this scan to only the repos that still need scanning.
 	reposToScan, progressIndexOffset := sources.FilterReposToResume(s.repos, s.GetProgress().EncodedResumeInfo)
 	s.repos = reposToScan
@@ -294,6 +397,11 @@ func (s *Source) scanRepos(ctx context.Context, chunksChan chan *sources.Chunk)
 			if len(repoURL) == 0 {
 				return
 			}
+			progress := s.checkpoint.Progress(repoURL)
+			if progress.Done {
+				log.WithField("repo", repoURL).Debug("skipping repo scanned before the scan was interrupted")
+				return
+			}
 
 			s.setProgressCompleteWithRepo(i, progressIndexOffset, repoURL)
 			// Ensure the repo is removed from the resume info after being scanned.
@@ -303,20 +411,7 @@ func (s *Source) scanRepos(ctx context.Context, chunksChan chan *sources.Chunk)
 				s.resumeInfoSlice = sources.RemoveRepoFromResumeInfo(s.resumeInfoSlice, repoURL)
 			}(s)
 
-			var path string
-			var repo *gogit.Repository
-			var err error
-			if s.authMethod == "UNAUTHENTICATED" {
-				path, repo, err = git.CloneRepoUsingUnauthenticated(ctx, repoURL)
-			} else {
-				// If a username is not provided we need to use a default one in order to clone a private repo.
-				// Not setting "placeholder" as s.user on purpose in case any downstream services rely on a "" value for s.user.
-				user := s.user
-				if user == "" {
-					user = "placeholder"
-				}
-				path, repo, err = git.CloneRepoUsingToken(ctx, s.token, repoURL, user)
-			}
+			path, repo, err := s.cloneRepo(ctx, repoURL, cloneArgs)
 			defer os.RemoveAll(path)
 			if err != nil {
 				errsMut.Lock()
@@ -325,7 +420,9 @@ func (s *Source) scanRepos(ctx context.Context, chunksChan chan *sources.Chunk)
 				return
 			}
 			log.Debugf("Starting to scan repo %d/%d: %s", i+1, len(s.repos), repoURL)
-			err = s.git.ScanRepo(ctx, repo, path, s.scanOptions, chunksChan)
+			scanOptions := *s.scanOptions
+			scanOptions.ExcludeRevs = progress.Heads
+			err = s.git.ScanRepo(ctx, repo, path, &scanOptions, chunksChan)
 			if err != nil {
 				errsMut.Lock()
 				errs = append(errs, err)
@@ -333,16 +430,95 @@ func (s *Source) scanRepos(ctx context.Context, chunksChan chan *sources.Chunk)
 				return
 			}
 			log.Debugf("Completed scanning repo %d/%d: %s", i+1, len(s.repos), repoURL)
+			if s.wikis {
+				s.scanWiki(ctx, repoURL, cloneArgs, chunksChan)
+			}
+			// Repos whose scan was cancelled are scanned again on resume.
+			if common.IsDone(ctx) {
+				return
+			}
+			heads, err := git.RefHeads(repo)
+			if err == nil {
+				err = s.checkpoint.RepoScanned(repoURL, heads)
+			}
+			if err != nil {
+				log.WithError(err).Error("could not save checkpoint")
+			}
 		}(ctx, repo, i)
 	}
 	wg.Wait()
 	if len(errs) == 0 {
 		s.SetProgressComplete(len(s.repos), len(s.repos), fmt.Sprintf("Completed scanning source %s", s.name), "")
 	}
+	if !common.IsDone(ctx) {
+		if err := s.checkpoint.ScanCompleted(); err != nil {
+			log.WithError(err).Error("could not save checkpoint")
+		}
+	}
 
 	return errs
 }
 
+func (s *Source) cloneRepo(ctx context.Context, repoURL string, cloneArgs []string) (string, *gogit.Repository, error) {
+	// Credentials dedicated to cloning take precedence over the token.
+	if s.sshKey != "" {
+		return git.CloneRepoOverSSH(ctx, repoURL, cloneArgs...)
+	}
+	if s.httpCredentials != "" {
+		userInfo, err := git.ParseHTTPCredentials(s.httpCredentials)
+		if err != nil {
+			return "", nil, err
+		}
+		return git.CloneRepo(ctx, userInfo, repoURL, cloneArgs...)
+	}
+	if s.authMethod == "UNAUTHENTICATED" {
+		return git.CloneRepoUsingUnauthenticated(ctx, repoURL, cloneArgs...)
+	}
+	// If a username is not provided we need to use a default one in order to clone a private repo.
+	// Not setting "placeholder" as s.user on purpose in case any downstream services rely on a "" value for s.user.
+	user := s.user
+	if user == "" {
+		user = "placeholder"
+	}
+	return git.CloneRepoUsingToken(ctx, s.token, repoURL, user, cloneArgs...)
+}
+
+// wikiURL returns the clone URL of the wiki of a project's repo.
+func wikiURL(repoURL string) string {
+	return strings.TrimSuffix(repoURL, ".git") + ".wiki.git"
+}
+
+// scanWiki clones and scans the wiki of a project. Wikis are separate git
+// repositories that only exist once their first page is created.
+func (s *Source) scanWiki(ctx context.Context, repoURL string, cloneArgs []string, chunksChan chan *sources.Chunk) {
+	path, repo, err := s.cloneRepo(ctx, wikiURL(repoURL), cloneArgs)
+	defer os.RemoveAll(path)
+	if err != nil {
+		log.WithError(err).Debugf("unable to clone wiki of %s, it may not exist", repoURL)
+		return
+	}
+	if err = s.git.ScanRepo(ctx, repo, path, git.NewScanOptions(), chunksChan); err != nil {
+		log.WithError(err).Errorf("unable to scan wiki of %s, continuing", repoURL)
+	}
+}
+
+func (s *Source) includeRepo(r string) bool {
+	if len(s.includeRepos) == 0 {
+		return true
+	}
+	for _, include := range s.includeRepos {
+		g, err := glob.Compile(include)
+		if err != nil {
+			log.WithError(err).Errorf("could not compile include repo glob %s", include)
+			continue
+		}
+		if g.Match(r) {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *Source) ignoreRepo(r string) bool {
 	for _, ignore := range s.ignoreRepos {
 		g, err := glob.Compile(ignore)
@@ -365,6 +541,9 @@ func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) err
 	if err != nil {
 		return errors.New(err)
 	}
+	if s.mrIID > 0 {
+		return s.scanMergeRequest(ctx, apiClient, chunksChan)
+	}
 	// Get repo within target.
 	repos, errs := s.getRepos()
 	for _, repoErr := range errs {
@@ -378,13 +557,18 @@ func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) err
 
 	// Get all repos if not specified.
 	if repos == nil {
-		projects, err := s.getAllProjects(apiClient)
+		var projects []*gitlab.Project
+		if len(s.groups) > 0 {
+			projects, err = s.getGroupProjects(apiClient)
+		} else {
+			projects, err = s.getAllProjects(apiClient)
+		}
 		if err != nil {
 			return errors.New(err)
 		}
 		// Turn projects into URLs for Git cloner.
 		for _, prj := range projects {
-			if s.ignoreRepo(prj.PathWithNamespace) {
+			if s.ignoreRepo(prj.PathWithNamespace) || !s.includeRepo(prj.PathWithNamespace) {
 				continue
 			}
 
@@ -415,6 +599,18 @@ func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) err
 		).Error("error scanning repo")
 	}
 
+	if s.snippets {
+		if err := s.scanSnippets(ctx, apiClient, repoProjects(repos), chunksChan); err != nil {
+			return err
+		}
+	}
+	if s.jobLogs {
+		s.scanJobLogs(ctx, apiClient, repoProjects(repos), chunksChan)
+	}
+	if s.issueComments || s.mrComments {
+		s.scanComments(ctx, apiClient, repoProjects(repos), chunksChan)
+	}
+
 	return nil
 }
 
@@ -451,3 +647,32 @@ func (s *Source) setProgressCompleteWithRepo(index int, offset int, repoURL stri
 func (s *Source) WithScanOptions(scanOptions *git.ScanOptions) {
 	s.scanOptions = scanOptions
 }
+
+// projectPath returns the path with namespace of the project a repo URL
+// points to, like "my-org/my-repo" for https://gitlab.com/my-org/my-repo.git.
+func projectPath(repoURL string) (string, error) {
+	u, err := url.Parse(repoURL)
+	if err != nil {
+		return "", err
+	}
+	path := strings.TrimSuffix(strings.Trim(u.Path, "/"), ".git")
+	if path == "" {
+		return "", errors.Errorf("no project in repo url %s", repoURL)
+	}
+	return path, nil
+}
+
+// repoProjects returns the paths of the projects of repos, whose snippets and
+// job logs are scanned along with them.
+func repoProjects(repos []string) []string {
+	var projects []string
+	for _, repo := range repos {
+		project, err := projectPath(repo)
+		if err != nil {
+			log.WithError(err).Warnf("could not get the project of repo %s", repo)
+			continue
+		}
+		projects = append(projects, project)
+	}
+	return projects
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/kylelemons/godebug/pretty"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/xanzy/go-gitlab"
 	"golang.org/x/sync/semaphore"
 	"google.golang.org/protobuf/types/known/anypb"
 
@@ -305,3 +306,57 @@ func Test_scanRepos_SetProgressComplete(t *testing.T) {
 		})
 	}
 }
+
+func TestSource_includeRepo(t *testing.T) {
+	s := &Source{}
+	assert.True(t, s.includeRepo("my-org/my-repo"))
+
+	s.includeRepos = []string{"my-org/my-*"}
+	assert.True(t, s.includeRepo("my-org/my-repo"))
+	assert.False(t, s.includeRepo("my-org/other-repo"))
+}
+
+func TestSource_ignoreRepo(t *testing.T) {
+	s := &Source{}
+	assert.False(t, s.ignoreRepo("my-org/my-repo"))
+
+	s.ignoreRepos = []string{"archive/*", "*-mirror"}
+	assert.True(t, s.ignoreRepo("archive/old-repo"))
+	assert.True(t, s.ignoreRepo("archive/nested/old-repo"))
+	assert.True(t, s.ignoreRepo("my-org/upstream-mirror"))
+	assert.False(t, s.ignoreRepo("my-org/my-repo"))
+}
+
+func TestParseAddedLines(t *testing.T) {
+	diff := `@@ -1,3 +1,4 @@
+ package main
+-const key = ""
++const key = "AKIAEXAMPLE"
++const secret = "abc"
+ 
+@@ -10,2 +11,3 @@ func main() {
+ 	fmt.Println()
++	fmt.Println(key)
+ }
+`
+	assert.Equal(t, []addedLines{
+		{line: 2, data: "const key = \"AKIAEXAMPLE\"\nconst secret = \"abc\"\n"},
+		{line: 12, data: "\tfmt.Println(key)\n"},
+	}, parseAddedLines(diff))
+}
+
+func TestRepoProjects(t *testing.T) {
+	assert.Equal(t, []string{"my-org/my-repo", "my-org/sub/repo"}, repoProjects([]string{
+		"https://gitlab.com/my-org/my-repo.git",
+		"https://gitlab.example.com/my-org/sub/repo.git",
+		"https://gitlab.com/",
+	}))
+}
+
+func TestWikiURL(t *testing.T) {
+	assert.Equal(t, "https://gitlab.com/my-org/my-repo.wiki.git", wikiURL("https://gitlab.com/my-org/my-repo.git"))
+}
+
+func TestNoteURL(t *testing.T) {
+	assert.Equal(t, "https://gitlab.com/my-org/my-repo/-/issues/3#note_42", noteURL("https://gitlab.com/my-org/my-repo/-/issues/3", &gitlab.Note{ID: 42}))
+}
@@ -0,0 +1,109 @@
+package gitlab
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// scanJobLogs scans the CI job logs of the given projects. Scripts running
+// with `set -x` print the secrets injected in their environment to these logs.
+func (s *Source) scanJobLogs(ctx context.Context, apiClient *gitlab.Client, projects []string, chunksChan chan *sources.Chunk) {
+	for _, project := range projects {
+		if common.IsDone(ctx) {
+			return
+		}
+		if err := s.scanProjectJobLogs(ctx, apiClient, project, chunksChan); err != nil {
+			// CI/CD may be disabled for the project.
+			log.WithError(err).WithField("project", project).Warn("could not scan project job logs")
+		}
+	}
+}
+
+// scanProjectJobLogs scans the logs of the latest jobs of a project, up to
+// maxJobs of them and none created before jobLogsSince.
+func (s *Source) scanProjectJobLogs(ctx context.Context, apiClient *gitlab.Client, project string, chunksChan chan *sources.Chunk) error {
+	var scanned int
+	// Jobs are listed from the most recent.
+	listOptions := &gitlab.ListJobsOptions{ListOptions: gitlab.ListOptions{PerPage: 100}}
+	for {
+		jobs, res, err := apiClient.Jobs.ListProjectJobs(project, listOptions)
+		if err != nil {
+			return err
+		}
+		for _, job := range jobs {
+			if common.IsDone(ctx) {
+				return ctx.Err()
+			}
+			if s.maxJobs > 0 && scanned >= s.maxJobs {
+				return nil
+			}
+			if !s.jobLogsSince.IsZero() && job.CreatedAt != nil && job.CreatedAt.Before(s.jobLogsSince) {
+				return nil
+			}
+			scanned++
+			if err := s.scanJobLog(apiClient, project, job, chunksChan); err != nil {
+				log.WithError(err).WithField("job", job.WebURL).Warn("could not scan job log")
+			}
+		}
+		listOptions.Page = res.NextPage
+		if res.NextPage == 0 {
+			break
+		}
+	}
+	return nil
+}
+
+func (s *Source) scanJobLog(apiClient *gitlab.Client, project string, job *gitlab.Job, chunksChan chan *sources.Chunk) error {
+	trace, _, err := apiClient.Jobs.GetTraceFile(project, job.ID)
+	if err != nil {
+		return err
+	}
+	data, err := io.ReadAll(trace)
+	if err != nil {
+		return err
+	}
+	if len(data) == 0 {
+		return nil
+	}
+
+	var commit, email, timestamp string
+	if job.Commit != nil {
+		commit = job.Commit.ID
+	}
+	if job.User != nil {
+		email = job.User.Username
+	}
+	if job.CreatedAt != nil {
+		timestamp = job.CreatedAt.Format(time.RFC3339)
+	}
+	chunksChan <- &sources.Chunk{
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		SourceType: s.Type(),
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Gitlab{
+				Gitlab: &source_metadatapb.Gitlab{
+					Commit:     sanitizer.UTF8(commit),
+					File:       sanitizer.UTF8(fmt.Sprintf("job %d: %s", job.ID, job.Name)),
+					Link:       sanitizer.UTF8(job.WebURL),
+					Email:      sanitizer.UTF8(email),
+					Repository: sanitizer.UTF8(project),
+					Timestamp:  sanitizer.UTF8(timestamp),
+				},
+			},
+		},
+		Data:   data,
+		Verify: s.verify,
+	}
+	return nil
+}
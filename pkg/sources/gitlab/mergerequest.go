@@ -0,0 +1,133 @@
+package gitlab
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-errors/errors"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// addedLines is a run of consecutive lines added by a diff.
+type addedLines struct {
+	// line is the number of the first line in the new version of the file.
+	line int64
+	data string
+}
+
+// parseAddedLines returns the runs of lines a unified diff adds. Their line
+// numbers are those of the new version of the file, which are the positions
+// used by merge request discussions.
+func parseAddedLines(diff string) []addedLines {
+	var (
+		runs    []addedLines
+		current *addedLines
+		newLine int64
+	)
+	flush := func() {
+		if current != nil {
+			runs = append(runs, *current)
+			current = nil
+		}
+	}
+
+	for _, line := range strings.Split(diff, "\n") {
+		switch {
+		case strings.HasPrefix(line, "@@"):
+			flush()
+			newLine = hunkNewStart(line)
+		case strings.HasPrefix(line, "+"):
+			// Keep runs within the size of a chunk.
+			if current != nil && len(current.data)+len(line) > sources.ChunkSize {
+				flush()
+			}
+			if current == nil {
+				current = &addedLines{line: newLine}
+			}
+			current.data += line[1:] + "\n"
+			newLine++
+		case strings.HasPrefix(line, "-"), strings.HasPrefix(line, `\`):
+			// Removed lines don't exist in the new file.
+			flush()
+		default:
+			flush()
+			newLine++
+		}
+	}
+	flush()
+	return runs
+}
+
+// hunkNewStart returns the first line of the new file in a hunk header like
+// "@@ -1,4 +1,5 @@".
+func hunkNewStart(header string) int64 {
+	for _, field := range strings.Fields(header) {
+		if !strings.HasPrefix(field, "+") {
+			continue
+		}
+		start, _, _ := strings.Cut(field[1:], ",")
+		n, err := strconv.ParseInt(start, 10, 64)
+		if err != nil {
+			return 0
+		}
+		return n
+	}
+	return 0
+}
+
+// scanMergeRequest scans the lines added by a merge request, as a whole,
+// rather than the history of the project.
+func (s *Source) scanMergeRequest(ctx context.Context, apiClient *gitlab.Client, chunksChan chan *sources.Chunk) error {
+	mr, _, err := apiClient.MergeRequests.GetMergeRequestChanges(s.mrProject, s.mrIID, nil)
+	if err != nil {
+		return errors.WrapPrefix(err, fmt.Sprintf("could not get the changes of merge request %s!%d", s.mrProject, s.mrIID), 0)
+	}
+
+	var timestamp string
+	if mr.UpdatedAt != nil {
+		timestamp = mr.UpdatedAt.String()
+	}
+	var author string
+	if mr.Author != nil {
+		author = mr.Author.Username
+	}
+	for _, change := range mr.Changes {
+		if change.DeletedFile {
+			continue
+		}
+		for _, added := range parseAddedLines(change.Diff) {
+			if common.IsDone(ctx) {
+				return ctx.Err()
+			}
+			chunksChan <- &sources.Chunk{
+				SourceName: s.name,
+				SourceID:   s.SourceID(),
+				SourceType: s.Type(),
+				SourceMetadata: &source_metadatapb.MetaData{
+					Data: &source_metadatapb.MetaData_Gitlab{
+						Gitlab: &source_metadatapb.Gitlab{
+							Commit:     sanitizer.UTF8(mr.SHA),
+							File:       sanitizer.UTF8(change.NewPath),
+							Link:       sanitizer.UTF8(mr.WebURL),
+							Email:      sanitizer.UTF8(author),
+							Repository: sanitizer.UTF8(s.mrProject),
+							Timestamp:  sanitizer.UTF8(timestamp),
+							Line:       added.line,
+						},
+					},
+				},
+				Data:   []byte(added.data),
+				Verify: s.verify,
+			}
+		}
+	}
+	s.SetProgressComplete(1, 1, fmt.Sprintf("Completed scanning merge request %s!%d", s.mrProject, s.mrIID), "")
+	return nil
+}
@@ -0,0 +1,101 @@
+package gitlab
+
+import (
+	"time"
+
+	"github.com/go-errors/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// scanSnippets scans the snippets of the authenticated user and those of the
+// given projects.
+func (s *Source) scanSnippets(ctx context.Context, apiClient *gitlab.Client, projects []string, chunksChan chan *sources.Chunk) error {
+	listOptions := &gitlab.ListSnippetsOptions{}
+	for {
+		snippets, res, err := apiClient.Snippets.ListSnippets(listOptions)
+		if err != nil {
+			return errors.WrapPrefix(err, "received error on listing snippets", 0)
+		}
+		for _, snippet := range snippets {
+			if common.IsDone(ctx) {
+				return ctx.Err()
+			}
+			content, _, err := apiClient.Snippets.SnippetContent(snippet.ID)
+			if err != nil {
+				log.WithError(err).WithField("snippet", snippet.WebURL).Warn("could not get snippet content")
+				continue
+			}
+			s.sendSnippet(snippet, "", content, chunksChan)
+		}
+		listOptions.Page = res.NextPage
+		if res.NextPage == 0 {
+			break
+		}
+	}
+
+	for _, project := range projects {
+		if err := s.scanProjectSnippets(ctx, apiClient, project, chunksChan); err != nil {
+			// Snippets may be disabled for the project.
+			log.WithError(err).WithField("project", project).Warn("could not scan project snippets")
+		}
+	}
+	return nil
+}
+
+func (s *Source) scanProjectSnippets(ctx context.Context, apiClient *gitlab.Client, project string, chunksChan chan *sources.Chunk) error {
+	listOptions := &gitlab.ListProjectSnippetsOptions{}
+	for {
+		snippets, res, err := apiClient.ProjectSnippets.ListSnippets(project, listOptions)
+		if err != nil {
+			return err
+		}
+		for _, snippet := range snippets {
+			if common.IsDone(ctx) {
+				return ctx.Err()
+			}
+			content, _, err := apiClient.ProjectSnippets.SnippetContent(project, snippet.ID)
+			if err != nil {
+				log.WithError(err).WithField("snippet", snippet.WebURL).Warn("could not get snippet content")
+				continue
+			}
+			s.sendSnippet(snippet, project, content, chunksChan)
+		}
+		listOptions.Page = res.NextPage
+		if res.NextPage == 0 {
+			break
+		}
+	}
+	return nil
+}
+
+func (s *Source) sendSnippet(snippet *gitlab.Snippet, project string, content []byte, chunksChan chan *sources.Chunk) {
+	var timestamp string
+	if snippet.UpdatedAt != nil {
+		timestamp = snippet.UpdatedAt.Format(time.RFC3339)
+	}
+	chunksChan <- &sources.Chunk{
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		SourceType: s.Type(),
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Gitlab{
+				Gitlab: &source_metadatapb.Gitlab{
+					File:       sanitizer.UTF8(snippet.FileName),
+					Link:       sanitizer.UTF8(snippet.WebURL),
+					Email:      sanitizer.UTF8(snippet.Author.Username),
+					Repository: sanitizer.UTF8(project),
+					Timestamp:  sanitizer.UTF8(timestamp),
+				},
+			},
+		},
+		Data:   content,
+		Verify: s.verify,
+	}
+}
@@ -0,0 +1,284 @@
+package gomodproxy
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync/atomic"
+
+	diskbufferreader "github.com/bill-rich/disk-buffer-reader"
+	"github.com/go-errors/errors"
+	"github.com/go-logr/logr"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const (
+	defaultEndpoint = "https://proxy.golang.org/"
+	// maxZipSize is the size limit the go command enforces on module zips.
+	maxZipSize = 500 * 1024 * 1024 // 500MB
+)
+
+type Source struct {
+	name     string
+	sourceId int64
+	jobId    int64
+	verify   bool
+	endpoint string
+	// auth sets the credentials on requests to the proxy.
+	auth        func(*http.Request)
+	modules     []module.Version
+	maxVersions int
+	client      *http.Client
+	jobPool     *errgroup.Group
+	log         logr.Logger
+	sources.Progress
+}
+
+// Ensure the Source satisfies the interface at compile time.
+var _ sources.Source = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return sourcespb.SourceType_SOURCE_TYPE_GO_MODULE_PROXY
+}
+
+func (s *Source) SourceID() int64 {
+	return s.sourceId
+}
+
+func (s *Source) JobID() int64 {
+	return s.jobId
+}
+
+// Init returns an initialized Go module proxy source.
+func (s *Source) Init(aCtx context.Context, name string, jobId, sourceId int64, verify bool, connection *anypb.Any, concurrency int) error {
+	s.log = context.WithValues(aCtx, "source", s.Type(), "name", name).Logger()
+
+	s.name = name
+	s.sourceId = sourceId
+	s.jobId = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	var conn sourcespb.GoModuleProxy
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return errors.WrapPrefix(err, "error unmarshalling connection", 0)
+	}
+
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.GoModuleProxy_Unauthenticated:
+		s.auth = func(*http.Request) {}
+	case *sourcespb.GoModuleProxy_Token:
+		s.auth = func(req *http.Request) { req.Header.Set("Authorization", "Bearer "+cred.Token) }
+	case *sourcespb.GoModuleProxy_BasicAuth:
+		s.auth = func(req *http.Request) {
+			req.SetBasicAuth(cred.BasicAuth.GetUsername(), cred.BasicAuth.GetPassword())
+		}
+	default:
+		return errors.Errorf("invalid configuration given for %s source", name)
+	}
+
+	s.endpoint = conn.GetEndpoint()
+	if s.endpoint == "" {
+		s.endpoint = defaultEndpoint
+	}
+	if !strings.HasSuffix(s.endpoint, "/") {
+		s.endpoint += "/"
+	}
+
+	for _, m := range conn.GetModules() {
+		mod, err := parseModule(m)
+		if err != nil {
+			return err
+		}
+		s.modules = append(s.modules, mod)
+	}
+	if len(s.modules) == 0 {
+		return errors.New("at least one module is required")
+	}
+	s.maxVersions = int(conn.GetMaxVersions())
+	s.client = common.RetryableHttpClientTimeout(300)
+
+	return nil
+}
+
+// parseModule parses a module path with an optional @version suffix.
+func parseModule(value string) (module.Version, error) {
+	path, version, _ := strings.Cut(value, "@")
+	if err := module.CheckPath(path); err != nil {
+		return module.Version{}, fmt.Errorf("invalid module %q: %w", value, err)
+	}
+	return module.Version{Path: path, Version: version}, nil
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) error {
+	var scanned uint64
+	for i, mod := range s.modules {
+		if common.IsDone(ctx) {
+			break
+		}
+		s.SetProgressComplete(i, len(s.modules), fmt.Sprintf("Module: %s", mod.Path), "")
+
+		versions := []string{mod.Version}
+		if mod.Version == "" {
+			var err error
+			versions, err = s.versions(ctx, mod.Path)
+			if err != nil {
+				s.log.Error(err, "could not list module versions", "module", mod.Path)
+				continue
+			}
+		}
+		for _, v := range versions {
+			mod := module.Version{Path: mod.Path, Version: v}
+			s.jobPool.Go(func() error {
+				if err := s.scanVersion(ctx, mod, chunksChan); err != nil {
+					s.log.Error(err, "could not scan module", "module", mod.String())
+					return nil
+				}
+				atomic.AddUint64(&scanned, 1)
+				return nil
+			})
+		}
+	}
+	_ = s.jobPool.Wait()
+
+	s.SetProgressComplete(len(s.modules), len(s.modules), fmt.Sprintf("Completed scanning source %s. %d module versions scanned.", s.name, scanned), "")
+	return nil
+}
+
+// versions returns the tagged versions of a module from newest to oldest,
+// limited to maxVersions when it is set. Modules without tags fall back to
+// the pseudo-version reported as latest.
+func (s *Source) versions(ctx context.Context, path string) ([]string, error) {
+	escaped, err := module.EscapePath(path)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.get(ctx, escaped+"/@v/list")
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	var versions []string
+	scanner := bufio.NewScanner(res.Body)
+	for scanner.Scan() {
+		if v := strings.TrimSpace(scanner.Text()); semver.IsValid(v) {
+			versions = append(versions, v)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(versions) == 0 {
+		var latest struct {
+			Version string
+		}
+		if err := s.getJSON(ctx, escaped+"/@latest", &latest); err != nil {
+			return nil, err
+		}
+		return []string{latest.Version}, nil
+	}
+
+	sort.Slice(versions, func(i, j int) bool {
+		return semver.Compare(versions[i], versions[j]) > 0
+	})
+	if s.maxVersions > 0 && len(versions) > s.maxVersions {
+		versions = versions[:s.maxVersions]
+	}
+	return versions, nil
+}
+
+func (s *Source) scanVersion(ctx context.Context, mod module.Version, chunksChan chan *sources.Chunk) error {
+	escaped, err := module.EscapePath(mod.Path)
+	if err != nil {
+		return err
+	}
+	escapedVersion, err := module.EscapeVersion(mod.Version)
+	if err != nil {
+		return err
+	}
+	file := fmt.Sprintf("%s/@v/%s.zip", escaped, escapedVersion)
+	res, err := s.get(ctx, file)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	reader, err := diskbufferreader.New(io.LimitReader(res.Body, maxZipSize))
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	link := s.endpoint + file
+	if s.endpoint == defaultEndpoint {
+		link = fmt.Sprintf("https://pkg.go.dev/%s@%s", mod.Path, mod.Version)
+	}
+	chunkSkel := &sources.Chunk{
+		SourceType: s.Type(),
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_GoModule{
+				GoModule: &source_metadatapb.GoModule{
+					File:    sanitizer.UTF8(file),
+					Module:  sanitizer.UTF8(mod.Path),
+					Version: sanitizer.UTF8(mod.Version),
+					Link:    sanitizer.UTF8(link),
+				},
+			},
+		},
+		Verify: s.verify,
+	}
+	if !handlers.HandleFile(ctx, reader, chunkSkel, chunksChan) {
+		return fmt.Errorf("could not extract %s", file)
+	}
+	return nil
+}
+
+func (s *Source) getJSON(ctx context.Context, path string, out interface{}) error {
+	res, err := s.get(ctx, path)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	return json.NewDecoder(res.Body).Decode(out)
+}
+
+func (s *Source) get(ctx context.Context, path string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.endpoint+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	s.auth(req)
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		res.Body.Close()
+		return nil, fmt.Errorf("unexpected status code %d requesting %s", res.StatusCode, path)
+	}
+	return res, nil
+}
@@ -0,0 +1,79 @@
+package gomodproxy
+
+import (
+	"archive/zip"
+	"bytes"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/mod/module"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/sourcestest"
+)
+
+func moduleZip(t *testing.T, name, content string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	w, err := zw.Create(name)
+	assert.NoError(t, err)
+	_, err = w.Write([]byte(content))
+	assert.NoError(t, err)
+	assert.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func TestParseModule(t *testing.T) {
+	mod, err := parseModule("github.com/Acme/widgets@v1.2.3")
+	assert.NoError(t, err)
+	assert.Equal(t, module.Version{Path: "github.com/Acme/widgets", Version: "v1.2.3"}, mod)
+
+	_, err = parseModule("not a module")
+	assert.Error(t, err)
+}
+
+func TestSource_Chunks(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://proxy.golang.org").
+		Get("/github.com/!acme/widgets/@v/list").
+		Reply(200).
+		BodyString("v1.0.0\nv1.10.0\nv1.9.0\n")
+	gock.New("https://proxy.golang.org").
+		Get("/github.com/!acme/widgets/@v/v1.10.0.zip").
+		Reply(200).
+		Body(bytes.NewReader(moduleZip(t, "github.com/!acme/widgets@v1.10.0/config.go", `const token = "secret"`)))
+	gock.New("https://proxy.golang.org").
+		Get("/github.com/!acme/widgets/@v/v1.9.0.zip").
+		Reply(200).
+		Body(bytes.NewReader(moduleZip(t, "github.com/!acme/widgets@v1.9.0/config.go", `const token = "old"`)))
+
+	s := &Source{}
+	sourcestest.Init(t, s, &sourcespb.GoModuleProxy{
+		Credential:  &sourcespb.GoModuleProxy_Unauthenticated{Unauthenticated: &credentialspb.Unauthenticated{}},
+		Modules:     []string{"github.com/Acme/widgets"},
+		MaxVersions: 2,
+	}, func() *http.Client { return s.client })
+
+	chunksCh := make(chan *sources.Chunk, 10)
+	assert.NoError(t, s.Chunks(context.Background(), chunksCh))
+	close(chunksCh)
+
+	var data, links []string
+	for chunk := range chunksCh {
+		meta := chunk.SourceMetadata.GetGoModule()
+		assert.Equal(t, "github.com/Acme/widgets", meta.Module)
+		links = append(links, meta.Link)
+		data = append(data, strings.TrimRight(string(chunk.Data), "\x00"))
+	}
+	assert.Equal(t, []string{`const token = "secret"`, `const token = "old"`}, data)
+	assert.Equal(t, "https://pkg.go.dev/github.com/Acme/widgets@v1.10.0", links[0])
+	assert.True(t, gock.IsDone())
+}
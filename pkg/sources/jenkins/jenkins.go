@@ -0,0 +1,454 @@
+package jenkins
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	diskbufferreader "github.com/bill-rich/disk-buffer-reader"
+	"github.com/go-errors/errors"
+	"github.com/go-logr/logr"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const (
+	// maxDownloadSize is the largest console log or artifact that will be scanned.
+	maxDownloadSize = 100 * 1024 * 1024 // 100MB
+)
+
+type Source struct {
+	name     string
+	sourceId int64
+	jobId    int64
+	verify   bool
+	endpoint string
+	// auth sets the credentials on requests to Jenkins.
+	auth          func(*http.Request)
+	crumbField    string
+	crumb         string
+	folders       []string
+	ignoreFolders []string
+	maxBuilds     int
+	skipArtifacts bool
+	client        *http.Client
+	jobPool       *errgroup.Group
+	log           logr.Logger
+	sources.Progress
+}
+
+// Ensure the Source satisfies the interface at compile time.
+var _ sources.Source = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return sourcespb.SourceType_SOURCE_TYPE_JENKINS
+}
+
+func (s *Source) SourceID() int64 {
+	return s.sourceId
+}
+
+func (s *Source) JobID() int64 {
+	return s.jobId
+}
+
+// Init returns an initialized Jenkins source.
+func (s *Source) Init(aCtx context.Context, name string, jobId, sourceId int64, verify bool, connection *anypb.Any, concurrency int) error {
+	s.log = context.WithValues(aCtx, "source", s.Type(), "name", name).Logger()
+
+	s.name = name
+	s.sourceId = sourceId
+	s.jobId = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	var conn sourcespb.Jenkins
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return errors.WrapPrefix(err, "error unmarshalling connection", 0)
+	}
+
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.Jenkins_Unauthenticated:
+		s.auth = func(*http.Request) {}
+	case *sourcespb.Jenkins_BasicAuth:
+		s.auth = func(req *http.Request) {
+			req.SetBasicAuth(cred.BasicAuth.GetUsername(), cred.BasicAuth.GetPassword())
+		}
+	case *sourcespb.Jenkins_Header:
+		s.auth = func(req *http.Request) { req.Header.Set(cred.Header.GetKey(), cred.Header.GetValue()) }
+	default:
+		return errors.Errorf("invalid configuration given for %s source", name)
+	}
+
+	s.endpoint = conn.GetEndpoint()
+	if s.endpoint == "" {
+		return errors.New("endpoint is required")
+	}
+	if !strings.HasSuffix(s.endpoint, "/") {
+		s.endpoint += "/"
+	}
+	for _, folder := range conn.GetFolders() {
+		s.folders = append(s.folders, strings.Trim(folder, "/"))
+	}
+	for _, folder := range conn.GetIgnoreFolders() {
+		s.ignoreFolders = append(s.ignoreFolders, strings.Trim(folder, "/"))
+	}
+	s.maxBuilds = int(conn.GetMaxBuilds())
+	s.skipArtifacts = conn.GetSkipArtifacts()
+
+	s.client = common.RetryableHttpClientTimeout(120)
+	if conn.GetInsecureSkipVerifyTls() {
+		s.client.Transport = common.NewCustomTransport(&http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		})
+	}
+	// Crumbs are bound to the session that requested them.
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		return err
+	}
+	s.client.Jar = jar
+
+	return nil
+}
+
+type item struct {
+	Name  string `json:"name"`
+	Class string `json:"_class"`
+}
+
+// isFolder reports whether an item contains other items, such as folders,
+// organization folders and multibranch pipelines.
+func (i item) isFolder() bool {
+	return strings.HasSuffix(i.Class, "Folder") || strings.HasSuffix(i.Class, "MultiBranchProject")
+}
+
+type build struct {
+	Number    int64 `json:"number"`
+	Timestamp int64 `json:"timestamp"`
+	Artifacts []struct {
+		RelativePath string `json:"relativePath"`
+	} `json:"artifacts"`
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) error {
+	s.fetchCrumb(ctx)
+
+	jobs, err := s.listJobs(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error listing jobs: %w", err)
+	}
+
+	var scanned uint64
+	for i, job := range jobs {
+		if common.IsDone(ctx) {
+			break
+		}
+		s.SetProgressComplete(i, len(jobs), fmt.Sprintf("Job: %s", strings.Join(job, "/")), "")
+
+		job := job
+		s.jobPool.Go(func() error {
+			if err := s.scanJob(ctx, job, chunksChan); err != nil {
+				s.log.Error(err, "could not scan job", "job", strings.Join(job, "/"))
+				return nil
+			}
+			atomic.AddUint64(&scanned, 1)
+			return nil
+		})
+	}
+	_ = s.jobPool.Wait()
+
+	s.SetProgressComplete(len(jobs), len(jobs), fmt.Sprintf("Completed scanning source %s. %d jobs scanned.", s.name, scanned), "")
+	return nil
+}
+
+// fetchCrumb requests a CSRF crumb. Instances with CSRF protection disabled
+// don't have a crumb issuer, so failures are not fatal.
+func (s *Source) fetchCrumb(ctx context.Context) {
+	var crumb struct {
+		Crumb             string `json:"crumb"`
+		CrumbRequestField string `json:"crumbRequestField"`
+	}
+	if err := s.getJSON(ctx, s.endpoint+"crumbIssuer/api/json", &crumb); err != nil {
+		s.log.V(2).Info("could not get crumb", "error", err.Error())
+		return
+	}
+	s.crumbField, s.crumb = crumb.CrumbRequestField, crumb.Crumb
+}
+
+// listJobs recursively lists the jobs and folders under a folder. Folders are
+// returned too, as their configuration can hold credentials as well.
+func (s *Source) listJobs(ctx context.Context, folder []string) ([][]string, error) {
+	var res struct {
+		Jobs []item `json:"jobs"`
+	}
+	if err := s.getJSON(ctx, s.jobURL(folder)+"api/json?tree=jobs[name]", &res); err != nil {
+		return nil, err
+	}
+
+	var jobs [][]string
+	for _, it := range res.Jobs {
+		path := append(append([]string{}, folder...), it.Name)
+		fullName := strings.Join(path, "/")
+		if s.ignored(fullName) {
+			continue
+		}
+		if s.included(fullName) {
+			jobs = append(jobs, path)
+		}
+		if !it.isFolder() || !s.mayContainIncluded(fullName) {
+			continue
+		}
+		children, err := s.listJobs(ctx, path)
+		if err != nil {
+			s.log.Error(err, "could not list folder", "folder", fullName)
+			continue
+		}
+		jobs = append(jobs, children...)
+	}
+	return jobs, nil
+}
+
+// under reports whether name is folder or an item inside it.
+func under(name, folder string) bool {
+	return name == folder || strings.HasPrefix(name, folder+"/")
+}
+
+func (s *Source) ignored(name string) bool {
+	for _, folder := range s.ignoreFolders {
+		if under(name, folder) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Source) included(name string) bool {
+	if len(s.folders) == 0 {
+		return true
+	}
+	for _, folder := range s.folders {
+		if under(name, folder) {
+			return true
+		}
+	}
+	return false
+}
+
+// mayContainIncluded reports whether a folder is, or is a parent of, one of
+// the folders to scan.
+func (s *Source) mayContainIncluded(name string) bool {
+	if s.included(name) {
+		return true
+	}
+	for _, folder := range s.folders {
+		if strings.HasPrefix(folder, name+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// jobURL returns the URL of a job from its path, which is independent of the
+// root URL Jenkins is configured with.
+func (s *Source) jobURL(path []string) string {
+	var b strings.Builder
+	b.WriteString(s.endpoint)
+	for _, name := range path {
+		b.WriteString("job/" + url.PathEscape(name) + "/")
+	}
+	return b.String()
+}
+
+func (s *Source) scanJob(ctx context.Context, job []string, chunksChan chan *sources.Chunk) error {
+	jobURL := s.jobURL(job)
+	fullName := strings.Join(job, "/")
+
+	config, err := s.download(ctx, jobURL+"config.xml")
+	if err != nil {
+		return err
+	}
+	meta := &source_metadatapb.Jenkins{
+		ProjectName: sanitizer.UTF8(fullName),
+		Link:        sanitizer.UTF8(jobURL + "config.xml"),
+		File:        "config.xml",
+	}
+	if err := s.send(ctx, meta, config, chunksChan); err != nil {
+		return err
+	}
+
+	// allBuilds is needed to go past the 100 most recent builds.
+	field := "allBuilds"
+	if s.maxBuilds > 0 {
+		field = "builds"
+	}
+	tree := field + "[number,timestamp,artifacts[relativePath]]"
+	if s.maxBuilds > 0 {
+		tree += fmt.Sprintf("{0,%d}", s.maxBuilds)
+	}
+	var res struct {
+		Builds    []build `json:"builds"`
+		AllBuilds []build `json:"allBuilds"`
+	}
+	if err := s.getJSON(ctx, jobURL+"api/json?tree="+url.QueryEscape(tree), &res); err != nil {
+		// Folders don't have builds.
+		s.log.V(2).Info("could not list builds", "job", fullName, "error", err.Error())
+		return nil
+	}
+	for _, b := range append(res.Builds, res.AllBuilds...) {
+		if common.IsDone(ctx) {
+			return ctx.Err()
+		}
+		if err := s.scanBuild(ctx, job, b, chunksChan); err != nil {
+			s.log.Error(err, "could not scan build", "job", fullName, "build", b.Number)
+		}
+	}
+	return nil
+}
+
+func (s *Source) scanBuild(ctx context.Context, job []string, b build, chunksChan chan *sources.Chunk) error {
+	buildURL := s.jobURL(job) + strconv.FormatInt(b.Number, 10) + "/"
+	newMeta := func(file, link string) *source_metadatapb.Jenkins {
+		return &source_metadatapb.Jenkins{
+			ProjectName: sanitizer.UTF8(strings.Join(job, "/")),
+			BuildNumber: b.Number,
+			Link:        sanitizer.UTF8(link),
+			Timestamp:   time.UnixMilli(b.Timestamp).UTC().Format(time.RFC3339),
+			File:        sanitizer.UTF8(file),
+		}
+	}
+
+	console, err := s.download(ctx, buildURL+"consoleText")
+	if err != nil {
+		return err
+	}
+	if err := s.send(ctx, newMeta("console", buildURL+"console"), console, chunksChan); err != nil {
+		return err
+	}
+
+	if s.skipArtifacts {
+		return nil
+	}
+	for _, artifact := range b.Artifacts {
+		link := buildURL + "artifact/" + (&url.URL{Path: artifact.RelativePath}).EscapedPath()
+		if err := s.scanArtifact(ctx, link, newMeta(artifact.RelativePath, link), chunksChan); err != nil {
+			s.log.Error(err, "could not scan artifact", "artifact", artifact.RelativePath)
+		}
+	}
+	return nil
+}
+
+func (s *Source) scanArtifact(ctx context.Context, link string, meta *source_metadatapb.Jenkins, chunksChan chan *sources.Chunk) error {
+	res, err := s.get(ctx, link)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	reader, err := diskbufferreader.New(io.LimitReader(res.Body, maxDownloadSize))
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	chunkSkel := s.chunk(meta, nil)
+	if handlers.HandleFile(ctx, reader, chunkSkel, chunksChan) {
+		return nil
+	}
+	if err := reader.Reset(); err != nil {
+		return err
+	}
+	reader.Stop()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	return s.send(ctx, meta, data, chunksChan)
+}
+
+func (s *Source) chunk(meta *source_metadatapb.Jenkins, data []byte) *sources.Chunk {
+	return &sources.Chunk{
+		SourceType: s.Type(),
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		Data:       data,
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Jenkins{
+				Jenkins: meta,
+			},
+		},
+		Verify: s.verify,
+	}
+}
+
+func (s *Source) send(ctx context.Context, meta *source_metadatapb.Jenkins, data []byte, chunksChan chan *sources.Chunk) error {
+	for c := range sources.Chunker(s.chunk(meta, data)) {
+		select {
+		case chunksChan <- c:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+func (s *Source) getJSON(ctx context.Context, u string, out interface{}) error {
+	res, err := s.get(ctx, u)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	return json.NewDecoder(res.Body).Decode(out)
+}
+
+func (s *Source) download(ctx context.Context, u string) ([]byte, error) {
+	res, err := s.get(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	return io.ReadAll(io.LimitReader(res.Body, maxDownloadSize))
+}
+
+func (s *Source) get(ctx context.Context, u string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	s.auth(req)
+	if s.crumb != "" {
+		req.Header.Set(s.crumbField, s.crumb)
+	}
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		res.Body.Close()
+		return nil, fmt.Errorf("unexpected status code %d requesting %s", res.StatusCode, req.URL.Path)
+	}
+	return res, nil
+}
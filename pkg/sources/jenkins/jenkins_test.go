@@ -0,0 +1,96 @@
+package jenkins
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/sourcestest"
+)
+
+const folderClass = "com.cloudbees.hudson.plugins.folder.Folder"
+
+func TestSource_Chunks(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://jenkins.example.com").
+		Get("/crumbIssuer/api/json").
+		MatchHeader("Authorization", "^Basic ").
+		Reply(200).
+		JSON(map[string]string{"crumb": "abc", "crumbRequestField": "Jenkins-Crumb"})
+	gock.New("https://jenkins.example.com").
+		Get("^/api/json").
+		MatchHeader("Jenkins-Crumb", "abc").
+		Reply(200).
+		JSON(map[string]interface{}{"jobs": []map[string]string{
+			{"name": "team-a", "_class": folderClass},
+			{"name": "team-b", "_class": folderClass},
+		}})
+	gock.New("https://jenkins.example.com").
+		Get("/job/team-a/api/json").
+		Reply(200).
+		JSON(map[string]interface{}{"jobs": []map[string]string{
+			{"name": "deploy", "_class": "org.jenkinsci.plugins.workflow.job.WorkflowJob"},
+			{"name": "sandbox", "_class": folderClass},
+		}})
+	gock.New("https://jenkins.example.com").
+		Get("/job/team-a/job/deploy/config.xml").
+		Reply(200).
+		BodyString("<flow-definition><password>hunter2</password></flow-definition>")
+	gock.New("https://jenkins.example.com").
+		Get("/job/team-a/job/deploy/api/json").
+		MatchParam("tree", `^builds\[number,timestamp,artifacts\[relativePath\]\]\{0,1\}$`).
+		Reply(200).
+		JSON(map[string]interface{}{"builds": []map[string]interface{}{
+			{"number": 42, "timestamp": 1670000000000, "artifacts": []map[string]string{{"relativePath": "out/app.env"}}},
+		}})
+	gock.New("https://jenkins.example.com").
+		Get("/job/team-a/job/deploy/42/consoleText").
+		Reply(200).
+		BodyString("+ curl -u admin:hunter3 https://example.com")
+	gock.New("https://jenkins.example.com").
+		Get("/job/team-a/job/deploy/42/artifact/out/app.env").
+		Reply(200).
+		BodyString("TOKEN=hunter4")
+
+	s := &Source{}
+	sourcestest.Init(t, s, &sourcespb.Jenkins{
+		Endpoint: "https://jenkins.example.com",
+		Credential: &sourcespb.Jenkins_BasicAuth{BasicAuth: &credentialspb.BasicAuth{
+			Username: "admin",
+			Password: "api-token",
+		}},
+		Folders:       []string{"team-a/deploy"},
+		IgnoreFolders: []string{"team-a/sandbox"},
+		MaxBuilds:     1,
+	}, func() *http.Client { return s.client })
+
+	chunksCh := make(chan *sources.Chunk, 10)
+	assert.NoError(t, s.Chunks(context.Background(), chunksCh))
+	close(chunksCh)
+
+	files := make(map[string]string)
+	for chunk := range chunksCh {
+		meta := chunk.SourceMetadata.GetJenkins()
+		assert.Equal(t, "team-a/deploy", meta.ProjectName)
+		files[meta.File] = strings.TrimRight(string(chunk.Data), "\x00")
+		if meta.File == "console" {
+			assert.Equal(t, int64(42), meta.BuildNumber)
+			assert.Equal(t, "2022-12-02T16:53:20Z", meta.Timestamp)
+			assert.Equal(t, "https://jenkins.example.com/job/team-a/job/deploy/42/console", meta.Link)
+		}
+	}
+	assert.Equal(t, map[string]string{
+		"config.xml":  "<flow-definition><password>hunter2</password></flow-definition>",
+		"console":     "+ curl -u admin:hunter3 https://example.com",
+		"out/app.env": "TOKEN=hunter4",
+	}, files)
+	assert.True(t, gock.IsDone())
+}
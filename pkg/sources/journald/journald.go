@@ -0,0 +1,216 @@
+package journald
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/go-logr/logr"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+type Source struct {
+	name     string
+	sourceId int64
+	jobId    int64
+	verify   bool
+	// journalctl is the command used to read the journal.
+	journalctl string
+	args       []string
+	log        logr.Logger
+	sources.Progress
+}
+
+// Ensure the Source satisfies the interface at compile time.
+var _ sources.Source = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return sourcespb.SourceType_SOURCE_TYPE_JOURNALD
+}
+
+func (s *Source) SourceID() int64 {
+	return s.sourceId
+}
+
+func (s *Source) JobID() int64 {
+	return s.jobId
+}
+
+// Init returns an initialized journald source.
+func (s *Source) Init(aCtx context.Context, name string, jobId, sourceId int64, verify bool, connection *anypb.Any, _ int) error {
+	s.log = context.WithValues(aCtx, "source", s.Type(), "name", name).Logger()
+
+	s.name = name
+	s.sourceId = sourceId
+	s.jobId = jobId
+	s.verify = verify
+
+	var conn sourcespb.Journald
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return errors.WrapPrefix(err, "error unmarshalling connection", 0)
+	}
+
+	s.journalctl = "journalctl"
+	s.args = journalctlArgs(&conn)
+	return nil
+}
+
+// journalctlArgs returns the arguments making journalctl print the selected
+// entries as JSON, one per line.
+func journalctlArgs(conn *sourcespb.Journald) []string {
+	args := []string{"--output=json", "--no-pager", "--all"}
+	for _, unit := range conn.GetUnits() {
+		args = append(args, "--unit="+unit)
+	}
+	if conn.GetSince() != nil {
+		args = append(args, fmt.Sprintf("--since=@%d", conn.GetSince().AsTime().Unix()))
+	}
+	if conn.GetUntil() != nil {
+		args = append(args, fmt.Sprintf("--until=@%d", conn.GetUntil().AsTime().Unix()))
+	}
+	if conn.GetDirectory() != "" {
+		args = append(args, "--directory="+conn.GetDirectory())
+	}
+	if conn.GetTail() {
+		args = append(args, "--follow")
+	}
+	return args
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) error {
+	cmd := exec.CommandContext(ctx, s.journalctl, s.args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	if err := cmd.Start(); err != nil {
+		return errors.WrapPrefix(err, "could not run journalctl", 0)
+	}
+
+	var scanned int
+	reader := bufio.NewReader(stdout)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(bytes.TrimSpace(line)) > 0 {
+			if err := s.scanEntry(ctx, line, chunksChan); err != nil {
+				_ = cmd.Process.Kill()
+				_ = cmd.Wait()
+				return err
+			}
+			scanned++
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			_ = cmd.Process.Kill()
+			_ = cmd.Wait()
+			return err
+		}
+	}
+
+	if err := cmd.Wait(); err != nil && ctx.Err() == nil {
+		return fmt.Errorf("journalctl failed: %w: %s", err, bytes.TrimSpace(stderr.Bytes()))
+	}
+
+	s.SetProgressComplete(1, 1, fmt.Sprintf("Completed scanning source %s. %d journal entries scanned.", s.name, scanned), "")
+	return nil
+}
+
+func (s *Source) scanEntry(ctx context.Context, line []byte, chunksChan chan *sources.Chunk) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(line, &fields); err != nil {
+		s.log.V(2).Info("could not parse journal entry", "error", err)
+		return nil
+	}
+
+	meta := &source_metadatapb.Journald{
+		Hostname:   sanitizer.UTF8(field(fields, "_HOSTNAME")),
+		Unit:       sanitizer.UTF8(field(fields, "_SYSTEMD_UNIT")),
+		Identifier: sanitizer.UTF8(field(fields, "SYSLOG_IDENTIFIER")),
+		Pid:        field(fields, "_PID"),
+		Cursor:     field(fields, "__CURSOR"),
+	}
+	if usec, err := strconv.ParseInt(field(fields, "__REALTIME_TIMESTAMP"), 10, 64); err == nil {
+		meta.Timestamp = time.UnixMicro(usec).UTC().Format(time.RFC3339)
+	}
+
+	chunk := &sources.Chunk{
+		SourceType: s.Type(),
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		Data:       render(fields),
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Journald{
+				Journald: meta,
+			},
+		},
+		Verify: s.verify,
+	}
+	for c := range sources.Chunker(chunk) {
+		select {
+		case chunksChan <- c:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// field returns the value of a journal field. journalctl prints fields that
+// aren't valid UTF-8 as arrays of bytes, and fields repeated in an entry as
+// arrays of values, of which the first is returned.
+func field(fields map[string]json.RawMessage, name string) string {
+	raw, ok := fields[name]
+	if !ok {
+		return ""
+	}
+	var str string
+	if err := json.Unmarshal(raw, &str); err == nil {
+		return str
+	}
+	var data []int
+	if err := json.Unmarshal(raw, &data); err == nil {
+		b := make([]byte, len(data))
+		for i, v := range data {
+			b[i] = byte(v)
+		}
+		return string(b)
+	}
+	var values []json.RawMessage
+	if err := json.Unmarshal(raw, &values); err == nil && len(values) > 0 {
+		return field(map[string]json.RawMessage{name: values[0]}, name)
+	}
+	return ""
+}
+
+// render formats the message of an entry followed by the command line of the
+// process that logged it.
+func render(fields map[string]json.RawMessage) []byte {
+	var b strings.Builder
+	b.WriteString(field(fields, "MESSAGE"))
+	b.WriteString("\n")
+	if cmdline := field(fields, "_CMDLINE"); cmdline != "" {
+		b.WriteString("_CMDLINE = " + cmdline + "\n")
+	}
+	return []byte(b.String())
+}
@@ -0,0 +1,73 @@
+package journald
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+func TestJournalctlArgs(t *testing.T) {
+	args := journalctlArgs(&sourcespb.Journald{
+		Units:     []string{"nginx.service", "cron.service"},
+		Since:     timestamppb.New(time.Unix(1672531200, 0)),
+		Directory: "/var/log/journal",
+	})
+	assert.Equal(t, []string{
+		"--output=json", "--no-pager", "--all",
+		"--unit=nginx.service", "--unit=cron.service",
+		"--since=@1672531200",
+		"--directory=/var/log/journal",
+	}, args)
+}
+
+func TestSource_Chunks(t *testing.T) {
+	// A fake journalctl prints fixed entries.
+	dir := t.TempDir()
+	journalctl := filepath.Join(dir, "journalctl")
+	script := `#!/bin/sh
+echo '{"__CURSOR":"s=1","__REALTIME_TIMESTAMP":"1672531200000000","_HOSTNAME":"web-1","_SYSTEMD_UNIT":"app.service","_PID":"42","MESSAGE":"connecting with password=hunter2","_CMDLINE":"/usr/bin/app --token abc"}'
+echo 'not json'
+echo '{"__CURSOR":"s=2","MESSAGE":[104,105,255]}'
+`
+	if err := os.WriteFile(journalctl, []byte(script), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	s := &Source{}
+	a, err := anypb.New(&sourcespb.Journald{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Init(context.Background(), "test - journald", 0, 0, false, a, 1); err != nil {
+		t.Fatal(err)
+	}
+	s.journalctl = journalctl
+
+	chunksCh := make(chan *sources.Chunk, 10)
+	assert.NoError(t, s.Chunks(context.Background(), chunksCh))
+	close(chunksCh)
+
+	var chunks []*sources.Chunk
+	for c := range chunksCh {
+		chunks = append(chunks, c)
+	}
+	if assert.Len(t, chunks, 2) {
+		assert.Equal(t, "connecting with password=hunter2\n_CMDLINE = /usr/bin/app --token abc\n", string(chunks[0].Data))
+		meta := chunks[0].SourceMetadata.GetJournald()
+		assert.Equal(t, "web-1", meta.GetHostname())
+		assert.Equal(t, "app.service", meta.GetUnit())
+		assert.Equal(t, "42", meta.GetPid())
+		assert.Equal(t, "2023-01-01T00:00:00Z", meta.GetTimestamp())
+
+		assert.Equal(t, "hi\xff\n", string(chunks[1].Data))
+	}
+}
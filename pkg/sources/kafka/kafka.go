@@ -0,0 +1,435 @@
+package kafka
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/go-logr/logr"
+	"github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/sasl/plain"
+	"github.com/segmentio/kafka-go/sasl/scram"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const (
+	// idleTimeout ends a bounded scan of a partition that stops returning
+	// messages before its end offset, which happens when the last offsets
+	// hold transaction markers or were removed by compaction.
+	idleTimeout = 10 * time.Second
+	// maxKeyLength is the length keys are truncated to in metadata.
+	maxKeyLength = 256
+)
+
+type Source struct {
+	name        string
+	sourceId    int64
+	jobId       int64
+	verify      bool
+	brokers     []string
+	topics      []string
+	partitions  map[int]bool
+	startOffset int64
+	endOffset   int64
+	since       time.Time
+	until       time.Time
+	tail        bool
+	maxMessages int64
+	dialer      *kafka.Dialer
+	jobPool     *errgroup.Group
+	log         logr.Logger
+	sources.Progress
+}
+
+// Ensure the Source satisfies the interface at compile time.
+var _ sources.Source = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return sourcespb.SourceType_SOURCE_TYPE_KAFKA
+}
+
+func (s *Source) SourceID() int64 {
+	return s.sourceId
+}
+
+func (s *Source) JobID() int64 {
+	return s.jobId
+}
+
+// Init returns an initialized Kafka source.
+func (s *Source) Init(aCtx context.Context, name string, jobId, sourceId int64, verify bool, connection *anypb.Any, concurrency int) error {
+	s.log = context.WithValues(aCtx, "source", s.Type(), "name", name).Logger()
+
+	s.name = name
+	s.sourceId = sourceId
+	s.jobId = jobId
+	s.verify = verify
+
+	var conn sourcespb.Kafka
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return errors.WrapPrefix(err, "error unmarshalling connection", 0)
+	}
+
+	if len(conn.GetBrokers()) == 0 {
+		return errors.New("at least one broker is required")
+	}
+	s.brokers = conn.GetBrokers()
+
+	s.dialer = &kafka.Dialer{
+		Timeout:   time.Minute,
+		DualStack: true,
+	}
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.Kafka_Unauthenticated:
+	case *sourcespb.Kafka_SaslPlain:
+		s.dialer.SASLMechanism = plain.Mechanism{
+			Username: cred.SaslPlain.Username,
+			Password: cred.SaslPlain.Password,
+		}
+	case *sourcespb.Kafka_SaslScramSha256:
+		mechanism, err := scram.Mechanism(scram.SHA256, cred.SaslScramSha256.Username, cred.SaslScramSha256.Password)
+		if err != nil {
+			return errors.WrapPrefix(err, "could not create SCRAM mechanism", 0)
+		}
+		s.dialer.SASLMechanism = mechanism
+	case *sourcespb.Kafka_SaslScramSha512:
+		mechanism, err := scram.Mechanism(scram.SHA512, cred.SaslScramSha512.Username, cred.SaslScramSha512.Password)
+		if err != nil {
+			return errors.WrapPrefix(err, "could not create SCRAM mechanism", 0)
+		}
+		s.dialer.SASLMechanism = mechanism
+	default:
+		return errors.Errorf("invalid configuration given for %s source", name)
+	}
+
+	tlsConfig, err := newTLSConfig(&conn)
+	if err != nil {
+		return err
+	}
+	s.dialer.TLS = tlsConfig
+
+	s.topics = conn.GetTopics()
+	if len(conn.GetPartitions()) > 0 {
+		s.partitions = make(map[int]bool, len(conn.GetPartitions()))
+		for _, p := range conn.GetPartitions() {
+			s.partitions[int(p)] = true
+		}
+	}
+	s.startOffset = conn.GetStartOffset()
+	s.endOffset = conn.GetEndOffset()
+	if conn.GetSince() != nil {
+		s.since = conn.GetSince().AsTime()
+	}
+	if conn.GetUntil() != nil {
+		s.until = conn.GetUntil().AsTime()
+	}
+	s.tail = conn.GetTail()
+	s.maxMessages = conn.GetMaxMessages()
+
+	s.jobPool = &errgroup.Group{}
+	// Tailed partitions are consumed until the scan is cancelled, so they
+	// can't wait for each other.
+	if !s.tail {
+		s.jobPool.SetLimit(concurrency)
+	}
+
+	return nil
+}
+
+// newTLSConfig returns the TLS configuration used to connect to the brokers,
+// or nil when TLS is not enabled.
+func newTLSConfig(conn *sourcespb.Kafka) (*tls.Config, error) {
+	if !conn.GetTls() && conn.GetTlsCa() == "" && conn.GetTlsCert() == "" && !conn.GetInsecureSkipVerifyTls() {
+		return nil, nil
+	}
+
+	cfg := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: conn.GetInsecureSkipVerifyTls(),
+	}
+	if conn.GetTlsCa() != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(conn.GetTlsCa())) {
+			return nil, errors.New("could not parse CA certificate")
+		}
+		cfg.RootCAs = pool
+	}
+	if conn.GetTlsCert() != "" || conn.GetTlsKey() != "" {
+		cert, err := tls.X509KeyPair([]byte(conn.GetTlsCert()), []byte(conn.GetTlsKey()))
+		if err != nil {
+			return nil, errors.WrapPrefix(err, "could not load key pair", 0)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	return cfg, nil
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) error {
+	partitions, err := s.listPartitions(ctx)
+	if err != nil {
+		return err
+	}
+
+	var scanned uint64
+	for i, partition := range partitions {
+		if common.IsDone(ctx) {
+			break
+		}
+		s.SetProgressComplete(i, len(partitions), fmt.Sprintf("Topic: %s, partition: %d", partition.Topic, partition.ID), "")
+
+		partition := partition
+		s.jobPool.Go(func() error {
+			n, err := s.scanPartition(ctx, partition, chunksChan)
+			atomic.AddUint64(&scanned, n)
+			if err != nil && !errors.Is(err, ctx.Err()) {
+				s.log.Error(err, "could not scan partition", "topic", partition.Topic, "partition", partition.ID)
+			}
+			return nil
+		})
+	}
+	_ = s.jobPool.Wait()
+
+	s.SetProgressComplete(len(partitions), len(partitions), fmt.Sprintf("Completed scanning source %s. %d messages scanned.", s.name, scanned), "")
+	return nil
+}
+
+// listPartitions returns the partitions of the topics to scan, using the
+// first broker that can be reached.
+func (s *Source) listPartitions(ctx context.Context) ([]kafka.Partition, error) {
+	var conn *kafka.Conn
+	var err error
+	for _, broker := range s.brokers {
+		conn, err = s.dialer.DialContext(ctx, "tcp", broker)
+		if err == nil {
+			break
+		}
+		s.log.V(2).Info("could not connect to broker", "broker", broker, "error", err)
+	}
+	if err != nil {
+		return nil, errors.WrapPrefix(err, "could not connect to any broker", 0)
+	}
+	defer conn.Close()
+
+	all, err := conn.ReadPartitions(s.topics...)
+	if err != nil {
+		return nil, errors.WrapPrefix(err, "could not list partitions", 0)
+	}
+	return s.selected(all), nil
+}
+
+// selected filters out partitions that were not asked for. Internal topics
+// are only scanned when they are named explicitly.
+func (s *Source) selected(all []kafka.Partition) []kafka.Partition {
+	var partitions []kafka.Partition
+	for _, p := range all {
+		if len(s.topics) == 0 && strings.HasPrefix(p.Topic, "__") {
+			continue
+		}
+		if s.partitions != nil && !s.partitions[p.ID] {
+			continue
+		}
+		partitions = append(partitions, p)
+	}
+	return partitions
+}
+
+// scanPartition reads the messages of a partition between the configured
+// offsets. When tailing without an end, it keeps reading until the context is
+// cancelled.
+func (s *Source) scanPartition(ctx context.Context, partition kafka.Partition, chunksChan chan *sources.Chunk) (uint64, error) {
+	start, end, err := s.offsets(ctx, partition)
+	if err != nil {
+		return 0, err
+	}
+	if end >= 0 && start >= end {
+		return 0, nil
+	}
+
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:   s.brokers,
+		Topic:     partition.Topic,
+		Partition: partition.ID,
+		Dialer:    s.dialer,
+		MinBytes:  1,
+		MaxBytes:  10e6,
+		MaxWait:   time.Second,
+	})
+	defer reader.Close()
+	if err := reader.SetOffset(start); err != nil {
+		return 0, err
+	}
+
+	var scanned uint64
+	for end < 0 || start < end {
+		if s.maxMessages > 0 && scanned >= uint64(s.maxMessages) {
+			return scanned, nil
+		}
+
+		var msg kafka.Message
+		var err error
+		if end >= 0 {
+			readCtx, cancel := context.WithTimeout(ctx, idleTimeout)
+			msg, err = reader.ReadMessage(readCtx)
+			cancel()
+			if err != nil && !common.IsDone(ctx) && common.IsDone(readCtx) {
+				s.log.V(2).Info("partition stopped returning messages before its end offset", "topic", partition.Topic, "partition", partition.ID, "offset", start, "end", end)
+				return scanned, nil
+			}
+		} else {
+			msg, err = reader.ReadMessage(ctx)
+		}
+		if err != nil {
+			if common.IsDone(ctx) {
+				return scanned, ctx.Err()
+			}
+			return scanned, err
+		}
+		start = msg.Offset + 1
+		if end >= 0 && msg.Offset >= end {
+			return scanned, nil
+		}
+
+		if err := s.scanMessage(ctx, msg, chunksChan); err != nil {
+			return scanned, err
+		}
+		scanned++
+	}
+	return scanned, nil
+}
+
+// offsets returns the first offset to read from a partition and the offset to
+// stop at, which is -1 when the partition is tailed without an end.
+func (s *Source) offsets(ctx context.Context, partition kafka.Partition) (int64, int64, error) {
+	conn, err := s.dialer.DialPartition(ctx, "tcp", "", partition)
+	if err != nil {
+		return 0, 0, errors.WrapPrefix(err, "could not connect to partition leader", 0)
+	}
+	defer conn.Close()
+
+	first, last, err := conn.ReadOffsets()
+	if err != nil {
+		return 0, 0, errors.WrapPrefix(err, "could not read partition offsets", 0)
+	}
+
+	var sinceOffset, untilOffset int64 = -1, -1
+	if !s.since.IsZero() {
+		if sinceOffset, err = conn.ReadOffset(s.since); err != nil {
+			return 0, 0, errors.WrapPrefix(err, "could not read partition offset for start time", 0)
+		}
+		// No message was produced after the start time.
+		if sinceOffset < 0 {
+			sinceOffset = last
+		}
+	}
+	if !s.until.IsZero() {
+		if untilOffset, err = conn.ReadOffset(s.until); err != nil {
+			return 0, 0, errors.WrapPrefix(err, "could not read partition offset for end time", 0)
+		}
+		if untilOffset < 0 {
+			untilOffset = last
+		}
+	}
+
+	start, end := bounds(first, last, s.startOffset, s.endOffset, sinceOffset, untilOffset, s.tail)
+	return start, end, nil
+}
+
+// bounds combines the offsets available in a partition with the offsets and
+// times requested. Negative since and until offsets mean no time was given.
+// The returned end is exclusive, and -1 when reading should never stop.
+func bounds(first, last, startOffset, endOffset, sinceOffset, untilOffset int64, tail bool) (int64, int64) {
+	start := first
+	if startOffset > start {
+		start = startOffset
+	}
+	if sinceOffset > start {
+		start = sinceOffset
+	}
+
+	end := int64(-1)
+	if endOffset > 0 {
+		end = endOffset
+	}
+	if untilOffset >= 0 && (end < 0 || untilOffset < end) {
+		end = untilOffset
+	}
+	if end < 0 && !tail {
+		end = last
+	}
+	if !tail && end > last {
+		end = last
+	}
+	return start, end
+}
+
+func (s *Source) scanMessage(ctx context.Context, msg kafka.Message, chunksChan chan *sources.Chunk) error {
+	key := string(msg.Key)
+	if len(key) > maxKeyLength {
+		key = key[:maxKeyLength]
+	}
+	meta := &source_metadatapb.Kafka{
+		Topic:     sanitizer.UTF8(msg.Topic),
+		Partition: int64(msg.Partition),
+		Offset:    msg.Offset,
+		Key:       sanitizer.UTF8(key),
+	}
+	if !msg.Time.IsZero() {
+		meta.Timestamp = msg.Time.UTC().Format(time.RFC3339)
+	}
+
+	chunk := &sources.Chunk{
+		SourceType: s.Type(),
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		Data:       render(msg),
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Kafka{
+				Kafka: meta,
+			},
+		},
+		Verify: s.verify,
+	}
+	for c := range sources.Chunker(chunk) {
+		select {
+		case chunksChan <- c:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// render formats the value of a message followed by its key and headers as
+// "name = value" lines.
+func render(msg kafka.Message) []byte {
+	var b strings.Builder
+	b.Write(msg.Value)
+	b.WriteString("\n")
+	if len(msg.Key) > 0 {
+		b.WriteString("key = ")
+		b.Write(msg.Key)
+		b.WriteString("\n")
+	}
+	for _, h := range msg.Headers {
+		b.WriteString(h.Key + " = ")
+		b.Write(h.Value)
+		b.WriteString("\n")
+	}
+	return []byte(b.String())
+}
@@ -0,0 +1,83 @@
+package kafka
+
+import (
+	"testing"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/sourcestest"
+)
+
+func TestSource_Selected(t *testing.T) {
+	all := []kafka.Partition{
+		{Topic: "__consumer_offsets", ID: 0},
+		{Topic: "orders", ID: 0},
+		{Topic: "orders", ID: 1},
+	}
+
+	s := &Source{}
+	sourcestest.Init(t, s, &sourcespb.Kafka{Brokers: []string{"localhost:9092"}, Credential: &sourcespb.Kafka_Unauthenticated{Unauthenticated: &credentialspb.Unauthenticated{}}})
+	assert.Equal(t, all[1:], s.selected(all))
+
+	s = &Source{}
+	sourcestest.Init(t, s, &sourcespb.Kafka{
+		Brokers:    []string{"localhost:9092"},
+		Credential: &sourcespb.Kafka_Unauthenticated{Unauthenticated: &credentialspb.Unauthenticated{}},
+		Topics:     []string{"__consumer_offsets", "orders"},
+		Partitions: []int64{0},
+	})
+	assert.Equal(t, []kafka.Partition{all[0], all[1]}, s.selected(all))
+}
+
+func TestBounds(t *testing.T) {
+	tests := []struct {
+		name                   string
+		startOffset, endOffset int64
+		sinceOffset            int64
+		untilOffset            int64
+		tail                   bool
+		wantStart, wantEnd     int64
+	}{
+		{name: "whole partition", sinceOffset: -1, untilOffset: -1, wantStart: 10, wantEnd: 100},
+		{name: "offsets", startOffset: 20, endOffset: 50, sinceOffset: -1, untilOffset: -1, wantStart: 20, wantEnd: 50},
+		{name: "offsets past the end", startOffset: 5, endOffset: 500, sinceOffset: -1, untilOffset: -1, wantStart: 10, wantEnd: 100},
+		{name: "times", sinceOffset: 30, untilOffset: 60, wantStart: 30, wantEnd: 60},
+		{name: "offsets and times", startOffset: 40, endOffset: 50, sinceOffset: 30, untilOffset: 60, wantStart: 40, wantEnd: 50},
+		{name: "tail", sinceOffset: -1, untilOffset: -1, tail: true, wantStart: 10, wantEnd: -1},
+		{name: "tail with end", endOffset: 500, sinceOffset: -1, untilOffset: -1, tail: true, wantStart: 10, wantEnd: 500},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := bounds(10, 100, tt.startOffset, tt.endOffset, tt.sinceOffset, tt.untilOffset, tt.tail)
+			assert.Equal(t, tt.wantStart, start)
+			assert.Equal(t, tt.wantEnd, end)
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	msg := kafka.Message{
+		Key:   []byte("user-42"),
+		Value: []byte(`{"token":"abc"}`),
+		Headers: []kafka.Header{
+			{Key: "authorization", Value: []byte("Bearer xyz")},
+		},
+	}
+	assert.Equal(t, "{\"token\":\"abc\"}\nkey = user-42\nauthorization = Bearer xyz\n", string(render(msg)))
+}
+
+func TestNewTLSConfig(t *testing.T) {
+	cfg, err := newTLSConfig(&sourcespb.Kafka{})
+	assert.NoError(t, err)
+	assert.Nil(t, cfg)
+
+	cfg, err = newTLSConfig(&sourcespb.Kafka{Tls: true, InsecureSkipVerifyTls: true})
+	assert.NoError(t, err)
+	assert.True(t, cfg.InsecureSkipVerify)
+
+	_, err = newTLSConfig(&sourcespb.Kafka{TlsCa: "not a certificate"})
+	assert.Error(t, err)
+}
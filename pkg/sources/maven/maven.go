@@ -0,0 +1,396 @@
+package maven
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync/atomic"
+
+	diskbufferreader "github.com/bill-rich/disk-buffer-reader"
+	"github.com/go-errors/errors"
+	"github.com/go-logr/logr"
+	"github.com/gobwas/glob"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const (
+	defaultEndpoint = "https://repo1.maven.org/maven2/"
+	// maxGroupDepth limits how deep group directories are walked looking for artifacts.
+	maxGroupDepth = 5
+	// maxFileSize is the largest file that will be downloaded and scanned.
+	maxFileSize = 250 * 1024 * 1024 // 250MB
+)
+
+// listingLink matches the directory links of a repository directory listing.
+var listingLink = regexp.MustCompile(`href="([^"?#]+/)"`)
+
+type Source struct {
+	name     string
+	sourceId int64
+	jobId    int64
+	verify   bool
+	endpoint string
+	// auth sets the credentials on requests to the repository.
+	auth            func(*http.Request)
+	groups          []string
+	artifacts       []coordinate
+	ignoreArtifacts []glob.Glob
+	maxVersions     int
+	client          *http.Client
+	jobPool         *errgroup.Group
+	log             logr.Logger
+	sources.Progress
+}
+
+// Ensure the Source satisfies the interface at compile time.
+var _ sources.Source = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return sourcespb.SourceType_SOURCE_TYPE_MAVEN
+}
+
+func (s *Source) SourceID() int64 {
+	return s.sourceId
+}
+
+func (s *Source) JobID() int64 {
+	return s.jobId
+}
+
+// Init returns an initialized Maven source.
+func (s *Source) Init(aCtx context.Context, name string, jobId, sourceId int64, verify bool, connection *anypb.Any, concurrency int) error {
+	s.log = context.WithValues(aCtx, "source", s.Type(), "name", name).Logger()
+
+	s.name = name
+	s.sourceId = sourceId
+	s.jobId = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	var conn sourcespb.Maven
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return errors.WrapPrefix(err, "error unmarshalling connection", 0)
+	}
+
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.Maven_Unauthenticated:
+		s.auth = func(*http.Request) {}
+	case *sourcespb.Maven_Token:
+		s.auth = func(req *http.Request) { req.Header.Set("Authorization", "Bearer "+cred.Token) }
+	case *sourcespb.Maven_BasicAuth:
+		s.auth = func(req *http.Request) {
+			req.SetBasicAuth(cred.BasicAuth.GetUsername(), cred.BasicAuth.GetPassword())
+		}
+	default:
+		return errors.Errorf("invalid configuration given for %s source", name)
+	}
+
+	s.endpoint = conn.GetEndpoint()
+	if s.endpoint == "" {
+		s.endpoint = defaultEndpoint
+	}
+	if !strings.HasSuffix(s.endpoint, "/") {
+		s.endpoint += "/"
+	}
+
+	s.groups = conn.GetGroups()
+	for _, a := range conn.GetArtifacts() {
+		c, err := parseCoordinate(a)
+		if err != nil {
+			return err
+		}
+		s.artifacts = append(s.artifacts, c)
+	}
+	if len(s.groups) == 0 && len(s.artifacts) == 0 {
+		return errors.New("at least one group or artifact is required")
+	}
+	for _, pattern := range conn.GetIgnoreArtifacts() {
+		g, err := glob.Compile(pattern)
+		if err != nil {
+			return errors.WrapPrefix(err, fmt.Sprintf("invalid artifact pattern %q", pattern), 0)
+		}
+		s.ignoreArtifacts = append(s.ignoreArtifacts, g)
+	}
+	s.maxVersions = int(conn.GetMaxVersions())
+	s.client = common.RetryableHttpClientTimeout(300)
+
+	return nil
+}
+
+// coordinate identifies an artifact, optionally at a single version.
+type coordinate struct {
+	group, artifact, version string
+}
+
+func (c coordinate) String() string {
+	return c.group + ":" + c.artifact
+}
+
+// path returns the directory of the artifact in the repository layout.
+func (c coordinate) path() string {
+	return strings.ReplaceAll(c.group, ".", "/") + "/" + c.artifact + "/"
+}
+
+// parseCoordinate parses group:artifact or group:artifact:version.
+func parseCoordinate(value string) (coordinate, error) {
+	parts := strings.Split(value, ":")
+	if len(parts) < 2 || len(parts) > 3 || parts[0] == "" || parts[1] == "" {
+		return coordinate{}, fmt.Errorf("invalid artifact %q, expected group:artifact[:version]", value)
+	}
+	c := coordinate{group: parts[0], artifact: parts[1]}
+	if len(parts) == 3 {
+		c.version = parts[2]
+	}
+	return c, nil
+}
+
+type metadata struct {
+	Versions []string `xml:"versioning>versions>version"`
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) error {
+	artifacts := append([]coordinate{}, s.artifacts...)
+	for _, group := range s.groups {
+		found, err := s.listGroup(ctx, group, 0)
+		if err != nil {
+			s.log.Error(err, "could not list group", "group", group)
+			continue
+		}
+		artifacts = append(artifacts, found...)
+	}
+
+	var scanned uint64
+	for i, a := range artifacts {
+		if common.IsDone(ctx) {
+			break
+		}
+		if s.ignored(a) {
+			s.log.V(2).Info("skipping artifact", "artifact", a.String())
+			continue
+		}
+		s.SetProgressComplete(i, len(artifacts), fmt.Sprintf("Artifact: %s", a), "")
+
+		versions := []string{a.version}
+		if a.version == "" {
+			var err error
+			versions, err = s.versions(ctx, a)
+			if err != nil {
+				s.log.Error(err, "could not get artifact versions", "artifact", a.String())
+				continue
+			}
+		}
+		for _, v := range versions {
+			a := coordinate{group: a.group, artifact: a.artifact, version: v}
+			s.jobPool.Go(func() error {
+				s.scanVersion(ctx, a, chunksChan)
+				atomic.AddUint64(&scanned, 1)
+				return nil
+			})
+		}
+	}
+	_ = s.jobPool.Wait()
+
+	s.SetProgressComplete(len(artifacts), len(artifacts), fmt.Sprintf("Completed scanning source %s. %d artifact versions scanned.", s.name, scanned), "")
+	return nil
+}
+
+func (s *Source) ignored(a coordinate) bool {
+	for _, g := range s.ignoreArtifacts {
+		if g.Match(a.String()) {
+			return true
+		}
+	}
+	return false
+}
+
+// listGroup walks the directory listing of a group and returns the artifacts
+// it contains. Directories without artifact metadata are treated as sub-groups.
+func (s *Source) listGroup(ctx context.Context, group string, depth int) ([]coordinate, error) {
+	if depth >= maxGroupDepth {
+		return nil, nil
+	}
+	dir := strings.ReplaceAll(group, ".", "/") + "/"
+	body, err := s.read(ctx, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var artifacts []coordinate
+	for _, name := range childDirs(s.endpoint+dir, body) {
+		if common.IsDone(ctx) {
+			break
+		}
+		c := coordinate{group: group, artifact: name}
+		if _, err := s.versions(ctx, c); err == nil {
+			artifacts = append(artifacts, c)
+			continue
+		}
+		nested, err := s.listGroup(ctx, group+"."+name, depth+1)
+		if err != nil {
+			s.log.V(2).Info("could not list group", "group", group+"."+name, "error", err)
+			continue
+		}
+		artifacts = append(artifacts, nested...)
+	}
+	return artifacts, nil
+}
+
+// childDirs returns the names of the immediate subdirectories linked from a
+// directory listing. Repository managers use both relative and absolute links.
+func childDirs(dirURL string, listing []byte) []string {
+	base, err := url.Parse(dirURL)
+	if err != nil {
+		return nil
+	}
+	seen := map[string]struct{}{}
+	var names []string
+	for _, match := range listingLink.FindAllStringSubmatch(string(listing), -1) {
+		ref, err := url.Parse(match[1])
+		if err != nil {
+			continue
+		}
+		link := base.ResolveReference(ref)
+		if link.Host != base.Host || !strings.HasPrefix(link.Path, base.Path) {
+			continue
+		}
+		name := strings.TrimSuffix(strings.TrimPrefix(link.Path, base.Path), "/")
+		if name == "" || strings.Contains(name, "/") {
+			continue
+		}
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+		names = append(names, name)
+	}
+	return names
+}
+
+// versions returns the published versions of an artifact from newest to
+// oldest, limited to maxVersions when it is set.
+func (s *Source) versions(ctx context.Context, a coordinate) ([]string, error) {
+	body, err := s.read(ctx, a.path()+"maven-metadata.xml")
+	if err != nil {
+		return nil, err
+	}
+	var meta metadata
+	if err := xml.Unmarshal(body, &meta); err != nil {
+		return nil, err
+	}
+
+	versions := make([]string, 0, len(meta.Versions))
+	for i := len(meta.Versions) - 1; i >= 0; i-- {
+		versions = append(versions, meta.Versions[i])
+	}
+	if s.maxVersions > 0 && len(versions) > s.maxVersions {
+		versions = versions[:s.maxVersions]
+	}
+	return versions, nil
+}
+
+// scanVersion scans the POM and JAR of an artifact version. Artifacts with a
+// pom packaging have no JAR, so a missing JAR is not an error.
+func (s *Source) scanVersion(ctx context.Context, a coordinate, chunksChan chan *sources.Chunk) {
+	for _, ext := range []string{"pom", "jar"} {
+		file := fmt.Sprintf("%s%s/%s-%s.%s", a.path(), a.version, a.artifact, a.version, ext)
+		if err := s.scanFile(ctx, a, file, chunksChan); err != nil {
+			s.log.V(2).Info("could not scan file", "file", file, "error", err)
+		}
+	}
+}
+
+func (s *Source) scanFile(ctx context.Context, a coordinate, file string, chunksChan chan *sources.Chunk) error {
+	res, err := s.get(ctx, file)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	reader, err := diskbufferreader.New(io.LimitReader(res.Body, maxFileSize))
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	chunkSkel := &sources.Chunk{
+		SourceType: s.Type(),
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Maven{
+				Maven: &source_metadatapb.Maven{
+					File:     sanitizer.UTF8(file),
+					Group:    sanitizer.UTF8(a.group),
+					Artifact: sanitizer.UTF8(a.artifact),
+					Version:  sanitizer.UTF8(a.version),
+					Link:     sanitizer.UTF8(s.endpoint + file),
+				},
+			},
+		},
+		Verify: s.verify,
+	}
+	// JARs are zip archives, and any archives nested inside them are unpacked too.
+	if handlers.HandleFile(ctx, reader, chunkSkel, chunksChan) {
+		return nil
+	}
+	if err := reader.Reset(); err != nil {
+		return err
+	}
+	reader.Stop()
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+
+	chunk := *chunkSkel
+	chunk.Data = data
+	select {
+	case chunksChan <- &chunk:
+	case <-ctx.Done():
+	}
+	return nil
+}
+
+func (s *Source) read(ctx context.Context, path string) ([]byte, error) {
+	res, err := s.get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	return io.ReadAll(io.LimitReader(res.Body, 10*1024*1024))
+}
+
+func (s *Source) get(ctx context.Context, path string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.endpoint+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	s.auth(req)
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		res.Body.Close()
+		return nil, fmt.Errorf("unexpected status code %d requesting %s", res.StatusCode, path)
+	}
+	return res, nil
+}
@@ -0,0 +1,102 @@
+package maven
+
+import (
+	"archive/zip"
+	"bytes"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/sourcestest"
+)
+
+func jar(t *testing.T, name, content string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	w, err := zw.Create(name)
+	assert.NoError(t, err)
+	_, err = w.Write([]byte(content))
+	assert.NoError(t, err)
+	assert.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func TestParseCoordinate(t *testing.T) {
+	c, err := parseCoordinate("com.acme:widgets")
+	assert.NoError(t, err)
+	assert.Equal(t, coordinate{group: "com.acme", artifact: "widgets"}, c)
+	assert.Equal(t, "com/acme/widgets/", c.path())
+
+	c, err = parseCoordinate("com.acme:widgets:1.0.0")
+	assert.NoError(t, err)
+	assert.Equal(t, "1.0.0", c.version)
+
+	_, err = parseCoordinate("com.acme")
+	assert.Error(t, err)
+}
+
+func TestChildDirs(t *testing.T) {
+	listing := []byte(`<a href="../">../</a>
+<a href="widgets/">widgets/</a>
+<a href="https://nexus.example.com/repository/maven/com/acme/gadgets/">gadgets/</a>
+<a href="https://nexus.example.com/repository/maven/com/">parent</a>
+<a href="maven-metadata.xml">maven-metadata.xml</a>`)
+	assert.Equal(t, []string{"widgets", "gadgets"}, childDirs("https://nexus.example.com/repository/maven/com/acme/", listing))
+}
+
+func TestSource_Chunks(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://repo1.maven.org").
+		Get("/maven2/com/acme/$").
+		Reply(200).
+		BodyString(`<a href="../">../</a><a href="widgets/">widgets/</a><a href="internal/">internal/</a>`)
+	gock.New("https://repo1.maven.org").
+		Get("/maven2/com/acme/widgets/maven-metadata.xml").
+		Times(2).
+		Reply(200).
+		BodyString(`<metadata><versioning><versions><version>1.0.0</version><version>1.1.0</version></versions></versioning></metadata>`)
+	gock.New("https://repo1.maven.org").
+		Get("/maven2/com/acme/internal/maven-metadata.xml").
+		Reply(200).
+		BodyString(`<metadata><versioning><versions><version>1.0.0</version></versions></versioning></metadata>`)
+	gock.New("https://repo1.maven.org").
+		Get("/maven2/com/acme/widgets/1.1.0/widgets-1.1.0.pom").
+		Reply(200).
+		BodyString("<project><password>hunter2</password></project>")
+	gock.New("https://repo1.maven.org").
+		Get("/maven2/com/acme/widgets/1.1.0/widgets-1.1.0.jar").
+		Reply(200).
+		Body(bytes.NewReader(jar(t, "application.properties", "db.password=hunter3")))
+
+	s := &Source{}
+	sourcestest.Init(t, s, &sourcespb.Maven{
+		Credential:      &sourcespb.Maven_Unauthenticated{Unauthenticated: &credentialspb.Unauthenticated{}},
+		Groups:          []string{"com.acme"},
+		IgnoreArtifacts: []string{"com.acme:intern*"},
+		MaxVersions:     1,
+	}, func() *http.Client { return s.client })
+
+	chunksCh := make(chan *sources.Chunk, 10)
+	assert.NoError(t, s.Chunks(context.Background(), chunksCh))
+	close(chunksCh)
+
+	var data []string
+	for chunk := range chunksCh {
+		meta := chunk.SourceMetadata.GetMaven()
+		assert.Equal(t, "com.acme", meta.Group)
+		assert.Equal(t, "widgets", meta.Artifact)
+		assert.Equal(t, "1.1.0", meta.Version)
+		data = append(data, strings.TrimRight(string(chunk.Data), "\x00"))
+	}
+	assert.ElementsMatch(t, []string{"<project><password>hunter2</password></project>", "db.password=hunter3"}, data)
+	assert.True(t, gock.IsDone())
+}
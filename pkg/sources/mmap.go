@@ -0,0 +1,94 @@
+package sources
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"runtime/debug"
+	"sync/atomic"
+)
+
+// MmapThreshold is the size in bytes from which files are memory-mapped to be
+// scanned rather than copied.
+const MmapThreshold = 16 * 1024 * 1024
+
+var errMmapUnsupported = errors.New("mmap is not supported on this platform")
+
+// MapFile maps the contents of a file of at least MmapThreshold bytes in
+// memory, read only, so its pages are read from the page cache as they are
+// accessed instead of being copied to the heap. It fails for smaller files,
+// files that can't be mapped and on platforms without mmap, and those files
+// must be read instead. unmap must be called once the data isn't used
+// anymore.
+func MapFile(f *os.File) (data []byte, unmap func() error, err error) {
+	info, err := f.Stat()
+	if err != nil {
+		return nil, nil, err
+	}
+	if !info.Mode().IsRegular() || info.Size() < MmapThreshold {
+		return nil, nil, errors.New("file is too small to be mapped")
+	}
+	if int64(int(info.Size())) != info.Size() {
+		return nil, nil, errors.New("file is too large to be mapped")
+	}
+	data, err = mmap(f, int(info.Size()))
+	if err != nil {
+		return nil, nil, err
+	}
+	return data, func() error { return munmap(data) }, nil
+}
+
+// ErrMappedFault is the error of the reads of a MappedReader that faulted,
+// such as on the pages past the end of a file truncated since it was mapped.
+var ErrMappedFault = errors.New("could not read the mapped file, it may have been truncated")
+
+// MappedReader reads the data of MapFile. Reading a page that can't be read
+// anymore raises SIGBUS, which crashes the process unless the goroutine
+// reading it panics on faults instead, so every read is made with
+// debug.SetPanicOnFault and recovers such panics as ErrMappedFault.
+type MappedReader struct {
+	reader  *bytes.Reader
+	faulted uint32
+}
+
+// NewMappedReader returns a reader of the data of MapFile.
+func NewMappedReader(data []byte) *MappedReader {
+	return &MappedReader{reader: bytes.NewReader(data)}
+}
+
+func (r *MappedReader) Read(p []byte) (n int, err error) {
+	defer debug.SetPanicOnFault(debug.SetPanicOnFault(true))
+	defer r.recoverFault(&err)
+	return r.reader.Read(p)
+}
+
+func (r *MappedReader) ReadAt(p []byte, off int64) (n int, err error) {
+	defer debug.SetPanicOnFault(debug.SetPanicOnFault(true))
+	defer r.recoverFault(&err)
+	return r.reader.ReadAt(p, off)
+}
+
+func (r *MappedReader) Seek(offset int64, whence int) (int64, error) {
+	return r.reader.Seek(offset, whence)
+}
+
+// Faulted reports whether a read faulted, in which case the data read may
+// miss parts of the file, which must be read instead.
+func (r *MappedReader) Faulted() bool {
+	return atomic.LoadUint32(&r.faulted) == 1
+}
+
+// recoverFault recovers the panic of a fault of the read it is deferred by,
+// returning ErrMappedFault in err instead.
+func (r *MappedReader) recoverFault(err *error) {
+	recovered := recover()
+	if recovered == nil {
+		return
+	}
+	// The panics of faults are runtime errors with the faulting address.
+	if _, ok := recovered.(interface{ Addr() uintptr }); !ok {
+		panic(recovered)
+	}
+	atomic.StoreUint32(&r.faulted, 1)
+	*err = ErrMappedFault
+}
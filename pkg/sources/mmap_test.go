@@ -0,0 +1,78 @@
+package sources
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestMapFile(t *testing.T) {
+	dir := t.TempDir()
+	open := func(name string, data []byte) *os.File {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, data, 0644); err != nil {
+			t.Fatal(err)
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { f.Close() })
+		return f
+	}
+
+	if _, _, err := MapFile(open("small", []byte("small"))); err == nil {
+		t.Error("small files were mapped")
+	}
+
+	large := bytes.Repeat([]byte("0123456789abcdef"), MmapThreshold/16+1)
+	data, unmap, err := MapFile(open("large", large))
+	if err == errMmapUnsupported {
+		t.Skip(err)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, large) {
+		t.Error("the mapped data differs from the file")
+	}
+	if err := unmap(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMappedReader_truncated(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "large")
+	large := bytes.Repeat([]byte("0123456789abcdef"), MmapThreshold/16+1)
+	if err := os.WriteFile(path, large, 0644); err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	data, unmap, err := MapFile(f)
+	if err == errMmapUnsupported {
+		t.Skip(err)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unmap()
+
+	// The pages of a truncated file can't be read anymore, which faults.
+	if err := os.Truncate(path, 0); err != nil {
+		t.Fatal(err)
+	}
+	reader := NewMappedReader(data)
+	if _, err := io.ReadAll(reader); !errors.Is(err, ErrMappedFault) {
+		t.Fatalf("reading truncated file returned %v, want ErrMappedFault", err)
+	}
+	if !reader.Faulted() {
+		t.Error("reader didn't report the fault")
+	}
+}
@@ -0,0 +1,288 @@
+package mongodb
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/go-errors/errors"
+	"github.com/go-logr/logr"
+	"github.com/gobwas/glob"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// systemDatabases hold server state rather than application data.
+var systemDatabases = map[string]bool{"admin": true, "config": true, "local": true}
+
+type Source struct {
+	name              string
+	sourceId          int64
+	jobId             int64
+	verify            bool
+	clientOpts        *options.ClientOptions
+	databases         []string
+	collections       []glob.Glob
+	ignoreCollections []glob.Glob
+	maxDepth          int
+	maxDocuments      int64
+	jobPool           *errgroup.Group
+	log               logr.Logger
+	sources.Progress
+}
+
+// Ensure the Source satisfies the interface at compile time.
+var _ sources.Source = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return sourcespb.SourceType_SOURCE_TYPE_MONGODB
+}
+
+func (s *Source) SourceID() int64 {
+	return s.sourceId
+}
+
+func (s *Source) JobID() int64 {
+	return s.jobId
+}
+
+// Init returns an initialized MongoDB source.
+func (s *Source) Init(aCtx context.Context, name string, jobId, sourceId int64, verify bool, connection *anypb.Any, concurrency int) error {
+	s.log = context.WithValues(aCtx, "source", s.Type(), "name", name).Logger()
+
+	s.name = name
+	s.sourceId = sourceId
+	s.jobId = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	var conn sourcespb.MongoDB
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return errors.WrapPrefix(err, "error unmarshalling connection", 0)
+	}
+
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.MongoDB_ConnectionString:
+		s.clientOpts = options.Client().ApplyURI(cred.ConnectionString)
+		if err := s.clientOpts.Validate(); err != nil {
+			return errors.WrapPrefix(err, "invalid connection string", 0)
+		}
+	default:
+		return errors.Errorf("invalid configuration given for %s source", name)
+	}
+
+	s.databases = conn.GetDatabases()
+	var err error
+	if s.collections, err = compileGlobs(conn.GetCollections()); err != nil {
+		return err
+	}
+	if s.ignoreCollections, err = compileGlobs(conn.GetIgnoreCollections()); err != nil {
+		return err
+	}
+	s.maxDepth = int(conn.GetMaxDepth())
+	s.maxDocuments = conn.GetMaxDocuments()
+
+	return nil
+}
+
+func compileGlobs(patterns []string) ([]glob.Glob, error) {
+	var globs []glob.Glob
+	for _, pattern := range patterns {
+		g, err := glob.Compile(pattern, '.')
+		if err != nil {
+			return nil, fmt.Errorf("invalid collection pattern %q: %w", pattern, err)
+		}
+		globs = append(globs, g)
+	}
+	return globs, nil
+}
+
+// selected reports whether a collection, named as database.collection,
+// should be scanned.
+func (s *Source) selected(collection string) bool {
+	for _, g := range s.ignoreCollections {
+		if g.Match(collection) {
+			return false
+		}
+	}
+	if len(s.collections) == 0 {
+		return true
+	}
+	for _, g := range s.collections {
+		if g.Match(collection) {
+			return true
+		}
+	}
+	return false
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) error {
+	client, err := mongo.Connect(ctx, s.clientOpts)
+	if err != nil {
+		return fmt.Errorf("error connecting to MongoDB: %w", err)
+	}
+	defer func() {
+		_ = client.Disconnect(ctx)
+	}()
+
+	databases := s.databases
+	if len(databases) == 0 {
+		if databases, err = client.ListDatabaseNames(ctx, bson.D{}); err != nil {
+			return fmt.Errorf("error listing databases: %w", err)
+		}
+	}
+
+	var collections [][2]string
+	for _, database := range databases {
+		if len(s.databases) == 0 && systemDatabases[database] {
+			continue
+		}
+		// Views are skipped as their documents are scanned through their source collections.
+		names, err := client.Database(database).ListCollectionNames(ctx, bson.D{{Key: "type", Value: "collection"}})
+		if err != nil {
+			s.log.Error(err, "could not list collections", "database", database)
+			continue
+		}
+		for _, name := range names {
+			if strings.HasPrefix(name, "system.") || !s.selected(database+"."+name) {
+				continue
+			}
+			collections = append(collections, [2]string{database, name})
+		}
+	}
+
+	var scanned uint64
+	for i, c := range collections {
+		if common.IsDone(ctx) {
+			break
+		}
+		s.SetProgressComplete(i, len(collections), fmt.Sprintf("Collection: %s.%s", c[0], c[1]), "")
+
+		c := c
+		s.jobPool.Go(func() error {
+			if err := s.scanCollection(ctx, client.Database(c[0]).Collection(c[1]), chunksChan); err != nil {
+				s.log.Error(err, "could not scan collection", "collection", c[0]+"."+c[1])
+				return nil
+			}
+			atomic.AddUint64(&scanned, 1)
+			return nil
+		})
+	}
+	_ = s.jobPool.Wait()
+
+	s.SetProgressComplete(len(collections), len(collections), fmt.Sprintf("Completed scanning source %s. %d collections scanned.", s.name, scanned), "")
+	return nil
+}
+
+func (s *Source) scanCollection(ctx context.Context, coll *mongo.Collection, chunksChan chan *sources.Chunk) error {
+	opts := options.Find()
+	if s.maxDocuments > 0 {
+		opts.SetLimit(s.maxDocuments)
+	}
+	cursor, err := coll.Find(ctx, bson.D{}, opts)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var doc bson.D
+		if err := cursor.Decode(&doc); err != nil {
+			return err
+		}
+		data := flatten(doc, s.maxDepth)
+		if len(data) == 0 {
+			continue
+		}
+		chunk := &sources.Chunk{
+			SourceType: s.Type(),
+			SourceName: s.name,
+			SourceID:   s.SourceID(),
+			Data:       data,
+			SourceMetadata: &source_metadatapb.MetaData{
+				Data: &source_metadatapb.MetaData_Mongodb{
+					Mongodb: &source_metadatapb.MongoDB{
+						Database:   sanitizer.UTF8(coll.Database().Name()),
+						Collection: sanitizer.UTF8(coll.Name()),
+						Id:         sanitizer.UTF8(documentID(doc)),
+					},
+				},
+			},
+			Verify: s.verify,
+		}
+		for c := range sources.Chunker(chunk) {
+			select {
+			case chunksChan <- c:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+	return cursor.Err()
+}
+
+func documentID(doc bson.D) string {
+	for _, e := range doc {
+		if e.Key != "_id" {
+			continue
+		}
+		if id, ok := e.Value.(primitive.ObjectID); ok {
+			return id.Hex()
+		}
+		return fmt.Sprint(e.Value)
+	}
+	return ""
+}
+
+// flatten renders the string fields of a document as "path = value" lines,
+// descending into embedded documents and arrays up to maxDepth levels. A
+// maxDepth of zero has no limit.
+func flatten(doc bson.D, maxDepth int) []byte {
+	var b strings.Builder
+	var walk func(path string, v interface{}, depth int)
+	walk = func(path string, v interface{}, depth int) {
+		if maxDepth > 0 && depth > maxDepth {
+			return
+		}
+		switch v := v.(type) {
+		case string:
+			if v != "" {
+				b.WriteString(path + " = " + v + "\n")
+			}
+		case bson.D:
+			for _, e := range v {
+				walk(join(path, e.Key), e.Value, depth+1)
+			}
+		case bson.A:
+			for i, e := range v {
+				walk(join(path, strconv.Itoa(i)), e, depth+1)
+			}
+		}
+	}
+	walk("", doc, 0)
+	return []byte(b.String())
+}
+
+func join(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
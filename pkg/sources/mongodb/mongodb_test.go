@@ -0,0 +1,49 @@
+package mongodb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+)
+
+func TestSource_Selected(t *testing.T) {
+	s := &Source{}
+	a, err := anypb.New(&sourcespb.MongoDB{
+		Credential:        &sourcespb.MongoDB_ConnectionString{ConnectionString: "mongodb://localhost:27017"},
+		Collections:       []string{"app.*", "billing.invoices"},
+		IgnoreCollections: []string{"app.audit_*"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Init(context.Background(), "test - mongodb", 0, 0, false, a, 1); err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, s.selected("app.settings"))
+	assert.True(t, s.selected("billing.invoices"))
+	assert.False(t, s.selected("app.audit_log"))
+	assert.False(t, s.selected("billing.payments"))
+}
+
+func TestFlatten(t *testing.T) {
+	id := primitive.NewObjectID()
+	doc := bson.D{
+		{Key: "_id", Value: id},
+		{Key: "name", Value: "billing"},
+		{Key: "port", Value: int32(5432)},
+		{Key: "integrations", Value: bson.A{
+			bson.D{{Key: "token", Value: "hunter2"}, {Key: "deep", Value: bson.D{{Key: "secret", Value: "hunter3"}}}},
+		}},
+	}
+
+	assert.Equal(t, "name = billing\nintegrations.0.token = hunter2\nintegrations.0.deep.secret = hunter3\n", string(flatten(doc, 0)))
+	assert.Equal(t, "name = billing\nintegrations.0.token = hunter2\n", string(flatten(doc, 3)))
+	assert.Equal(t, id.Hex(), documentID(doc))
+	assert.Equal(t, "settings", documentID(bson.D{{Key: "_id", Value: "settings"}}))
+}
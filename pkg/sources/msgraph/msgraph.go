@@ -0,0 +1,217 @@
+// Package msgraph contains a minimal Microsoft Graph client shared by the
+// sources that read from Microsoft 365.
+package msgraph
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+)
+
+const (
+	DefaultEndpoint = "https://graph.microsoft.com/v1.0/"
+	tokenURLFormat  = "https://login.microsoftonline.com/%s/oauth2/v2.0/token"
+	defaultScope    = "https://graph.microsoft.com/.default"
+)
+
+// Client performs authenticated requests against the Graph API.
+type Client struct {
+	endpoint string
+	client   *http.Client
+}
+
+// NewClient returns a Client authenticating with either a static bearer token
+// or an app registration's client credentials.
+func NewClient(ctx context.Context, endpoint, token string, creds *credentialspb.ClientCredentials) (*Client, error) {
+	if endpoint == "" {
+		endpoint = DefaultEndpoint
+	}
+	if !strings.HasSuffix(endpoint, "/") {
+		endpoint += "/"
+	}
+
+	base := common.RetryableHttpClientTimeout(60)
+	var ts oauth2.TokenSource
+	switch {
+	case token != "":
+		ts = oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token})
+	case creds != nil:
+		cfg := clientcredentials.Config{
+			ClientID:     creds.GetClientId(),
+			ClientSecret: creds.GetClientSecret(),
+			TokenURL:     fmt.Sprintf(tokenURLFormat, creds.GetTenantId()),
+			Scopes:       []string{defaultScope},
+		}
+		ts = cfg.TokenSource(context.WithValue(ctx, oauth2.HTTPClient, base))
+	default:
+		return nil, fmt.Errorf("a token or client credentials are required")
+	}
+
+	return &Client{
+		endpoint: endpoint,
+		client: &http.Client{
+			Timeout:   base.Timeout,
+			Transport: &oauth2.Transport{Source: ts, Base: base.Transport},
+		},
+	}, nil
+}
+
+// HTTPClient returns the underlying authenticated HTTP client.
+func (c *Client) HTTPClient() *http.Client {
+	return c.client
+}
+
+// Get requests the given path or absolute URL and decodes the JSON response into out.
+func (c *Client) Get(ctx context.Context, path string, out interface{}) error {
+	res, err := c.do(ctx, path)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	return json.NewDecoder(res.Body).Decode(out)
+}
+
+// Download returns up to maxSize bytes from the given path or absolute URL.
+func (c *Client) Download(ctx context.Context, path string, maxSize int64) ([]byte, error) {
+	res, err := c.do(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	return io.ReadAll(io.LimitReader(res.Body, maxSize))
+}
+
+// Open returns the response body of the given path or absolute URL. The
+// caller is responsible for closing it.
+func (c *Client) Open(ctx context.Context, path string) (io.ReadCloser, error) {
+	res, err := c.do(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return res.Body, nil
+}
+
+func (c *Client) do(ctx context.Context, path string) (*http.Response, error) {
+	reqURL := path
+	if !strings.HasPrefix(path, "http://") && !strings.HasPrefix(path, "https://") {
+		reqURL = c.endpoint + strings.TrimPrefix(path, "/")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		res.Body.Close()
+		return nil, fmt.Errorf("unexpected status code %d requesting %s: %s", res.StatusCode, reqURL, body)
+	}
+	return res, nil
+}
+
+// Page is the envelope Graph uses for collections.
+type Page[T any] struct {
+	Value     []T    `json:"value"`
+	NextLink  string `json:"@odata.nextLink"`
+	DeltaLink string `json:"@odata.deltaLink"`
+}
+
+// List follows every nextLink of a collection starting at path, calling fn for
+// each page. It returns the deltaLink of the final page, if there is one.
+func List[T any](ctx context.Context, c *Client, path string, fn func([]T) error) (string, error) {
+	next := path
+	for next != "" {
+		if common.IsDone(ctx) {
+			return "", ctx.Err()
+		}
+		var page Page[T]
+		if err := c.Get(ctx, next, &page); err != nil {
+			return "", err
+		}
+		if err := fn(page.Value); err != nil {
+			return "", err
+		}
+		if page.DeltaLink != "" {
+			return page.DeltaLink, nil
+		}
+		next = page.NextLink
+	}
+	return "", nil
+}
+
+// DeltaState persists delta links between runs so subsequent scans only
+// request what changed since the last one. A zero value DeltaState is usable
+// and simply does not persist anything.
+type DeltaState struct {
+	path  string
+	mu    sync.Mutex
+	Links map[string]string `json:"links"`
+}
+
+// LoadDeltaState reads the delta links stored at path. A missing file yields
+// an empty state that will be written to path on Save.
+func LoadDeltaState(path string) (*DeltaState, error) {
+	state := &DeltaState{path: path, Links: map[string]string{}}
+	if path == "" {
+		return state, nil
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return state, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("could not parse delta state %s: %w", path, err)
+	}
+	if state.Links == nil {
+		state.Links = map[string]string{}
+	}
+	return state, nil
+}
+
+// Get returns the stored delta link for key.
+func (d *DeltaState) Get(key string) string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.Links[key]
+}
+
+// Set stores the delta link for key.
+func (d *DeltaState) Set(key, link string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.Links == nil {
+		d.Links = map[string]string{}
+	}
+	d.Links[key] = link
+}
+
+// Save writes the state back to the file it was loaded from.
+func (d *DeltaState) Save() error {
+	if d.path == "" {
+		return nil
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	data, err := json.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(d.path, data, 0600)
+}
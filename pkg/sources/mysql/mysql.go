@@ -0,0 +1,338 @@
+package mysql
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/go-errors/errors"
+	"github.com/go-logr/logr"
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/gobwas/glob"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// listColumnsQuery lists the string columns of the tables in every user
+// database. It's completed with a filter on the databases to scan.
+const listColumnsQuery = `
+SELECT c.table_schema, c.table_name, c.column_name
+FROM information_schema.columns c
+JOIN information_schema.tables t ON t.table_schema = c.table_schema AND t.table_name = c.table_name
+WHERE t.table_type = 'BASE TABLE'
+  AND c.table_schema NOT IN ('mysql', 'sys', 'performance_schema', 'information_schema')
+  AND c.data_type IN ('char', 'varchar', 'tinytext', 'text', 'mediumtext', 'longtext', 'json')`
+
+const primaryKeyQuery = `
+SELECT column_name
+FROM information_schema.key_column_usage
+WHERE constraint_name = 'PRIMARY' AND table_schema = ? AND table_name = ?
+ORDER BY ordinal_position`
+
+type Source struct {
+	name          string
+	sourceId      int64
+	jobId         int64
+	verify        bool
+	db            *sql.DB
+	databases     []string
+	tables        []glob.Glob
+	ignoreTables  []glob.Glob
+	maxRows       int64
+	samplePercent float64
+	jobPool       *errgroup.Group
+	log           logr.Logger
+	sources.Progress
+}
+
+// Ensure the Source satisfies the interface at compile time.
+var _ sources.Source = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return sourcespb.SourceType_SOURCE_TYPE_MYSQL
+}
+
+func (s *Source) SourceID() int64 {
+	return s.sourceId
+}
+
+func (s *Source) JobID() int64 {
+	return s.jobId
+}
+
+// Init returns an initialized MySQL source.
+func (s *Source) Init(aCtx context.Context, name string, jobId, sourceId int64, verify bool, connection *anypb.Any, concurrency int) error {
+	s.log = context.WithValues(aCtx, "source", s.Type(), "name", name).Logger()
+
+	s.name = name
+	s.sourceId = sourceId
+	s.jobId = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	var conn sourcespb.MySQL
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return errors.WrapPrefix(err, "error unmarshalling connection", 0)
+	}
+
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.MySQL_ConnectionString:
+		// Opening doesn't connect, so this only fails on malformed connection strings.
+		db, err := sql.Open("mysql", cred.ConnectionString)
+		if err != nil {
+			return errors.WrapPrefix(err, "invalid connection string", 0)
+		}
+		db.SetMaxOpenConns(concurrency)
+		s.db = db
+	default:
+		return errors.Errorf("invalid configuration given for %s source", name)
+	}
+
+	s.databases = conn.GetDatabases()
+	var err error
+	if s.tables, err = compileGlobs(conn.GetTables()); err != nil {
+		return err
+	}
+	if s.ignoreTables, err = compileGlobs(conn.GetIgnoreTables()); err != nil {
+		return err
+	}
+	s.maxRows = conn.GetMaxRows()
+	s.samplePercent = conn.GetSamplePercent()
+	if s.samplePercent < 0 || s.samplePercent > 100 {
+		return errors.Errorf("sample percent must be between 0 and 100, got %v", s.samplePercent)
+	}
+
+	return nil
+}
+
+func compileGlobs(patterns []string) ([]glob.Glob, error) {
+	var globs []glob.Glob
+	for _, pattern := range patterns {
+		g, err := glob.Compile(pattern, '.')
+		if err != nil {
+			return nil, fmt.Errorf("invalid table pattern %q: %w", pattern, err)
+		}
+		globs = append(globs, g)
+	}
+	return globs, nil
+}
+
+// selected reports whether a table, named as database.table, should be scanned.
+func (s *Source) selected(table string) bool {
+	for _, g := range s.ignoreTables {
+		if g.Match(table) {
+			return false
+		}
+	}
+	if len(s.tables) == 0 {
+		return true
+	}
+	for _, g := range s.tables {
+		if g.Match(table) {
+			return true
+		}
+	}
+	return false
+}
+
+type table struct {
+	database string
+	name     string
+	columns  []string
+}
+
+func (t table) String() string {
+	return t.database + "." + t.name
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) error {
+	defer s.db.Close()
+
+	tables, err := s.listTables(ctx)
+	if err != nil {
+		return fmt.Errorf("error listing tables: %w", err)
+	}
+
+	var scanned uint64
+	for i, t := range tables {
+		if common.IsDone(ctx) {
+			break
+		}
+		s.SetProgressComplete(i, len(tables), fmt.Sprintf("Table: %s", t), "")
+
+		t := t
+		s.jobPool.Go(func() error {
+			if err := s.scanTable(ctx, t, chunksChan); err != nil {
+				s.log.Error(err, "could not scan table", "table", t.String())
+				return nil
+			}
+			atomic.AddUint64(&scanned, 1)
+			return nil
+		})
+	}
+	_ = s.jobPool.Wait()
+
+	s.SetProgressComplete(len(tables), len(tables), fmt.Sprintf("Completed scanning source %s. %d tables scanned.", s.name, scanned), "")
+	return nil
+}
+
+func (s *Source) listTables(ctx context.Context) ([]table, error) {
+	query := listColumnsQuery
+	var args []interface{}
+	if len(s.databases) > 0 {
+		query += " AND c.table_schema IN (?" + strings.Repeat(", ?", len(s.databases)-1) + ")"
+		for _, database := range s.databases {
+			args = append(args, database)
+		}
+	}
+	query += " ORDER BY c.table_schema, c.table_name, c.ordinal_position"
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tables []table
+	for rows.Next() {
+		var database, name, column string
+		if err := rows.Scan(&database, &name, &column); err != nil {
+			return nil, err
+		}
+		if n := len(tables); n > 0 && tables[n-1].database == database && tables[n-1].name == name {
+			tables[n-1].columns = append(tables[n-1].columns, column)
+			continue
+		}
+		t := table{database: database, name: name, columns: []string{column}}
+		if !s.selected(t.String()) {
+			continue
+		}
+		tables = append(tables, t)
+	}
+	return tables, rows.Err()
+}
+
+func (s *Source) primaryKey(ctx context.Context, t table) ([]string, error) {
+	rows, err := s.db.QueryContext(ctx, primaryKeyQuery, t.database, t.name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var key []string
+	for rows.Next() {
+		var column string
+		if err := rows.Scan(&column); err != nil {
+			return nil, err
+		}
+		key = append(key, column)
+	}
+	return key, rows.Err()
+}
+
+// quoteIdentifier quotes a database, table or column name.
+func quoteIdentifier(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+// selectQuery returns the query reading the key and string columns of a
+// table. MySQL has no TABLESAMPLE, so rows are sampled with RAND().
+func (s *Source) selectQuery(t table, key []string) string {
+	var exprs []string
+	for _, column := range append(append([]string{}, key...), t.columns...) {
+		exprs = append(exprs, quoteIdentifier(column))
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM %s.%s", strings.Join(exprs, ", "), quoteIdentifier(t.database), quoteIdentifier(t.name))
+	if s.samplePercent > 0 {
+		query += " WHERE RAND() < " + strconv.FormatFloat(s.samplePercent/100, 'f', -1, 64)
+	}
+	if s.maxRows > 0 {
+		query += " LIMIT " + strconv.FormatInt(s.maxRows, 10)
+	}
+	return query
+}
+
+// rowID formats the key of a row, such as "id=42". Rows of tables without a
+// primary key are identified by their position in the scan.
+func rowID(key []string, values []sql.NullString, n int64) string {
+	if len(key) == 0 {
+		return "row=" + strconv.FormatInt(n, 10)
+	}
+	parts := make([]string, len(key))
+	for i, column := range key {
+		parts[i] = column + "=" + values[i].String
+	}
+	return strings.Join(parts, ",")
+}
+
+func (s *Source) scanTable(ctx context.Context, t table, chunksChan chan *sources.Chunk) error {
+	key, err := s.primaryKey(ctx, t)
+	if err != nil {
+		return err
+	}
+	rows, err := s.db.QueryContext(ctx, s.selectQuery(t, key))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	values := make([]sql.NullString, len(key)+len(t.columns))
+	dest := make([]interface{}, len(values))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	var n int64
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			return err
+		}
+		n++
+		row := rowID(key, values[:len(key)], n)
+		for i, column := range t.columns {
+			value := values[len(key)+i]
+			if !value.Valid || value.String == "" {
+				continue
+			}
+			chunk := &sources.Chunk{
+				SourceType: s.Type(),
+				SourceName: s.name,
+				SourceID:   s.SourceID(),
+				Data:       []byte(value.String),
+				SourceMetadata: &source_metadatapb.MetaData{
+					Data: &source_metadatapb.MetaData_Mysql{
+						Mysql: &source_metadatapb.MySQL{
+							Database: sanitizer.UTF8(t.database),
+							Table:    sanitizer.UTF8(t.name),
+							Column:   sanitizer.UTF8(column),
+							Row:      sanitizer.UTF8(row),
+						},
+					},
+				},
+				Verify: s.verify,
+			}
+			for c := range sources.Chunker(chunk) {
+				select {
+				case chunksChan <- c:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+		}
+	}
+	return rows.Err()
+}
@@ -0,0 +1,46 @@
+package mysql
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/sourcestest"
+)
+
+func TestSource_Selected(t *testing.T) {
+	s := &Source{}
+	sourcestest.Init(t, s, &sourcespb.MySQL{
+		Credential:   &sourcespb.MySQL_ConnectionString{ConnectionString: "app:secret@tcp(localhost:3306)/app"},
+		Tables:       []string{"app.*", "billing.invoices"},
+		IgnoreTables: []string{"app.audit_*"},
+	})
+	assert.True(t, s.selected("app.settings"))
+	assert.True(t, s.selected("billing.invoices"))
+	assert.False(t, s.selected("app.audit_log"))
+	assert.False(t, s.selected("billing.payments"))
+}
+
+func TestSource_SelectQuery(t *testing.T) {
+	tbl := table{database: "app", name: "user`settings", columns: []string{"value", "notes"}}
+
+	s := &Source{}
+	sourcestest.Init(t, s, &sourcespb.MySQL{Credential: &sourcespb.MySQL_ConnectionString{ConnectionString: "app:secret@tcp(localhost:3306)/app"}})
+	assert.Equal(t,
+		"SELECT `id`, `value`, `notes` FROM `app`.`user``settings`",
+		s.selectQuery(tbl, []string{"id"}))
+
+	s = &Source{}
+	sourcestest.Init(t, s, &sourcespb.MySQL{Credential: &sourcespb.MySQL_ConnectionString{ConnectionString: "app:secret@tcp(localhost:3306)/app"}, MaxRows: 1000, SamplePercent: 2.5})
+	assert.Equal(t,
+		"SELECT `value`, `notes` FROM `app`.`user``settings` WHERE RAND() < 0.025 LIMIT 1000",
+		s.selectQuery(tbl, nil))
+}
+
+func TestRowID(t *testing.T) {
+	value := func(s string) sql.NullString { return sql.NullString{String: s, Valid: true} }
+	assert.Equal(t, "row=7", rowID(nil, nil, 7))
+	assert.Equal(t, "org=3,id=42", rowID([]string{"org", "id"}, []sql.NullString{value("3"), value("42")}, 1))
+}
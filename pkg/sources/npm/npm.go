@@ -0,0 +1,295 @@
+package npm
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync/atomic"
+
+	diskbufferreader "github.com/bill-rich/disk-buffer-reader"
+	"github.com/go-errors/errors"
+	"github.com/go-logr/logr"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const (
+	defaultEndpoint = "https://registry.npmjs.org/"
+	searchPageSize  = 250
+	// maxTarballSize is the largest package tarball that will be downloaded and scanned.
+	maxTarballSize = 100 * 1024 * 1024 // 100MB
+)
+
+type Source struct {
+	name        string
+	sourceId    int64
+	jobId       int64
+	verify      bool
+	endpoint    string
+	token       string
+	packages    []string
+	scopes      []string
+	maxVersions int
+	client      *http.Client
+	jobPool     *errgroup.Group
+	log         logr.Logger
+	sources.Progress
+}
+
+// Ensure the Source satisfies the interface at compile time.
+var _ sources.Source = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return sourcespb.SourceType_SOURCE_TYPE_NPM
+}
+
+func (s *Source) SourceID() int64 {
+	return s.sourceId
+}
+
+func (s *Source) JobID() int64 {
+	return s.jobId
+}
+
+// Init returns an initialized npm source.
+func (s *Source) Init(aCtx context.Context, name string, jobId, sourceId int64, verify bool, connection *anypb.Any, concurrency int) error {
+	s.log = context.WithValues(aCtx, "source", s.Type(), "name", name).Logger()
+
+	s.name = name
+	s.sourceId = sourceId
+	s.jobId = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	var conn sourcespb.NPM
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return errors.WrapPrefix(err, "error unmarshalling connection", 0)
+	}
+
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.NPM_Unauthenticated:
+	case *sourcespb.NPM_Token:
+		s.token = cred.Token
+	default:
+		return errors.Errorf("invalid configuration given for %s source", name)
+	}
+
+	s.endpoint = conn.GetEndpoint()
+	if s.endpoint == "" {
+		s.endpoint = defaultEndpoint
+	}
+	if !strings.HasSuffix(s.endpoint, "/") {
+		s.endpoint += "/"
+	}
+	s.packages = conn.GetPackages()
+	for _, scope := range conn.GetScopes() {
+		s.scopes = append(s.scopes, strings.TrimPrefix(scope, "@"))
+	}
+	if len(s.packages) == 0 && len(s.scopes) == 0 {
+		return errors.New("at least one package or scope is required")
+	}
+	s.maxVersions = int(conn.GetMaxVersions())
+	s.client = common.RetryableHttpClientTimeout(120)
+
+	return nil
+}
+
+// packument is the registry document describing every version of a package.
+type packument struct {
+	Name     string             `json:"name"`
+	Versions map[string]release `json:"versions"`
+	Time     map[string]string  `json:"time"`
+}
+
+type release struct {
+	Version string `json:"version"`
+	Dist    struct {
+		Tarball string `json:"tarball"`
+	} `json:"dist"`
+	NPMUser struct {
+		Email string `json:"email"`
+	} `json:"_npmUser"`
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) error {
+	packages := append([]string{}, s.packages...)
+	for _, scope := range s.scopes {
+		scoped, err := s.listScope(ctx, scope)
+		if err != nil {
+			s.log.Error(err, "could not list scope packages", "scope", scope)
+			continue
+		}
+		packages = append(packages, scoped...)
+	}
+
+	var scanned uint64
+	for i, pkg := range packages {
+		if common.IsDone(ctx) {
+			break
+		}
+		s.SetProgressComplete(i, len(packages), fmt.Sprintf("Package: %s", pkg), "")
+
+		var doc packument
+		if err := s.getJSON(ctx, s.endpoint+escapeName(pkg), &doc); err != nil {
+			s.log.Error(err, "could not get package", "package", pkg)
+			continue
+		}
+		for _, rel := range s.releases(doc) {
+			rel := rel
+			s.jobPool.Go(func() error {
+				if err := s.scanRelease(ctx, doc.Name, rel, chunksChan); err != nil {
+					s.log.Error(err, "could not scan release", "package", doc.Name, "version", rel.Version)
+					return nil
+				}
+				atomic.AddUint64(&scanned, 1)
+				return nil
+			})
+		}
+	}
+	_ = s.jobPool.Wait()
+
+	s.SetProgressComplete(len(packages), len(packages), fmt.Sprintf("Completed scanning source %s. %d releases scanned.", s.name, scanned), "")
+	return nil
+}
+
+// listScope returns the names of every package published under a scope.
+func (s *Source) listScope(ctx context.Context, scope string) ([]string, error) {
+	var names []string
+	for from := 0; ; from += searchPageSize {
+		query := url.Values{}
+		query.Set("text", "scope:"+scope)
+		query.Set("size", fmt.Sprint(searchPageSize))
+		query.Set("from", fmt.Sprint(from))
+		var res struct {
+			Total   int `json:"total"`
+			Objects []struct {
+				Package struct {
+					Name string `json:"name"`
+				} `json:"package"`
+			} `json:"objects"`
+		}
+		if err := s.getJSON(ctx, s.endpoint+"-/v1/search?"+query.Encode(), &res); err != nil {
+			return nil, err
+		}
+		for _, obj := range res.Objects {
+			// Search is full text, so make sure the result is actually in the scope.
+			if strings.HasPrefix(obj.Package.Name, "@"+scope+"/") {
+				names = append(names, obj.Package.Name)
+			}
+		}
+		if len(res.Objects) == 0 || from+len(res.Objects) >= res.Total {
+			return names, nil
+		}
+	}
+}
+
+// releases returns the releases of a package from newest to oldest, limited
+// to maxVersions when it is set.
+func (s *Source) releases(doc packument) []release {
+	releases := make([]release, 0, len(doc.Versions))
+	for _, rel := range doc.Versions {
+		releases = append(releases, rel)
+	}
+	sort.Slice(releases, func(i, j int) bool {
+		ti, tj := doc.Time[releases[i].Version], doc.Time[releases[j].Version]
+		if ti == tj {
+			return releases[i].Version > releases[j].Version
+		}
+		return ti > tj
+	})
+	if s.maxVersions > 0 && len(releases) > s.maxVersions {
+		releases = releases[:s.maxVersions]
+	}
+	return releases
+}
+
+func (s *Source) scanRelease(ctx context.Context, pkg string, rel release, chunksChan chan *sources.Chunk) error {
+	res, err := s.get(ctx, rel.Dist.Tarball)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	reader, err := diskbufferreader.New(io.LimitReader(res.Body, maxTarballSize))
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	link := rel.Dist.Tarball
+	if s.endpoint == defaultEndpoint {
+		link = fmt.Sprintf("https://www.npmjs.com/package/%s/v/%s", pkg, rel.Version)
+	}
+	chunkSkel := &sources.Chunk{
+		SourceType: s.Type(),
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Npm{
+				Npm: &source_metadatapb.NPM{
+					File:    sanitizer.UTF8(rel.Dist.Tarball),
+					Package: sanitizer.UTF8(pkg),
+					Release: sanitizer.UTF8(rel.Version),
+					Link:    sanitizer.UTF8(link),
+					Email:   sanitizer.UTF8(rel.NPMUser.Email),
+				},
+			},
+		},
+		Verify: s.verify,
+	}
+	if !handlers.HandleFile(ctx, reader, chunkSkel, chunksChan) {
+		return fmt.Errorf("could not extract tarball")
+	}
+	return nil
+}
+
+func (s *Source) getJSON(ctx context.Context, reqURL string, out interface{}) error {
+	res, err := s.get(ctx, reqURL)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	return json.NewDecoder(res.Body).Decode(out)
+}
+
+func (s *Source) get(ctx context.Context, reqURL string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	// Only send the token to the registry itself so it can't leak to other hosts serving tarballs.
+	if s.token != "" && strings.HasPrefix(reqURL, s.endpoint) {
+		req.Header.Set("Authorization", "Bearer "+s.token)
+	}
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		res.Body.Close()
+		return nil, fmt.Errorf("unexpected status code %d requesting %s", res.StatusCode, reqURL)
+	}
+	return res, nil
+}
+
+// escapeName escapes the slash of scoped package names as the registry expects.
+func escapeName(pkg string) string {
+	return strings.Replace(pkg, "/", "%2f", 1)
+}
@@ -0,0 +1,126 @@
+package npm
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/anypb"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/sourcestest"
+)
+
+func tarball(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content))}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestSource_Releases(t *testing.T) {
+	s := &Source{maxVersions: 2}
+	doc := packument{
+		Versions: map[string]release{"1.0.0": {Version: "1.0.0"}, "1.1.0": {Version: "1.1.0"}, "2.0.0": {Version: "2.0.0"}},
+		Time:     map[string]string{"1.0.0": "2020-01-01T00:00:00Z", "1.1.0": "2021-01-01T00:00:00Z", "2.0.0": "2022-01-01T00:00:00Z"},
+	}
+	var versions []string
+	for _, rel := range s.releases(doc) {
+		versions = append(versions, rel.Version)
+	}
+	assert.Equal(t, []string{"2.0.0", "1.1.0"}, versions)
+}
+
+func TestSource_Chunks(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://npm.example.com").
+		Get("/-/v1/search").
+		MatchParam("text", "scope:acme").
+		MatchHeader("Authorization", "Bearer token").
+		Reply(200).
+		JSON(map[string]interface{}{"total": 2, "objects": []map[string]interface{}{
+			{"package": map[string]string{"name": "@acme/widgets"}},
+			{"package": map[string]string{"name": "acme-unrelated"}},
+		}})
+	gock.New("https://npm.example.com").
+		Get("/@acme/widgets$").
+		Reply(200).
+		JSON(map[string]interface{}{
+			"name": "@acme/widgets",
+			"versions": map[string]interface{}{
+				"1.0.0": map[string]interface{}{
+					"version":  "1.0.0",
+					"dist":     map[string]string{"tarball": "https://npm.example.com/@acme/widgets/-/widgets-1.0.0.tgz"},
+					"_npmUser": map[string]string{"email": "dev@acme.com"},
+				},
+			},
+			"time": map[string]string{"1.0.0": "2023-01-01T00:00:00Z"},
+		})
+	gock.New("https://npm.example.com").
+		Get("/@acme/widgets/-/widgets-1.0.0.tgz").
+		MatchHeader("Authorization", "Bearer token").
+		Reply(200).
+		Body(bytes.NewReader(tarball(t, map[string]string{"package/.npmrc": "//registry.npmjs.org/:_authToken=secret"})))
+
+	s := &Source{}
+	sourcestest.Init(t, s, &sourcespb.NPM{
+		Endpoint:   "https://npm.example.com",
+		Credential: &sourcespb.NPM_Token{Token: "token"},
+		Scopes:     []string{"@acme"},
+	}, func() *http.Client { return s.client })
+
+	chunksCh := make(chan *sources.Chunk, 10)
+	assert.NoError(t, s.Chunks(context.Background(), chunksCh))
+	close(chunksCh)
+
+	var data []string
+	for chunk := range chunksCh {
+		meta := chunk.SourceMetadata.GetNpm()
+		assert.Equal(t, "@acme/widgets", meta.Package)
+		assert.Equal(t, "1.0.0", meta.Release)
+		assert.Equal(t, "dev@acme.com", meta.Email)
+		data = append(data, strings.TrimRight(string(chunk.Data), "\x00"))
+	}
+	assert.Equal(t, []string{"//registry.npmjs.org/:_authToken=secret"}, data)
+	assert.True(t, gock.IsDone())
+}
+
+func TestSource_Init(t *testing.T) {
+	s := &Source{}
+	a, _ := anypb.New(&sourcespb.NPM{
+		Credential: &sourcespb.NPM_Unauthenticated{Unauthenticated: &credentialspb.Unauthenticated{}},
+	})
+	assert.Error(t, s.Init(context.Background(), "test - npm", 0, 0, false, a, 1))
+
+	a, _ = anypb.New(&sourcespb.NPM{
+		Credential: &sourcespb.NPM_Unauthenticated{Unauthenticated: &credentialspb.Unauthenticated{}},
+		Packages:   []string{"left-pad"},
+	})
+	assert.NoError(t, s.Init(context.Background(), "test - npm", 0, 0, false, a, 1))
+	assert.Equal(t, defaultEndpoint, s.endpoint)
+	assert.Equal(t, "@acme%2fwidgets", escapeName("@acme/widgets"))
+}
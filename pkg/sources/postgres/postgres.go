@@ -0,0 +1,337 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/go-errors/errors"
+	"github.com/go-logr/logr"
+	"github.com/gobwas/glob"
+	"github.com/lib/pq"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// listColumnsQuery lists the textual columns of the tables in the given
+// schemas, or in every user schema when none are given.
+const listColumnsQuery = `
+SELECT c.table_schema, c.table_name, c.column_name
+FROM information_schema.columns c
+JOIN information_schema.tables t ON t.table_schema = c.table_schema AND t.table_name = c.table_name
+WHERE t.table_type = 'BASE TABLE'
+  AND c.table_schema NOT IN ('pg_catalog', 'information_schema')
+  AND c.table_schema NOT LIKE 'pg_toast%'
+  AND (cardinality($1::text[]) = 0 OR c.table_schema = ANY($1))
+  AND (c.data_type IN ('text', 'character varying', 'character', 'json', 'jsonb', 'xml') OR c.udt_name = 'citext')
+ORDER BY c.table_schema, c.table_name, c.ordinal_position`
+
+const primaryKeyQuery = `
+SELECT kcu.column_name
+FROM information_schema.table_constraints tc
+JOIN information_schema.key_column_usage kcu
+  ON kcu.constraint_name = tc.constraint_name AND kcu.table_schema = tc.table_schema AND kcu.table_name = tc.table_name
+WHERE tc.constraint_type = 'PRIMARY KEY' AND tc.table_schema = $1 AND tc.table_name = $2
+ORDER BY kcu.ordinal_position`
+
+type Source struct {
+	name          string
+	sourceId      int64
+	jobId         int64
+	verify        bool
+	db            *sql.DB
+	database      string
+	schemas       []string
+	tables        []glob.Glob
+	ignoreTables  []glob.Glob
+	maxRows       int64
+	samplePercent float64
+	jobPool       *errgroup.Group
+	log           logr.Logger
+	sources.Progress
+}
+
+// Ensure the Source satisfies the interface at compile time.
+var _ sources.Source = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return sourcespb.SourceType_SOURCE_TYPE_POSTGRES
+}
+
+func (s *Source) SourceID() int64 {
+	return s.sourceId
+}
+
+func (s *Source) JobID() int64 {
+	return s.jobId
+}
+
+// Init returns an initialized PostgreSQL source.
+func (s *Source) Init(aCtx context.Context, name string, jobId, sourceId int64, verify bool, connection *anypb.Any, concurrency int) error {
+	s.log = context.WithValues(aCtx, "source", s.Type(), "name", name).Logger()
+
+	s.name = name
+	s.sourceId = sourceId
+	s.jobId = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	var conn sourcespb.Postgres
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return errors.WrapPrefix(err, "error unmarshalling connection", 0)
+	}
+
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.Postgres_ConnectionString:
+		// Opening doesn't connect, so this only fails on malformed connection strings.
+		db, err := sql.Open("postgres", cred.ConnectionString)
+		if err != nil {
+			return errors.WrapPrefix(err, "invalid connection string", 0)
+		}
+		db.SetMaxOpenConns(concurrency)
+		s.db = db
+	default:
+		return errors.Errorf("invalid configuration given for %s source", name)
+	}
+
+	s.schemas = conn.GetSchemas()
+	var err error
+	if s.tables, err = compileGlobs(conn.GetTables()); err != nil {
+		return err
+	}
+	if s.ignoreTables, err = compileGlobs(conn.GetIgnoreTables()); err != nil {
+		return err
+	}
+	s.maxRows = conn.GetMaxRows()
+	s.samplePercent = conn.GetSamplePercent()
+	if s.samplePercent < 0 || s.samplePercent > 100 {
+		return errors.Errorf("sample percent must be between 0 and 100, got %v", s.samplePercent)
+	}
+
+	return nil
+}
+
+func compileGlobs(patterns []string) ([]glob.Glob, error) {
+	var globs []glob.Glob
+	for _, pattern := range patterns {
+		g, err := glob.Compile(pattern, '.')
+		if err != nil {
+			return nil, fmt.Errorf("invalid table pattern %q: %w", pattern, err)
+		}
+		globs = append(globs, g)
+	}
+	return globs, nil
+}
+
+// selected reports whether a table, named as schema.table, should be scanned.
+func (s *Source) selected(table string) bool {
+	for _, g := range s.ignoreTables {
+		if g.Match(table) {
+			return false
+		}
+	}
+	if len(s.tables) == 0 {
+		return true
+	}
+	for _, g := range s.tables {
+		if g.Match(table) {
+			return true
+		}
+	}
+	return false
+}
+
+type table struct {
+	schema  string
+	name    string
+	columns []string
+}
+
+func (t table) String() string {
+	return t.schema + "." + t.name
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) error {
+	defer s.db.Close()
+
+	if err := s.db.QueryRowContext(ctx, "SELECT current_database()").Scan(&s.database); err != nil {
+		return fmt.Errorf("error connecting to database: %w", err)
+	}
+	tables, err := s.listTables(ctx)
+	if err != nil {
+		return fmt.Errorf("error listing tables: %w", err)
+	}
+
+	var scanned uint64
+	for i, t := range tables {
+		if common.IsDone(ctx) {
+			break
+		}
+		s.SetProgressComplete(i, len(tables), fmt.Sprintf("Table: %s", t), "")
+
+		t := t
+		s.jobPool.Go(func() error {
+			if err := s.scanTable(ctx, t, chunksChan); err != nil {
+				s.log.Error(err, "could not scan table", "table", t.String())
+				return nil
+			}
+			atomic.AddUint64(&scanned, 1)
+			return nil
+		})
+	}
+	_ = s.jobPool.Wait()
+
+	s.SetProgressComplete(len(tables), len(tables), fmt.Sprintf("Completed scanning source %s. %d tables scanned.", s.name, scanned), "")
+	return nil
+}
+
+func (s *Source) listTables(ctx context.Context) ([]table, error) {
+	rows, err := s.db.QueryContext(ctx, listColumnsQuery, pq.Array(s.schemas))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tables []table
+	for rows.Next() {
+		var schema, name, column string
+		if err := rows.Scan(&schema, &name, &column); err != nil {
+			return nil, err
+		}
+		if n := len(tables); n > 0 && tables[n-1].schema == schema && tables[n-1].name == name {
+			tables[n-1].columns = append(tables[n-1].columns, column)
+			continue
+		}
+		t := table{schema: schema, name: name, columns: []string{column}}
+		if !s.selected(t.String()) {
+			continue
+		}
+		tables = append(tables, t)
+	}
+	return tables, rows.Err()
+}
+
+func (s *Source) primaryKey(ctx context.Context, t table) ([]string, error) {
+	rows, err := s.db.QueryContext(ctx, primaryKeyQuery, t.schema, t.name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var key []string
+	for rows.Next() {
+		var column string
+		if err := rows.Scan(&column); err != nil {
+			return nil, err
+		}
+		key = append(key, column)
+	}
+	return key, rows.Err()
+}
+
+// selectQuery returns the query reading the key and textual columns of a
+// table. Rows of tables without a primary key are identified by their ctid.
+func (s *Source) selectQuery(t table, key []string) string {
+	var exprs []string
+	if len(key) == 0 {
+		exprs = append(exprs, "ctid::text")
+	}
+	for _, column := range append(append([]string{}, key...), t.columns...) {
+		exprs = append(exprs, pq.QuoteIdentifier(column)+"::text")
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM %s.%s", strings.Join(exprs, ", "), pq.QuoteIdentifier(t.schema), pq.QuoteIdentifier(t.name))
+	if s.samplePercent > 0 {
+		query += " TABLESAMPLE SYSTEM (" + strconv.FormatFloat(s.samplePercent, 'f', -1, 64) + ")"
+	}
+	if s.maxRows > 0 {
+		query += " LIMIT " + strconv.FormatInt(s.maxRows, 10)
+	}
+	return query
+}
+
+// rowID formats the key of a row, such as "id=42" or "ctid=(0,1)".
+func rowID(key []string, values []sql.NullString) string {
+	if len(key) == 0 {
+		key = []string{"ctid"}
+	}
+	parts := make([]string, len(key))
+	for i, column := range key {
+		parts[i] = column + "=" + values[i].String
+	}
+	return strings.Join(parts, ",")
+}
+
+func (s *Source) scanTable(ctx context.Context, t table, chunksChan chan *sources.Chunk) error {
+	key, err := s.primaryKey(ctx, t)
+	if err != nil {
+		return err
+	}
+	rows, err := s.db.QueryContext(ctx, s.selectQuery(t, key))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	keyLen := len(key)
+	if keyLen == 0 {
+		keyLen = 1
+	}
+	values := make([]sql.NullString, keyLen+len(t.columns))
+	dest := make([]interface{}, len(values))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			return err
+		}
+		row := rowID(key, values[:keyLen])
+		for i, column := range t.columns {
+			value := values[keyLen+i]
+			if !value.Valid || value.String == "" {
+				continue
+			}
+			chunk := &sources.Chunk{
+				SourceType: s.Type(),
+				SourceName: s.name,
+				SourceID:   s.SourceID(),
+				Data:       []byte(value.String),
+				SourceMetadata: &source_metadatapb.MetaData{
+					Data: &source_metadatapb.MetaData_Postgres{
+						Postgres: &source_metadatapb.Postgres{
+							Database: sanitizer.UTF8(s.database),
+							Schema:   sanitizer.UTF8(t.schema),
+							Table:    sanitizer.UTF8(t.name),
+							Column:   sanitizer.UTF8(column),
+							Row:      sanitizer.UTF8(row),
+						},
+					},
+				},
+				Verify: s.verify,
+			}
+			for c := range sources.Chunker(chunk) {
+				select {
+				case chunksChan <- c:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+		}
+	}
+	return rows.Err()
+}
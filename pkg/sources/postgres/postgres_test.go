@@ -0,0 +1,46 @@
+package postgres
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/sourcestest"
+)
+
+func TestSource_Selected(t *testing.T) {
+	s := &Source{}
+	sourcestest.Init(t, s, &sourcespb.Postgres{
+		Credential:   &sourcespb.Postgres_ConnectionString{ConnectionString: "postgres://localhost/app"},
+		Tables:       []string{"public.*", "billing.invoices"},
+		IgnoreTables: []string{"public.audit_*"},
+	})
+	assert.True(t, s.selected("public.settings"))
+	assert.True(t, s.selected("billing.invoices"))
+	assert.False(t, s.selected("public.audit_log"))
+	assert.False(t, s.selected("billing.payments"))
+}
+
+func TestSource_SelectQuery(t *testing.T) {
+	tbl := table{schema: "public", name: "user settings", columns: []string{"value", "Notes"}}
+
+	s := &Source{}
+	sourcestest.Init(t, s, &sourcespb.Postgres{Credential: &sourcespb.Postgres_ConnectionString{ConnectionString: "postgres://localhost/app"}})
+	assert.Equal(t,
+		`SELECT "id"::text, "value"::text, "Notes"::text FROM "public"."user settings"`,
+		s.selectQuery(tbl, []string{"id"}))
+
+	s = &Source{}
+	sourcestest.Init(t, s, &sourcespb.Postgres{Credential: &sourcespb.Postgres_ConnectionString{ConnectionString: "postgres://localhost/app"}, MaxRows: 1000, SamplePercent: 2.5})
+	assert.Equal(t,
+		`SELECT ctid::text, "value"::text, "Notes"::text FROM "public"."user settings" TABLESAMPLE SYSTEM (2.5) LIMIT 1000`,
+		s.selectQuery(tbl, nil))
+}
+
+func TestRowID(t *testing.T) {
+	value := func(s string) sql.NullString { return sql.NullString{String: s, Valid: true} }
+	assert.Equal(t, "ctid=(0,1)", rowID(nil, []sql.NullString{value("(0,1)")}))
+	assert.Equal(t, "org=3,id=42", rowID([]string{"org", "id"}, []sql.NullString{value("3"), value("42")}))
+}
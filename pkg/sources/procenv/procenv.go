@@ -0,0 +1,216 @@
+package procenv
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-errors/errors"
+	"github.com/go-logr/logr"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const defaultProcPath = "/proc"
+
+type Source struct {
+	name     string
+	sourceId int64
+	jobId    int64
+	verify   bool
+	pids     []int64
+	procPath string
+	log      logr.Logger
+	sources.Progress
+}
+
+// Ensure the Source satisfies the interface at compile time.
+var _ sources.Source = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return sourcespb.SourceType_SOURCE_TYPE_PROCESS_ENVIRONMENT
+}
+
+func (s *Source) SourceID() int64 {
+	return s.sourceId
+}
+
+func (s *Source) JobID() int64 {
+	return s.jobId
+}
+
+// Init returns an initialized process environment source.
+func (s *Source) Init(aCtx context.Context, name string, jobId, sourceId int64, verify bool, connection *anypb.Any, _ int) error {
+	s.log = context.WithValues(aCtx, "source", s.Type(), "name", name).Logger()
+
+	s.name = name
+	s.sourceId = sourceId
+	s.jobId = jobId
+	s.verify = verify
+
+	var conn sourcespb.ProcessEnvironment
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return errors.WrapPrefix(err, "error unmarshalling connection", 0)
+	}
+
+	s.pids = conn.GetPids()
+	s.procPath = conn.GetProcPath()
+	if s.procPath == "" {
+		s.procPath = defaultProcPath
+	}
+	// procfs is only available on Linux.
+	if _, err := os.Stat(filepath.Join(s.procPath, "1")); err != nil {
+		return errors.WrapPrefix(err, "could not read procfs", 0)
+	}
+	return nil
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) error {
+	pids := s.pids
+	if len(pids) == 0 {
+		var err error
+		if pids, err = s.processes(); err != nil {
+			return errors.WrapPrefix(err, "could not list processes", 0)
+		}
+	}
+
+	scanned := 0
+	for i, pid := range pids {
+		if common.IsDone(ctx) {
+			return ctx.Err()
+		}
+		// The command line of this process holds the credentials of the
+		// scan, if any.
+		if s.procPath == defaultProcPath && pid == int64(os.Getpid()) {
+			continue
+		}
+		s.SetProgressComplete(i, len(pids), fmt.Sprintf("Process: %d", pid), "")
+
+		if err := s.scanProcess(ctx, pid, chunksChan); err != nil {
+			if common.IsDone(ctx) {
+				return ctx.Err()
+			}
+			// Processes can exit while they are scanned, and reading the
+			// environment of processes of other users requires privileges.
+			s.log.V(2).Info("could not scan process", "pid", pid, "error", err)
+			continue
+		}
+		scanned++
+	}
+
+	s.SetProgressComplete(len(pids), len(pids), fmt.Sprintf("Completed scanning source %s. %d processes scanned.", s.name, scanned), "")
+	return nil
+}
+
+// processes returns the IDs of the running processes.
+func (s *Source) processes() ([]int64, error) {
+	entries, err := os.ReadDir(s.procPath)
+	if err != nil {
+		return nil, err
+	}
+	var pids []int64
+	for _, entry := range entries {
+		if pid, err := strconv.ParseInt(entry.Name(), 10, 64); err == nil && entry.IsDir() {
+			pids = append(pids, pid)
+		}
+	}
+	sort.Slice(pids, func(i, j int) bool { return pids[i] < pids[j] })
+	return pids, nil
+}
+
+func (s *Source) scanProcess(ctx context.Context, pid int64, chunksChan chan *sources.Chunk) error {
+	dir := filepath.Join(s.procPath, strconv.FormatInt(pid, 10))
+	comm, err := os.ReadFile(filepath.Join(dir, "comm"))
+	if err != nil {
+		return err
+	}
+	uid, err := processUID(filepath.Join(dir, "status"))
+	if err != nil {
+		return err
+	}
+
+	for _, file := range []string{"environ", "cmdline"} {
+		data, err := os.ReadFile(filepath.Join(dir, file))
+		if err != nil {
+			return err
+		}
+		// Both files hold strings terminated by null bytes. Variables are
+		// scanned one per line, and arguments are joined as they would be
+		// typed.
+		separator := []byte("\n")
+		if file == "cmdline" {
+			separator = []byte(" ")
+		}
+		data = bytes.ReplaceAll(bytes.TrimRight(data, "\x00"), []byte("\x00"), separator)
+		if len(data) == 0 {
+			continue
+		}
+
+		chunk := &sources.Chunk{
+			SourceType: s.Type(),
+			SourceName: s.name,
+			SourceID:   s.SourceID(),
+			Data:       data,
+			SourceMetadata: &source_metadatapb.MetaData{
+				Data: &source_metadatapb.MetaData_ProcessEnvironment{
+					ProcessEnvironment: &source_metadatapb.ProcessEnvironment{
+						Pid:     pid,
+						Command: sanitizer.UTF8(strings.TrimSpace(string(comm))),
+						Uid:     uid,
+						File:    file,
+					},
+				},
+			},
+			Verify: s.verify,
+		}
+		for c := range sources.Chunker(chunk) {
+			select {
+			case chunksChan <- c:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+	return nil
+}
+
+// processUID returns the real user ID of a process from its status file.
+func processUID(path string) (int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		ids, ok := strings.CutPrefix(scanner.Text(), "Uid:")
+		if !ok {
+			continue
+		}
+		fields := strings.Fields(ids)
+		if len(fields) == 0 {
+			break
+		}
+		return strconv.ParseInt(fields[0], 10, 64)
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+	return 0, errors.Errorf("no Uid in %s", path)
+}
@@ -0,0 +1,80 @@
+package procenv
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/sourcestest"
+)
+
+// testProcFS returns a directory laid out like procfs.
+func testProcFS(t *testing.T) string {
+	t.Helper()
+	root := t.TempDir()
+	files := map[string]string{
+		"1/comm":      "systemd\n",
+		"1/status":    "Name:\tsystemd\nUid:\t0\t0\t0\t0\nGid:\t0\t0\t0\t0\n",
+		"1/environ":   "",
+		"1/cmdline":   "/sbin/init\x00",
+		"42/comm":     "worker\n",
+		"42/status":   "Name:\tworker\nUid:\t1000\t1000\t1000\t1000\n",
+		"42/environ":  "HOME=/home/app\x00AWS_SECRET_ACCESS_KEY=abc\x00",
+		"42/cmdline":  "worker\x00--db-password\x00hunter2\x00",
+		"self/comm":   "trufflehog\n",
+		"cpuinfo/x":   "",
+		"1337/status": "Name:\texited\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		assert.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+	return root
+}
+
+func TestSource_Chunks(t *testing.T) {
+	s := &Source{}
+	sourcestest.Init(t, s, &sourcespb.ProcessEnvironment{ProcPath: testProcFS(t)})
+	chunksCh := make(chan *sources.Chunk, 10)
+	assert.NoError(t, s.Chunks(context.Background(), chunksCh))
+	close(chunksCh)
+
+	var got []string
+	for c := range chunksCh {
+		meta := c.SourceMetadata.GetProcessEnvironment()
+		got = append(got, meta.GetCommand()+" "+meta.GetFile()+" "+string(c.Data))
+		if meta.GetPid() == 42 {
+			assert.Equal(t, int64(1000), meta.GetUid())
+		}
+	}
+	sort.Strings(got)
+	assert.Equal(t, []string{
+		"systemd cmdline /sbin/init",
+		"worker cmdline worker --db-password hunter2",
+		"worker environ HOME=/home/app\nAWS_SECRET_ACCESS_KEY=abc",
+	}, got)
+}
+
+func TestSource_Pids(t *testing.T) {
+	s := &Source{}
+	sourcestest.Init(t, s, &sourcespb.ProcessEnvironment{ProcPath: testProcFS(t), Pids: []int64{42}})
+	chunksCh := make(chan *sources.Chunk, 10)
+	assert.NoError(t, s.Chunks(context.Background(), chunksCh))
+	close(chunksCh)
+	assert.Len(t, chunksCh, 2)
+}
+
+func TestProcesses(t *testing.T) {
+	s := &Source{}
+	sourcestest.Init(t, s, &sourcespb.ProcessEnvironment{ProcPath: testProcFS(t)})
+	pids, err := s.processes()
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 42, 1337}, pids)
+}
@@ -0,0 +1,301 @@
+package redis
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
+
+	"github.com/go-errors/errors"
+	"github.com/go-logr/logr"
+	"github.com/go-redis/redis"
+	"github.com/gobwas/glob"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const (
+	// scanCount is the number of keys or elements requested per SCAN call.
+	scanCount = 1000
+	// maxElements is the largest number of elements of a collection that
+	// will be scanned.
+	maxElements = 10000
+)
+
+type Source struct {
+	name        string
+	sourceId    int64
+	jobId       int64
+	verify      bool
+	opts        *redis.Options
+	databases   []int
+	keyPatterns []string
+	ignoreKeys  []glob.Glob
+	maxKeys     int64
+	jobPool     *errgroup.Group
+	log         logr.Logger
+	sources.Progress
+}
+
+// Ensure the Source satisfies the interface at compile time.
+var _ sources.Source = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return sourcespb.SourceType_SOURCE_TYPE_REDIS
+}
+
+func (s *Source) SourceID() int64 {
+	return s.sourceId
+}
+
+func (s *Source) JobID() int64 {
+	return s.jobId
+}
+
+// Init returns an initialized Redis source.
+func (s *Source) Init(aCtx context.Context, name string, jobId, sourceId int64, verify bool, connection *anypb.Any, concurrency int) error {
+	s.log = context.WithValues(aCtx, "source", s.Type(), "name", name).Logger()
+
+	s.name = name
+	s.sourceId = sourceId
+	s.jobId = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	var conn sourcespb.Redis
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return errors.WrapPrefix(err, "error unmarshalling connection", 0)
+	}
+
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.Redis_ConnectionString:
+		opts, err := redis.ParseURL(cred.ConnectionString)
+		if err != nil {
+			return errors.WrapPrefix(err, "invalid connection string", 0)
+		}
+		opts.PoolSize = concurrency
+		s.opts = opts
+	default:
+		return errors.Errorf("invalid configuration given for %s source", name)
+	}
+
+	for _, db := range conn.GetDatabases() {
+		s.databases = append(s.databases, int(db))
+	}
+	if len(s.databases) == 0 {
+		s.databases = []int{s.opts.DB}
+	}
+	s.keyPatterns = conn.GetKeyPatterns()
+	if len(s.keyPatterns) == 0 {
+		s.keyPatterns = []string{"*"}
+	}
+	for _, pattern := range conn.GetIgnoreKeys() {
+		g, err := glob.Compile(pattern)
+		if err != nil {
+			return fmt.Errorf("invalid key pattern %q: %w", pattern, err)
+		}
+		s.ignoreKeys = append(s.ignoreKeys, g)
+	}
+	s.maxKeys = conn.GetMaxKeys()
+
+	return nil
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) error {
+	var scanned uint64
+	for i, db := range s.databases {
+		if common.IsDone(ctx) {
+			break
+		}
+		s.SetProgressComplete(i, len(s.databases), fmt.Sprintf("Database: %d", db), "")
+
+		opts := *s.opts
+		opts.DB = db
+		client := redis.NewClient(&opts).WithContext(ctx)
+		if err := s.scanDatabase(ctx, client, db, &scanned, chunksChan); err != nil {
+			s.log.Error(err, "could not scan database", "db", db)
+		}
+		_ = s.jobPool.Wait()
+		_ = client.Close()
+	}
+
+	s.SetProgressComplete(len(s.databases), len(s.databases), fmt.Sprintf("Completed scanning source %s. %d keys scanned.", s.name, scanned), "")
+	return nil
+}
+
+// scanDatabase iterates the keys of a database with SCAN, which unlike KEYS
+// doesn't block the server, and scans them in batches.
+func (s *Source) scanDatabase(ctx context.Context, client *redis.Client, db int, scanned *uint64, chunksChan chan *sources.Chunk) error {
+	var listed int64
+	for _, pattern := range s.keyPatterns {
+		var cursor uint64
+		for {
+			if common.IsDone(ctx) {
+				return ctx.Err()
+			}
+			keys, next, err := client.Scan(cursor, pattern, scanCount).Result()
+			if err != nil {
+				return err
+			}
+
+			var batch []string
+			for _, key := range keys {
+				if s.ignored(key) {
+					continue
+				}
+				if s.maxKeys > 0 && listed >= s.maxKeys {
+					break
+				}
+				listed++
+				batch = append(batch, key)
+			}
+			s.jobPool.Go(func() error {
+				for _, key := range batch {
+					if err := s.scanKey(ctx, client, db, key, chunksChan); err != nil {
+						s.log.V(2).Info("could not scan key", "db", db, "key", key, "error", err.Error())
+						continue
+					}
+					atomic.AddUint64(scanned, 1)
+				}
+				return nil
+			})
+
+			if s.maxKeys > 0 && listed >= s.maxKeys {
+				return nil
+			}
+			if cursor = next; cursor == 0 {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Source) ignored(key string) bool {
+	for _, g := range s.ignoreKeys {
+		if g.Match(key) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Source) scanKey(ctx context.Context, client *redis.Client, db int, key string, chunksChan chan *sources.Chunk) error {
+	typ, err := client.Type(key).Result()
+	if err != nil {
+		return err
+	}
+	data, err := readValue(client, key, typ)
+	if err != nil || len(data) == 0 {
+		return err
+	}
+
+	chunk := &sources.Chunk{
+		SourceType: s.Type(),
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		Data:       data,
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Redis{
+				Redis: &source_metadatapb.Redis{
+					Address:  sanitizer.UTF8(s.opts.Addr),
+					Database: int64(db),
+					Key:      sanitizer.UTF8(key),
+					Type:     typ,
+				},
+			},
+		},
+		Verify: s.verify,
+	}
+	for c := range sources.Chunker(chunk) {
+		select {
+		case chunksChan <- c:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// readValue renders the value of a key. Hash fields are rendered as
+// "field = value" lines and the elements of other collections one per line.
+func readValue(client *redis.Client, key, typ string) ([]byte, error) {
+	var lines []string
+	switch typ {
+	case "string":
+		v, err := client.Get(key).Bytes()
+		return v, err
+	case "hash":
+		var cursor uint64
+		for len(lines) < maxElements {
+			kv, next, err := client.HScan(key, cursor, "", scanCount).Result()
+			if err != nil {
+				return nil, err
+			}
+			for i := 0; i+1 < len(kv); i += 2 {
+				lines = append(lines, kv[i]+" = "+kv[i+1])
+			}
+			if cursor = next; cursor == 0 {
+				break
+			}
+		}
+		// HSCAN returns fields in hash order, which isn't stable.
+		sort.Strings(lines)
+	case "list":
+		v, err := client.LRange(key, 0, maxElements-1).Result()
+		if err != nil {
+			return nil, err
+		}
+		lines = v
+	case "set":
+		var cursor uint64
+		for len(lines) < maxElements {
+			v, next, err := client.SScan(key, cursor, "", scanCount).Result()
+			if err != nil {
+				return nil, err
+			}
+			lines = append(lines, v...)
+			if cursor = next; cursor == 0 {
+				break
+			}
+		}
+	case "zset":
+		v, err := client.ZRange(key, 0, maxElements-1).Result()
+		if err != nil {
+			return nil, err
+		}
+		lines = v
+	case "stream":
+		msgs, err := client.XRangeN(key, "-", "+", maxElements).Result()
+		if err != nil {
+			return nil, err
+		}
+		for _, msg := range msgs {
+			fields := make([]string, 0, len(msg.Values))
+			for k, v := range msg.Values {
+				fields = append(fields, fmt.Sprintf("%s = %v", k, v))
+			}
+			sort.Strings(fields)
+			lines = append(lines, msg.ID+": "+strings.Join(fields, ", "))
+		}
+	default:
+		// Modules types such as RedisJSON can't be read generically.
+		return nil, nil
+	}
+	if len(lines) == 0 {
+		return nil, nil
+	}
+	return []byte(strings.Join(lines, "\n") + "\n"), nil
+}
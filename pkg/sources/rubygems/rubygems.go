@@ -0,0 +1,251 @@
+package rubygems
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+
+	diskbufferreader "github.com/bill-rich/disk-buffer-reader"
+	"github.com/go-errors/errors"
+	"github.com/go-logr/logr"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const (
+	defaultEndpoint = "https://rubygems.org/"
+	// maxGemSize is the largest .gem file that will be downloaded and scanned.
+	maxGemSize = 100 * 1024 * 1024 // 100MB
+)
+
+type Source struct {
+	name     string
+	sourceId int64
+	jobId    int64
+	verify   bool
+	endpoint string
+	// auth sets the credentials on requests to the gem server.
+	auth        func(*http.Request)
+	gems        []string
+	owners      []string
+	maxVersions int
+	client      *http.Client
+	jobPool     *errgroup.Group
+	log         logr.Logger
+	sources.Progress
+}
+
+// Ensure the Source satisfies the interface at compile time.
+var _ sources.Source = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return sourcespb.SourceType_SOURCE_TYPE_RUBYGEMS
+}
+
+func (s *Source) SourceID() int64 {
+	return s.sourceId
+}
+
+func (s *Source) JobID() int64 {
+	return s.jobId
+}
+
+// Init returns an initialized RubyGems source.
+func (s *Source) Init(aCtx context.Context, name string, jobId, sourceId int64, verify bool, connection *anypb.Any, concurrency int) error {
+	s.log = context.WithValues(aCtx, "source", s.Type(), "name", name).Logger()
+
+	s.name = name
+	s.sourceId = sourceId
+	s.jobId = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	var conn sourcespb.RubyGems
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return errors.WrapPrefix(err, "error unmarshalling connection", 0)
+	}
+
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.RubyGems_Unauthenticated:
+		s.auth = func(*http.Request) {}
+	case *sourcespb.RubyGems_Token:
+		// rubygems.org expects the bare API key rather than a bearer token.
+		s.auth = func(req *http.Request) { req.Header.Set("Authorization", cred.Token) }
+	case *sourcespb.RubyGems_BasicAuth:
+		// Private gem servers such as Gemfury accept their token as the username.
+		s.auth = func(req *http.Request) {
+			req.SetBasicAuth(cred.BasicAuth.GetUsername(), cred.BasicAuth.GetPassword())
+		}
+	default:
+		return errors.Errorf("invalid configuration given for %s source", name)
+	}
+
+	s.endpoint = conn.GetEndpoint()
+	if s.endpoint == "" {
+		s.endpoint = defaultEndpoint
+	}
+	if !strings.HasSuffix(s.endpoint, "/") {
+		s.endpoint += "/"
+	}
+	s.gems = conn.GetGems()
+	s.owners = conn.GetOwners()
+	if len(s.gems) == 0 && len(s.owners) == 0 {
+		return errors.New("at least one gem or owner is required")
+	}
+	s.maxVersions = int(conn.GetMaxVersions())
+	s.client = common.RetryableHttpClientTimeout(120)
+
+	return nil
+}
+
+type gem struct {
+	Name string `json:"name"`
+}
+
+type version struct {
+	Number   string `json:"number"`
+	Platform string `json:"platform"`
+	Authors  string `json:"authors"`
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) error {
+	gems := append([]string{}, s.gems...)
+	for _, owner := range s.owners {
+		var owned []gem
+		if err := s.getJSON(ctx, fmt.Sprintf("api/v1/owners/%s/gems.json", url.PathEscape(owner)), &owned); err != nil {
+			s.log.Error(err, "could not list owner gems", "owner", owner)
+			continue
+		}
+		for _, g := range owned {
+			gems = append(gems, g.Name)
+		}
+	}
+
+	var scanned uint64
+	for i, g := range gems {
+		if common.IsDone(ctx) {
+			break
+		}
+		s.SetProgressComplete(i, len(gems), fmt.Sprintf("Gem: %s", g), "")
+
+		// Versions are returned newest first.
+		var versions []version
+		if err := s.getJSON(ctx, fmt.Sprintf("api/v1/versions/%s.json", url.PathEscape(g)), &versions); err != nil {
+			s.log.Error(err, "could not list gem versions", "gem", g)
+			continue
+		}
+		if s.maxVersions > 0 && len(versions) > s.maxVersions {
+			versions = versions[:s.maxVersions]
+		}
+		for _, v := range versions {
+			g, v := g, v
+			s.jobPool.Go(func() error {
+				if err := s.scanVersion(ctx, g, v, chunksChan); err != nil {
+					s.log.Error(err, "could not scan gem", "gem", g, "version", v.Number)
+					return nil
+				}
+				atomic.AddUint64(&scanned, 1)
+				return nil
+			})
+		}
+	}
+	_ = s.jobPool.Wait()
+
+	s.SetProgressComplete(len(gems), len(gems), fmt.Sprintf("Completed scanning source %s. %d gem versions scanned.", s.name, scanned), "")
+	return nil
+}
+
+func (s *Source) scanVersion(ctx context.Context, g string, v version, chunksChan chan *sources.Chunk) error {
+	file := gemFileName(g, v)
+	res, err := s.get(ctx, "gems/"+file)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	reader, err := diskbufferreader.New(io.LimitReader(res.Body, maxGemSize))
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	link := s.endpoint + "gems/" + file
+	if s.endpoint == defaultEndpoint {
+		link = fmt.Sprintf("https://rubygems.org/gems/%s/versions/%s", g, v.Number)
+	}
+	chunkSkel := &sources.Chunk{
+		SourceType: s.Type(),
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Rubygems{
+				Rubygems: &source_metadatapb.RubyGems{
+					File:    sanitizer.UTF8(file),
+					Package: sanitizer.UTF8(g),
+					Release: sanitizer.UTF8(v.Number),
+					Link:    sanitizer.UTF8(link),
+					Authors: sanitizer.UTF8(v.Authors),
+				},
+			},
+		},
+		Verify: s.verify,
+	}
+	// A .gem is a tar archive wrapping the compressed gem contents, which the
+	// archive handler unpacks recursively.
+	if !handlers.HandleFile(ctx, reader, chunkSkel, chunksChan) {
+		return fmt.Errorf("could not extract %s", file)
+	}
+	return nil
+}
+
+// gemFileName returns the name of the .gem file of a version. Platform
+// specific gems carry their platform in the file name.
+func gemFileName(g string, v version) string {
+	if v.Platform == "" || v.Platform == "ruby" {
+		return fmt.Sprintf("%s-%s.gem", g, v.Number)
+	}
+	return fmt.Sprintf("%s-%s-%s.gem", g, v.Number, v.Platform)
+}
+
+func (s *Source) getJSON(ctx context.Context, path string, out interface{}) error {
+	res, err := s.get(ctx, path)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	return json.NewDecoder(res.Body).Decode(out)
+}
+
+func (s *Source) get(ctx context.Context, path string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.endpoint+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	s.auth(req)
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		res.Body.Close()
+		return nil, fmt.Errorf("unexpected status code %d requesting %s", res.StatusCode, path)
+	}
+	return res, nil
+}
@@ -0,0 +1,114 @@
+package rubygems
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/sourcestest"
+)
+
+func writeTar(t *testing.T, w *tar.Writer, name string, content []byte) {
+	t.Helper()
+	if err := w.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content))}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write(content); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// gemFile builds a .gem archive: a plain tar wrapping a gzipped data tarball.
+func gemFile(t *testing.T, name, content string) []byte {
+	t.Helper()
+	var data bytes.Buffer
+	gz := gzip.NewWriter(&data)
+	tw := tar.NewWriter(gz)
+	writeTar(t, tw, name, []byte(content))
+	assert.NoError(t, tw.Close())
+	assert.NoError(t, gz.Close())
+
+	var gem bytes.Buffer
+	tw = tar.NewWriter(&gem)
+	writeTar(t, tw, "data.tar.gz", data.Bytes())
+	assert.NoError(t, tw.Close())
+	return gem.Bytes()
+}
+
+func TestGemFileName(t *testing.T) {
+	assert.Equal(t, "rails-7.0.0.gem", gemFileName("rails", version{Number: "7.0.0", Platform: "ruby"}))
+	assert.Equal(t, "nokogiri-1.14.0-x86_64-linux.gem", gemFileName("nokogiri", version{Number: "1.14.0", Platform: "x86_64-linux"}))
+}
+
+func TestSource_Chunks(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://rubygems.org").
+		Get("/api/v1/owners/acme/gems.json").
+		Reply(200).
+		JSON([]map[string]string{{"name": "widgets"}})
+	gock.New("https://rubygems.org").
+		Get("/api/v1/versions/widgets.json").
+		Reply(200).
+		JSON([]map[string]string{
+			{"number": "2.0.0", "platform": "ruby", "authors": "Acme"},
+			{"number": "1.0.0", "platform": "ruby", "authors": "Acme"},
+		})
+	gock.New("https://rubygems.org").
+		Get("/gems/widgets-2.0.0.gem").
+		Reply(200).
+		Body(bytes.NewReader(gemFile(t, "config/secrets.yml", "api_key: secret")))
+
+	s := &Source{}
+	sourcestest.Init(t, s, &sourcespb.RubyGems{
+		Credential:  &sourcespb.RubyGems_Unauthenticated{Unauthenticated: &credentialspb.Unauthenticated{}},
+		Owners:      []string{"acme"},
+		MaxVersions: 1,
+	}, func() *http.Client { return s.client })
+
+	chunksCh := make(chan *sources.Chunk, 10)
+	assert.NoError(t, s.Chunks(context.Background(), chunksCh))
+	close(chunksCh)
+
+	var data []string
+	for chunk := range chunksCh {
+		meta := chunk.SourceMetadata.GetRubygems()
+		assert.Equal(t, "widgets", meta.Package)
+		assert.Equal(t, "2.0.0", meta.Release)
+		assert.Equal(t, "https://rubygems.org/gems/widgets/versions/2.0.0", meta.Link)
+		data = append(data, strings.TrimRight(string(chunk.Data), "\x00"))
+	}
+	assert.Contains(t, data, "api_key: secret")
+	assert.True(t, gock.IsDone())
+}
+
+func TestSource_BasicAuth(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gem.fury.io").
+		Get("/acme/api/v1/versions/widgets.json").
+		BasicAuth("token", "").
+		Reply(200).
+		JSON([]map[string]string{})
+
+	s := &Source{}
+	sourcestest.Init(t, s, &sourcespb.RubyGems{
+		Endpoint:   "https://gem.fury.io/acme",
+		Credential: &sourcespb.RubyGems_BasicAuth{BasicAuth: &credentialspb.BasicAuth{Username: "token"}},
+		Gems:       []string{"widgets"},
+	}, func() *http.Client { return s.client })
+
+	chunksCh := make(chan *sources.Chunk, 10)
+	assert.NoError(t, s.Chunks(context.Background(), chunksCh))
+	assert.True(t, gock.IsDone())
+}
@@ -0,0 +1,117 @@
+package s3
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// checkpoint persists the progress of a scan so an interrupted scan resumes
+// where it left off. Progress is tracked per bucket and prefix by the key of
+// the last object of the last scanned page, which is used as StartAfter when
+// resuming since continuation tokens aren't guaranteed to stay valid. A zero
+// value checkpoint is usable and simply does not persist anything.
+type checkpoint struct {
+	path     string
+	mu       sync.Mutex
+	Listings map[string]*listingProgress `json:"listings"`
+}
+
+// listingProgress is the progress of the listing of a bucket and prefix.
+type listingProgress struct {
+	StartAfter string `json:"start_after,omitempty"`
+	Done       bool   `json:"done,omitempty"`
+}
+
+// listingKey identifies the listing of the prefix of a bucket.
+func listingKey(bucket, prefix string) string {
+	return bucket + "/" + prefix
+}
+
+// loadCheckpoint reads the checkpoint stored at path. A missing file yields
+// an empty checkpoint that will be written to path on save.
+func loadCheckpoint(path string) (*checkpoint, error) {
+	cp := &checkpoint{path: path, Listings: map[string]*listingProgress{}}
+	if path == "" {
+		return cp, nil
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return cp, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, cp); err != nil {
+		return nil, fmt.Errorf("could not parse checkpoint %s: %w", path, err)
+	}
+	if cp.Listings == nil {
+		cp.Listings = map[string]*listingProgress{}
+	}
+	return cp, nil
+}
+
+// progress returns the progress of the listing of the prefix of a bucket.
+func (c *checkpoint) progress(bucket, prefix string) listingProgress {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if p, ok := c.Listings[listingKey(bucket, prefix)]; ok {
+		return *p
+	}
+	return listingProgress{}
+}
+
+// pageScanned records that the objects up to lastKey were scanned.
+func (c *checkpoint) pageScanned(bucket, prefix, lastKey string) {
+	c.update(bucket, prefix, func(p *listingProgress) { p.StartAfter = lastKey })
+}
+
+// listingDone records that every object of the prefix of a bucket was scanned.
+func (c *checkpoint) listingDone(bucket, prefix string) {
+	c.update(bucket, prefix, func(p *listingProgress) { p.Done = true })
+}
+
+func (c *checkpoint) update(bucket, prefix string, f func(*listingProgress)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.Listings == nil {
+		c.Listings = map[string]*listingProgress{}
+	}
+	key := listingKey(bucket, prefix)
+	p, ok := c.Listings[key]
+	if !ok {
+		p = &listingProgress{}
+		c.Listings[key] = p
+	}
+	f(p)
+}
+
+// save writes the checkpoint back to the file it was loaded from.
+func (c *checkpoint) save() error {
+	if c.path == "" {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(c.path, data, 0600)
+}
+
+// clear removes the checkpoint once the scan completed, so the next scan
+// starts over.
+func (c *checkpoint) clear() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.Listings = map[string]*listingProgress{}
+	if c.path == "" {
+		return nil
+	}
+	if err := os.Remove(c.path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
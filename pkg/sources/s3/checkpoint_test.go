@@ -0,0 +1,32 @@
+package s3
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckpoint(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "s3.json")
+
+	cp, err := loadCheckpoint(path)
+	assert.NoError(t, err)
+	assert.Equal(t, listingProgress{}, cp.progress("bucket", ""))
+
+	cp.pageScanned("bucket", "", "logs/0999")
+	cp.listingDone("other", "config/")
+	assert.NoError(t, cp.save())
+
+	cp, err = loadCheckpoint(path)
+	assert.NoError(t, err)
+	assert.Equal(t, listingProgress{StartAfter: "logs/0999"}, cp.progress("bucket", ""))
+	assert.Equal(t, listingProgress{Done: true}, cp.progress("other", "config/"))
+	assert.Equal(t, listingProgress{}, cp.progress("other", ""))
+
+	assert.NoError(t, cp.clear())
+	_, err = os.Stat(path)
+	assert.True(t, os.IsNotExist(err))
+	assert.Equal(t, listingProgress{}, cp.progress("bucket", ""))
+}
@@ -2,14 +2,18 @@ package s3
 
 import (
 	"fmt"
-	"io"
+	"net/url"
+	"path"
 	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
@@ -39,6 +43,7 @@ type Source struct {
 	sources.Progress
 	errorCount *sync.Map
 	conn       *sourcespb.S3
+	checkpoint *checkpoint
 }
 
 // Ensure the Source satisfies the interface at compile time
@@ -76,13 +81,29 @@ func (s *Source) Init(aCtx context.Context, name string, jobId, sourceId int64,
 	}
 	s.conn = &conn
 
+	for _, pattern := range s.conn.ExcludeBuckets {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid bucket glob %q: %w", pattern, err)
+		}
+	}
+
+	s.checkpoint, err = loadCheckpoint(s.conn.GetStateFile())
+	if err != nil {
+		return errors.WrapPrefix(err, "could not load checkpoint", 0)
+	}
+
 	return nil
 }
 
-func (s *Source) newClient(region string) (*s3.S3, error) {
+// newClient returns a client of the region that assumes role when it is set.
+func (s *Source) newClient(region, role string) (*s3.S3, error) {
 	cfg := aws.NewConfig()
 	cfg.CredentialsChainVerboseErrors = aws.Bool(true)
 	cfg.Region = aws.String(region)
+	if s.conn.Endpoint != "" {
+		cfg.Endpoint = aws.String(s.conn.Endpoint)
+	}
+	cfg.S3ForcePathStyle = aws.Bool(s.conn.ForcePathStyle)
 
 	switch cred := s.conn.GetCredential().(type) {
 	case *sourcespb.S3_AccessKey:
@@ -103,14 +124,63 @@ func (s *Source) newClient(region string) (*s3.S3, error) {
 		return nil, err
 	}
 
+	if role != "" {
+		// The role is assumed with the credentials of the source.
+		roleCreds := stscreds.NewCredentials(sess, role, func(p *stscreds.AssumeRoleProvider) {
+			if s.conn.ExternalId != "" {
+				p.ExternalID = aws.String(s.conn.ExternalId)
+			}
+		})
+		return s3.New(sess, &aws.Config{Credentials: roleCreds}), nil
+	}
 	return s3.New(sess), nil
 }
 
+const (
+	defaultAWSRegion = "us-east-1"
+	// restoreDays is the number of days restored copies of archived objects
+	// are kept, leaving time for a later scan.
+	restoreDays = 7
+)
+
 // Chunks emits chunks of bytes over a channel.
 func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) error {
-	const defaultAWSRegion = "us-east-1"
+	objectCount := uint64(0)
+	// The buckets of the account of each role are scanned in turn, such as
+	// those of every account of an organization. Given buckets are scanned
+	// with the first role that can list their objects.
+	scanned := map[string]bool{}
+	roles := s.conn.RoleArns
+	if len(roles) == 0 {
+		roles = []string{""}
+	}
+	for _, role := range roles {
+		if common.IsDone(ctx) {
+			return nil
+		}
+		if err := s.scanBuckets(ctx, role, scanned, chunksChan, &objectCount); err != nil {
+			if role == "" {
+				return err
+			}
+			s.log.Error(err, "could not scan the buckets of role", "role", role)
+		}
+	}
+	if common.IsDone(ctx) {
+		return nil
+	}
+	if err := s.checkpoint.clear(); err != nil {
+		s.log.Error(err, "could not remove checkpoint")
+	}
+	s.SetProgressComplete(1, 1, fmt.Sprintf("Completed scanning source %s. %d objects scanned.", s.name, objectCount), "")
+
+	return nil
+}
 
-	client, err := s.newClient(defaultAWSRegion)
+// scanBuckets scans the buckets of the source, or those of the account when
+// there are none, with the credentials of role when it is set. Buckets are
+// added to scanned once their objects could be listed.
+func (s *Source) scanBuckets(ctx context.Context, role string, scanned map[string]bool, chunksChan chan *sources.Chunk, objectCount *uint64) error {
+	client, err := s.newClient(defaultAWSRegion, role)
 	if err != nil {
 		return errors.WrapPrefix(err, "could not create s3 client", 0)
 	}
@@ -137,23 +207,43 @@ func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) err
 		return errors.Errorf("invalid configuration given for %s source", s.name)
 	}
 
-	objectCount := uint64(0)
 	for i, bucket := range bucketsToScan {
 		if common.IsDone(ctx) {
 			return nil
 		}
+		if scanned[bucket] {
+			continue
+		}
+		if s.excludedBucket(bucket) {
+			s.log.V(2).Info("Skipping excluded bucket", "bucket", bucket)
+			continue
+		}
 
 		s.SetProgressComplete(i, len(bucketsToScan), fmt.Sprintf("Bucket: %s", bucket), "")
 
-		s.log.Info("Scanning bucket", "bucket", bucket)
-		region, err := s3manager.GetBucketRegionWithClient(context.Background(), client, bucket)
+		s.log.Info("Scanning bucket", "bucket", bucket, "role", role)
+		region, err := s3manager.GetBucketRegionWithClient(context.Background(), client, bucket, s.requestPayerOption)
+		if err != nil && s.conn.Endpoint != "" {
+			// Self-hosted stores don't always report the region of buckets.
+			s.log.V(2).Info("could not get region of bucket, using the default region", "bucket", bucket, "error", err)
+			region, err = defaultAWSRegion, nil
+		}
 		if err != nil {
 			s.log.Error(err, "could not get s3 region for bucket", "bucket", bucket)
 			continue
 		}
+		if !s.includedRegion(region) {
+			s.log.V(2).Info("Skipping bucket outside of the scanned regions", "bucket", bucket, "region", region)
+			continue
+		}
+		if s.conn.DryRun {
+			s.log.Info("Bucket would be scanned", "bucket", bucket, "region", region, "role", role)
+			scanned[bucket] = true
+			continue
+		}
 		var regionalClient *s3.S3
 		if region != defaultAWSRegion {
-			regionalClient, err = s.newClient(region)
+			regionalClient, err = s.newClient(region, role)
 			if err != nil {
 				s.log.Error(err, "could not make regional s3 client")
 			}
@@ -163,19 +253,58 @@ func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) err
 
 		errorCount := sync.Map{}
 
-		err = regionalClient.ListObjectsV2PagesWithContext(
-			ctx, &s3.ListObjectsV2Input{Bucket: &bucket},
-			func(page *s3.ListObjectsV2Output, last bool) bool {
-				s.pageChunker(ctx, regionalClient, chunksChan, bucket, page, &errorCount, i+1, &objectCount)
-				return true
-			})
+		// Listing by prefix avoids listing the objects that aren't scanned.
+		prefixes := s.conn.Prefixes
+		if len(prefixes) == 0 {
+			prefixes = []string{""}
+		}
+		for _, prefix := range prefixes {
+			progress := s.checkpoint.progress(bucket, prefix)
+			if progress.Done {
+				s.log.V(2).Info("Skipping objects scanned by a previous scan", "bucket", bucket, "prefix", prefix)
+				scanned[bucket] = true
+				continue
+			}
+			input := &s3.ListObjectsV2Input{Bucket: &bucket, RequestPayer: s.requestPayer()}
+			if prefix != "" {
+				input.Prefix = aws.String(prefix)
+			}
+			if progress.StartAfter != "" {
+				s.log.V(2).Info("Resuming scan of bucket", "bucket", bucket, "prefix", prefix, "start_after", progress.StartAfter)
+				input.StartAfter = aws.String(progress.StartAfter)
+			}
+			err = regionalClient.ListObjectsV2PagesWithContext(
+				ctx, input,
+				func(page *s3.ListObjectsV2Output, last bool) bool {
+					s.pageChunker(ctx, regionalClient, chunksChan, bucket, page, &errorCount, i+1, objectCount)
+					// The objects of the page are only all scanned when the
+					// scan wasn't cancelled.
+					if common.IsDone(ctx) {
+						return false
+					}
+					if n := len(page.Contents); n > 0 && page.Contents[n-1] != nil {
+						s.checkpoint.pageScanned(bucket, prefix, *page.Contents[n-1].Key)
+						if err := s.checkpoint.save(); err != nil {
+							s.log.Error(err, "could not save checkpoint")
+						}
+					}
+					return true
+				})
 
-		if err != nil {
-			s.log.Error(err, "could not list objects in s3 bucket", "bucket", bucket)
+			if err != nil {
+				s.log.Error(err, "could not list objects in s3 bucket", "bucket", bucket, "prefix", prefix)
+				continue
+			}
+			if common.IsDone(ctx) {
+				return nil
+			}
+			s.checkpoint.listingDone(bucket, prefix)
+			if err := s.checkpoint.save(); err != nil {
+				s.log.Error(err, "could not save checkpoint")
+			}
+			scanned[bucket] = true
 		}
 	}
-	s.SetProgressComplete(len(bucketsToScan), len(bucketsToScan), fmt.Sprintf("Completed scanning source %s. %d objects scanned.", s.name, objectCount), "")
-
 	return nil
 }
 
@@ -192,8 +321,14 @@ func (s *Source) pageChunker(ctx context.Context, client *s3.S3, chunksChan chan
 			continue
 		}
 
-		// skip GLACIER and GLACIER_IR objects
-		if obj.StorageClass == nil || strings.Contains(*obj.StorageClass, "GLACIER") {
+		if s.excludedSuffix(*obj.Key) {
+			s.log.V(5).Info("Skipping object with excluded suffix", "object", *obj.Key)
+			continue
+		}
+
+		// Archived objects can only be read once they are restored.
+		archived := isArchived(obj.StorageClass)
+		if archived && !s.conn.RestoreArchived {
 			s.log.V(5).Info("Skipping object in storage class", "storage_class", *obj.StorageClass, "object", *obj.Key)
 			continue
 		}
@@ -248,9 +383,14 @@ func (s *Source) pageChunker(ctx context.Context, client *s3.S3, chunksChan chan
 			ctx, cancel := context.WithTimeout(ctx, time.Second*5)
 			defer cancel()
 			res, err := client.GetObjectWithContext(ctx, &s3.GetObjectInput{
-				Bucket: &bucket,
-				Key:    obj.Key,
+				Bucket:       &bucket,
+				Key:          obj.Key,
+				RequestPayer: s.requestPayer(),
 			})
+			if aerr, ok := err.(awserr.Error); ok && archived && aerr.Code() == "InvalidObjectState" {
+				s.restoreObject(ctx, client, bucket, *obj.Key)
+				return
+			}
 			if err != nil {
 				if !strings.Contains(err.Error(), "AccessDenied") {
 					s.log.Error(err, "could not get S3 object", "object", *obj.Key)
@@ -295,7 +435,7 @@ func (s *Source) pageChunker(ctx context.Context, client *s3.S3, chunksChan chan
 						S3: &source_metadatapb.S3{
 							Bucket:    bucket,
 							File:      sanitizer.UTF8(*obj.Key),
-							Link:      sanitizer.UTF8(makeS3Link(bucket, *client.Config.Region, *obj.Key)),
+							Link:      sanitizer.UTF8(s.objectLink(bucket, *client.Config.Region, *obj.Key)),
 							Email:     sanitizer.UTF8(email),
 							Timestamp: sanitizer.UTF8(modified),
 						},
@@ -314,16 +454,13 @@ func (s *Source) pageChunker(ctx context.Context, client *s3.S3, chunksChan chan
 			}
 			reader.Stop()
 
-			chunk := *chunkSkel
-			chunkData, err := io.ReadAll(reader)
-			if err != nil {
+			// Large objects are streamed rather than read in memory at once.
+			if err := sources.ChunkReader(ctx, reader, chunkSkel, chunksChan); err != nil {
 				s.log.Error(err, "Could not read file data.")
 				return
 			}
 			atomic.AddUint64(objectCount, 1)
 			s.log.V(5).Info("S3 object scanned.", "object_count", objectCount, "page_number", pageNumber)
-			chunk.Data = chunkData
-			chunksChan <- &chunk
 
 			nErr, ok = errorCount.Load(prefix)
 			if !ok {
@@ -337,6 +474,119 @@ func (s *Source) pageChunker(ctx context.Context, client *s3.S3, chunksChan chan
 	wg.Wait()
 }
 
+// requestPayer returns the payer of the requests to requester pays buckets.
+func (s *Source) requestPayer() *string {
+	if !s.conn.RequesterPays {
+		return nil
+	}
+	return aws.String(s3.RequestPayerRequester)
+}
+
+// requestPayerOption sets the payer of requests that have no input field for
+// it, such as the ones finding the region of buckets.
+func (s *Source) requestPayerOption(r *request.Request) {
+	if s.conn.RequesterPays {
+		r.HTTPRequest.Header.Set("x-amz-request-payer", s3.RequestPayerRequester)
+	}
+}
+
+// isArchived reports whether objects of the storage class must be restored
+// before they can be read.
+func isArchived(storageClass *string) bool {
+	if storageClass == nil {
+		return false
+	}
+	switch *storageClass {
+	case s3.ObjectStorageClassGlacier, s3.ObjectStorageClassDeepArchive:
+		return true
+	}
+	return false
+}
+
+// restoreObject requests the restore of an archived object, so that a later
+// scan can scan its restored copy.
+func (s *Source) restoreObject(ctx context.Context, client *s3.S3, bucket, key string) {
+	_, err := client.RestoreObjectWithContext(ctx, &s3.RestoreObjectInput{
+		Bucket:       &bucket,
+		Key:          &key,
+		RequestPayer: s.requestPayer(),
+		RestoreRequest: &s3.RestoreRequest{
+			Days:                 aws.Int64(restoreDays),
+			GlacierJobParameters: &s3.GlacierJobParameters{Tier: aws.String(s3.TierBulk)},
+		},
+	})
+	if aerr, ok := err.(awserr.Error); ok && aerr.Code() == "RestoreAlreadyInProgress" {
+		s.log.V(3).Info("Restore of archived object already in progress", "object", key)
+		return
+	}
+	if err != nil {
+		s.log.Error(err, "could not restore archived S3 object", "object", key)
+		return
+	}
+	s.log.V(2).Info("Requested restore of archived object, it will be scanned by a later scan", "object", key)
+}
+
+// excludedBucket reports whether the name of the bucket matches one of the
+// excluded bucket globs.
+func (s *Source) excludedBucket(bucket string) bool {
+	for _, pattern := range s.conn.ExcludeBuckets {
+		if ok, _ := path.Match(pattern, bucket); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// includedRegion reports whether the buckets of the region are scanned.
+func (s *Source) includedRegion(region string) bool {
+	if len(s.conn.Regions) == 0 {
+		return true
+	}
+	for _, r := range s.conn.Regions {
+		if strings.EqualFold(r, region) {
+			return true
+		}
+	}
+	return false
+}
+
+// excludedSuffix reports whether the key ends with one of the excluded
+// suffixes, ignoring case.
+func (s *Source) excludedSuffix(key string) bool {
+	key = strings.ToLower(key)
+	for _, suffix := range s.conn.ExcludeSuffixes {
+		if strings.HasSuffix(key, strings.ToLower(suffix)) {
+			return true
+		}
+	}
+	return false
+}
+
+// objectLink returns the link of the object, on the endpoint of the source
+// when it is set.
+func (s *Source) objectLink(bucket, region, key string) string {
+	if s.conn.Endpoint == "" {
+		return makeS3Link(bucket, region, key)
+	}
+	return makeEndpointLink(s.conn.Endpoint, bucket, key, s.conn.ForcePathStyle)
+}
+
+// makeEndpointLink returns the link of an object of a self-hosted store, with
+// the bucket in the path or in the host of the endpoint.
+func makeEndpointLink(endpoint, bucket, key string, pathStyle bool) string {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" {
+		return ""
+	}
+	if pathStyle {
+		u.Path = path.Join("/", u.Path, bucket, key)
+	} else {
+		u.Host = bucket + "." + u.Host
+		u.Path = path.Join("/", u.Path, key)
+	}
+	return u.String()
+}
+
 // S3 links currently have the general format of:
 // https://[bucket].s3[.region unless us-east-1].amazonaws.com/[key]
 func makeS3Link(bucket, region, key string) string {
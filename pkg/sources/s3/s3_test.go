@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/kylelemons/godebug/pretty"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
@@ -99,3 +100,45 @@ func TestSource_Chunks(t *testing.T) {
 		})
 	}
 }
+
+func TestSource_excludedSuffix(t *testing.T) {
+	s := Source{conn: &sourcespb.S3{ExcludeSuffixes: []string{".parquet", ".MP4"}}}
+	assert.True(t, s.excludedSuffix("data/2023/part-0001.parquet"))
+	assert.True(t, s.excludedSuffix("videos/demo.mp4"))
+	assert.True(t, s.excludedSuffix("DATA.PARQUET"))
+	assert.False(t, s.excludedSuffix("config/.env"))
+	assert.False(t, s.excludedSuffix("data.parquet.json"))
+}
+
+func TestSource_excludedBucket(t *testing.T) {
+	s := Source{conn: &sourcespb.S3{ExcludeBuckets: []string{"*-logs", "tmp-?"}}}
+	assert.True(t, s.excludedBucket("prod-logs"))
+	assert.True(t, s.excludedBucket("tmp-1"))
+	assert.False(t, s.excludedBucket("tmp-10"))
+	assert.False(t, s.excludedBucket("prod-data"))
+}
+
+func TestSource_includedRegion(t *testing.T) {
+	s := Source{conn: &sourcespb.S3{}}
+	assert.True(t, s.includedRegion("eu-west-1"))
+
+	s.conn.Regions = []string{"us-east-1", "EU-WEST-1"}
+	assert.True(t, s.includedRegion("eu-west-1"))
+	assert.False(t, s.includedRegion("ap-south-1"))
+}
+
+func Test_makeEndpointLink(t *testing.T) {
+	assert.Equal(t, "https://minio.example.com:9000/secrets/config/.env",
+		makeEndpointLink("https://minio.example.com:9000", "secrets", "config/.env", true))
+	assert.Equal(t, "https://secrets.s3.wasabisys.com/config/.env",
+		makeEndpointLink("https://s3.wasabisys.com/", "secrets", "config/.env", false))
+	assert.Equal(t, "", makeEndpointLink("minio:9000", "secrets", "config/.env", true))
+}
+
+func Test_isArchived(t *testing.T) {
+	assert.False(t, isArchived(nil))
+	assert.False(t, isArchived(aws.String("STANDARD")))
+	assert.False(t, isArchived(aws.String("GLACIER_IR")))
+	assert.True(t, isArchived(aws.String("GLACIER")))
+	assert.True(t, isArchived(aws.String("DEEP_ARCHIVE")))
+}
@@ -0,0 +1,393 @@
+package sharepoint
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"sync/atomic"
+
+	diskbufferreader "github.com/bill-rich/disk-buffer-reader"
+	"github.com/go-errors/errors"
+	"github.com/go-logr/logr"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/msgraph"
+)
+
+const (
+	// maxFileSize is the largest file that will be downloaded and scanned.
+	maxFileSize = 50 * 1024 * 1024 // 50MB
+)
+
+type Source struct {
+	name     string
+	sourceId int64
+	jobId    int64
+	verify   bool
+	sites,
+	ignoreSites,
+	users []string
+	includeOneDrive bool
+	client          *msgraph.Client
+	state           *msgraph.DeltaState
+	jobPool         *errgroup.Group
+	log             logr.Logger
+	sources.Progress
+}
+
+// Ensure the Source satisfies the interface at compile time.
+var _ sources.Source = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return sourcespb.SourceType_SOURCE_TYPE_SHAREPOINT
+}
+
+func (s *Source) SourceID() int64 {
+	return s.sourceId
+}
+
+func (s *Source) JobID() int64 {
+	return s.jobId
+}
+
+// Init returns an initialized SharePoint source.
+func (s *Source) Init(aCtx context.Context, name string, jobId, sourceId int64, verify bool, connection *anypb.Any, concurrency int) error {
+	s.log = context.WithValues(aCtx, "source", s.Type(), "name", name).Logger()
+
+	s.name = name
+	s.sourceId = sourceId
+	s.jobId = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	var conn sourcespb.SharePoint
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return errors.WrapPrefix(err, "error unmarshalling connection", 0)
+	}
+
+	var err error
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.SharePoint_Token:
+		s.client, err = msgraph.NewClient(aCtx, conn.GetEndpoint(), cred.Token, nil)
+	case *sourcespb.SharePoint_Authenticated:
+		s.client, err = msgraph.NewClient(aCtx, conn.GetEndpoint(), "", cred.Authenticated)
+	default:
+		return errors.Errorf("invalid configuration given for %s source", name)
+	}
+	if err != nil {
+		return errors.WrapPrefix(err, "could not create Graph client", 0)
+	}
+
+	s.sites = conn.GetSites()
+	s.ignoreSites = conn.GetIgnoreSites()
+	s.users = conn.GetUsers()
+	s.includeOneDrive = conn.GetIncludeOnedrive() || len(s.users) > 0
+
+	s.state, err = msgraph.LoadDeltaState(conn.GetDeltaStateFile())
+	if err != nil {
+		return errors.WrapPrefix(err, "could not load delta state", 0)
+	}
+
+	return nil
+}
+
+type site struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+	WebURL      string `json:"webUrl"`
+}
+
+type user struct {
+	ID                string `json:"id"`
+	UserPrincipalName string `json:"userPrincipalName"`
+}
+
+type drive struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	WebURL string `json:"webUrl"`
+	site   site
+	owner  string
+}
+
+type driveItem struct {
+	ID                   string `json:"id"`
+	Name                 string `json:"name"`
+	Size                 int64  `json:"size"`
+	WebURL               string `json:"webUrl"`
+	LastModifiedDateTime string `json:"lastModifiedDateTime"`
+	LastModifiedBy       struct {
+		User struct {
+			Email string `json:"email"`
+		} `json:"user"`
+	} `json:"lastModifiedBy"`
+	ParentReference struct {
+		Path string `json:"path"`
+	} `json:"parentReference"`
+	File    *struct{} `json:"file"`
+	Deleted *struct{} `json:"deleted"`
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) error {
+	sites, err := s.listSites(ctx)
+	if err != nil {
+		return fmt.Errorf("error listing sites: %w", err)
+	}
+
+	var drives []drive
+	for _, st := range sites {
+		st := st
+		_, err := msgraph.List(ctx, s.client, fmt.Sprintf("sites/%s/drives", st.ID), func(page []drive) error {
+			for _, d := range page {
+				d.site = st
+				drives = append(drives, d)
+			}
+			return nil
+		})
+		if err != nil {
+			s.log.Error(err, "could not list drives", "site", st.WebURL)
+		}
+	}
+
+	if s.includeOneDrive {
+		oneDrives, err := s.listOneDrives(ctx)
+		if err != nil {
+			s.log.Error(err, "could not list OneDrive drives")
+		}
+		drives = append(drives, oneDrives...)
+	}
+
+	var scanned uint64
+	for i, d := range drives {
+		if common.IsDone(ctx) {
+			break
+		}
+		d := d
+		s.SetProgressComplete(i, len(drives), fmt.Sprintf("Drive: %s", d.WebURL), "")
+		s.jobPool.Go(func() error {
+			if err := s.scanDrive(ctx, d, chunksChan); err != nil {
+				s.log.Error(err, "error scanning drive", "drive", d.WebURL)
+				return nil
+			}
+			atomic.AddUint64(&scanned, 1)
+			return nil
+		})
+	}
+	_ = s.jobPool.Wait()
+
+	if err := s.state.Save(); err != nil {
+		s.log.Error(err, "could not save delta state")
+	}
+	s.SetProgressComplete(len(drives), len(drives), fmt.Sprintf("Completed scanning source %s. %d drives scanned.", s.name, scanned), "")
+	return nil
+}
+
+// listSites returns the sites to scan. Sites given as URLs are resolved
+// directly; any other filter is matched against a tenant-wide site search.
+func (s *Source) listSites(ctx context.Context) ([]site, error) {
+	var sites, names []string
+	for _, ref := range s.sites {
+		if strings.HasPrefix(ref, "https://") || strings.HasPrefix(ref, "http://") {
+			sites = append(sites, ref)
+		} else {
+			names = append(names, ref)
+		}
+	}
+
+	var result []site
+	for _, ref := range sites {
+		u, err := url.Parse(ref)
+		if err != nil {
+			return nil, fmt.Errorf("invalid site URL %q: %w", ref, err)
+		}
+		var st site
+		if err := s.client.Get(ctx, fmt.Sprintf("sites/%s:%s", u.Host, strings.TrimSuffix(u.Path, "/")), &st); err != nil {
+			return nil, err
+		}
+		if s.shouldScanSite(nil, st) {
+			result = append(result, st)
+		}
+	}
+	if len(sites) > 0 && len(names) == 0 {
+		return result, nil
+	}
+
+	_, err := msgraph.List(ctx, s.client, "sites?search=*", func(page []site) error {
+		for _, st := range page {
+			if s.shouldScanSite(names, st) {
+				result = append(result, st)
+			} else {
+				s.log.V(2).Info("skipping site", "site", st.WebURL)
+			}
+		}
+		return nil
+	})
+	return result, err
+}
+
+// shouldScanSite reports whether a site is in the include list (or the
+// include list is empty) and not excluded. Sites can be referenced by ID,
+// name, display name, or URL.
+func (s *Source) shouldScanSite(include []string, st site) bool {
+	contains := func(list []string) bool {
+		for _, item := range list {
+			item = strings.TrimSuffix(item, "/")
+			if strings.EqualFold(item, st.ID) || strings.EqualFold(item, st.Name) ||
+				strings.EqualFold(item, st.DisplayName) || strings.EqualFold(item, strings.TrimSuffix(st.WebURL, "/")) {
+				return true
+			}
+		}
+		return false
+	}
+	if contains(s.ignoreSites) {
+		return false
+	}
+	return len(include) == 0 || contains(include)
+}
+
+// listOneDrives returns the personal OneDrive of the configured users, or of
+// every user in the tenant when none are configured.
+func (s *Source) listOneDrives(ctx context.Context) ([]drive, error) {
+	users := s.users
+	if len(users) == 0 {
+		_, err := msgraph.List(ctx, s.client, "users?$select=id,userPrincipalName", func(page []user) error {
+			for _, u := range page {
+				users = append(users, u.UserPrincipalName)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var drives []drive
+	for _, u := range users {
+		if common.IsDone(ctx) {
+			break
+		}
+		var d drive
+		// Users without a OneDrive license have no drive, so failures are expected here.
+		if err := s.client.Get(ctx, fmt.Sprintf("users/%s/drive", url.PathEscape(u)), &d); err != nil {
+			s.log.V(2).Info("could not get OneDrive", "user", u, "error", err)
+			continue
+		}
+		d.owner = u
+		drives = append(drives, d)
+	}
+	return drives, nil
+}
+
+// scanDrive scans the files of a drive that changed since the previous run,
+// using the stored delta link when one is available.
+func (s *Source) scanDrive(ctx context.Context, d drive, chunksChan chan *sources.Chunk) error {
+	key := "drive/" + d.ID
+	start := s.state.Get(key)
+	if start == "" {
+		start = fmt.Sprintf("drives/%s/root/delta", d.ID)
+	}
+
+	deltaLink, err := msgraph.List(ctx, s.client, start, func(page []driveItem) error {
+		for _, item := range page {
+			if item.File == nil || item.Deleted != nil {
+				continue
+			}
+			if item.Size > maxFileSize {
+				s.log.V(2).Info("skipping large file", "file", item.WebURL, "size", item.Size)
+				continue
+			}
+			if err := s.scanItem(ctx, d, item, chunksChan); err != nil {
+				s.log.Error(err, "could not scan file", "file", item.WebURL)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if deltaLink != "" {
+		s.state.Set(key, deltaLink)
+	}
+	return nil
+}
+
+func (s *Source) scanItem(ctx context.Context, d drive, item driveItem, chunksChan chan *sources.Chunk) error {
+	body, err := s.client.Open(ctx, fmt.Sprintf("drives/%s/items/%s/content", d.ID, item.ID))
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	reader, err := diskbufferreader.New(io.LimitReader(body, maxFileSize))
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	chunkSkel := &sources.Chunk{
+		SourceType: s.Type(),
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Sharepoint{
+				Sharepoint: &source_metadatapb.SharePoint{
+					SiteId:    d.site.ID,
+					SiteName:  sanitizer.UTF8(d.site.DisplayName),
+					DriveId:   d.ID,
+					DriveName: sanitizer.UTF8(d.Name),
+					File:      sanitizer.UTF8(itemPath(item)),
+					Link:      sanitizer.UTF8(item.WebURL),
+					Email:     sanitizer.UTF8(item.LastModifiedBy.User.Email),
+					Timestamp: item.LastModifiedDateTime,
+				},
+			},
+		},
+		Verify: s.verify,
+	}
+	if handlers.HandleFile(ctx, reader, chunkSkel, chunksChan) {
+		return nil
+	}
+	if err := reader.Reset(); err != nil {
+		return err
+	}
+	reader.Stop()
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	if len(data) == 0 {
+		return nil
+	}
+
+	chunk := *chunkSkel
+	chunk.Data = data
+	select {
+	case chunksChan <- &chunk:
+	case <-ctx.Done():
+	}
+	return nil
+}
+
+// itemPath returns the path of an item relative to the root of its drive.
+func itemPath(item driveItem) string {
+	parent := item.ParentReference.Path
+	if i := strings.Index(parent, ":"); i >= 0 {
+		parent = parent[i+1:]
+	}
+	return strings.TrimPrefix(parent+"/"+item.Name, "/")
+}
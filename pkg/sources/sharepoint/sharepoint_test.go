@@ -0,0 +1,106 @@
+package sharepoint
+
+import (
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/msgraph"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/sourcestest"
+)
+
+func TestSource_ShouldScanSite(t *testing.T) {
+	st := site{ID: "contoso.sharepoint.com,1,2", Name: "eng", DisplayName: "Engineering", WebURL: "https://contoso.sharepoint.com/sites/eng"}
+	s := &Source{}
+	assert.True(t, s.shouldScanSite(nil, st))
+	assert.True(t, s.shouldScanSite([]string{"engineering"}, st))
+	assert.False(t, s.shouldScanSite([]string{"marketing"}, st))
+
+	s = &Source{ignoreSites: []string{"https://contoso.sharepoint.com/sites/eng/"}}
+	assert.False(t, s.shouldScanSite(nil, st))
+}
+
+func TestItemPath(t *testing.T) {
+	item := driveItem{Name: "secrets.env"}
+	item.ParentReference.Path = "/drives/d1/root:/config/prod"
+	assert.Equal(t, "config/prod/secrets.env", itemPath(item))
+
+	item.ParentReference.Path = "/drives/d1/root:"
+	assert.Equal(t, "secrets.env", itemPath(item))
+}
+
+func TestSource_Chunks(t *testing.T) {
+	defer gock.Off()
+
+	stateFile := filepath.Join(t.TempDir(), "state.json")
+
+	gock.New("https://graph.microsoft.com").
+		Get("/v1.0/sites").
+		MatchParam("search", `\*`).
+		Reply(200).
+		JSON(map[string]interface{}{"value": []map[string]string{
+			{"id": "s1", "name": "eng", "displayName": "Engineering", "webUrl": "https://contoso.sharepoint.com/sites/eng"},
+			{"id": "s2", "name": "hr", "displayName": "HR", "webUrl": "https://contoso.sharepoint.com/sites/hr"},
+		}})
+	gock.New("https://graph.microsoft.com").
+		Get("/v1.0/sites/s1/drives").
+		Reply(200).
+		JSON(map[string]interface{}{"value": []map[string]string{
+			{"id": "d1", "name": "Documents", "webUrl": "https://contoso.sharepoint.com/sites/eng/Shared%20Documents"},
+		}})
+	gock.New("https://graph.microsoft.com").
+		Get("/v1.0/drives/d1/root/delta").
+		Reply(200).
+		JSON(map[string]interface{}{
+			"value": []map[string]interface{}{
+				{"id": "root", "name": "root", "folder": map[string]int{"childCount": 2}},
+				{
+					"id": "i1", "name": "prod.env", "size": 16,
+					"webUrl":               "https://contoso.sharepoint.com/sites/eng/Shared%20Documents/prod.env",
+					"lastModifiedDateTime": "2023-01-01T00:00:00Z",
+					"lastModifiedBy":       map[string]interface{}{"user": map[string]string{"email": "dev@contoso.com"}},
+					"parentReference":      map[string]string{"path": "/drives/d1/root:"},
+					"file":                 map[string]string{"mimeType": "text/plain"},
+				},
+				{"id": "i2", "name": "old.env", "file": map[string]string{}, "deleted": map[string]string{}},
+			},
+			"@odata.deltaLink": "https://graph.microsoft.com/v1.0/drives/d1/root/delta?token=abc",
+		})
+	gock.New("https://graph.microsoft.com").
+		Get("/v1.0/drives/d1/items/i1/content").
+		Reply(200).
+		BodyString("PASSWORD=hunter2")
+
+	s := &Source{}
+	sourcestest.Init(t, s, &sourcespb.SharePoint{
+		Credential:     &sourcespb.SharePoint_Token{Token: "token"},
+		IgnoreSites:    []string{"HR"},
+		DeltaStateFile: stateFile,
+	}, func() *http.Client { return s.client.HTTPClient() })
+
+	chunksCh := make(chan *sources.Chunk, 10)
+	assert.NoError(t, s.Chunks(context.Background(), chunksCh))
+	close(chunksCh)
+
+	var data []string
+	for chunk := range chunksCh {
+		meta := chunk.SourceMetadata.GetSharepoint()
+		assert.Equal(t, "Engineering", meta.SiteName)
+		assert.Equal(t, "Documents", meta.DriveName)
+		assert.Equal(t, "prod.env", meta.File)
+		assert.Equal(t, "dev@contoso.com", meta.Email)
+		data = append(data, string(chunk.Data))
+	}
+	assert.Equal(t, []string{"PASSWORD=hunter2"}, data)
+	assert.True(t, gock.IsDone())
+
+	state, err := msgraph.LoadDeltaState(stateFile)
+	assert.NoError(t, err)
+	assert.Contains(t, state.Get("drive/d1"), "token=abc")
+}
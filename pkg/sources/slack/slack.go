@@ -0,0 +1,505 @@
+package slack
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/go-logr/logr"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const (
+	defaultEndpoint = "https://slack.com/api/"
+	// maxFileSize is the largest uploaded file that will be downloaded and scanned.
+	maxFileSize = 10 * 1024 * 1024 // 10MB
+	pageLimit   = "200"
+)
+
+type Source struct {
+	name           string
+	sourceId       int64
+	jobId          int64
+	verify         bool
+	token          string
+	endpoint       string
+	channels       []string
+	ignoreChannels []string
+	oldest         string
+	latest         string
+	workspaceURL   string
+	jobPool        *errgroup.Group
+	client         *http.Client
+	log            logr.Logger
+	sources.Progress
+}
+
+// Ensure the Source satisfies the interface at compile time.
+var _ sources.Source = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return sourcespb.SourceType_SOURCE_TYPE_SLACK
+}
+
+func (s *Source) SourceID() int64 {
+	return s.sourceId
+}
+
+func (s *Source) JobID() int64 {
+	return s.jobId
+}
+
+// Init returns an initialized Slack source.
+func (s *Source) Init(aCtx context.Context, name string, jobId, sourceId int64, verify bool, connection *anypb.Any, concurrency int) error {
+	s.log = context.WithValues(aCtx, "source", s.Type(), "name", name).Logger()
+
+	s.name = name
+	s.sourceId = sourceId
+	s.jobId = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+	s.client = common.RetryableHttpClientTimeout(30)
+
+	var conn sourcespb.Slack
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return errors.WrapPrefix(err, "error unmarshalling connection", 0)
+	}
+
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.Slack_Token:
+		s.token = cred.Token
+	case *sourcespb.Slack_Tokens:
+		// A user (client) token can read everything the user can, which is a
+		// superset of what a bot can see, so prefer it when provided.
+		s.token = cred.Tokens.GetClientToken()
+		if s.token == "" {
+			s.token = cred.Tokens.GetBotToken()
+		}
+	default:
+		return errors.Errorf("invalid configuration given for %s source", name)
+	}
+	if s.token == "" {
+		return errors.New("a Slack token is required")
+	}
+
+	s.endpoint = conn.GetEndpoint()
+	if s.endpoint == "" {
+		s.endpoint = defaultEndpoint
+	}
+	if !strings.HasSuffix(s.endpoint, "/") {
+		s.endpoint += "/"
+	}
+
+	for _, c := range conn.GetChannels() {
+		s.channels = append(s.channels, strings.TrimPrefix(c, "#"))
+	}
+	for _, c := range conn.GetIgnoreList() {
+		s.ignoreChannels = append(s.ignoreChannels, strings.TrimPrefix(c, "#"))
+	}
+
+	if conn.GetSince() != nil {
+		s.oldest = toSlackTimestamp(conn.GetSince().AsTime())
+	}
+	if conn.GetUntil() != nil {
+		s.latest = toSlackTimestamp(conn.GetUntil().AsTime())
+	}
+
+	return nil
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) error {
+	auth, err := s.authTest(ctx)
+	if err != nil {
+		return fmt.Errorf("error authenticating with Slack: %w", err)
+	}
+	s.workspaceURL = auth.URL
+
+	channels, err := s.listChannels(ctx)
+	if err != nil {
+		return fmt.Errorf("error listing channels: %w", err)
+	}
+
+	var (
+		scanned uint64
+		errsMu  sync.Mutex
+		errs    []string
+	)
+	for i, ch := range channels {
+		if common.IsDone(ctx) {
+			break
+		}
+		ch := ch
+		s.SetProgressComplete(i, len(channels), fmt.Sprintf("Channel: %s", ch.Name), "")
+		s.jobPool.Go(func() error {
+			defer common.RecoverWithExit(ctx)
+			if err := s.scanChannel(ctx, ch, chunksChan); err != nil {
+				s.log.Error(err, "error scanning channel", "channel", ch.Name)
+				errsMu.Lock()
+				errs = append(errs, fmt.Sprintf("%s: %v", ch.Name, err))
+				errsMu.Unlock()
+				return nil
+			}
+			atomic.AddUint64(&scanned, 1)
+			s.log.V(2).Info("scanned channel", "channel", ch.Name, "scanned", atomic.LoadUint64(&scanned), "total", len(channels))
+			return nil
+		})
+	}
+	_ = s.jobPool.Wait()
+	s.SetProgressComplete(len(channels), len(channels), fmt.Sprintf("Completed scanning source %s", s.name), "")
+
+	// Channels failing alongside scanned ones are only logged, but a scan
+	// that couldn't read any channel fails.
+	if scanned == 0 && len(errs) > 0 {
+		return errors.Errorf("could not scan any of the %d channels: %s", len(channels), strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// apiResponse holds the fields common to every Slack Web API response.
+type apiResponse struct {
+	OK               bool   `json:"ok"`
+	Error            string `json:"error"`
+	ResponseMetadata struct {
+		NextCursor string `json:"next_cursor"`
+	} `json:"response_metadata"`
+}
+
+type authTestResponse struct {
+	apiResponse
+	URL  string `json:"url"`
+	Team string `json:"team"`
+}
+
+type channel struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	IsPrivate bool   `json:"is_private"`
+}
+
+type file struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Mode       string `json:"mode"`
+	Mimetype   string `json:"mimetype"`
+	Size       int64  `json:"size"`
+	URLPrivate string `json:"url_private"`
+	Permalink  string `json:"permalink"`
+}
+
+type message struct {
+	Type       string `json:"type"`
+	User       string `json:"user"`
+	Text       string `json:"text"`
+	TS         string `json:"ts"`
+	ThreadTS   string `json:"thread_ts"`
+	ReplyCount int    `json:"reply_count"`
+	Files      []file `json:"files"`
+}
+
+type historyResponse struct {
+	apiResponse
+	Messages []message `json:"messages"`
+	HasMore  bool      `json:"has_more"`
+}
+
+func (s *Source) authTest(ctx context.Context) (*authTestResponse, error) {
+	var res authTestResponse
+	if err := s.apiCall(ctx, "auth.test", url.Values{}, &res, &res.apiResponse); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// listChannels returns every conversation visible to the token that passes
+// the configured include and exclude lists.
+func (s *Source) listChannels(ctx context.Context) ([]channel, error) {
+	var channels []channel
+	cursor := ""
+	for {
+		params := url.Values{}
+		params.Set("types", "public_channel,private_channel")
+		params.Set("limit", pageLimit)
+		if cursor != "" {
+			params.Set("cursor", cursor)
+		}
+
+		var res struct {
+			apiResponse
+			Channels []channel `json:"channels"`
+		}
+		if err := s.apiCall(ctx, "conversations.list", params, &res, &res.apiResponse); err != nil {
+			return nil, err
+		}
+		for _, ch := range res.Channels {
+			if s.shouldScanChannel(ch) {
+				channels = append(channels, ch)
+			} else {
+				s.log.V(2).Info("skipping channel", "channel", ch.Name)
+			}
+		}
+
+		cursor = res.ResponseMetadata.NextCursor
+		if cursor == "" {
+			return channels, nil
+		}
+	}
+}
+
+// shouldScanChannel matches a channel against the include and exclude lists by
+// either its name or its ID.
+func (s *Source) shouldScanChannel(ch channel) bool {
+	matches := func(list []string) bool {
+		for _, item := range list {
+			if item == ch.Name || item == ch.ID {
+				return true
+			}
+		}
+		return false
+	}
+	if matches(s.ignoreChannels) {
+		return false
+	}
+	return len(s.channels) == 0 || matches(s.channels)
+}
+
+func (s *Source) scanChannel(ctx context.Context, ch channel, chunksChan chan *sources.Chunk) error {
+	cursor := ""
+	for {
+		if common.IsDone(ctx) {
+			return nil
+		}
+		params := url.Values{}
+		params.Set("channel", ch.ID)
+		params.Set("limit", pageLimit)
+		if s.oldest != "" {
+			params.Set("oldest", s.oldest)
+		}
+		if s.latest != "" {
+			params.Set("latest", s.latest)
+		}
+		if cursor != "" {
+			params.Set("cursor", cursor)
+		}
+
+		var res historyResponse
+		if err := s.apiCall(ctx, "conversations.history", params, &res, &res.apiResponse); err != nil {
+			return err
+		}
+
+		for _, msg := range res.Messages {
+			s.chunkMessage(ctx, ch, msg, "", chunksChan)
+			if msg.ReplyCount > 0 && msg.ThreadTS == msg.TS {
+				if err := s.scanThread(ctx, ch, msg.TS, chunksChan); err != nil {
+					s.log.Error(err, "error scanning thread", "channel", ch.Name, "thread", msg.TS)
+				}
+			}
+		}
+
+		cursor = res.ResponseMetadata.NextCursor
+		if !res.HasMore || cursor == "" {
+			return nil
+		}
+	}
+}
+
+func (s *Source) scanThread(ctx context.Context, ch channel, threadTS string, chunksChan chan *sources.Chunk) error {
+	cursor := ""
+	for {
+		params := url.Values{}
+		params.Set("channel", ch.ID)
+		params.Set("ts", threadTS)
+		params.Set("limit", pageLimit)
+		if cursor != "" {
+			params.Set("cursor", cursor)
+		}
+
+		var res historyResponse
+		if err := s.apiCall(ctx, "conversations.replies", params, &res, &res.apiResponse); err != nil {
+			return err
+		}
+		for _, msg := range res.Messages {
+			// The parent message is always returned first and has already been scanned.
+			if msg.TS == threadTS {
+				continue
+			}
+			s.chunkMessage(ctx, ch, msg, threadTS, chunksChan)
+		}
+
+		cursor = res.ResponseMetadata.NextCursor
+		if !res.HasMore || cursor == "" {
+			return nil
+		}
+	}
+}
+
+func (s *Source) chunkMessage(ctx context.Context, ch channel, msg message, threadTS string, chunksChan chan *sources.Chunk) {
+	location := "message"
+	if threadTS != "" {
+		location = "thread"
+	}
+	link := s.messageLink(ch.ID, msg.TS, threadTS)
+
+	if strings.TrimSpace(msg.Text) != "" {
+		s.sendChunk(ctx, chunksChan, []byte(msg.Text), s.metadata(ch, msg, link, "", location))
+	}
+
+	for _, f := range msg.Files {
+		if !isTextFile(f) {
+			continue
+		}
+		if f.Size > maxFileSize {
+			s.log.V(3).Info("skipping file over size limit", "file", f.Name, "size", f.Size)
+			continue
+		}
+		data, err := s.download(ctx, f.URLPrivate)
+		if err != nil {
+			s.log.Error(err, "error downloading file", "file", f.Name)
+			continue
+		}
+		fileLink := f.Permalink
+		if fileLink == "" {
+			fileLink = link
+		}
+		s.sendChunk(ctx, chunksChan, data, s.metadata(ch, msg, fileLink, f.Name, "file"))
+	}
+}
+
+func (s *Source) metadata(ch channel, msg message, link, fileName, location string) *source_metadatapb.MetaData {
+	visibility := source_metadatapb.Visibility_public
+	if ch.IsPrivate {
+		visibility = source_metadatapb.Visibility_private
+	}
+	return &source_metadatapb.MetaData{
+		Data: &source_metadatapb.MetaData_Slack{
+			Slack: &source_metadatapb.Slack{
+				ChannelId:   ch.ID,
+				ChannelName: sanitizer.UTF8(ch.Name),
+				Timestamp:   fromSlackTimestamp(msg.TS),
+				UserId:      msg.User,
+				Link:        sanitizer.UTF8(link),
+				File:        sanitizer.UTF8(fileName),
+				Visibility:  visibility,
+				Location:    location,
+			},
+		},
+	}
+}
+
+func (s *Source) sendChunk(ctx context.Context, chunksChan chan *sources.Chunk, data []byte, metadata *source_metadatapb.MetaData) {
+	chunk := &sources.Chunk{
+		SourceType:     s.Type(),
+		SourceName:     s.name,
+		SourceID:       s.SourceID(),
+		Data:           data,
+		SourceMetadata: metadata,
+		Verify:         s.verify,
+	}
+	select {
+	case chunksChan <- chunk:
+	case <-ctx.Done():
+	}
+}
+
+// messageLink builds a permalink to a message using the workspace URL
+// returned from auth.test.
+func (s *Source) messageLink(channelID, ts, threadTS string) string {
+	if s.workspaceURL == "" {
+		return ""
+	}
+	link := fmt.Sprintf("%sarchives/%s/p%s", ensureTrailingSlash(s.workspaceURL), channelID, strings.Replace(ts, ".", "", 1))
+	if threadTS != "" {
+		link += fmt.Sprintf("?thread_ts=%s&cid=%s", threadTS, channelID)
+	}
+	return link
+}
+
+func (s *Source) apiCall(ctx context.Context, method string, params url.Values, out interface{}, base *apiResponse) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.endpoint+method+"?"+params.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+s.token)
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d calling %s", res.StatusCode, method)
+	}
+	if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+		return err
+	}
+	if !base.OK {
+		return fmt.Errorf("slack API %s returned error: %s", method, base.Error)
+	}
+	return nil
+}
+
+func (s *Source) download(ctx context.Context, fileURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fileURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+s.token)
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d downloading file", res.StatusCode)
+	}
+	return io.ReadAll(io.LimitReader(res.Body, maxFileSize))
+}
+
+// isTextFile reports whether an uploaded file is a snippet, post, or other
+// text document worth scanning.
+func isTextFile(f file) bool {
+	switch f.Mode {
+	case "snippet", "post":
+		return true
+	}
+	return strings.HasPrefix(f.Mimetype, "text/") || f.Mimetype == "application/json"
+}
+
+func toSlackTimestamp(t time.Time) string {
+	return strconv.FormatInt(t.Unix(), 10) + ".000000"
+}
+
+func fromSlackTimestamp(ts string) string {
+	secs, err := strconv.ParseFloat(ts, 64)
+	if err != nil {
+		return ts
+	}
+	return time.Unix(int64(secs), 0).UTC().String()
+}
+
+func ensureTrailingSlash(u string) string {
+	if strings.HasSuffix(u, "/") {
+		return u
+	}
+	return u + "/"
+}
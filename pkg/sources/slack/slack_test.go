@@ -0,0 +1,157 @@
+package slack
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/sourcestest"
+)
+
+func TestSource_Init(t *testing.T) {
+	since := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := &Source{}
+	a, _ := anypb.New(&sourcespb.Slack{
+		Credential: &sourcespb.Slack_Tokens{Tokens: &credentialspb.SlackTokens{}},
+	})
+	assert.Error(t, s.Init(context.Background(), "test - slack", 0, 0, false, a, 1))
+
+	s = &Source{}
+	a, _ = anypb.New(&sourcespb.Slack{
+		Credential: &sourcespb.Slack_Token{Token: "xoxb-token"},
+		Channels:   []string{"#general"},
+		Since:      timestamppb.New(since),
+	})
+	assert.NoError(t, s.Init(context.Background(), "test - slack", 0, 0, false, a, 1))
+	assert.Equal(t, defaultEndpoint, s.endpoint)
+	assert.Equal(t, []string{"general"}, s.channels)
+	assert.Equal(t, "1672531200.000000", s.oldest)
+	assert.Empty(t, s.latest)
+}
+
+func TestSource_ShouldScanChannel(t *testing.T) {
+	s := &Source{channels: []string{"general", "C0002"}, ignoreChannels: []string{"C0002"}}
+	assert.True(t, s.shouldScanChannel(channel{ID: "C0001", Name: "general"}))
+	assert.False(t, s.shouldScanChannel(channel{ID: "C0002", Name: "random"}))
+	assert.False(t, s.shouldScanChannel(channel{ID: "C0003", Name: "dev"}))
+
+	s = &Source{ignoreChannels: []string{"dev"}}
+	assert.True(t, s.shouldScanChannel(channel{ID: "C0001", Name: "general"}))
+	assert.False(t, s.shouldScanChannel(channel{ID: "C0003", Name: "dev"}))
+}
+
+func TestSource_Chunks(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://slack.com").
+		Get("/api/auth.test").
+		Reply(200).
+		JSON(map[string]interface{}{"ok": true, "url": "https://example.slack.com/"})
+	gock.New("https://slack.com").
+		Get("/api/conversations.list").
+		Reply(200).
+		JSON(map[string]interface{}{
+			"ok": true,
+			"channels": []map[string]interface{}{
+				{"id": "C0001", "name": "general"},
+				{"id": "C0002", "name": "random"},
+			},
+		})
+	gock.New("https://slack.com").
+		Get("/api/conversations.history").
+		MatchParam("channel", "C0001").
+		Reply(200).
+		JSON(map[string]interface{}{
+			"ok": true,
+			"messages": []map[string]interface{}{
+				{
+					"user": "U0001", "text": "here is my token", "ts": "1672531200.000100",
+					"thread_ts": "1672531200.000100", "reply_count": 1,
+					"files": []map[string]interface{}{
+						{"name": "config.env", "mode": "snippet", "size": 12, "url_private": "https://files.slack.com/config.env"},
+						{"name": "image.png", "mode": "hosted", "mimetype": "image/png", "size": 12, "url_private": "https://files.slack.com/image.png"},
+					},
+				},
+			},
+		})
+	gock.New("https://slack.com").
+		Get("/api/conversations.replies").
+		MatchParam("ts", "1672531200.000100").
+		Reply(200).
+		JSON(map[string]interface{}{
+			"ok": true,
+			"messages": []map[string]interface{}{
+				{"user": "U0001", "text": "here is my token", "ts": "1672531200.000100"},
+				{"user": "U0002", "text": "thread reply", "ts": "1672531300.000200"},
+			},
+		})
+	gock.New("https://files.slack.com").
+		Get("/config.env").
+		Reply(200).
+		BodyString("SECRET=value")
+
+	s := &Source{}
+	sourcestest.Init(t, s, &sourcespb.Slack{
+		Credential: &sourcespb.Slack_Token{Token: "xoxb-token"},
+		IgnoreList: []string{"random"},
+	}, func() *http.Client { return s.client })
+
+	chunksCh := make(chan *sources.Chunk, 10)
+	assert.NoError(t, s.Chunks(context.Background(), chunksCh))
+	close(chunksCh)
+
+	var got []*source_metadatapb.Slack
+	var data []string
+	for chunk := range chunksCh {
+		got = append(got, chunk.SourceMetadata.GetSlack())
+		data = append(data, string(chunk.Data))
+	}
+	assert.Equal(t, []string{"here is my token", "SECRET=value", "thread reply"}, data)
+	assert.Equal(t, "message", got[0].Location)
+	assert.Equal(t, "https://example.slack.com/archives/C0001/p1672531200000100", got[0].Link)
+	assert.Equal(t, "config.env", got[1].File)
+	assert.Equal(t, "file", got[1].Location)
+	assert.Equal(t, "thread", got[2].Location)
+	assert.Equal(t, "U0002", got[2].UserId)
+	assert.Equal(t, "https://example.slack.com/archives/C0001/p1672531300000200?thread_ts=1672531200.000100&cid=C0001", got[2].Link)
+	assert.True(t, gock.IsDone())
+}
+
+func TestSource_Chunks_allChannelsFail(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://slack.com").
+		Get("/api/auth.test").
+		Reply(200).
+		JSON(map[string]interface{}{"ok": true, "url": "https://example.slack.com/"})
+	gock.New("https://slack.com").
+		Get("/api/conversations.list").
+		Reply(200).
+		JSON(map[string]interface{}{
+			"ok":       true,
+			"channels": []map[string]interface{}{{"id": "C0001", "name": "general"}},
+		})
+	gock.New("https://slack.com").
+		Get("/api/conversations.history").
+		Reply(200).
+		JSON(map[string]interface{}{"ok": false, "error": "not_in_channel"})
+
+	s := &Source{}
+	sourcestest.Init(t, s, &sourcespb.Slack{
+		Credential: &sourcespb.Slack_Token{Token: "xoxb-token"},
+	}, func() *http.Client { return s.client })
+
+	chunksCh := make(chan *sources.Chunk, 10)
+	err := s.Chunks(context.Background(), chunksCh)
+	assert.ErrorContains(t, err, "not_in_channel")
+}
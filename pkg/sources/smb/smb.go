@@ -0,0 +1,366 @@
+package smb
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"net"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	diskbufferreader "github.com/bill-rich/disk-buffer-reader"
+	"github.com/cloudsoda/go-smb2"
+	"github.com/go-errors/errors"
+	"github.com/go-logr/logr"
+	"github.com/gobwas/glob"
+	krbclient "github.com/jcmturner/gokrb5/v8/client"
+	"github.com/jcmturner/gokrb5/v8/config"
+	"github.com/jcmturner/gokrb5/v8/credentials"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const (
+	defaultPort = "445"
+	// defaultMaxSize is the size of the largest file that will be scanned.
+	defaultMaxSize = 250 * 1024 * 1024 // 250MB
+)
+
+type Source struct {
+	name         string
+	sourceId     int64
+	jobId        int64
+	verify       bool
+	address      string
+	host         string
+	shares       []string
+	excludePaths []glob.Glob
+	maxSize      int64
+	// newInitiator returns the authenticator of a new session. Kerberos
+	// initiators hold per-session keys, so every session needs its own.
+	newInitiator func() smb2.Initiator
+	jobPool      *errgroup.Group
+	log          logr.Logger
+	sources.Progress
+}
+
+// Ensure the Source satisfies the interface at compile time.
+var _ sources.Source = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return sourcespb.SourceType_SOURCE_TYPE_SMB
+}
+
+func (s *Source) SourceID() int64 {
+	return s.sourceId
+}
+
+func (s *Source) JobID() int64 {
+	return s.jobId
+}
+
+// Init returns an initialized SMB source.
+func (s *Source) Init(aCtx context.Context, name string, jobId, sourceId int64, verify bool, connection *anypb.Any, concurrency int) error {
+	s.log = context.WithValues(aCtx, "source", s.Type(), "name", name).Logger()
+
+	s.name = name
+	s.sourceId = sourceId
+	s.jobId = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	var conn sourcespb.SMB
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return errors.WrapPrefix(err, "error unmarshalling connection", 0)
+	}
+
+	if conn.GetAddress() == "" {
+		return errors.New("a file server address is required")
+	}
+	s.address, s.host = withDefaultPort(conn.GetAddress())
+
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.SMB_Ntlm:
+		domain, user := splitDomain(cred.Ntlm.Username)
+		s.newInitiator = func() smb2.Initiator {
+			return &smb2.NTLMInitiator{User: user, Password: cred.Ntlm.Password, Domain: domain}
+		}
+	case *sourcespb.SMB_Kerberos:
+		client, err := kerberosClient(cred.Kerberos)
+		if err != nil {
+			return err
+		}
+		s.newInitiator = func() smb2.Initiator {
+			return &smb2.Krb5Initiator{Client: client, TargetSPN: "cifs/" + s.host}
+		}
+	case *sourcespb.SMB_Unauthenticated:
+		s.newInitiator = func() smb2.Initiator {
+			return &smb2.NTLMInitiator{User: "Guest"}
+		}
+	default:
+		return errors.Errorf("invalid configuration given for %s source", name)
+	}
+
+	s.shares = conn.GetShares()
+	for _, pattern := range conn.GetExcludePaths() {
+		g, err := glob.Compile(pattern, '/')
+		if err != nil {
+			return fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+		s.excludePaths = append(s.excludePaths, g)
+	}
+	s.maxSize = conn.GetMaxSize()
+	if s.maxSize <= 0 {
+		s.maxSize = defaultMaxSize
+	}
+
+	return nil
+}
+
+// withDefaultPort returns the address to dial and the host name of a file
+// server address.
+func withDefaultPort(address string) (string, string) {
+	address = strings.TrimPrefix(address, `\\`)
+	address = strings.TrimPrefix(address, "smb://")
+	address = strings.TrimRight(address, `/\`)
+	if host, _, err := net.SplitHostPort(address); err == nil {
+		return address, host
+	}
+	return net.JoinHostPort(address, defaultPort), address
+}
+
+// splitDomain splits DOMAIN\user and user@domain usernames.
+func splitDomain(username string) (domain, user string) {
+	if d, u, ok := strings.Cut(username, `\`); ok {
+		return d, u
+	}
+	if u, d, ok := strings.Cut(username, "@"); ok {
+		return d, u
+	}
+	return "", username
+}
+
+// kerberosClient returns a client using the ticket of a credentials cache, or
+// getting one with a password.
+func kerberosClient(cred *credentialspb.Kerberos) (*krbclient.Client, error) {
+	cfg, err := config.NewFromString(cred.GetConfig())
+	if err != nil {
+		return nil, errors.WrapPrefix(err, "could not parse Kerberos configuration", 0)
+	}
+	if len(cred.GetCcache()) > 0 {
+		ccache := new(credentials.CCache)
+		if err := ccache.Unmarshal(cred.GetCcache()); err != nil {
+			return nil, errors.WrapPrefix(err, "could not parse Kerberos credentials cache", 0)
+		}
+		return krbclient.NewFromCCache(ccache, cfg, krbclient.DisablePAFXFAST(true))
+	}
+	if cred.GetUsername() == "" || cred.GetPassword() == "" {
+		return nil, errors.New("a Kerberos credentials cache or a username and password are required")
+	}
+	realm := cred.GetRealm()
+	if realm == "" {
+		realm = cfg.LibDefaults.DefaultRealm
+	}
+	client := krbclient.NewWithPassword(cred.GetUsername(), realm, cred.GetPassword(), cfg, krbclient.DisablePAFXFAST(true))
+	if err := client.Login(); err != nil {
+		return nil, errors.WrapPrefix(err, "could not log in to Kerberos", 0)
+	}
+	return client, nil
+}
+
+// dial opens a new session with the file server.
+func (s *Source) dial(ctx context.Context) (*smb2.Session, error) {
+	dialer := &smb2.Dialer{Initiator: s.newInitiator()}
+	session, err := dialer.Dial(ctx, s.address)
+	if err != nil {
+		return nil, errors.WrapPrefix(err, "could not connect to file server", 0)
+	}
+	return session.WithContext(ctx), nil
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) error {
+	shares := s.shares
+	if len(shares) == 0 {
+		session, err := s.dial(ctx)
+		if err != nil {
+			return err
+		}
+		names, err := session.ListSharenames()
+		_ = session.Logoff()
+		if err != nil {
+			return errors.WrapPrefix(err, "could not list shares", 0)
+		}
+		shares = userShares(names)
+	}
+
+	var scanned uint64
+	for i, share := range shares {
+		if common.IsDone(ctx) {
+			break
+		}
+		s.SetProgressComplete(i, len(shares), fmt.Sprintf("Share: %s", share), "")
+
+		share := share
+		s.jobPool.Go(func() error {
+			n, err := s.scanShare(ctx, share, chunksChan)
+			atomic.AddUint64(&scanned, n)
+			if err != nil {
+				s.log.Error(err, "could not scan share", "share", share)
+			}
+			return nil
+		})
+	}
+	_ = s.jobPool.Wait()
+
+	s.SetProgressComplete(len(shares), len(shares), fmt.Sprintf("Completed scanning source %s. %d files scanned.", s.name, scanned), "")
+	return nil
+}
+
+// userShares filters out administrative and hidden shares, such as C$ and
+// IPC$.
+func userShares(names []string) []string {
+	var shares []string
+	for _, name := range names {
+		if strings.HasSuffix(name, "$") {
+			continue
+		}
+		shares = append(shares, name)
+	}
+	return shares
+}
+
+// excluded reports whether a path matches one of the excluded patterns.
+func (s *Source) excluded(path string) bool {
+	for _, g := range s.excludePaths {
+		if g.Match(path) {
+			return true
+		}
+	}
+	return false
+}
+
+// scanShare walks a share and scans its files. Each share uses its own
+// session so shares are scanned in parallel.
+func (s *Source) scanShare(ctx context.Context, share string, chunksChan chan *sources.Chunk) (uint64, error) {
+	session, err := s.dial(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = session.Logoff() }()
+
+	fsys, err := session.Mount(share)
+	if err != nil {
+		return 0, errors.WrapPrefix(err, "could not mount share", 0)
+	}
+	defer func() { _ = fsys.Umount() }()
+	fsys = fsys.WithContext(ctx)
+
+	var scanned uint64
+	err = fs.WalkDir(fsys.DirFS("."), ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// Directories that can't be listed, usually for lack of
+			// permissions, are skipped.
+			s.log.V(2).Info("could not read directory", "share", share, "path", path, "error", err)
+			return nil
+		}
+		if common.IsDone(ctx) {
+			return ctx.Err()
+		}
+		if path != "." && s.excluded(path) {
+			if d.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return nil
+		}
+		if info.Size() > s.maxSize {
+			s.log.V(2).Info("skipping file larger than the maximum size", "share", share, "path", path, "size", info.Size())
+			return nil
+		}
+
+		if err := s.scanFile(ctx, fsys, share, path, info.ModTime(), chunksChan); err != nil {
+			if common.IsDone(ctx) {
+				return ctx.Err()
+			}
+			s.log.V(2).Info("could not scan file", "share", share, "path", path, "error", err)
+			return nil
+		}
+		scanned++
+		return nil
+	})
+	return scanned, err
+}
+
+func (s *Source) scanFile(ctx context.Context, fsys *smb2.Share, share, path string, modified time.Time, chunksChan chan *sources.Chunk) error {
+	file, err := fsys.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	reader, err := diskbufferreader.New(file)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	chunkSkel := &sources.Chunk{
+		SourceType: s.Type(),
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Smb{
+				Smb: &source_metadatapb.SMB{
+					Address:   s.host,
+					Share:     sanitizer.UTF8(share),
+					File:      sanitizer.UTF8(path),
+					Timestamp: modified.UTC().Format(time.RFC3339),
+				},
+			},
+		},
+		Verify: s.verify,
+	}
+	if handlers.HandleFile(ctx, reader, chunkSkel, chunksChan) {
+		return nil
+	}
+	if err := reader.Reset(); err != nil {
+		return err
+	}
+	reader.Stop()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	chunk := *chunkSkel
+	chunk.Data = data
+	for c := range sources.Chunker(&chunk) {
+		select {
+		case chunksChan <- c:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package smb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+)
+
+func TestWithDefaultPort(t *testing.T) {
+	tests := []struct {
+		address, wantAddress, wantHost string
+	}{
+		{`\\files.corp.example.com`, "files.corp.example.com:445", "files.corp.example.com"},
+		{"smb://10.0.0.5:1445/", "10.0.0.5:1445", "10.0.0.5"},
+		{"fileserver", "fileserver:445", "fileserver"},
+	}
+	for _, tt := range tests {
+		address, host := withDefaultPort(tt.address)
+		assert.Equal(t, tt.wantAddress, address)
+		assert.Equal(t, tt.wantHost, host)
+	}
+}
+
+func TestSplitDomain(t *testing.T) {
+	domain, user := splitDomain(`CORP\alice`)
+	assert.Equal(t, "CORP", domain)
+	assert.Equal(t, "alice", user)
+
+	domain, user = splitDomain("bob@corp.example.com")
+	assert.Equal(t, "corp.example.com", domain)
+	assert.Equal(t, "bob", user)
+
+	domain, user = splitDomain("carol")
+	assert.Equal(t, "", domain)
+	assert.Equal(t, "carol", user)
+}
+
+func TestUserShares(t *testing.T) {
+	assert.Equal(t, []string{"Finance", "Public"}, userShares([]string{"ADMIN$", "C$", "Finance", "IPC$", "Public"}))
+}
+
+func TestSource_Excluded(t *testing.T) {
+	s := &Source{}
+	a, err := anypb.New(&sourcespb.SMB{
+		Credential:   &sourcespb.SMB_Ntlm{Ntlm: &credentialspb.BasicAuth{Username: `CORP\alice`, Password: "secret"}},
+		Address:      "fileserver",
+		ExcludePaths: []string{"**/node_modules", "*.iso"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Init(context.Background(), "test - smb", 0, 0, false, a, 1); err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, s.excluded("apps/web/node_modules"))
+	assert.True(t, s.excluded("backup.iso"))
+	assert.False(t, s.excluded("apps/web/config.json"))
+}
+
+func TestKerberosClient(t *testing.T) {
+	_, err := kerberosClient(&credentialspb.Kerberos{
+		Config: "[libdefaults]\n  default_realm = CORP.EXAMPLE.COM\n",
+	})
+	assert.Error(t, err)
+
+	_, err = kerberosClient(&credentialspb.Kerberos{Ccache: []byte{0x04}})
+	assert.Error(t, err)
+}
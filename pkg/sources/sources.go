@@ -2,6 +2,7 @@ package sources
 
 import (
 	"sync"
+	"time"
 
 	"google.golang.org/protobuf/types/known/anypb"
 
@@ -52,6 +53,8 @@ type Config struct {
 	Repo,
 	// Token is the token to use to authenticate with the source.
 	Token,
+	// Username is the username to use to authenticate with the source.
+	Username,
 	// Key is any key to use to authenticate with the source. (ex: S3)
 	Key,
 	// Secret is any secret to use to authenticate with the source. (ex: S3)
@@ -71,15 +74,171 @@ type Config struct {
 	// HeadRef is the head reference to use to scan from.
 	HeadRef,
 	// BaseRef is the base reference to use to scan from.
-	BaseRef string
+	BaseRef,
+	// Author is a pattern the authors of the scanned commits must match. (ex: git)
+	Author,
+	// TenantID is the directory (tenant) used to authenticate with the source. (ex: Microsoft 365)
+	TenantID,
+	// ClientID is the OAuth client ID used to authenticate with the source.
+	ClientID,
+	// ClientSecret is the OAuth client secret used to authenticate with the source.
+	ClientSecret,
+	// JWTConfig is the JSON configuration of an app authenticating with JWT. (ex: Box)
+	JWTConfig,
+	// SASToken is an Azure shared access signature used to authenticate with the source.
+	SASToken,
+	// StateFile is the path of a file used to persist incremental scan state between runs.
+	StateFile,
+	// VCSUsername is the username used to clone repositories referenced by the source.
+	VCSUsername,
+	// VCSToken is the token used to clone repositories referenced by the source.
+	VCSToken,
+	// SSHKeyPath is the path of the private key used to clone repositories over SSH.
+	SSHKeyPath,
+	// SSHKeyPassphrase is the passphrase of the private key of SSHKeyPath.
+	SSHKeyPassphrase,
+	// HTTPCredentials are the "username:password" used to clone repositories over HTTP.
+	HTTPCredentials,
+	// ConnectionString is the DSN or URI used to connect to a database.
+	ConnectionString,
+	// Region is the cloud region of the source.
+	Region,
+	// CAPath is the path to the certificate authority used to verify the source's certificate.
+	CAPath,
+	// SASLMechanism is the SASL mechanism used to authenticate with the source. (ex: Kafka)
+	SASLMechanism,
+	// CredentialsFile is the path of a credentials file used to authenticate with the source. (ex: GCP service account key)
+	CredentialsFile,
+	// Query is a query items must match to be scanned. (ex: Cloud Logging filter)
+	Query,
+	// Realm is the Kerberos realm used to authenticate with the source.
+	Realm,
+	// KerberosConfigPath is the path of the krb5.conf file used to authenticate with the source.
+	KerberosConfigPath,
+	// CCachePath is the path of a Kerberos credentials cache used to authenticate with the source.
+	CCachePath,
+	// ExternalID is the external ID required to assume the roles of RoleARNs. (ex: AWS)
+	ExternalID,
+	// AppID is the ID of the app used to authenticate with the source. (ex: GitHub App)
+	AppID,
+	// InstallationID is the ID of the app installation used to authenticate with the source.
+	InstallationID,
+	// MergeRequestProject is the path of the project of MergeRequest. (ex: GitLab)
+	MergeRequestProject string
 	// Concurrency is the number of concurrent workers to use to scan the source.
 	Concurrency,
 	// MaxDepth is the maximum depth to scan the source.
-	MaxDepth int
+	MaxDepth,
+	// MaxVersions is the maximum number of versions of each package to scan. Zero scans every version.
+	MaxVersions,
+	// MaxBuilds is the maximum number of builds or runs of each job to scan. Zero scans every build.
+	MaxBuilds int
+	// MaxRows is the maximum number of rows, documents, items or keys of each table, collection or database to scan. Zero scans every row.
+	MaxRows,
+	// Segments is the number of segments tables are split in to be scanned in parallel. (ex: DynamoDB)
+	Segments,
+	// MaxMessages is the maximum number of messages or log entries of each queue, partition or project to scan. Zero scans every message.
+	MaxMessages,
+	// VisibilityTimeout is the number of seconds received messages stay hidden from other consumers. (ex: SQS)
+	VisibilityTimeout,
+	// MaxPages is the maximum number of pages to crawl. Zero crawls every page. (ex: website)
+	MaxPages,
+	// MaxJobs is the maximum number of CI jobs of each project whose logs are scanned. Zero scans every job. (ex: GitLab)
+	MaxJobs,
+	// MergeRequest is the IID of the merge request whose changes are scanned. (ex: GitLab)
+	MergeRequest int
+	// MaxSize is the size in bytes of the largest object to scan. Zero uses the source's default.
+	MaxSize,
+	// MaxReleaseAssetSize is the size in bytes of the largest release asset to scan. Zero uses the source's default. (ex: GitHub)
+	MaxReleaseAssetSize,
+	// MaxRepoSize is the size in bytes of the largest repository to scan. Zero scans every repository.
+	MaxRepoSize,
+	// StartOffset is the first offset to scan in each partition. (ex: Kafka)
+	StartOffset,
+	// EndOffset is the offset to stop scanning at in each partition. Zero scans up to the last offset.
+	EndOffset int64
+	// SamplePercent is the percentage of each table to sample. Zero scans the whole table.
+	SamplePercent float64
+	// PIDs is the list of process IDs to scan. (ex: procenv)
+	PIDs []int64
 	// IncludeForks indicates whether to include forks in the scan.
 	IncludeForks,
 	// IncludeMembers indicates whether to include members in the scan.
 	IncludeMembers,
+	// IncludeGists indicates whether to include gists in the scan.
+	IncludeGists,
+	// IncludeSnippets indicates whether to include snippets in the scan. (ex: GitLab)
+	IncludeSnippets,
+	// IncludeIssueComments indicates whether to include issues and their comments in the scan.
+	IncludeIssueComments,
+	// IncludePullRequestComments indicates whether to include pull requests and their comments in the scan.
+	IncludePullRequestComments,
+	// IncludeMergeRequestComments indicates whether to include merge requests and their comments in the scan. (ex: GitLab)
+	IncludeMergeRequestComments,
+	// IncludeWikis indicates whether to include wikis in the scan.
+	IncludeWikis,
+	// IncludeJobLogs indicates whether to include the logs of CI jobs in the scan. (ex: GitLab)
+	IncludeJobLogs,
+	// IncludeReleases indicates whether to include release notes and assets in the scan.
+	IncludeReleases,
+	// IncludeArtifacts indicates whether to include the artifacts stored by builds in the scan. (ex: CircleCI)
+	IncludeArtifacts,
+	// UseGraphQL indicates whether to enumerate repositories with the GraphQL API.
+	UseGraphQL,
+	// ExcludeArchived indicates whether to exclude archived repositories from the scan.
+	ExcludeArchived,
+	// IncludeChats indicates whether to include chat conversations in the scan.
+	IncludeChats,
+	// SkipHistory indicates whether to skip previous versions of documents.
+	SkipHistory,
+	// SkipAttachments indicates whether to skip attachments of documents.
+	SkipAttachments,
+	// SkipArtifacts indicates whether to skip build artifacts.
+	SkipArtifacts,
+	// ForcePathStyle indicates whether to address buckets in the path of URLs rather than in their host. (ex: MinIO)
+	ForcePathStyle,
+	// RequesterPays indicates whether to pay for the requests to requester pays buckets. (ex: S3)
+	RequesterPays,
+	// RestoreArchived indicates whether to request the restore of archived objects to scan them later. (ex: S3)
+	RestoreArchived,
+	// InsecureSkipVerifyTLS disables TLS certificate verification.
+	InsecureSkipVerifyTLS,
+	// IncludeOneDrive indicates whether to include users' OneDrive drives in the scan.
+	IncludeOneDrive,
+	// SkipVersionedSettings indicates whether to skip repositories holding versioned settings. (ex: TeamCity)
+	SkipVersionedSettings,
+	// DeleteMessages indicates whether to delete messages from queues once they are scanned.
+	DeleteMessages,
+	// AllUsers indicates whether to scan the content of every user the credentials can act as.
+	AllUsers,
+	// TLS indicates whether to connect to the source over TLS.
+	TLS,
+	// Kerberos indicates whether to authenticate with Kerberos. (ex: SMB)
+	Kerberos,
+	// IgnoreRobots indicates whether to crawl pages disallowed by robots.txt.
+	IgnoreRobots,
+	// Staged indicates whether to only scan the changes staged in the index. (ex: git pre-commit hook)
+	Staged,
+	// AllBranches indicates whether to attribute the commits of every branch to their branch. (ex: git)
+	AllBranches,
+	// IncludeTags indicates whether to include the messages of annotated tags in the scan. (ex: git)
+	IncludeTags,
+	// IncludeUnreachable indicates whether to include objects no ref points to in the scan. (ex: git)
+	IncludeUnreachable,
+	// RecurseSubmodules indicates whether to clone and scan the submodules of a repo. (ex: git)
+	RecurseSubmodules,
+	// Blame indicates whether to attribute the lines of results to the commits that introduced them. (ex: git)
+	Blame,
+	// UseGitignore indicates whether to skip the files ignored by .gitignore files. (ex: filesystem)
+	UseGitignore,
+	// FollowSymlinks indicates whether to scan the files and directories symlinks point to. (ex: filesystem)
+	FollowSymlinks,
+	// ScanGitHistory indicates whether to scan the history of the git repos found in directories. (ex: filesystem)
+	ScanGitHistory,
+	// DryRun indicates whether to only list what would be scanned.
+	DryRun,
+	// Tail indicates whether to keep scanning new content until the scan is cancelled.
+	Tail,
 	// CloudCred determines whether to use cloud credentials.
 	// This can NOT be used with a secret.
 	CloudCred bool
@@ -89,14 +248,124 @@ type Config struct {
 	Orgs,
 	// Buckets is the list of buckets to scan.
 	Buckets,
+	// ExcludeBuckets is a list of bucket name globs to exclude from the scan.
+	ExcludeBuckets,
+	// Regions is the list of cloud regions to scan.
+	Regions,
 	// ExcludeRepos is a list of repositories to exclude from the scan.
 	ExcludeRepos,
 	// IncludeRepos is a list of repositories to include in the scan.
 	IncludeRepos,
+	// Channels is the list of channels to scan.
+	Channels,
+	// ExcludeChannels is the list of channels to exclude from the scan.
+	ExcludeChannels,
+	// Teams is the list of teams to scan.
+	Teams,
+	// ExcludeTeams is the list of teams to exclude from the scan.
+	ExcludeTeams,
+	// Spaces is the list of spaces to scan.
+	Spaces,
+	// ExcludeSpaces is the list of spaces to exclude from the scan.
+	ExcludeSpaces,
+	// Sites is the list of sites to scan.
+	Sites,
+	// ExcludeSites is the list of sites to exclude from the scan.
+	ExcludeSites,
+	// Users is the list of users to scan.
+	Users,
+	// Folders is the list of folders to scan.
+	Folders,
+	// ExcludeFolders is the list of folders to exclude from the scan.
+	ExcludeFolders,
+	// Packages is the list of packages to scan.
+	Packages,
+	// ExcludePackages is the list of packages to exclude from the scan.
+	ExcludePackages,
+	// Groups is the list of groups to scan.
+	Groups,
+	// Owners is the list of package owners whose packages should be scanned.
+	Owners,
+	// PackageTypes is the list of package types to scan. (ex: Artifactory)
+	PackageTypes,
+	// IncludePaths is a list of path globs to include in the scan.
+	IncludePaths,
+	// ExcludePaths is a list of path globs to exclude from the scan.
+	ExcludePaths,
+	// Workspaces is the list of workspaces to scan.
+	Workspaces,
+	// ExcludeWorkspaces is the list of workspaces to exclude from the scan.
+	ExcludeWorkspaces,
+	// Locations is the list of local paths or URLs to scan. (ex: Terraform state)
+	Locations,
 	// Directories is the list of directories to scan.
-	Directories []string
+	Directories,
+	// Paths is the list of files, directories and globs to scan. (ex: filesystem)
+	Paths,
+	// Projects is the list of projects to scan.
+	Projects,
+	// ExcludeProjects is the list of projects to exclude from the scan.
+	ExcludeProjects,
+	// Pipelines is the list of pipelines to scan.
+	Pipelines,
+	// ExcludePipelines is the list of pipelines to exclude from the scan.
+	ExcludePipelines,
+	// Databases is the list of databases to scan.
+	Databases,
+	// Schemas is the list of database schemas to scan.
+	Schemas,
+	// Tables is the list of tables to scan.
+	Tables,
+	// ExcludeTables is the list of tables to exclude from the scan.
+	ExcludeTables,
+	// Collections is the list of collections to scan.
+	Collections,
+	// ExcludeCollections is the list of collections to exclude from the scan.
+	ExcludeCollections,
+	// Keys is the list of key patterns to scan.
+	Keys,
+	// ExcludeKeys is the list of key patterns to exclude from the scan.
+	ExcludeKeys,
+	// Prefixes is the list of key prefixes of the objects to scan. (ex: S3)
+	Prefixes,
+	// RoleARNs is the list of roles assumed to scan the source, such as those of the accounts of an AWS organization.
+	RoleARNs,
+	// ExcludeSuffixes is the list of key suffixes of the objects to exclude from the scan. (ex: S3)
+	ExcludeSuffixes,
+	// Attributes is the list of attributes to scan. (ex: DynamoDB)
+	Attributes,
+	// Queues is the list of queues to scan.
+	Queues,
+	// Brokers is the list of broker addresses to connect to. (ex: Kafka)
+	Brokers,
+	// Topics is the list of topics to scan.
+	Topics,
+	// Partitions is the list of partitions of each topic to scan.
+	Partitions,
+	// Units is the list of systemd units to scan. (ex: journald)
+	Units,
+	// Hosts is the list of hosts that can be crawled.
+	Hosts,
+	// ClientNames is the list of names one of which client certificates must have. (ex: syslog)
+	ClientNames,
+	// Languages is the list of primary languages of the repositories to scan.
+	Languages,
+	// Shares is the list of file shares to scan.
+	Shares,
+	// PushedRevs is the list of revisions being pushed whose new commits are scanned. (ex: git pre-receive hook)
+	PushedRevs []string
 	// Filter is the filter to use to scan the source.
 	Filter *common.Filter
+	// Since is the earliest point in time to scan from.
+	Since,
+	// Until is the latest point in time to scan up to.
+	Until,
+	// PushedAfter is the time only the repositories pushed to after are scanned. Since is used when it is zero. (ex: GitHub)
+	PushedAfter,
+	// JobLogsSince is the earliest start of the CI jobs whose logs are scanned. Since is used when it is zero. (ex: GitLab)
+	JobLogsSince time.Time
+	// RepoTimeout is the deadline to scan each repository. Zero scans repositories without a deadline.
+	RepoTimeout time.Duration
 }
 
 // NewConfig returns a new Config with optional values.
@@ -147,3 +416,17 @@ func (p *Progress) GetProgress() *Progress {
 	defer p.mut.Unlock()
 	return p
 }
+
+// Snapshot returns a copy of the progress, which can be read while the source
+// keeps updating it.
+func (p *Progress) Snapshot() *Progress {
+	p.mut.Lock()
+	defer p.mut.Unlock()
+	return &Progress{
+		PercentComplete:   p.PercentComplete,
+		Message:           p.Message,
+		EncodedResumeInfo: p.EncodedResumeInfo,
+		SectionsCompleted: p.SectionsCompleted,
+		SectionsRemaining: p.SectionsRemaining,
+	}
+}
@@ -0,0 +1,31 @@
+// Package sourcestest has the fixtures shared by the tests of the sources.
+package sourcestest
+
+import (
+	"net/http"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// Init initializes source with the connection conn, failing the test if it
+// can't. The HTTP clients returned by clients once the source is initialized
+// are intercepted by gock, so the requests of the source can be mocked.
+func Init(t testing.TB, source sources.Source, conn proto.Message, clients ...func() *http.Client) {
+	t.Helper()
+	a, err := anypb.New(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := source.Init(context.Background(), "test - "+source.Type().String(), 0, 0, false, a, 1); err != nil {
+		t.Fatal(err)
+	}
+	for _, client := range clients {
+		gock.InterceptClient(client())
+	}
+}
@@ -0,0 +1,346 @@
+package sqs
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/go-errors/errors"
+	"github.com/go-logr/logr"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const (
+	defaultRegion = "us-east-1"
+	// defaultVisibilityTimeout hides received messages from other consumers
+	// long enough to go through a queue without receiving them twice.
+	defaultVisibilityTimeout = 300
+	// waitTimeSeconds makes receives query every SQS server, so an empty
+	// response means the queue has no visible messages left.
+	waitTimeSeconds = 1
+)
+
+type Source struct {
+	name              string
+	sourceId          int64
+	jobId             int64
+	verify            bool
+	queues            []string
+	deleteMessages    bool
+	visibilityTimeout int64
+	maxMessages       int64
+	httpClient        *http.Client
+	client            *sqs.SQS
+	jobPool           *errgroup.Group
+	log               logr.Logger
+	sources.Progress
+}
+
+// Ensure the Source satisfies the interface at compile time.
+var _ sources.Source = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return sourcespb.SourceType_SOURCE_TYPE_SQS
+}
+
+func (s *Source) SourceID() int64 {
+	return s.sourceId
+}
+
+func (s *Source) JobID() int64 {
+	return s.jobId
+}
+
+// Init returns an initialized SQS source.
+func (s *Source) Init(aCtx context.Context, name string, jobId, sourceId int64, verify bool, connection *anypb.Any, concurrency int) error {
+	s.log = context.WithValues(aCtx, "source", s.Type(), "name", name).Logger()
+
+	s.name = name
+	s.sourceId = sourceId
+	s.jobId = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	var conn sourcespb.SQS
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return errors.WrapPrefix(err, "error unmarshalling connection", 0)
+	}
+
+	region := conn.GetRegion()
+	if region == "" {
+		region = defaultRegion
+	}
+	cfg := aws.NewConfig()
+	cfg.CredentialsChainVerboseErrors = aws.Bool(true)
+	cfg.Region = aws.String(region)
+	if conn.GetEndpoint() != "" {
+		cfg.Endpoint = aws.String(conn.GetEndpoint())
+	}
+	// The SDK retries requests itself, and needs the default transport to
+	// load custom CA bundles.
+	s.httpClient = &http.Client{Timeout: time.Minute}
+	cfg.HTTPClient = s.httpClient
+
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.SQS_AccessKey:
+		cfg.Credentials = credentials.NewStaticCredentials(cred.AccessKey.Key, cred.AccessKey.Secret, "")
+	case *sourcespb.SQS_CloudEnvironment:
+		// The default credential chain is used.
+	default:
+		return errors.Errorf("invalid configuration given for %s source", name)
+	}
+
+	sess, err := session.NewSessionWithOptions(session.Options{
+		SharedConfigState: session.SharedConfigEnable,
+		Config:            *cfg,
+	})
+	if err != nil {
+		return err
+	}
+	s.client = sqs.New(sess)
+
+	s.queues = conn.GetQueues()
+	s.deleteMessages = conn.GetDeleteMessages()
+	s.visibilityTimeout = conn.GetVisibilityTimeout()
+	if s.visibilityTimeout <= 0 {
+		s.visibilityTimeout = defaultVisibilityTimeout
+	}
+	s.maxMessages = conn.GetMaxMessages()
+
+	return nil
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) error {
+	queues := s.queues
+	if len(queues) == 0 {
+		err := s.client.ListQueuesPagesWithContext(ctx, &sqs.ListQueuesInput{}, func(page *sqs.ListQueuesOutput, _ bool) bool {
+			queues = append(queues, aws.StringValueSlice(page.QueueUrls)...)
+			return true
+		})
+		if err != nil {
+			return fmt.Errorf("error listing queues: %w", err)
+		}
+	}
+
+	var scanned uint64
+	for i, queue := range queues {
+		if common.IsDone(ctx) {
+			break
+		}
+		s.SetProgressComplete(i, len(queues), fmt.Sprintf("Queue: %s", queue), "")
+
+		queue := queue
+		s.jobPool.Go(func() error {
+			n, err := s.scanQueue(ctx, queue, chunksChan)
+			atomic.AddUint64(&scanned, n)
+			if err != nil {
+				s.log.Error(err, "could not scan queue", "queue", queue)
+			}
+			return nil
+		})
+	}
+	_ = s.jobPool.Wait()
+
+	s.SetProgressComplete(len(queues), len(queues), fmt.Sprintf("Completed scanning source %s. %d messages scanned.", s.name, scanned), "")
+	return nil
+}
+
+// scanQueue receives the messages of a queue until none are left. Without
+// deleteMessages, received messages stay in the queue and are made visible
+// to other consumers again once the queue is scanned.
+func (s *Source) scanQueue(ctx context.Context, queue string, chunksChan chan *sources.Chunk) (uint64, error) {
+	queueURL := queue
+	if !strings.Contains(queue, "://") {
+		res, err := s.client.GetQueueUrlWithContext(ctx, &sqs.GetQueueUrlInput{QueueName: aws.String(queue)})
+		if err != nil {
+			return 0, err
+		}
+		queueURL = aws.StringValue(res.QueueUrl)
+	}
+	queueName := queueURL[strings.LastIndex(queueURL, "/")+1:]
+
+	// Messages are received again when scanning takes longer than the
+	// visibility timeout, so they are tracked to know when to stop.
+	seen := make(map[string]bool)
+	// Messages are released once the whole queue is scanned rather than after
+	// each batch, or the next receives would return them again instead of the
+	// rest of the queue.
+	received := make(map[string]*string)
+	if !s.deleteMessages {
+		defer s.releaseMessages(queueURL, queueName, received)
+	}
+	var scanned uint64
+	for {
+		if common.IsDone(ctx) {
+			return scanned, ctx.Err()
+		}
+		batch := int64(10)
+		if s.maxMessages > 0 && s.maxMessages-int64(scanned) < batch {
+			batch = s.maxMessages - int64(scanned)
+		}
+		if batch <= 0 {
+			return scanned, nil
+		}
+		res, err := s.client.ReceiveMessageWithContext(ctx, &sqs.ReceiveMessageInput{
+			QueueUrl:              aws.String(queueURL),
+			MaxNumberOfMessages:   aws.Int64(batch),
+			VisibilityTimeout:     aws.Int64(s.visibilityTimeout),
+			WaitTimeSeconds:       aws.Int64(waitTimeSeconds),
+			AttributeNames:        aws.StringSlice([]string{sqs.MessageSystemAttributeNameSentTimestamp}),
+			MessageAttributeNames: aws.StringSlice([]string{"All"}),
+		})
+		if err != nil {
+			return scanned, err
+		}
+
+		var fresh int
+		var toDelete []*sqs.DeleteMessageBatchRequestEntry
+		for _, msg := range res.Messages {
+			id := aws.StringValue(msg.MessageId)
+			if !s.deleteMessages {
+				// Only the latest receipt handle of a message is valid.
+				received[id] = msg.ReceiptHandle
+			}
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+			fresh++
+
+			if err := s.scanMessage(ctx, queueName, msg, chunksChan); err != nil {
+				return scanned, err
+			}
+			scanned++
+			toDelete = append(toDelete, &sqs.DeleteMessageBatchRequestEntry{
+				Id:            aws.String(strconv.Itoa(len(toDelete))),
+				ReceiptHandle: msg.ReceiptHandle,
+			})
+		}
+		if s.deleteMessages && len(toDelete) > 0 {
+			out, err := s.client.DeleteMessageBatchWithContext(ctx, &sqs.DeleteMessageBatchInput{
+				QueueUrl: aws.String(queueURL),
+				Entries:  toDelete,
+			})
+			if err != nil {
+				return scanned, err
+			}
+			for _, failed := range out.Failed {
+				s.log.Error(fmt.Errorf("%s", aws.StringValue(failed.Message)), "could not delete message", "queue", queueName)
+			}
+		}
+		if fresh == 0 {
+			return scanned, nil
+		}
+	}
+}
+
+// releaseMessages resets the visibility timeout of received messages so
+// other consumers receive them again right away.
+func (s *Source) releaseMessages(queueURL, queueName string, handles map[string]*string) {
+	// Messages are released even when the scan is cancelled.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	ids := make([]string, 0, len(handles))
+	for id := range handles {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for start := 0; start < len(ids); start += 10 {
+		end := start + 10
+		if end > len(ids) {
+			end = len(ids)
+		}
+		var entries []*sqs.ChangeMessageVisibilityBatchRequestEntry
+		for _, id := range ids[start:end] {
+			entries = append(entries, &sqs.ChangeMessageVisibilityBatchRequestEntry{
+				Id:                aws.String(strconv.Itoa(len(entries))),
+				ReceiptHandle:     handles[id],
+				VisibilityTimeout: aws.Int64(0),
+			})
+		}
+		out, err := s.client.ChangeMessageVisibilityBatchWithContext(ctx, &sqs.ChangeMessageVisibilityBatchInput{
+			QueueUrl: aws.String(queueURL),
+			Entries:  entries,
+		})
+		if err != nil {
+			s.log.Error(err, "could not release messages", "queue", queueName)
+			return
+		}
+		for _, failed := range out.Failed {
+			s.log.Error(fmt.Errorf("%s", aws.StringValue(failed.Message)), "could not release message", "queue", queueName)
+		}
+	}
+}
+
+func (s *Source) scanMessage(ctx context.Context, queue string, msg *sqs.Message, chunksChan chan *sources.Chunk) error {
+	meta := &source_metadatapb.SQS{
+		Queue:     sanitizer.UTF8(queue),
+		MessageId: aws.StringValue(msg.MessageId),
+	}
+	if sent, err := strconv.ParseInt(aws.StringValue(msg.Attributes[sqs.MessageSystemAttributeNameSentTimestamp]), 10, 64); err == nil {
+		meta.Timestamp = time.UnixMilli(sent).UTC().Format(time.RFC3339)
+	}
+
+	chunk := &sources.Chunk{
+		SourceType: s.Type(),
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		Data:       render(msg),
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Sqs{
+				Sqs: meta,
+			},
+		},
+		Verify: s.verify,
+	}
+	for c := range sources.Chunker(chunk) {
+		select {
+		case chunksChan <- c:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// render formats the body of a message followed by its string message
+// attributes as "name = value" lines.
+func render(msg *sqs.Message) []byte {
+	var b strings.Builder
+	b.WriteString(aws.StringValue(msg.Body))
+	b.WriteString("\n")
+
+	names := make([]string, 0, len(msg.MessageAttributes))
+	for name := range msg.MessageAttributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if v := msg.MessageAttributes[name].StringValue; v != nil {
+			b.WriteString(name + " = " + *v + "\n")
+		}
+	}
+	return []byte(b.String())
+}
@@ -0,0 +1,132 @@
+package sqs
+
+import (
+	"bytes"
+	"crypto/md5"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/sourcestest"
+)
+
+const queueURL = "https://sqs.eu-west-1.amazonaws.com/123456789012/events"
+
+// action matches Query API requests for an action.
+func action(name string) func(*http.Request) bool {
+	return func(req *http.Request) bool {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			return false
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		return strings.Contains(string(body), "Action="+name+"&")
+	}
+}
+
+func receiveResponse(id, body string) string {
+	return fmt.Sprintf(`<ReceiveMessageResponse><ReceiveMessageResult><Message>
+<MessageId>%s</MessageId><ReceiptHandle>handle-%s</ReceiptHandle><MD5OfBody>%x</MD5OfBody><Body>%s</Body>
+<Attribute><Name>SentTimestamp</Name><Value>1670000000000</Value></Attribute>
+<MessageAttribute><Name>authorization</Name><Value><DataType>String</DataType><StringValue>Bearer hunter3</StringValue></Value></MessageAttribute>
+</Message></ReceiveMessageResult></ReceiveMessageResponse>`, id, id, md5.Sum([]byte(body)), body)
+}
+
+func collect(t *testing.T, s *Source) []*sources.Chunk {
+	t.Helper()
+	chunksCh := make(chan *sources.Chunk, 10)
+	assert.NoError(t, s.Chunks(context.Background(), chunksCh))
+	close(chunksCh)
+	var chunks []*sources.Chunk
+	for chunk := range chunksCh {
+		chunks = append(chunks, chunk)
+	}
+	return chunks
+}
+
+func TestSource_Peek(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(queueURL).
+		Post("").
+		Filter(action("ReceiveMessage")).
+		Reply(200).
+		BodyString(receiveResponse("m1", `{"password": "hunter2"}`))
+	// The message is received again once its visibility timeout expires.
+	gock.New(queueURL).
+		Post("").
+		Filter(action("ReceiveMessage")).
+		Reply(200).
+		BodyString(receiveResponse("m1", `{"password": "hunter2"}`))
+	gock.New(queueURL).
+		Post("").
+		Filter(action("ChangeMessageVisibilityBatch")).
+		Filter(func(req *http.Request) bool {
+			body, _ := io.ReadAll(req.Body)
+			req.Body = io.NopCloser(bytes.NewReader(body))
+			return strings.Contains(string(body), "ReceiptHandle=handle-m1") && strings.Contains(string(body), "VisibilityTimeout=0")
+		}).
+		Reply(200).
+		BodyString(`<ChangeMessageVisibilityBatchResponse><ChangeMessageVisibilityBatchResult><ChangeMessageVisibilityBatchResultEntry><Id>0</Id></ChangeMessageVisibilityBatchResultEntry></ChangeMessageVisibilityBatchResult></ChangeMessageVisibilityBatchResponse>`)
+
+	s := &Source{}
+	sourcestest.Init(t, s, &sourcespb.SQS{
+		Credential: &sourcespb.SQS_AccessKey{AccessKey: &credentialspb.KeySecret{Key: "AKIAEXAMPLE", Secret: "secret"}},
+		Region:     "eu-west-1",
+		Queues:     []string{queueURL},
+	}, func() *http.Client { return s.httpClient })
+	chunks := collect(t, s)
+	if assert.Len(t, chunks, 1) {
+		meta := chunks[0].SourceMetadata.GetSqs()
+		assert.Equal(t, "events", meta.Queue)
+		assert.Equal(t, "m1", meta.MessageId)
+		assert.Equal(t, "2022-12-02T16:53:20Z", meta.Timestamp)
+		assert.Equal(t, "{\"password\": \"hunter2\"}\nauthorization = Bearer hunter3\n", string(chunks[0].Data))
+	}
+	assert.True(t, gock.IsDone())
+}
+
+func TestSource_Drain(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(queueURL).
+		Post("").
+		Filter(action("ReceiveMessage")).
+		Reply(200).
+		BodyString(receiveResponse("m1", "token=hunter2"))
+	gock.New(queueURL).
+		Post("").
+		Filter(action("DeleteMessageBatch")).
+		Filter(func(req *http.Request) bool {
+			body, _ := io.ReadAll(req.Body)
+			req.Body = io.NopCloser(bytes.NewReader(body))
+			return strings.Contains(string(body), "ReceiptHandle=handle-m1")
+		}).
+		Reply(200).
+		BodyString(`<DeleteMessageBatchResponse><DeleteMessageBatchResult><DeleteMessageBatchResultEntry><Id>0</Id></DeleteMessageBatchResultEntry></DeleteMessageBatchResult></DeleteMessageBatchResponse>`)
+	gock.New(queueURL).
+		Post("").
+		Filter(action("ReceiveMessage")).
+		Reply(200).
+		BodyString(`<ReceiveMessageResponse><ReceiveMessageResult></ReceiveMessageResult></ReceiveMessageResponse>`)
+
+	s := &Source{}
+	sourcestest.Init(t, s, &sourcespb.SQS{
+		Credential:     &sourcespb.SQS_AccessKey{AccessKey: &credentialspb.KeySecret{Key: "AKIAEXAMPLE", Secret: "secret"}},
+		Region:         "eu-west-1",
+		Queues:         []string{queueURL},
+		DeleteMessages: true,
+	}, func() *http.Client { return s.httpClient })
+	chunks := collect(t, s)
+	assert.Len(t, chunks, 1)
+	assert.True(t, gock.IsDone())
+}
@@ -0,0 +1,163 @@
+package syslog
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/go-errors/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// maxRELPFrameSize is the size of the largest RELP frame that is accepted.
+const maxRELPFrameSize = 128 * 1024
+
+// relpFrame is a RELP command or response, as defined by
+// https://www.rsyslog.com/doc/relp.html.
+type relpFrame struct {
+	txnr    int
+	command string
+	data    []byte
+}
+
+// readRELPFrame reads a frame formatted as "TXNR SP COMMAND SP DATALEN [SP DATA] LF".
+func readRELPFrame(r *bufio.Reader) (*relpFrame, error) {
+	txnr, err := readRELPToken(r, ' ')
+	if err != nil {
+		return nil, err
+	}
+	command, err := readRELPToken(r, ' ')
+	if err != nil {
+		return nil, err
+	}
+	// DATALEN is followed by a space before DATA, or by the trailer when
+	// there is no data.
+	var dataLen strings.Builder
+	var end byte
+	for {
+		end, err = r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		if end == ' ' || end == '\n' {
+			break
+		}
+		dataLen.WriteByte(end)
+	}
+
+	frame := &relpFrame{command: command}
+	if frame.txnr, err = strconv.Atoi(txnr); err != nil {
+		return nil, fmt.Errorf("invalid RELP transaction number %q", txnr)
+	}
+	n, err := strconv.Atoi(dataLen.String())
+	if err != nil || n < 0 || n > maxRELPFrameSize {
+		return nil, fmt.Errorf("invalid RELP data length %q", dataLen.String())
+	}
+	if end == '\n' {
+		if n != 0 {
+			return nil, fmt.Errorf("missing RELP data of %d bytes", n)
+		}
+		return frame, nil
+	}
+
+	frame.data = make([]byte, n)
+	if _, err := io.ReadFull(r, frame.data); err != nil {
+		return nil, err
+	}
+	trailer, err := r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	if trailer != '\n' {
+		return nil, fmt.Errorf("invalid RELP frame trailer %q", trailer)
+	}
+	return frame, nil
+}
+
+// readRELPToken reads a header field of a frame up to its delimiter.
+func readRELPToken(r *bufio.Reader, delim byte) (string, error) {
+	token, err := r.ReadString(delim)
+	if err != nil {
+		return "", err
+	}
+	if len(token) > 32 {
+		return "", fmt.Errorf("invalid RELP header field %q", token)
+	}
+	return strings.TrimSuffix(token, string(delim)), nil
+}
+
+// writeRELPResponse acknowledges the frame of a transaction.
+func writeRELPResponse(w io.Writer, txnr int, data string) error {
+	if data == "" {
+		_, err := fmt.Fprintf(w, "%d rsp 0\n", txnr)
+		return err
+	}
+	_, err := fmt.Fprintf(w, "%d rsp %d %s\n", txnr, len(data), data)
+	return err
+}
+
+// monitorRELPConnection scans the syslog messages of a RELP session, such as
+// one of rsyslog's omrelp, acknowledging each of them.
+func (s *Source) monitorRELPConnection(ctx context.Context, conn net.Conn, chunksChan chan *sources.Chunk) {
+	defer common.RecoverWithExit(ctx)
+	defer conn.Close()
+
+	// Reads block until the next frame, so the connection is closed to stop
+	// them when the scan is cancelled.
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-done:
+		}
+	}()
+
+	remote := conn.RemoteAddr().String()
+	r := bufio.NewReader(conn)
+	for {
+		frame, err := readRELPFrame(r)
+		if err != nil {
+			if !errors.Is(err, io.EOF) && !common.IsDone(ctx) {
+				logrus.WithError(err).WithField("client", remote).Debug("could not read RELP frame")
+			}
+			return
+		}
+
+		switch frame.command {
+		case "open":
+			err = writeRELPResponse(conn, frame.txnr, "200 OK\nrelp_version=0\nrelp_software=trufflehog\ncommands=syslog")
+		case "syslog":
+			metadata, parseErr := s.parseSyslogMetadata(frame.data, remote)
+			if parseErr != nil {
+				logrus.WithError(parseErr).Debug("failed to generate metadata")
+			}
+			chunksChan <- &sources.Chunk{
+				SourceName:     s.syslog.sourceName,
+				SourceID:       s.syslog.sourceID,
+				SourceType:     s.syslog.sourceType,
+				SourceMetadata: metadata,
+				Data:           frame.data,
+				Verify:         s.verify,
+			}
+			err = writeRELPResponse(conn, frame.txnr, "200 OK")
+		case "close":
+			_ = writeRELPResponse(conn, frame.txnr, "")
+			return
+		default:
+			err = writeRELPResponse(conn, frame.txnr, "500 unknown command "+frame.command)
+		}
+		if err != nil {
+			logrus.WithError(err).WithField("client", remote).Debug("could not write RELP response")
+			return
+		}
+	}
+}
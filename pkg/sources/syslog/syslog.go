@@ -1,7 +1,9 @@
 package syslog
 
 import (
+	"bytes"
 	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"io"
 	"net"
@@ -134,12 +136,20 @@ func (s *Source) verifyConnectionConfig() error {
 		return fmt.Errorf("TLS is not supported over UDP")
 	}
 
+	if s.conn.TlsClientCA != nilString && !tlsEnabled {
+		return fmt.Errorf("client certificates can only be verified over TLS")
+	}
+
 	if s.conn.ListenAddress == nilString {
 		s.conn.ListenAddress = ":5140"
 	}
 
-	if s.conn.Format == nilString {
-		s.conn.Format = "rfc3164"
+	switch s.conn.Format {
+	case nilString:
+		s.conn.Format = "auto"
+	case "auto", "rfc3164", "rfc5424":
+	default:
+		return fmt.Errorf("unsupported format %q, expected rfc3164, rfc5424 or auto", s.conn.Format)
 	}
 	return nil
 }
@@ -148,20 +158,19 @@ func (s *Source) verifyConnectionConfig() error {
 func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) error {
 	switch {
 	case s.conn.TlsCert != nilString || s.conn.TlsKey != nilString:
-		cert, err := tls.X509KeyPair([]byte(s.conn.TlsCert), []byte(s.conn.TlsKey))
+		cfg, err := s.tlsConfig()
 		if err != nil {
-			return errors.WrapPrefix(err, "could not load key pair", 0)
+			return err
 		}
-		cfg := &tls.Config{Certificates: []tls.Certificate{cert}}
-		lis, err := tls.Listen(s.conn.Protocol, s.conn.ListenAddress, cfg)
+		lis, err := tls.Listen("tcp", s.conn.ListenAddress, cfg)
 		if err != nil {
 			return errors.WrapPrefix(err, "error creating TLS listener", 0)
 		}
 		defer lis.Close()
 
 		return s.acceptTCPConnections(ctx, lis, chunksChan)
-	case s.conn.Protocol == "tcp":
-		lis, err := net.Listen(s.conn.Protocol, s.conn.ListenAddress)
+	case s.conn.Protocol == "tcp" || s.conn.Protocol == "relp":
+		lis, err := net.Listen("tcp", s.conn.ListenAddress)
 		if err != nil {
 			return errors.WrapPrefix(err, "error creating TCP listener", 0)
 		}
@@ -185,9 +194,92 @@ func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) err
 	}
 }
 
+// tlsConfig returns the configuration of the TLS listener, which requires
+// clients to present a certificate signed by the client CA when it is set.
+func (s *Source) tlsConfig() (*tls.Config, error) {
+	cert, err := tls.X509KeyPair([]byte(s.conn.TlsCert), []byte(s.conn.TlsKey))
+	if err != nil {
+		return nil, errors.WrapPrefix(err, "could not load key pair", 0)
+	}
+	cfg := &tls.Config{Certificates: []tls.Certificate{cert}}
+	if s.conn.TlsClientCA == nilString {
+		return cfg, nil
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM([]byte(s.conn.TlsClientCA)) {
+		return nil, fmt.Errorf("no certificate found in client CA")
+	}
+	cfg.ClientCAs = pool
+	cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	if len(s.conn.TlsClientNames) > 0 {
+		cfg.VerifyPeerCertificate = func(_ [][]byte, chains [][]*x509.Certificate) error {
+			return verifyClientName(chains, s.conn.TlsClientNames)
+		}
+	}
+	return cfg, nil
+}
+
+// verifyClientName checks that the verified certificate of a client has one
+// of the allowed names, as its common name or one of its DNS names.
+func verifyClientName(chains [][]*x509.Certificate, allowed []string) error {
+	if len(chains) == 0 || len(chains[0]) == 0 {
+		return fmt.Errorf("no verified client certificate")
+	}
+	leaf := chains[0][0]
+	for _, name := range allowed {
+		if name == leaf.Subject.CommonName {
+			return nil
+		}
+		for _, dnsName := range leaf.DNSNames {
+			if name == dnsName {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("client certificate %q isn't allowed", leaf.Subject.CommonName)
+}
+
 func (s *Source) parseSyslogMetadata(input []byte, remote string) (*source_metadatapb.MetaData, error) {
+	if s.conn.Format != "auto" {
+		return s.parseSyslogFormat(s.conn.Format, input, remote)
+	}
+
+	// Senders may use either format, so the other one is tried when the
+	// message doesn't parse as the detected one.
+	format, fallback := detectFormat(input)
+	metadata, err := s.parseSyslogFormat(format, input, remote)
+	if err == nil {
+		return metadata, nil
+	}
+	if metadata, fallbackErr := s.parseSyslogFormat(fallback, input, remote); fallbackErr == nil {
+		return metadata, nil
+	}
+	// The client is still reported for messages in neither format.
+	return s.syslog.sourceMetadataFunc(nilString, nilString, nilString, nilString, nilString, remote), err
+}
+
+// detectFormat returns the format of a message, followed by the other one.
+// RFC5424 messages have a version right after their priority, like "<34>1 ",
+// whereas RFC3164 ones are followed by a timestamp or a hostname.
+func detectFormat(input []byte) (format, fallback string) {
+	end := bytes.IndexByte(input, '>')
+	if len(input) > 0 && input[0] == '<' && end > 0 {
+		version := input[end+1:]
+		i := 0
+		for i < len(version) && i < 3 && version[i] >= '0' && version[i] <= '9' {
+			i++
+		}
+		if i > 0 && version[0] != '0' && i < len(version) && version[i] == ' ' {
+			return "rfc5424", "rfc3164"
+		}
+	}
+	return "rfc3164", "rfc5424"
+}
+
+func (s *Source) parseSyslogFormat(format string, input []byte, remote string) (*source_metadatapb.MetaData, error) {
 	var metadata *source_metadatapb.MetaData
-	switch s.conn.Format {
+	switch format {
 	case "rfc5424":
 		message := &rfc5424.Message{}
 		err := message.UnmarshalBinary(input)
@@ -219,13 +311,14 @@ func (s *Source) monitorConnection(ctx context.Context, conn net.Conn, chunksCha
 		}
 		input := make([]byte, 8096)
 		remote := conn.RemoteAddr()
-		_, err = conn.Read(input)
+		n, err := conn.Read(input)
 		if err != nil {
 			if errors.Is(err, io.EOF) {
 				return
 			}
 			continue
 		}
+		input = input[:n]
 		logrus.Trace(string(input))
 		metadata, err := s.parseSyslogMetadata(input, remote.String())
 		if err != nil {
@@ -252,6 +345,10 @@ func (s *Source) acceptTCPConnections(ctx context.Context, netListener net.Liste
 			logrus.WithError(err).Debug("failed to accept TCP connection")
 			continue
 		}
+		if s.conn.Protocol == "relp" {
+			go s.monitorRELPConnection(ctx, conn, chunksChan)
+			continue
+		}
 		go s.monitorConnection(ctx, conn, chunksChan)
 	}
 }
@@ -262,13 +359,14 @@ func (s *Source) acceptUDPConnections(ctx context.Context, netListener net.Packe
 			return nil
 		}
 		input := make([]byte, 65535)
-		_, remote, err := netListener.ReadFrom(input)
+		n, remote, err := netListener.ReadFrom(input)
 		if err != nil {
 			if errors.Is(err, io.EOF) {
 				return nil
 			}
 			continue
 		}
+		input = input[:n]
 		metadata, err := s.parseSyslogMetadata(input, remote.String())
 		if err != nil {
 			logrus.WithError(err).Debug("failed to parse metadata")
@@ -0,0 +1,557 @@
+package teamcity
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"runtime"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	diskbufferreader "github.com/bill-rich/disk-buffer-reader"
+	"github.com/go-errors/errors"
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-logr/logr"
+	"github.com/gobwas/glob"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/git"
+)
+
+const (
+	// maxDownloadSize is the largest build log or artifact archive that will be scanned.
+	maxDownloadSize = 500 * 1024 * 1024 // 500MB
+	// startDateLayout is the format of timestamps in the REST API.
+	startDateLayout = "20060102T150405-0700"
+)
+
+type Source struct {
+	name     string
+	sourceId int64
+	jobId    int64
+	verify   bool
+	endpoint string
+	// prefix is prepended to the paths of requests. Guest requests go
+	// through /guestAuth.
+	prefix string
+	// auth sets the credentials on requests to TeamCity.
+	auth                  func(*http.Request)
+	projects              []glob.Glob
+	ignoreProjects        []glob.Glob
+	maxBuilds             int
+	skipArtifacts         bool
+	skipVersionedSettings bool
+	vcsUsername           string
+	vcsToken              string
+	client                *http.Client
+	jobPool               *errgroup.Group
+	log                   logr.Logger
+	sources.Progress
+}
+
+// Ensure the Source satisfies the interface at compile time.
+var _ sources.Source = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return sourcespb.SourceType_SOURCE_TYPE_TEAMCITY
+}
+
+func (s *Source) SourceID() int64 {
+	return s.sourceId
+}
+
+func (s *Source) JobID() int64 {
+	return s.jobId
+}
+
+// Init returns an initialized TeamCity source.
+func (s *Source) Init(aCtx context.Context, name string, jobId, sourceId int64, verify bool, connection *anypb.Any, concurrency int) error {
+	s.log = context.WithValues(aCtx, "source", s.Type(), "name", name).Logger()
+
+	s.name = name
+	s.sourceId = sourceId
+	s.jobId = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	var conn sourcespb.TeamCity
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return errors.WrapPrefix(err, "error unmarshalling connection", 0)
+	}
+
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.TeamCity_Token:
+		s.auth = func(req *http.Request) { req.Header.Set("Authorization", "Bearer "+cred.Token) }
+	case *sourcespb.TeamCity_BasicAuth:
+		s.prefix = "/httpAuth"
+		s.auth = func(req *http.Request) {
+			req.SetBasicAuth(cred.BasicAuth.GetUsername(), cred.BasicAuth.GetPassword())
+		}
+	case *sourcespb.TeamCity_Unauthenticated:
+		s.prefix = "/guestAuth"
+		s.auth = func(*http.Request) {}
+	default:
+		return errors.Errorf("invalid configuration given for %s source", name)
+	}
+
+	s.endpoint = strings.TrimSuffix(conn.GetEndpoint(), "/")
+	if s.endpoint == "" {
+		return errors.New("endpoint is required")
+	}
+
+	var err error
+	if s.projects, err = compileGlobs(conn.GetProjects()); err != nil {
+		return err
+	}
+	if s.ignoreProjects, err = compileGlobs(conn.GetIgnoreProjects()); err != nil {
+		return err
+	}
+	s.maxBuilds = int(conn.GetMaxBuilds())
+	s.skipArtifacts = conn.GetSkipArtifacts()
+	s.skipVersionedSettings = conn.GetSkipVersionedSettings()
+	s.vcsUsername = conn.GetVcsUsername()
+	s.vcsToken = conn.GetVcsToken()
+
+	s.client = common.RetryableHttpClientTimeout(300)
+	if conn.GetInsecureSkipVerifyTls() {
+		s.client.Transport = common.NewCustomTransport(&http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		})
+	}
+
+	return nil
+}
+
+func compileGlobs(patterns []string) ([]glob.Glob, error) {
+	var globs []glob.Glob
+	for _, pattern := range patterns {
+		g, err := glob.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid project pattern %q: %w", pattern, err)
+		}
+		globs = append(globs, g)
+	}
+	return globs, nil
+}
+
+func matchAny(globs []glob.Glob, values ...string) bool {
+	for _, g := range globs {
+		for _, v := range values {
+			if g.Match(v) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// selected reports whether a project should be scanned. Patterns match
+// either the ID or the name of the project.
+func (s *Source) selected(p project) bool {
+	if matchAny(s.ignoreProjects, p.ID, p.Name) {
+		return false
+	}
+	return len(s.projects) == 0 || matchAny(s.projects, p.ID, p.Name)
+}
+
+type project struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	WebURL string `json:"webUrl"`
+}
+
+type buildType struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	WebURL string `json:"webUrl"`
+}
+
+type build struct {
+	ID        int64  `json:"id"`
+	Number    string `json:"number"`
+	StartDate string `json:"startDate"`
+	WebURL    string `json:"webUrl"`
+}
+
+type properties struct {
+	Property []struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	} `json:"property"`
+}
+
+func (p properties) get(name string) string {
+	for _, prop := range p.Property {
+		if prop.Name == name {
+			return prop.Value
+		}
+	}
+	return ""
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) error {
+	var res struct {
+		Project []project `json:"project"`
+	}
+	if err := s.getJSON(ctx, s.restURL("/projects?fields=project(id,name,webUrl)"), &res); err != nil {
+		return fmt.Errorf("error listing projects: %w", err)
+	}
+	projects := res.Project
+
+	var scanned uint64
+	// Subprojects usually share the versioned settings repository of their parent.
+	settingsRoots := make(map[string]bool)
+	for i, p := range projects {
+		if common.IsDone(ctx) {
+			break
+		}
+		if !s.selected(p) {
+			continue
+		}
+		s.SetProgressComplete(i, len(projects), fmt.Sprintf("Project: %s", p.Name), "")
+
+		buildTypes, err := s.scanProject(ctx, p, chunksChan)
+		if err != nil {
+			s.log.Error(err, "could not scan project", "project", p.ID)
+			continue
+		}
+		if !s.skipVersionedSettings {
+			if err := s.scanVersionedSettings(ctx, p, settingsRoots, chunksChan); err != nil {
+				s.log.Error(err, "could not scan versioned settings", "project", p.ID)
+			}
+		}
+
+		for _, bt := range buildTypes {
+			p, bt := p, bt
+			s.jobPool.Go(func() error {
+				if err := s.scanBuildType(ctx, p, bt, chunksChan); err != nil {
+					s.log.Error(err, "could not scan build configuration", "buildType", bt.ID)
+					return nil
+				}
+				atomic.AddUint64(&scanned, 1)
+				return nil
+			})
+		}
+	}
+	_ = s.jobPool.Wait()
+
+	s.SetProgressComplete(len(projects), len(projects), fmt.Sprintf("Completed scanning source %s. %d build configurations scanned.", s.name, scanned), "")
+	return nil
+}
+
+// scanProject scans the settings and parameters of a project and returns its
+// build configurations.
+func (s *Source) scanProject(ctx context.Context, p project, chunksChan chan *sources.Chunk) ([]buildType, error) {
+	data, err := s.download(ctx, s.restURL("/projects/id:"+url.PathEscape(p.ID)), "application/json")
+	if err != nil {
+		return nil, err
+	}
+	meta := &source_metadatapb.TeamCity{
+		Project: sanitizer.UTF8(p.ID),
+		File:    "project settings",
+		Link:    sanitizer.UTF8(p.WebURL),
+	}
+	if err := s.send(ctx, meta, data, chunksChan); err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		BuildTypes struct {
+			BuildType []buildType `json:"buildType"`
+		} `json:"buildTypes"`
+	}
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+	return res.BuildTypes.BuildType, nil
+}
+
+func (s *Source) scanBuildType(ctx context.Context, p project, bt buildType, chunksChan chan *sources.Chunk) error {
+	settings, err := s.download(ctx, s.restURL("/buildTypes/id:"+url.PathEscape(bt.ID)), "application/json")
+	if err != nil {
+		return err
+	}
+	meta := &source_metadatapb.TeamCity{
+		Project:   sanitizer.UTF8(p.ID),
+		BuildType: sanitizer.UTF8(bt.ID),
+		File:      "build configuration settings",
+		Link:      sanitizer.UTF8(bt.WebURL),
+	}
+	if err := s.send(ctx, meta, settings, chunksChan); err != nil {
+		return err
+	}
+
+	builds, err := s.listBuilds(ctx, bt.ID)
+	if err != nil {
+		return err
+	}
+	for _, b := range builds {
+		if common.IsDone(ctx) {
+			return ctx.Err()
+		}
+		if err := s.scanBuild(ctx, p, bt, b, chunksChan); err != nil {
+			s.log.Error(err, "could not scan build", "buildType", bt.ID, "build", b.ID)
+		}
+	}
+	return nil
+}
+
+// listBuilds returns the builds of a build configuration from newest to
+// oldest, including failed, canceled and personal builds.
+func (s *Source) listBuilds(ctx context.Context, buildTypeID string) ([]build, error) {
+	locator := fmt.Sprintf("buildType:(id:%s),defaultFilter:false", buildTypeID)
+	if s.maxBuilds > 0 {
+		locator += fmt.Sprintf(",count:%d", s.maxBuilds)
+	}
+	next := s.restURL("/builds?fields=nextHref,build(id,number,startDate,webUrl)&locator=" + url.QueryEscape(locator))
+
+	var builds []build
+	for next != "" {
+		var res struct {
+			NextHref string  `json:"nextHref"`
+			Build    []build `json:"build"`
+		}
+		if err := s.getJSON(ctx, next, &res); err != nil {
+			return nil, err
+		}
+		builds = append(builds, res.Build...)
+		if s.maxBuilds > 0 && len(builds) >= s.maxBuilds {
+			return builds[:s.maxBuilds], nil
+		}
+		next = ""
+		if res.NextHref != "" {
+			next = s.endpoint + res.NextHref
+		}
+	}
+	return builds, nil
+}
+
+func (s *Source) scanBuild(ctx context.Context, p project, bt buildType, b build, chunksChan chan *sources.Chunk) error {
+	newMeta := func(file, link string) *source_metadatapb.TeamCity {
+		meta := &source_metadatapb.TeamCity{
+			Project:     sanitizer.UTF8(p.ID),
+			BuildType:   sanitizer.UTF8(bt.ID),
+			BuildId:     b.ID,
+			BuildNumber: sanitizer.UTF8(b.Number),
+			File:        file,
+			Link:        sanitizer.UTF8(link),
+		}
+		if t, err := time.Parse(startDateLayout, b.StartDate); err == nil {
+			meta.Timestamp = t.UTC().Format(time.RFC3339)
+		}
+		return meta
+	}
+
+	logURL := fmt.Sprintf("%s%s/downloadBuildLog.html?buildId=%d", s.endpoint, s.prefix, b.ID)
+	if err := s.scanURL(ctx, logURL, newMeta("build log", b.WebURL+"&tab=buildLog"), chunksChan); err != nil {
+		return err
+	}
+
+	if s.skipArtifacts {
+		return nil
+	}
+	// The archive holds every artifact of the build, hidden ones included.
+	artifactsURL := s.restURL(fmt.Sprintf("/builds/id:%d/artifacts/archived", b.ID))
+	if err := s.scanURL(ctx, artifactsURL, newMeta("artifacts", b.WebURL+"&tab=artifacts"), chunksChan); err != nil {
+		// Builds without artifacts don't have an archive.
+		s.log.V(2).Info("could not scan artifacts", "build", b.ID, "error", err.Error())
+	}
+	return nil
+}
+
+// scanVersionedSettings clones and scans the repository a project's settings
+// are synchronized with. Old revisions of the settings often hold secrets
+// that have since been moved to secure parameters.
+func (s *Source) scanVersionedSettings(ctx context.Context, p project, seen map[string]bool, chunksChan chan *sources.Chunk) error {
+	var features struct {
+		ProjectFeature []struct {
+			Properties properties `json:"properties"`
+		} `json:"projectFeature"`
+	}
+	u := s.restURL("/projects/id:" + url.PathEscape(p.ID) + "/projectFeatures?locator=type:versionedSettings")
+	if err := s.getJSON(ctx, u, &features); err != nil {
+		return err
+	}
+	for _, feature := range features.ProjectFeature {
+		rootID := feature.Properties.get("rootId")
+		if feature.Properties.get("enabled") != "true" || rootID == "" || seen[rootID] {
+			continue
+		}
+		seen[rootID] = true
+
+		var root struct {
+			Properties properties `json:"properties"`
+		}
+		if err := s.getJSON(ctx, s.restURL("/vcs-roots/id:"+url.PathEscape(rootID)), &root); err != nil {
+			return err
+		}
+		repoURL := root.Properties.get("url")
+		if repoURL == "" {
+			continue
+		}
+		if err := s.scanRepo(ctx, p, repoURL, chunksChan); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Source) scanRepo(ctx context.Context, p project, repoURL string, chunksChan chan *sources.Chunk) error {
+	var path string
+	var repo *gogit.Repository
+	var err error
+	switch {
+	case strings.HasPrefix(repoURL, "git@") || strings.HasPrefix(repoURL, "ssh://"):
+		path, repo, err = git.CloneRepoUsingSSH(ctx, repoURL)
+	case s.vcsToken != "":
+		// A username is required to clone with a token, though most hosts ignore it.
+		user := s.vcsUsername
+		if user == "" {
+			user = "placeholder"
+		}
+		path, repo, err = git.CloneRepoUsingToken(ctx, s.vcsToken, repoURL, user)
+	default:
+		path, repo, err = git.CloneRepoUsingUnauthenticated(ctx, repoURL)
+	}
+	defer os.RemoveAll(path)
+	if err != nil {
+		return err
+	}
+
+	g := git.NewGit(s.Type(), s.JobID(), s.SourceID(), s.name, s.verify, runtime.NumCPU(),
+		func(file, email, commit, timestamp, repository string, line int64) *source_metadatapb.MetaData {
+			return &source_metadatapb.MetaData{
+				Data: &source_metadatapb.MetaData_Teamcity{
+					Teamcity: &source_metadatapb.TeamCity{
+						Project:    sanitizer.UTF8(p.ID),
+						File:       sanitizer.UTF8(file),
+						Repository: sanitizer.UTF8(repository),
+						Commit:     sanitizer.UTF8(commit),
+						Email:      sanitizer.UTF8(email),
+						Link:       git.GenerateLink(repository, commit, file),
+						Timestamp:  sanitizer.UTF8(timestamp),
+						Line:       line,
+					},
+				},
+			}
+		})
+	return g.ScanRepo(ctx, repo, path, git.NewScanOptions(), chunksChan)
+}
+
+// scanURL downloads and scans a build log or artifact archive.
+func (s *Source) scanURL(ctx context.Context, u string, meta *source_metadatapb.TeamCity, chunksChan chan *sources.Chunk) error {
+	res, err := s.get(ctx, u, "")
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	reader, err := diskbufferreader.New(io.LimitReader(res.Body, maxDownloadSize))
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	chunkSkel := s.chunk(meta, nil)
+	if handlers.HandleFile(ctx, reader, chunkSkel, chunksChan) {
+		return nil
+	}
+	if err := reader.Reset(); err != nil {
+		return err
+	}
+	reader.Stop()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	return s.send(ctx, meta, data, chunksChan)
+}
+
+func (s *Source) chunk(meta *source_metadatapb.TeamCity, data []byte) *sources.Chunk {
+	return &sources.Chunk{
+		SourceType: s.Type(),
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		Data:       data,
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Teamcity{
+				Teamcity: meta,
+			},
+		},
+		Verify: s.verify,
+	}
+}
+
+func (s *Source) send(ctx context.Context, meta *source_metadatapb.TeamCity, data []byte, chunksChan chan *sources.Chunk) error {
+	for c := range sources.Chunker(s.chunk(meta, data)) {
+		select {
+		case chunksChan <- c:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// restURL returns the URL of a REST API path.
+func (s *Source) restURL(path string) string {
+	return s.endpoint + s.prefix + "/app/rest" + path
+}
+
+func (s *Source) getJSON(ctx context.Context, u string, out interface{}) error {
+	res, err := s.get(ctx, u, "application/json")
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	return json.NewDecoder(res.Body).Decode(out)
+}
+
+func (s *Source) download(ctx context.Context, u, accept string) ([]byte, error) {
+	res, err := s.get(ctx, u, accept)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	return io.ReadAll(io.LimitReader(res.Body, maxDownloadSize))
+}
+
+func (s *Source) get(ctx context.Context, u, accept string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	s.auth(req)
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		res.Body.Close()
+		return nil, fmt.Errorf("unexpected status code %d requesting %s", res.StatusCode, req.URL.Path)
+	}
+	return res, nil
+}
@@ -0,0 +1,158 @@
+package teamcity
+
+import (
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/sourcestest"
+)
+
+// initSettingsRepo creates a repository with a settings file committed to it.
+func initSettingsRepo(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, ".teamcity"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	settings := `params { param("env.DB_PASSWORD", "hunter5") }`
+	if err := os.WriteFile(filepath.Join(dir, ".teamcity", "settings.kts"), []byte(settings), 0644); err != nil {
+		t.Fatal(err)
+	}
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"add", "."},
+		{"-c", "user.name=ci", "-c", "user.email=ci@example.com", "commit", "-q", "-m", "settings"},
+	} {
+		if out, err := exec.Command("git", append([]string{"-C", dir}, args...)...).CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v: %s", args, err, out)
+		}
+	}
+	return dir
+}
+
+func TestSource_Chunks(t *testing.T) {
+	defer gock.Off()
+	repoDir := initSettingsRepo(t)
+
+	gock.New("https://tc.example.com").
+		Get("^/app/rest/projects$").
+		MatchHeader("Authorization", "^Bearer tc-token$").
+		MatchHeader("Accept", "application/json").
+		Reply(200).
+		JSON(map[string]interface{}{"project": []map[string]string{
+			{"id": "Web", "name": "Web", "webUrl": "https://tc.example.com/project/Web"},
+			{"id": "Sandbox", "name": "Sandbox"},
+		}})
+	gock.New("https://tc.example.com").
+		Get("^/app/rest/projects/id:Web$").
+		Reply(200).
+		JSON(map[string]interface{}{
+			"id":         "Web",
+			"parameters": map[string]interface{}{"property": []map[string]string{{"name": "env.SLACK_WEBHOOK", "value": "hunter1"}}},
+			"buildTypes": map[string]interface{}{"buildType": []map[string]string{
+				{"id": "Web_Deploy", "name": "Deploy", "webUrl": "https://tc.example.com/buildConfiguration/Web_Deploy"},
+			}},
+		})
+	gock.New("https://tc.example.com").
+		Get("^/app/rest/projects/id:Web/projectFeatures$").
+		MatchParam("locator", "type:versionedSettings").
+		Reply(200).
+		JSON(map[string]interface{}{"projectFeature": []map[string]interface{}{
+			{"properties": map[string]interface{}{"property": []map[string]string{
+				{"name": "enabled", "value": "true"},
+				{"name": "rootId", "value": "Web_Settings"},
+			}}},
+		}})
+	gock.New("https://tc.example.com").
+		Get("^/app/rest/vcs-roots/id:Web_Settings$").
+		Reply(200).
+		JSON(map[string]interface{}{"properties": map[string]interface{}{"property": []map[string]string{
+			{"name": "url", "value": repoDir},
+		}}})
+	gock.New("https://tc.example.com").
+		Get("^/app/rest/buildTypes/id:Web_Deploy$").
+		Reply(200).
+		JSON(map[string]interface{}{"steps": map[string]interface{}{"step": []map[string]interface{}{
+			{"name": "deploy", "properties": map[string]interface{}{"property": []map[string]string{{"name": "script.content", "value": "deploy --password hunter2"}}}},
+		}}})
+	gock.New("https://tc.example.com").
+		Get("^/app/rest/builds$").
+		MatchParam("locator", `^buildType:\(id:Web_Deploy\),defaultFilter:false,count:1$`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"nextHref": "/app/rest/builds?locator=buildType:(id:Web_Deploy),count:1,start:1",
+			"build": []map[string]interface{}{
+				{"id": 42, "number": "1.0.42", "startDate": "20221202T165320+0000", "webUrl": "https://tc.example.com/viewLog.html?buildId=42"},
+			},
+		})
+	gock.New("https://tc.example.com").
+		Get("^/downloadBuildLog.html$").
+		MatchParam("buildId", "42").
+		Reply(200).
+		BodyString("[Step 1/1] curl -u admin:hunter3 https://example.com")
+	gock.New("https://tc.example.com").
+		Get("^/app/rest/builds/id:42/artifacts/archived$").
+		Reply(200).
+		BodyString("TOKEN=hunter4")
+
+	s := &Source{}
+	sourcestest.Init(t, s, &sourcespb.TeamCity{
+		Endpoint:       "https://tc.example.com/",
+		Credential:     &sourcespb.TeamCity_Token{Token: "tc-token"},
+		IgnoreProjects: []string{"Sand*"},
+		MaxBuilds:      1,
+	}, func() *http.Client { return s.client })
+
+	chunksCh := make(chan *sources.Chunk, 100)
+	assert.NoError(t, s.Chunks(context.Background(), chunksCh))
+	close(chunksCh)
+
+	files := make(map[string]*source_metadatapb.TeamCity)
+	var data []string
+	for chunk := range chunksCh {
+		meta := chunk.SourceMetadata.GetTeamcity()
+		assert.Equal(t, "Web", meta.Project)
+		files[meta.File] = meta
+		data = append(data, strings.TrimRight(string(chunk.Data), "\x00"))
+	}
+	joined := strings.Join(data, "\n")
+	for _, secret := range []string{"hunter1", "hunter2", "hunter3", "hunter4", "hunter5"} {
+		assert.Contains(t, joined, secret)
+	}
+	if log := files["build log"]; assert.NotNil(t, log) {
+		assert.Equal(t, "Web_Deploy", log.BuildType)
+		assert.Equal(t, int64(42), log.BuildId)
+		assert.Equal(t, "1.0.42", log.BuildNumber)
+		assert.Equal(t, "2022-12-02T16:53:20Z", log.Timestamp)
+	}
+	if settings := files[".teamcity/settings.kts"]; assert.NotNil(t, settings) {
+		assert.Contains(t, settings.Email, "ci@example.com")
+		assert.NotEmpty(t, settings.Commit)
+	}
+	assert.True(t, gock.IsDone())
+}
+
+func TestSource_Selected(t *testing.T) {
+	s := &Source{}
+	sourcestest.Init(t, s, &sourcespb.TeamCity{
+		Endpoint:       "https://tc.example.com",
+		Credential:     &sourcespb.TeamCity_Token{Token: "tc-token"},
+		Projects:       []string{"Web*", "Payments"},
+		IgnoreProjects: []string{"Web_Legacy"},
+	}, func() *http.Client { return s.client })
+	assert.True(t, s.selected(project{ID: "Web_Api", Name: "API"}))
+	assert.True(t, s.selected(project{ID: "Pay", Name: "Payments"}))
+	assert.False(t, s.selected(project{ID: "Web_Legacy", Name: "Legacy"}))
+	assert.False(t, s.selected(project{ID: "Mobile", Name: "Mobile"}))
+}
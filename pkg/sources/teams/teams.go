@@ -0,0 +1,377 @@
+package teams
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"sync/atomic"
+
+	"github.com/go-errors/errors"
+	"github.com/go-logr/logr"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/msgraph"
+)
+
+type Source struct {
+	name     string
+	sourceId int64
+	jobId    int64
+	verify   bool
+	teams,
+	ignoreTeams,
+	channels,
+	ignoreChannels []string
+	includeChats bool
+	client       *msgraph.Client
+	state        *msgraph.DeltaState
+	jobPool      *errgroup.Group
+	log          logr.Logger
+	sources.Progress
+}
+
+// Ensure the Source satisfies the interface at compile time.
+var _ sources.Source = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return sourcespb.SourceType_SOURCE_TYPE_TEAMS
+}
+
+func (s *Source) SourceID() int64 {
+	return s.sourceId
+}
+
+func (s *Source) JobID() int64 {
+	return s.jobId
+}
+
+// Init returns an initialized Teams source.
+func (s *Source) Init(aCtx context.Context, name string, jobId, sourceId int64, verify bool, connection *anypb.Any, concurrency int) error {
+	s.log = context.WithValues(aCtx, "source", s.Type(), "name", name).Logger()
+
+	s.name = name
+	s.sourceId = sourceId
+	s.jobId = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	var conn sourcespb.Teams
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return errors.WrapPrefix(err, "error unmarshalling connection", 0)
+	}
+
+	var err error
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.Teams_Token:
+		s.client, err = msgraph.NewClient(aCtx, conn.GetEndpoint(), cred.Token, nil)
+	case *sourcespb.Teams_Authenticated:
+		s.client, err = msgraph.NewClient(aCtx, conn.GetEndpoint(), "", cred.Authenticated)
+	default:
+		return errors.Errorf("invalid configuration given for %s source", name)
+	}
+	if err != nil {
+		return errors.WrapPrefix(err, "could not create Graph client", 0)
+	}
+
+	s.teams = conn.GetTeams()
+	if conn.GetTeamId() != "" {
+		s.teams = append(s.teams, conn.GetTeamId())
+	}
+	s.ignoreTeams = conn.GetIgnoreTeams()
+	s.channels = conn.GetChannels()
+	s.ignoreChannels = conn.GetIgnoreList()
+	s.includeChats = conn.GetIncludeChats()
+
+	s.state, err = msgraph.LoadDeltaState(conn.GetDeltaStateFile())
+	if err != nil {
+		return errors.WrapPrefix(err, "could not load delta state", 0)
+	}
+
+	return nil
+}
+
+type team struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"displayName"`
+}
+
+type channel struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"displayName"`
+	team        team
+}
+
+type chat struct {
+	ID    string `json:"id"`
+	Topic string `json:"topic"`
+}
+
+type message struct {
+	ID                   string `json:"id"`
+	CreatedDateTime      string `json:"createdDateTime"`
+	LastModifiedDateTime string `json:"lastModifiedDateTime"`
+	WebURL               string `json:"webUrl"`
+	From                 struct {
+		User struct {
+			ID          string `json:"id"`
+			DisplayName string `json:"displayName"`
+		} `json:"user"`
+	} `json:"from"`
+	Body struct {
+		Content string `json:"content"`
+	} `json:"body"`
+	Attachments []struct {
+		Name    string `json:"name"`
+		Content string `json:"content"`
+	} `json:"attachments"`
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) error {
+	teams, err := s.listTeams(ctx)
+	if err != nil {
+		return fmt.Errorf("error listing teams: %w", err)
+	}
+
+	var channels []channel
+	for _, t := range teams {
+		_, err := msgraph.List(ctx, s.client, fmt.Sprintf("teams/%s/channels", t.ID), func(page []channel) error {
+			for _, ch := range page {
+				ch.team = t
+				if !matchesFilters(s.channels, s.ignoreChannels, ch.ID, ch.DisplayName) {
+					s.log.V(2).Info("skipping channel", "team", t.DisplayName, "channel", ch.DisplayName)
+					continue
+				}
+				channels = append(channels, ch)
+			}
+			return nil
+		})
+		if err != nil {
+			s.log.Error(err, "could not list channels", "team", t.DisplayName)
+		}
+	}
+
+	var scanned uint64
+	for i, ch := range channels {
+		if common.IsDone(ctx) {
+			break
+		}
+		ch := ch
+		s.SetProgressComplete(i, len(channels), fmt.Sprintf("Channel: %s/%s", ch.team.DisplayName, ch.DisplayName), "")
+		s.jobPool.Go(func() error {
+			if err := s.scanChannel(ctx, ch, chunksChan); err != nil {
+				s.log.Error(err, "error scanning channel", "team", ch.team.DisplayName, "channel", ch.DisplayName)
+				return nil
+			}
+			atomic.AddUint64(&scanned, 1)
+			return nil
+		})
+	}
+	_ = s.jobPool.Wait()
+
+	if s.includeChats {
+		if err := s.scanChats(ctx, chunksChan); err != nil {
+			s.log.Error(err, "error scanning chats")
+		}
+	}
+
+	if err := s.state.Save(); err != nil {
+		s.log.Error(err, "could not save delta state")
+	}
+	s.SetProgressComplete(len(channels), len(channels), fmt.Sprintf("Completed scanning source %s. %d channels scanned.", s.name, scanned), "")
+	return nil
+}
+
+func (s *Source) listTeams(ctx context.Context) ([]team, error) {
+	var teams []team
+	// Teams given explicitly by ID can be fetched directly, avoiding a tenant-wide listing.
+	if len(s.teams) > 0 && allIDs(s.teams) {
+		for _, id := range s.teams {
+			var t team
+			if err := s.client.Get(ctx, "teams/"+id, &t); err != nil {
+				return nil, err
+			}
+			if matchesFilters(nil, s.ignoreTeams, t.ID, t.DisplayName) {
+				teams = append(teams, t)
+			}
+		}
+		return teams, nil
+	}
+
+	query := url.Values{}
+	query.Set("$filter", "resourceProvisioningOptions/Any(x:x eq 'Team')")
+	query.Set("$select", "id,displayName")
+	_, err := msgraph.List(ctx, s.client, "groups?"+query.Encode(), func(page []team) error {
+		for _, t := range page {
+			if matchesFilters(s.teams, s.ignoreTeams, t.ID, t.DisplayName) {
+				teams = append(teams, t)
+			} else {
+				s.log.V(2).Info("skipping team", "team", t.DisplayName)
+			}
+		}
+		return nil
+	})
+	return teams, err
+}
+
+// scanChannel scans the messages of a channel that changed since the previous
+// run, using the stored delta link when one is available.
+func (s *Source) scanChannel(ctx context.Context, ch channel, chunksChan chan *sources.Chunk) error {
+	key := ch.team.ID + "/" + ch.ID
+	start := s.state.Get(key)
+	if start == "" {
+		start = fmt.Sprintf("teams/%s/channels/%s/messages/delta", ch.team.ID, ch.ID)
+	}
+
+	deltaLink, err := msgraph.List(ctx, s.client, start, func(page []message) error {
+		for _, msg := range page {
+			s.chunkMessage(ctx, chunksChan, msg, s.channelMetadata(ch))
+			repliesPath := fmt.Sprintf("teams/%s/channels/%s/messages/%s/replies", ch.team.ID, ch.ID, msg.ID)
+			_, err := msgraph.List(ctx, s.client, repliesPath, func(replies []message) error {
+				for _, reply := range replies {
+					s.chunkMessage(ctx, chunksChan, reply, s.channelMetadata(ch))
+				}
+				return nil
+			})
+			if err != nil {
+				s.log.Error(err, "could not list replies", "channel", ch.DisplayName, "message", msg.ID)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if deltaLink != "" {
+		s.state.Set(key, deltaLink)
+	}
+	return nil
+}
+
+// scanChats scans the chats visible to the signed in user. Chats do not
+// support delta queries, so the latest modification time seen is stored
+// instead and used as a filter on the next run.
+func (s *Source) scanChats(ctx context.Context, chunksChan chan *sources.Chunk) error {
+	var chats []chat
+	if _, err := msgraph.List(ctx, s.client, "chats", func(page []chat) error {
+		chats = append(chats, page...)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	for _, c := range chats {
+		if common.IsDone(ctx) {
+			return nil
+		}
+		key := "chat/" + c.ID
+		query := url.Values{}
+		query.Set("$orderby", "lastModifiedDateTime desc")
+		if since := s.state.Get(key); since != "" {
+			query.Set("$filter", fmt.Sprintf("lastModifiedDateTime gt %s", since))
+		}
+
+		latest := ""
+		_, err := msgraph.List(ctx, s.client, fmt.Sprintf("chats/%s/messages?%s", c.ID, query.Encode()), func(page []message) error {
+			for _, msg := range page {
+				if msg.LastModifiedDateTime > latest {
+					latest = msg.LastModifiedDateTime
+				}
+				s.chunkMessage(ctx, chunksChan, msg, &source_metadatapb.Teams{
+					ChatId:      c.ID,
+					ChannelName: sanitizer.UTF8(c.Topic),
+				})
+			}
+			return nil
+		})
+		if err != nil {
+			s.log.Error(err, "could not list chat messages", "chat", c.ID)
+			continue
+		}
+		if latest != "" {
+			s.state.Set(key, latest)
+		}
+	}
+	return nil
+}
+
+func (s *Source) channelMetadata(ch channel) *source_metadatapb.Teams {
+	return &source_metadatapb.Teams{
+		ChannelId:   ch.ID,
+		ChannelName: sanitizer.UTF8(ch.DisplayName),
+		TeamId:      ch.team.ID,
+		TeamName:    sanitizer.UTF8(ch.team.DisplayName),
+	}
+}
+
+func (s *Source) chunkMessage(ctx context.Context, chunksChan chan *sources.Chunk, msg message, meta *source_metadatapb.Teams) {
+	var data strings.Builder
+	data.WriteString(msg.Body.Content)
+	for _, attachment := range msg.Attachments {
+		if attachment.Content == "" {
+			continue
+		}
+		data.WriteString("\n")
+		data.WriteString(attachment.Content)
+	}
+	if strings.TrimSpace(data.String()) == "" {
+		return
+	}
+
+	meta.Timestamp = msg.CreatedDateTime
+	meta.UserId = msg.From.User.ID
+	meta.Link = sanitizer.UTF8(msg.WebURL)
+
+	chunk := &sources.Chunk{
+		SourceType: s.Type(),
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		Data:       []byte(data.String()),
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Teams{Teams: meta},
+		},
+		Verify: s.verify,
+	}
+	select {
+	case chunksChan <- chunk:
+	case <-ctx.Done():
+	}
+}
+
+// matchesFilters reports whether an item, identified by ID or display name,
+// is in the include list (or the include list is empty) and not excluded.
+func matchesFilters(include, exclude []string, id, name string) bool {
+	contains := func(list []string) bool {
+		for _, item := range list {
+			if strings.EqualFold(item, id) || strings.EqualFold(item, name) {
+				return true
+			}
+		}
+		return false
+	}
+	if contains(exclude) {
+		return false
+	}
+	return len(include) == 0 || contains(include)
+}
+
+// allIDs reports whether every item looks like a Graph object ID (a GUID).
+func allIDs(items []string) bool {
+	for _, item := range items {
+		if len(item) != 36 || strings.Count(item, "-") != 4 {
+			return false
+		}
+	}
+	return true
+}
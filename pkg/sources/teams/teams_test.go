@@ -0,0 +1,89 @@
+package teams
+
+import (
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/msgraph"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/sourcestest"
+)
+
+const teamID = "11111111-2222-3333-4444-555555555555"
+
+func TestMatchesFilters(t *testing.T) {
+	assert.True(t, matchesFilters(nil, nil, "1", "General"))
+	assert.True(t, matchesFilters([]string{"general"}, nil, "1", "General"))
+	assert.False(t, matchesFilters([]string{"dev"}, nil, "1", "General"))
+	assert.False(t, matchesFilters(nil, []string{"1"}, "1", "General"))
+}
+
+func TestSource_Chunks(t *testing.T) {
+	defer gock.Off()
+
+	stateFile := filepath.Join(t.TempDir(), "state.json")
+
+	gock.New("https://graph.microsoft.com").
+		Get("/v1.0/teams/" + teamID).
+		Reply(200).
+		JSON(map[string]string{"id": teamID, "displayName": "Engineering"})
+	gock.New("https://graph.microsoft.com").
+		Get("/v1.0/teams/" + teamID + "/channels").
+		Reply(200).
+		JSON(map[string]interface{}{"value": []map[string]string{
+			{"id": "c1", "displayName": "General"},
+			{"id": "c2", "displayName": "Random"},
+		}})
+	gock.New("https://graph.microsoft.com").
+		Get("/v1.0/teams/" + teamID + "/channels/c1/messages/delta").
+		Reply(200).
+		JSON(map[string]interface{}{
+			"value": []map[string]interface{}{{
+				"id":              "m1",
+				"createdDateTime": "2023-01-01T00:00:00Z",
+				"webUrl":          "https://teams.microsoft.com/l/message/c1/m1",
+				"from":            map[string]interface{}{"user": map[string]string{"id": "u1"}},
+				"body":            map[string]string{"content": "password=hunter2"},
+			}},
+			"@odata.deltaLink": "https://graph.microsoft.com/v1.0/teams/" + teamID + "/channels/c1/messages/delta?$deltatoken=abc",
+		})
+	gock.New("https://graph.microsoft.com").
+		Get("/v1.0/teams/" + teamID + "/channels/c1/messages/m1/replies").
+		Reply(200).
+		JSON(map[string]interface{}{"value": []map[string]interface{}{{
+			"id":   "m2",
+			"body": map[string]string{"content": "reply"},
+		}}})
+
+	s := &Source{}
+	sourcestest.Init(t, s, &sourcespb.Teams{
+		Credential:     &sourcespb.Teams_Token{Token: "token"},
+		TeamId:         teamID,
+		IgnoreList:     []string{"Random"},
+		DeltaStateFile: stateFile,
+	}, func() *http.Client { return s.client.HTTPClient() })
+
+	chunksCh := make(chan *sources.Chunk, 10)
+	assert.NoError(t, s.Chunks(context.Background(), chunksCh))
+	close(chunksCh)
+
+	var data []string
+	for chunk := range chunksCh {
+		meta := chunk.SourceMetadata.GetTeams()
+		assert.Equal(t, "Engineering", meta.TeamName)
+		assert.Equal(t, "General", meta.ChannelName)
+		data = append(data, string(chunk.Data))
+	}
+	assert.Equal(t, []string{"password=hunter2", "reply"}, data)
+	assert.True(t, gock.IsDone())
+
+	state, err := msgraph.LoadDeltaState(stateFile)
+	assert.NoError(t, err)
+	assert.Contains(t, state.Get(teamID+"/c1"), "$deltatoken=abc")
+}
@@ -0,0 +1,458 @@
+package terraformcloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/go-errors/errors"
+	"github.com/go-logr/logr"
+	"github.com/gobwas/glob"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/tfstate"
+)
+
+const (
+	defaultEndpoint = "https://app.terraform.io/"
+	pageSize        = 100
+	// maxDownloadSize is the largest state file or plan log that will be read.
+	maxDownloadSize = 256 * 1024 * 1024 // 256MB
+)
+
+// secretLikeKey matches variable names that usually hold credentials.
+var secretLikeKey = regexp.MustCompile(`(?i)(passw(or)?d|secret|token|api_?key|access_?key|private_?key|credential|auth)`)
+
+type Source struct {
+	name             string
+	sourceId         int64
+	jobId            int64
+	verify           bool
+	endpoint         string
+	token            string
+	organizations    []string
+	workspaces       []glob.Glob
+	ignoreWorkspaces []glob.Glob
+	maxVersions      int
+	client           *http.Client
+	jobPool          *errgroup.Group
+	log              logr.Logger
+	sources.Progress
+}
+
+// Ensure the Source satisfies the interface at compile time.
+var _ sources.Source = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return sourcespb.SourceType_SOURCE_TYPE_TERRAFORM_CLOUD
+}
+
+func (s *Source) SourceID() int64 {
+	return s.sourceId
+}
+
+func (s *Source) JobID() int64 {
+	return s.jobId
+}
+
+// Init returns an initialized Terraform Cloud source.
+func (s *Source) Init(aCtx context.Context, name string, jobId, sourceId int64, verify bool, connection *anypb.Any, concurrency int) error {
+	s.log = context.WithValues(aCtx, "source", s.Type(), "name", name).Logger()
+
+	s.name = name
+	s.sourceId = sourceId
+	s.jobId = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	var conn sourcespb.TerraformCloud
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return errors.WrapPrefix(err, "error unmarshalling connection", 0)
+	}
+
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.TerraformCloud_Token:
+		s.token = cred.Token
+	default:
+		return errors.Errorf("invalid configuration given for %s source", name)
+	}
+
+	s.endpoint = conn.GetEndpoint()
+	if s.endpoint == "" {
+		s.endpoint = defaultEndpoint
+	}
+	if !strings.HasSuffix(s.endpoint, "/") {
+		s.endpoint += "/"
+	}
+	s.organizations = conn.GetOrganizations()
+
+	var err error
+	if s.workspaces, err = compileGlobs(conn.GetWorkspaces()); err != nil {
+		return err
+	}
+	if s.ignoreWorkspaces, err = compileGlobs(conn.GetIgnoreWorkspaces()); err != nil {
+		return err
+	}
+	s.maxVersions = int(conn.GetMaxVersions())
+	s.client = common.RetryableHttpClientTimeout(120)
+
+	return nil
+}
+
+func compileGlobs(patterns []string) ([]glob.Glob, error) {
+	globs := make([]glob.Glob, 0, len(patterns))
+	for _, pattern := range patterns {
+		g, err := glob.Compile(pattern)
+		if err != nil {
+			return nil, errors.WrapPrefix(err, fmt.Sprintf("invalid workspace pattern %q", pattern), 0)
+		}
+		globs = append(globs, g)
+	}
+	return globs, nil
+}
+
+// resource is a JSON:API resource object.
+type resource struct {
+	ID            string          `json:"id"`
+	Attributes    json.RawMessage `json:"attributes"`
+	Relationships map[string]struct {
+		Data json.RawMessage `json:"data"`
+	} `json:"relationships"`
+}
+
+type workspace struct {
+	id           string
+	name         string
+	organization string
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) error {
+	orgs := s.organizations
+	if len(orgs) == 0 {
+		var err error
+		if orgs, err = s.listOrganizations(ctx); err != nil {
+			return fmt.Errorf("error listing organizations: %w", err)
+		}
+	}
+
+	var scanned uint64
+	for i, org := range orgs {
+		if common.IsDone(ctx) {
+			break
+		}
+		s.SetProgressComplete(i, len(orgs), fmt.Sprintf("Organization: %s", org), "")
+
+		workspaces, err := s.listWorkspaces(ctx, org)
+		if err != nil {
+			s.log.Error(err, "could not list workspaces", "organization", org)
+			continue
+		}
+		for _, ws := range workspaces {
+			ws := ws
+			s.jobPool.Go(func() error {
+				if err := s.scanWorkspace(ctx, ws, chunksChan); err != nil {
+					s.log.Error(err, "could not scan workspace", "organization", ws.organization, "workspace", ws.name)
+					return nil
+				}
+				atomic.AddUint64(&scanned, 1)
+				return nil
+			})
+		}
+	}
+	_ = s.jobPool.Wait()
+
+	s.SetProgressComplete(len(orgs), len(orgs), fmt.Sprintf("Completed scanning source %s. %d workspaces scanned.", s.name, scanned), "")
+	return nil
+}
+
+func (s *Source) listOrganizations(ctx context.Context) ([]string, error) {
+	var orgs []string
+	err := s.list(ctx, "api/v2/organizations", nil, 0, func(r resource) error {
+		orgs = append(orgs, r.ID)
+		return nil
+	})
+	return orgs, err
+}
+
+func (s *Source) listWorkspaces(ctx context.Context, org string) ([]workspace, error) {
+	var workspaces []workspace
+	err := s.list(ctx, fmt.Sprintf("api/v2/organizations/%s/workspaces", url.PathEscape(org)), nil, 0, func(r resource) error {
+		var attrs struct {
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal(r.Attributes, &attrs); err != nil {
+			return err
+		}
+		if s.shouldScan(attrs.Name) {
+			workspaces = append(workspaces, workspace{id: r.ID, name: attrs.Name, organization: org})
+		}
+		return nil
+	})
+	return workspaces, err
+}
+
+func (s *Source) shouldScan(name string) bool {
+	for _, g := range s.ignoreWorkspaces {
+		if g.Match(name) {
+			return false
+		}
+	}
+	if len(s.workspaces) == 0 {
+		return true
+	}
+	for _, g := range s.workspaces {
+		if g.Match(name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Source) scanWorkspace(ctx context.Context, ws workspace, chunksChan chan *sources.Chunk) error {
+	if err := s.scanVariables(ctx, ws, chunksChan); err != nil {
+		s.log.Error(err, "could not scan workspace variables", "workspace", ws.name)
+	}
+	if err := s.scanStateVersions(ctx, ws, chunksChan); err != nil {
+		s.log.Error(err, "could not scan state versions", "workspace", ws.name)
+	}
+	if err := s.scanRuns(ctx, ws, chunksChan); err != nil {
+		s.log.Error(err, "could not scan runs", "workspace", ws.name)
+	}
+	return nil
+}
+
+// scanVariables scans the values of workspace variables. Values of variables
+// marked sensitive are never returned by the API, so anything found here is
+// readable by every workspace member.
+func (s *Source) scanVariables(ctx context.Context, ws workspace, chunksChan chan *sources.Chunk) error {
+	return s.list(ctx, fmt.Sprintf("api/v2/workspaces/%s/vars", ws.id), nil, 0, func(r resource) error {
+		var attrs struct {
+			Key       string `json:"key"`
+			Value     string `json:"value"`
+			Sensitive bool   `json:"sensitive"`
+			Category  string `json:"category"`
+		}
+		if err := json.Unmarshal(r.Attributes, &attrs); err != nil {
+			return err
+		}
+		if attrs.Sensitive || attrs.Value == "" {
+			return nil
+		}
+		unmarked := secretLikeKey.MatchString(attrs.Key)
+		if unmarked {
+			s.log.Info("workspace variable looks like a secret but is not marked sensitive",
+				"organization", ws.organization, "workspace", ws.name, "variable", attrs.Key)
+		}
+		meta := s.metadata(ws, fmt.Sprintf("%s variable %s", attrs.Category, attrs.Key), "variables")
+		meta.UnmarkedSecret = unmarked
+		return s.send(ctx, meta, []byte(fmt.Sprintf("%s = %s\n", attrs.Key, attrs.Value)), chunksChan)
+	})
+}
+
+// scanStateVersions scans the workspace's state versions from newest to oldest.
+func (s *Source) scanStateVersions(ctx context.Context, ws workspace, chunksChan chan *sources.Chunk) error {
+	query := url.Values{
+		"filter[workspace][name]":    {ws.name},
+		"filter[organization][name]": {ws.organization},
+	}
+	return s.list(ctx, "api/v2/state-versions", query, s.maxVersions, func(r resource) error {
+		var attrs struct {
+			Serial      int64  `json:"serial"`
+			DownloadURL string `json:"hosted-state-download-url"`
+		}
+		if err := json.Unmarshal(r.Attributes, &attrs); err != nil {
+			return err
+		}
+		if attrs.DownloadURL == "" {
+			return nil
+		}
+		data, err := s.download(ctx, attrs.DownloadURL)
+		if err != nil {
+			s.log.Error(err, "could not download state version", "workspace", ws.name, "state_version", r.ID)
+			return nil
+		}
+
+		object := fmt.Sprintf("state version %s (serial %d)", r.ID, attrs.Serial)
+		entries, err := tfstate.ParseState(data)
+		if err != nil {
+			entries = []tfstate.Entry{{Data: data}}
+		}
+		for _, e := range entries {
+			meta := s.metadata(ws, object, "states/"+r.ID)
+			meta.Address = sanitizer.UTF8(e.Address)
+			meta.Sensitive = e.Sensitive
+			if err := s.send(ctx, meta, e.Data, chunksChan); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// scanRuns scans the plan logs of the workspace's most recent runs, which
+// include the output of provisioners and any values echoed by the plan.
+func (s *Source) scanRuns(ctx context.Context, ws workspace, chunksChan chan *sources.Chunk) error {
+	return s.list(ctx, fmt.Sprintf("api/v2/workspaces/%s/runs", ws.id), nil, s.maxVersions, func(r resource) error {
+		plan, ok := r.Relationships["plan"]
+		if !ok {
+			return nil
+		}
+		var ref struct {
+			ID string `json:"id"`
+		}
+		if err := json.Unmarshal(plan.Data, &ref); err != nil || ref.ID == "" {
+			return nil
+		}
+
+		var res struct {
+			Data resource `json:"data"`
+		}
+		if err := s.getJSON(ctx, s.endpoint+"api/v2/plans/"+url.PathEscape(ref.ID), &res); err != nil {
+			s.log.Error(err, "could not get plan", "workspace", ws.name, "run", r.ID)
+			return nil
+		}
+		var attrs struct {
+			LogReadURL string `json:"log-read-url"`
+		}
+		if err := json.Unmarshal(res.Data.Attributes, &attrs); err != nil || attrs.LogReadURL == "" {
+			return nil
+		}
+		data, err := s.download(ctx, attrs.LogReadURL)
+		if err != nil {
+			s.log.Error(err, "could not download plan log", "workspace", ws.name, "run", r.ID)
+			return nil
+		}
+		return s.send(ctx, s.metadata(ws, fmt.Sprintf("plan log %s", r.ID), "runs/"+r.ID), data, chunksChan)
+	})
+}
+
+func (s *Source) metadata(ws workspace, object, page string) *source_metadatapb.TerraformCloud {
+	return &source_metadatapb.TerraformCloud{
+		Organization: sanitizer.UTF8(ws.organization),
+		Workspace:    sanitizer.UTF8(ws.name),
+		Object:       sanitizer.UTF8(object),
+		Link:         sanitizer.UTF8(fmt.Sprintf("%sapp/%s/workspaces/%s/%s", s.endpoint, ws.organization, ws.name, page)),
+	}
+}
+
+func (s *Source) send(ctx context.Context, meta *source_metadatapb.TerraformCloud, data []byte, chunksChan chan *sources.Chunk) error {
+	chunk := &sources.Chunk{
+		SourceType: s.Type(),
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		Data:       data,
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_TerraformCloud{
+				TerraformCloud: meta,
+			},
+		},
+		Verify: s.verify,
+	}
+	for c := range sources.Chunker(chunk) {
+		select {
+		case chunksChan <- c:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// list walks every page of a JSON:API collection, stopping after limit
+// resources when it is set.
+func (s *Source) list(ctx context.Context, path string, query url.Values, limit int, fn func(resource) error) error {
+	if query == nil {
+		query = url.Values{}
+	}
+	size := pageSize
+	if limit > 0 && limit < size {
+		size = limit
+	}
+	query.Set("page[size]", strconv.Itoa(size))
+
+	seen := 0
+	for page := 1; ; page++ {
+		query.Set("page[number]", strconv.Itoa(page))
+		var res struct {
+			Data []resource `json:"data"`
+			Meta struct {
+				Pagination struct {
+					NextPage *int `json:"next-page"`
+				} `json:"pagination"`
+			} `json:"meta"`
+		}
+		if err := s.getJSON(ctx, s.endpoint+path+"?"+query.Encode(), &res); err != nil {
+			return err
+		}
+		for _, r := range res.Data {
+			if err := fn(r); err != nil {
+				return err
+			}
+			seen++
+			if limit > 0 && seen >= limit {
+				return nil
+			}
+		}
+		if res.Meta.Pagination.NextPage == nil || len(res.Data) == 0 {
+			return nil
+		}
+	}
+}
+
+func (s *Source) getJSON(ctx context.Context, u string, out interface{}) error {
+	res, err := s.get(ctx, u)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	return json.NewDecoder(res.Body).Decode(out)
+}
+
+func (s *Source) download(ctx context.Context, u string) ([]byte, error) {
+	res, err := s.get(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	return io.ReadAll(io.LimitReader(res.Body, maxDownloadSize))
+}
+
+func (s *Source) get(ctx context.Context, u string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	// Plan logs are served from presigned URLs on another host, which must
+	// not receive the API token.
+	if strings.HasPrefix(u, s.endpoint) {
+		req.Header.Set("Authorization", "Bearer "+s.token)
+		req.Header.Set("Content-Type", "application/vnd.api+json")
+	}
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		res.Body.Close()
+		return nil, fmt.Errorf("unexpected status code %d requesting %s", res.StatusCode, req.URL.Path)
+	}
+	return res, nil
+}
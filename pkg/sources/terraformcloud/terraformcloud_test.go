@@ -0,0 +1,112 @@
+package terraformcloud
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/sourcestest"
+)
+
+func TestSource_Chunks(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://app.terraform.io").
+		Get("/api/v2/organizations/acme/workspaces").
+		MatchHeader("Authorization", "Bearer tfc-token").
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": []map[string]interface{}{
+				{"id": "ws-prod", "attributes": map[string]string{"name": "prod"}},
+				{"id": "ws-sandbox", "attributes": map[string]string{"name": "sandbox-1"}},
+			},
+			"meta": map[string]interface{}{"pagination": map[string]interface{}{"next-page": nil}},
+		})
+	gock.New("https://app.terraform.io").
+		Get("/api/v2/workspaces/ws-prod/vars").
+		Reply(200).
+		JSON(map[string]interface{}{"data": []map[string]interface{}{
+			{"id": "var-1", "attributes": map[string]interface{}{"key": "DB_PASSWORD", "value": "hunter2", "sensitive": false, "category": "env"}},
+			{"id": "var-2", "attributes": map[string]interface{}{"key": "api_token", "value": nil, "sensitive": true, "category": "terraform"}},
+			{"id": "var-3", "attributes": map[string]interface{}{"key": "region", "value": "us-east-1", "sensitive": false, "category": "terraform"}},
+		}})
+	gock.New("https://app.terraform.io").
+		Get("/api/v2/state-versions").
+		MatchParam("filter[workspace][name]", "prod").
+		MatchParam("filter[organization][name]", "acme").
+		MatchParam("page[size]", "1").
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": []map[string]interface{}{
+				{"id": "sv-2", "attributes": map[string]interface{}{"serial": 2, "hosted-state-download-url": "https://app.terraform.io/api/state-versions/sv-2/download"}},
+			},
+			"meta": map[string]interface{}{"pagination": map[string]interface{}{"next-page": 2}},
+		})
+	gock.New("https://app.terraform.io").
+		Get("/api/state-versions/sv-2/download").
+		MatchHeader("Authorization", "Bearer tfc-token").
+		Reply(200).
+		BodyString(`{"version": 4, "outputs": {"admin_password": {"value": "hunter3", "sensitive": true}}}`)
+	gock.New("https://app.terraform.io").
+		Get("/api/v2/workspaces/ws-prod/runs").
+		Reply(200).
+		JSON(map[string]interface{}{"data": []map[string]interface{}{
+			{"id": "run-1", "relationships": map[string]interface{}{"plan": map[string]interface{}{"data": map[string]string{"id": "plan-1", "type": "plans"}}}},
+		}})
+	gock.New("https://app.terraform.io").
+		Get("/api/v2/plans/plan-1").
+		Reply(200).
+		JSON(map[string]interface{}{"data": map[string]interface{}{
+			"id":         "plan-1",
+			"attributes": map[string]string{"log-read-url": "https://archivist.terraform.io/v1/object/plan-1"},
+		}})
+	gock.New("https://archivist.terraform.io").
+		Get("/v1/object/plan-1").
+		Filter(func(req *http.Request) bool { return req.Header.Get("Authorization") == "" }).
+		Reply(200).
+		BodyString("null_resource.x: Provisioning with 'local-exec'... token=abc123")
+
+	s := &Source{}
+	sourcestest.Init(t, s, &sourcespb.TerraformCloud{
+		Credential:       &sourcespb.TerraformCloud_Token{Token: "tfc-token"},
+		Organizations:    []string{"acme"},
+		IgnoreWorkspaces: []string{"sandbox-*"},
+		MaxVersions:      1,
+	}, func() *http.Client { return s.client })
+
+	chunksCh := make(chan *sources.Chunk, 10)
+	assert.NoError(t, s.Chunks(context.Background(), chunksCh))
+	close(chunksCh)
+
+	chunks := make(map[string]*source_metadatapb.TerraformCloud)
+	var data []string
+	for chunk := range chunksCh {
+		meta := chunk.SourceMetadata.GetTerraformCloud()
+		assert.Equal(t, "acme", meta.Organization)
+		assert.Equal(t, "prod", meta.Workspace)
+		chunks[meta.Object] = meta
+		data = append(data, strings.TrimSpace(string(chunk.Data)))
+	}
+	assert.ElementsMatch(t, []string{
+		"DB_PASSWORD = hunter2",
+		"region = us-east-1",
+		"# output.admin_password\nvalue = hunter3",
+		"null_resource.x: Provisioning with 'local-exec'... token=abc123",
+	}, data)
+	assert.True(t, chunks["env variable DB_PASSWORD"].UnmarkedSecret)
+	assert.False(t, chunks["terraform variable region"].UnmarkedSecret)
+	state := chunks["state version sv-2 (serial 2)"]
+	if assert.NotNil(t, state) {
+		assert.Equal(t, "output.admin_password", state.Address)
+		assert.True(t, state.Sensitive)
+		assert.Equal(t, "https://app.terraform.io/app/acme/workspaces/prod/states/sv-2", state.Link)
+	}
+	assert.True(t, gock.IsDone())
+}
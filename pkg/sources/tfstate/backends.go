@@ -0,0 +1,414 @@
+package tfstate
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure/auth"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"golang.org/x/oauth2/google"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+// object is a single state file found at a location.
+type object struct {
+	name string
+	link string
+	read func(ctx context.Context) ([]byte, error)
+}
+
+// isStateFile matches state files, their backups and the workspace keys of
+// backends that append the workspace name to the key (ex: azurerm).
+func isStateFile(name string) bool {
+	return strings.Contains(path.Base(name), ".tfstate")
+}
+
+// list resolves a location to the state files it contains. Remote locations
+// are treated as prefixes so that every workspace under a backend key is found.
+func (s *Source) list(ctx context.Context, location string) ([]object, error) {
+	if !strings.Contains(location, "://") {
+		return s.listLocal(location)
+	}
+	u, err := url.Parse(location)
+	if err != nil {
+		return nil, fmt.Errorf("invalid location %q: %w", location, err)
+	}
+	key := strings.TrimPrefix(u.Path, "/")
+	switch u.Scheme {
+	case "file":
+		return s.listLocal(u.Path)
+	case "s3":
+		return s.listS3(ctx, u.Host, key)
+	case "gs":
+		return s.listGCS(ctx, u.Host, key)
+	case "azurerm":
+		container, blob, _ := strings.Cut(key, "/")
+		return s.listAzure(ctx, u.Host, container, blob)
+	case "consul":
+		return s.listConsul(ctx, "http", u.Host, key)
+	case "consul+https":
+		return s.listConsul(ctx, "https", u.Host, key)
+	default:
+		return nil, fmt.Errorf("unsupported location %q", location)
+	}
+}
+
+func readAll(r io.Reader) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(r, maxStateSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxStateSize {
+		return nil, fmt.Errorf("state file is larger than %d bytes", maxStateSize)
+	}
+	return data, nil
+}
+
+func (s *Source) listLocal(root string) ([]object, error) {
+	info, err := os.Stat(root)
+	if err != nil {
+		return nil, err
+	}
+	readFile := func(name string) func(context.Context) ([]byte, error) {
+		return func(context.Context) ([]byte, error) {
+			f, err := os.Open(name)
+			if err != nil {
+				return nil, err
+			}
+			defer f.Close()
+			return readAll(f)
+		}
+	}
+	if !info.IsDir() {
+		return []object{{name: root, read: readFile(root)}}, nil
+	}
+
+	var objects []object
+	err = filepath.WalkDir(root, func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			s.log.V(2).Info("could not walk path", "path", name, "error", err.Error())
+			return nil
+		}
+		if d.Type().IsRegular() && isStateFile(name) {
+			objects = append(objects, object{name: name, read: readFile(name)})
+		}
+		return nil
+	})
+	return objects, err
+}
+
+func (s *Source) newS3Client(region string) (*s3.S3, error) {
+	cfg := aws.NewConfig()
+	cfg.CredentialsChainVerboseErrors = aws.Bool(true)
+	cfg.Region = aws.String(region)
+	if !s.cloudEnvironment {
+		cfg.Credentials = credentials.AnonymousCredentials
+	}
+	sess, err := session.NewSessionWithOptions(session.Options{
+		SharedConfigState: session.SharedConfigEnable,
+		Config:            *cfg,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s3.New(sess), nil
+}
+
+func (s *Source) listS3(ctx context.Context, bucket, prefix string) ([]object, error) {
+	const defaultAWSRegion = "us-east-1"
+
+	client, err := s.newS3Client(defaultAWSRegion)
+	if err != nil {
+		return nil, err
+	}
+	region, err := s3manager.GetBucketRegionWithClient(ctx, client, bucket)
+	if err != nil {
+		return nil, fmt.Errorf("could not get region of bucket %s: %w", bucket, err)
+	}
+	if region != defaultAWSRegion {
+		if client, err = s.newS3Client(region); err != nil {
+			return nil, err
+		}
+	}
+
+	var objects []object
+	err = client.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(prefix),
+	}, func(page *s3.ListObjectsV2Output, _ bool) bool {
+		for _, obj := range page.Contents {
+			key := aws.StringValue(obj.Key)
+			if !isStateFile(key) {
+				continue
+			}
+			objects = append(objects, object{
+				name: fmt.Sprintf("s3://%s/%s", bucket, key),
+				link: fmt.Sprintf("https://s3.console.aws.amazon.com/s3/object/%s?region=%s&prefix=%s", bucket, region, url.QueryEscape(key)),
+				read: func(ctx context.Context) ([]byte, error) {
+					res, err := client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+						Bucket: aws.String(bucket),
+						Key:    aws.String(key),
+					})
+					if err != nil {
+						return nil, err
+					}
+					defer res.Body.Close()
+					return readAll(res.Body)
+				},
+			})
+		}
+		return true
+	})
+	return objects, err
+}
+
+func (s *Source) listGCS(ctx context.Context, bucket, prefix string) ([]object, error) {
+	base := fmt.Sprintf("%sstorage/v1/b/%s/o", s.gcsEndpoint, url.PathEscape(bucket))
+
+	var objects []object
+	pageToken := ""
+	for {
+		query := url.Values{"prefix": {prefix}, "fields": {"items(name),nextPageToken"}}
+		if pageToken != "" {
+			query.Set("pageToken", pageToken)
+		}
+		var res struct {
+			Items []struct {
+				Name string `json:"name"`
+			} `json:"items"`
+			NextPageToken string `json:"nextPageToken"`
+		}
+		data, err := s.get(ctx, base+"?"+query.Encode(), s.googleAuth)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, &res); err != nil {
+			return nil, err
+		}
+		for _, item := range res.Items {
+			if !isStateFile(item.Name) {
+				continue
+			}
+			download := base + "/" + url.PathEscape(item.Name) + "?alt=media"
+			objects = append(objects, object{
+				name: fmt.Sprintf("gs://%s/%s", bucket, item.Name),
+				link: fmt.Sprintf("https://console.cloud.google.com/storage/browser/_details/%s/%s", bucket, item.Name),
+				read: func(ctx context.Context) ([]byte, error) {
+					return s.get(ctx, download, s.googleAuth)
+				},
+			})
+		}
+		if res.NextPageToken == "" {
+			return objects, nil
+		}
+		pageToken = res.NextPageToken
+	}
+}
+
+// googleAuth uses the application default credentials, like the gcs backend.
+func (s *Source) googleAuth(ctx context.Context, req *http.Request) error {
+	if !s.cloudEnvironment {
+		return nil
+	}
+	s.authMu.Lock()
+	defer s.authMu.Unlock()
+	if s.googleTokens == nil {
+		ts, err := google.DefaultTokenSource(ctx, "https://www.googleapis.com/auth/devstorage.read_only")
+		if err != nil {
+			return err
+		}
+		s.googleTokens = ts
+	}
+	token, err := s.googleTokens.Token()
+	if err != nil {
+		return err
+	}
+	token.SetAuthHeader(req)
+	return nil
+}
+
+func (s *Source) listAzure(ctx context.Context, account, container, prefix string) ([]object, error) {
+	base := fmt.Sprintf(s.azureEndpoint, account) + url.PathEscape(container)
+
+	var objects []object
+	marker := ""
+	for {
+		query := url.Values{"restype": {"container"}, "comp": {"list"}, "prefix": {prefix}}
+		if marker != "" {
+			query.Set("marker", marker)
+		}
+		var res struct {
+			Blobs []struct {
+				Name string `xml:"Name"`
+			} `xml:"Blobs>Blob"`
+			NextMarker string `xml:"NextMarker"`
+		}
+		data, err := s.get(ctx, s.withSASToken(base+"?"+query.Encode()), s.azureAuth)
+		if err != nil {
+			return nil, err
+		}
+		if err := xml.Unmarshal(data, &res); err != nil {
+			return nil, err
+		}
+		for _, blob := range res.Blobs {
+			if !isStateFile(blob.Name) {
+				continue
+			}
+			link := base + "/" + (&url.URL{Path: blob.Name}).EscapedPath()
+			objects = append(objects, object{
+				name: fmt.Sprintf("azurerm://%s/%s/%s", account, container, blob.Name),
+				link: link,
+				read: func(ctx context.Context) ([]byte, error) {
+					return s.get(ctx, s.withSASToken(link), s.azureAuth)
+				},
+			})
+		}
+		if res.NextMarker == "" {
+			return objects, nil
+		}
+		marker = res.NextMarker
+	}
+}
+
+func (s *Source) withSASToken(u string) string {
+	if s.azureSASToken == "" {
+		return u
+	}
+	sep := "?"
+	if strings.Contains(u, "?") {
+		sep = "&"
+	}
+	return u + sep + strings.TrimPrefix(s.azureSASToken, "?")
+}
+
+// azureAuth uses the credentials from the environment (ARM_* or AZURE_*
+// variables, managed identity or the Azure CLI) unless a SAS token is set.
+func (s *Source) azureAuth(_ context.Context, req *http.Request) error {
+	req.Header.Set("x-ms-version", "2020-04-08")
+	if s.azureSASToken != "" || !s.cloudEnvironment {
+		return nil
+	}
+	s.authMu.Lock()
+	defer s.authMu.Unlock()
+	if s.azureAuthorizer == nil {
+		authorizer, err := auth.NewAuthorizerFromEnvironmentWithResource("https://storage.azure.com/")
+		if err != nil {
+			authorizer, err = auth.NewAuthorizerFromCLIWithResource("https://storage.azure.com/")
+		}
+		if err != nil {
+			return err
+		}
+		s.azureAuthorizer = authorizer
+	}
+	_, err := autorest.Prepare(req, s.azureAuthorizer.WithAuthorization())
+	return err
+}
+
+// listConsul reads the state stored under a Consul KV path. Workspaces are
+// stored next to it as "<path>-env:<name>", so the path is read recursively.
+func (s *Source) listConsul(ctx context.Context, scheme, host, key string) ([]object, error) {
+	base := fmt.Sprintf("%s://%s/v1/kv/", scheme, host)
+	data, err := s.get(ctx, base+key+"?recurse=true", s.consulAuth)
+	if err != nil {
+		return nil, err
+	}
+	var pairs []struct {
+		Key   string `json:"Key"`
+		Value []byte `json:"Value"`
+	}
+	if err := json.Unmarshal(data, &pairs); err != nil {
+		return nil, err
+	}
+	values := make(map[string][]byte, len(pairs))
+	for _, pair := range pairs {
+		values[pair.Key] = pair.Value
+	}
+
+	var objects []object
+	for _, pair := range pairs {
+		// Large states are split into chunks that are read through their manifest.
+		if len(pair.Value) == 0 || strings.Contains(pair.Key, "/tfstate.") {
+			continue
+		}
+		value := pair.Value
+		objects = append(objects, object{
+			name: fmt.Sprintf("consul://%s/%s", host, pair.Key),
+			link: base + pair.Key,
+			read: func(context.Context) ([]byte, error) {
+				return decodeConsulState(value, values)
+			},
+		})
+	}
+	return objects, nil
+}
+
+// decodeConsulState reassembles chunked states and decompresses states
+// written with the backend's gzip option.
+func decodeConsulState(value []byte, values map[string][]byte) ([]byte, error) {
+	var manifest struct {
+		Chunks []string `json:"chunks"`
+	}
+	if json.Unmarshal(value, &manifest) == nil && len(manifest.Chunks) > 0 {
+		var buf bytes.Buffer
+		for _, chunk := range manifest.Chunks {
+			part, ok := values[chunk]
+			if !ok {
+				return nil, fmt.Errorf("missing state chunk %s", chunk)
+			}
+			buf.Write(part)
+		}
+		value = buf.Bytes()
+	}
+	if len(value) > 2 && value[0] == 0x1f && value[1] == 0x8b {
+		zr, err := gzip.NewReader(bytes.NewReader(value))
+		if err != nil {
+			return nil, err
+		}
+		defer zr.Close()
+		return readAll(zr)
+	}
+	return value, nil
+}
+
+func (s *Source) consulAuth(_ context.Context, req *http.Request) error {
+	if s.consulToken != "" {
+		req.Header.Set("X-Consul-Token", s.consulToken)
+	}
+	return nil
+}
+
+func (s *Source) get(ctx context.Context, u string, authFn func(context.Context, *http.Request) error) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := authFn(ctx, req); err != nil {
+		return nil, err
+	}
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d requesting %s", res.StatusCode, req.URL.Host+req.URL.Path)
+	}
+	return readAll(res.Body)
+}
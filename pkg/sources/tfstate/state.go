@@ -0,0 +1,195 @@
+package tfstate
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Entry is a single resource instance or output extracted from a state file.
+type Entry struct {
+	Address string
+	// Sensitive is set when Terraform itself flagged part of the entry as
+	// sensitive, which makes it a high-value place to look for secrets.
+	Sensitive bool
+	Data      []byte
+}
+
+type output struct {
+	Value     json.RawMessage `json:"value"`
+	Sensitive bool            `json:"sensitive"`
+}
+
+// state covers both the v4 format written by Terraform 0.12+ and the
+// module-based v3 format of older releases.
+type state struct {
+	Version   int               `json:"version"`
+	Outputs   map[string]output `json:"outputs"`
+	Resources []struct {
+		Module    string `json:"module"`
+		Mode      string `json:"mode"`
+		Type      string `json:"type"`
+		Name      string `json:"name"`
+		Instances []struct {
+			IndexKey            json.RawMessage            `json:"index_key"`
+			Attributes          json.RawMessage            `json:"attributes"`
+			AttributesFlat      map[string]string          `json:"attributes_flat"`
+			SensitiveAttributes [][]map[string]interface{} `json:"sensitive_attributes"`
+		} `json:"instances"`
+	} `json:"resources"`
+	Modules []struct {
+		Path      []string          `json:"path"`
+		Outputs   map[string]output `json:"outputs"`
+		Resources map[string]struct {
+			Primary struct {
+				Attributes map[string]string `json:"attributes"`
+			} `json:"primary"`
+		} `json:"resources"`
+	} `json:"modules"`
+}
+
+// ParseState splits a state file into one entry per resource instance and
+// output. Attributes are flattened into "path = value" lines so that
+// keyword-based detectors see the attribute name next to its value.
+func ParseState(data []byte) ([]Entry, error) {
+	var st state
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&st); err != nil {
+		return nil, err
+	}
+	if st.Version == 0 {
+		return nil, fmt.Errorf("not a terraform state file")
+	}
+
+	var entries []Entry
+	for _, res := range st.Resources {
+		base := res.Type + "." + res.Name
+		if res.Mode == "data" {
+			base = "data." + base
+		}
+		if res.Module != "" {
+			base = res.Module + "." + base
+		}
+		for _, inst := range res.Instances {
+			address := base + indexSuffix(inst.IndexKey)
+
+			attrs := make(map[string]string)
+			if len(inst.Attributes) > 0 {
+				var v interface{}
+				dec := json.NewDecoder(bytes.NewReader(inst.Attributes))
+				dec.UseNumber()
+				if err := dec.Decode(&v); err != nil {
+					return nil, fmt.Errorf("invalid attributes for %s: %w", address, err)
+				}
+				flatten("", v, attrs)
+			}
+			for k, v := range inst.AttributesFlat {
+				attrs[k] = v
+			}
+			entries = append(entries, Entry{
+				Address:   address,
+				Sensitive: len(inst.SensitiveAttributes) > 0,
+				Data:      render(address, attrs),
+			})
+		}
+	}
+	for _, mod := range st.Modules {
+		prefix := modulePrefix(mod.Path)
+		for name, res := range mod.Resources {
+			address := prefix + name
+			entries = append(entries, Entry{Address: address, Data: render(address, res.Primary.Attributes)})
+		}
+		for name, out := range mod.Outputs {
+			entries = append(entries, outputEntry(prefix+"output."+name, out))
+		}
+	}
+	for name, out := range st.Outputs {
+		entries = append(entries, outputEntry("output."+name, out))
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].Address < entries[j].Address })
+	return entries, nil
+}
+
+func outputEntry(address string, out output) Entry {
+	attrs := make(map[string]string)
+	var v interface{}
+	dec := json.NewDecoder(bytes.NewReader(out.Value))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err == nil {
+		flatten("value", v, attrs)
+	}
+	return Entry{Address: address, Sensitive: out.Sensitive, Data: render(address, attrs)}
+}
+
+// modulePrefix converts a v3 module path such as ["root", "db"] to the
+// address prefix "module.db.".
+func modulePrefix(path []string) string {
+	var b strings.Builder
+	for _, p := range path {
+		if p == "root" {
+			continue
+		}
+		b.WriteString("module." + p + ".")
+	}
+	return b.String()
+}
+
+// indexSuffix renders the count or for_each key of a resource instance.
+func indexSuffix(key json.RawMessage) string {
+	if len(key) == 0 {
+		return ""
+	}
+	var s string
+	if err := json.Unmarshal(key, &s); err == nil {
+		return "[" + strconv.Quote(s) + "]"
+	}
+	return "[" + string(key) + "]"
+}
+
+// flatten walks a decoded JSON value and records every scalar leaf under its
+// dotted attribute path.
+func flatten(prefix string, v interface{}, out map[string]string) {
+	join := func(k string) string {
+		if prefix == "" {
+			return k
+		}
+		return prefix + "." + k
+	}
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, child := range v {
+			flatten(join(k), child, out)
+		}
+	case []interface{}:
+		for i, child := range v {
+			flatten(join(strconv.Itoa(i)), child, out)
+		}
+	case nil:
+	case string:
+		if v != "" {
+			out[prefix] = v
+		}
+	default:
+		out[prefix] = fmt.Sprint(v)
+	}
+}
+
+func render(address string, attrs map[string]string) []byte {
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "# %s\n", address)
+	for _, k := range keys {
+		fmt.Fprintf(&buf, "%s = %s\n", k, attrs[k])
+	}
+	return buf.Bytes()
+}
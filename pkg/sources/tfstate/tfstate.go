@@ -0,0 +1,182 @@
+package tfstate
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/go-errors/errors"
+	"github.com/go-logr/logr"
+	"golang.org/x/oauth2"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const (
+	defaultGCSEndpoint   = "https://storage.googleapis.com/"
+	defaultAzureEndpoint = "https://%s.blob.core.windows.net/"
+	// maxStateSize is the largest state file that will be read.
+	maxStateSize = 256 * 1024 * 1024 // 256MB
+)
+
+type Source struct {
+	name      string
+	sourceId  int64
+	jobId     int64
+	verify    bool
+	locations []string
+	// cloudEnvironment uses the ambient cloud credentials for remote backends,
+	// the same way Terraform does.
+	cloudEnvironment bool
+	consulToken      string
+	azureSASToken    string
+	gcsEndpoint      string
+	azureEndpoint    string
+	client           *http.Client
+	jobPool          *errgroup.Group
+	log              logr.Logger
+	sources.Progress
+
+	authMu          sync.Mutex
+	googleTokens    oauth2.TokenSource
+	azureAuthorizer autorest.Authorizer
+}
+
+// Ensure the Source satisfies the interface at compile time.
+var _ sources.Source = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return sourcespb.SourceType_SOURCE_TYPE_TERRAFORM_STATE
+}
+
+func (s *Source) SourceID() int64 {
+	return s.sourceId
+}
+
+func (s *Source) JobID() int64 {
+	return s.jobId
+}
+
+// Init returns an initialized Terraform state source.
+func (s *Source) Init(aCtx context.Context, name string, jobId, sourceId int64, verify bool, connection *anypb.Any, concurrency int) error {
+	s.log = context.WithValues(aCtx, "source", s.Type(), "name", name).Logger()
+
+	s.name = name
+	s.sourceId = sourceId
+	s.jobId = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	var conn sourcespb.TerraformState
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return errors.WrapPrefix(err, "error unmarshalling connection", 0)
+	}
+
+	switch conn.GetCredential().(type) {
+	case *sourcespb.TerraformState_Unauthenticated:
+	case *sourcespb.TerraformState_CloudEnvironment:
+		s.cloudEnvironment = true
+	default:
+		return errors.Errorf("invalid configuration given for %s source", name)
+	}
+
+	s.locations = conn.GetLocations()
+	if len(s.locations) == 0 {
+		return errors.New("at least one state location is required")
+	}
+	s.consulToken = conn.GetConsulToken()
+	s.azureSASToken = conn.GetAzureSasToken()
+	s.gcsEndpoint = defaultGCSEndpoint
+	s.azureEndpoint = defaultAzureEndpoint
+	s.client = common.RetryableHttpClientTimeout(300)
+
+	return nil
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) error {
+	var scanned uint64
+	for i, location := range s.locations {
+		if common.IsDone(ctx) {
+			break
+		}
+		s.SetProgressComplete(i, len(s.locations), fmt.Sprintf("Location: %s", location), "")
+
+		objects, err := s.list(ctx, location)
+		if err != nil {
+			s.log.Error(err, "could not list state files", "location", location)
+			continue
+		}
+		for _, obj := range objects {
+			obj := obj
+			s.jobPool.Go(func() error {
+				if err := s.scanObject(ctx, obj, chunksChan); err != nil {
+					s.log.Error(err, "could not scan state file", "file", obj.name)
+					return nil
+				}
+				atomic.AddUint64(&scanned, 1)
+				return nil
+			})
+		}
+	}
+	_ = s.jobPool.Wait()
+
+	s.SetProgressComplete(len(s.locations), len(s.locations), fmt.Sprintf("Completed scanning source %s. %d state files scanned.", s.name, scanned), "")
+	return nil
+}
+
+func (s *Source) scanObject(ctx context.Context, obj object, chunksChan chan *sources.Chunk) error {
+	data, err := obj.read(ctx)
+	if err != nil {
+		return err
+	}
+
+	entries, err := ParseState(data)
+	if err != nil {
+		// Scan files that can't be parsed, such as partially written or
+		// encrypted states, as plain text.
+		s.log.V(2).Info("could not parse state file, scanning it as text", "file", obj.name, "error", err.Error())
+		entries = []Entry{{Data: data}}
+	}
+
+	for _, e := range entries {
+		chunk := &sources.Chunk{
+			SourceType: s.Type(),
+			SourceName: s.name,
+			SourceID:   s.SourceID(),
+			Data:       e.Data,
+			SourceMetadata: &source_metadatapb.MetaData{
+				Data: &source_metadatapb.MetaData_TerraformState{
+					TerraformState: &source_metadatapb.TerraformState{
+						File:      sanitizer.UTF8(obj.name),
+						Address:   sanitizer.UTF8(e.Address),
+						Sensitive: e.Sensitive,
+						Link:      sanitizer.UTF8(obj.link),
+					},
+				},
+			},
+			Verify: s.verify,
+		}
+		for c := range sources.Chunker(chunk) {
+			select {
+			case chunksChan <- c:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+	return nil
+}
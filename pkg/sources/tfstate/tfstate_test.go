@@ -0,0 +1,202 @@
+package tfstate
+
+import (
+	"bytes"
+	"compress/gzip"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/sourcestest"
+)
+
+const testState = `{
+  "version": 4,
+  "terraform_version": "1.3.6",
+  "outputs": {
+    "db_password": {"value": "hunter2", "type": "string", "sensitive": true}
+  },
+  "resources": [
+    {
+      "module": "module.db",
+      "mode": "managed",
+      "type": "aws_db_instance",
+      "name": "main",
+      "instances": [
+        {
+          "index_key": 0,
+          "attributes": {"username": "admin", "password": "hunter2", "tags": {"env": "prod"}, "port": 5432, "kms_key_id": null},
+          "sensitive_attributes": [[{"type": "get_attr", "value": "password"}]]
+        }
+      ]
+    },
+    {
+      "mode": "data",
+      "type": "aws_caller_identity",
+      "name": "current",
+      "instances": [
+        {"index_key": "a", "attributes": {"account_id": "123456789012"}, "sensitive_attributes": []}
+      ]
+    }
+  ]
+}`
+
+func collect(t *testing.T, s *Source) map[string]*sources.Chunk {
+	t.Helper()
+	chunksCh := make(chan *sources.Chunk, 10)
+	go func() {
+		defer close(chunksCh)
+		assert.NoError(t, s.Chunks(context.Background(), chunksCh))
+	}()
+	chunks := make(map[string]*sources.Chunk)
+	for chunk := range chunksCh {
+		meta := chunk.SourceMetadata.GetTerraformState()
+		chunks[meta.File+" "+meta.Address] = chunk
+	}
+	return chunks
+}
+
+func TestParseState(t *testing.T) {
+	entries, err := ParseState([]byte(testState))
+	assert.NoError(t, err)
+	assert.Equal(t, []Entry{
+		{
+			Address: "data.aws_caller_identity.current[\"a\"]",
+			Data:    []byte("# data.aws_caller_identity.current[\"a\"]\naccount_id = 123456789012\n"),
+		},
+		{
+			Address:   "module.db.aws_db_instance.main[0]",
+			Sensitive: true,
+			Data:      []byte("# module.db.aws_db_instance.main[0]\npassword = hunter2\nport = 5432\ntags.env = prod\nusername = admin\n"),
+		},
+		{
+			Address:   "output.db_password",
+			Sensitive: true,
+			Data:      []byte("# output.db_password\nvalue = hunter2\n"),
+		},
+	}, entries)
+}
+
+func TestParseState_V3(t *testing.T) {
+	entries, err := ParseState([]byte(`{
+  "version": 3,
+  "modules": [
+    {"path": ["root", "app"], "outputs": {}, "resources": {"aws_iam_access_key.ci": {"primary": {"attributes": {"secret": "abc123"}}}}}
+  ]
+}`))
+	assert.NoError(t, err)
+	assert.Equal(t, []Entry{{
+		Address: "module.app.aws_iam_access_key.ci",
+		Data:    []byte("# module.app.aws_iam_access_key.ci\nsecret = abc123\n"),
+	}}, entries)
+
+	_, err = ParseState([]byte(`{"foo": "bar"}`))
+	assert.Error(t, err)
+}
+
+func TestSource_Chunks_Local(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "terraform.tfstate.d", "prod"), 0o755))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "terraform.tfstate.d", "prod", "terraform.tfstate"), []byte(testState), 0o644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "terraform.tfstate.backup"), []byte("password = hunter3"), 0o644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "main.tf"), []byte("password = ignored"), 0o644))
+
+	s := &Source{}
+	sourcestest.Init(t, s, &sourcespb.TerraformState{
+		Credential: &sourcespb.TerraformState_Unauthenticated{Unauthenticated: &credentialspb.Unauthenticated{}},
+		Locations:  []string{dir},
+	}, func() *http.Client { return s.client })
+	chunks := collect(t, s)
+
+	assert.Len(t, chunks, 4)
+	db := chunks[filepath.Join(dir, "terraform.tfstate.d", "prod", "terraform.tfstate")+" module.db.aws_db_instance.main[0]"]
+	if assert.NotNil(t, db) {
+		assert.True(t, db.SourceMetadata.GetTerraformState().Sensitive)
+		assert.Contains(t, string(db.Data), "password = hunter2")
+	}
+	backup := chunks[filepath.Join(dir, "terraform.tfstate.backup")+" "]
+	if assert.NotNil(t, backup) {
+		assert.Equal(t, "password = hunter3", string(backup.Data))
+	}
+}
+
+func TestSource_Chunks_Consul(t *testing.T) {
+	defer gock.Off()
+
+	var compressed bytes.Buffer
+	zw := gzip.NewWriter(&compressed)
+	_, err := zw.Write([]byte(testState))
+	assert.NoError(t, err)
+	assert.NoError(t, zw.Close())
+	half := compressed.Len() / 2
+
+	gock.New("http://consul.example.com:8500").
+		Get("/v1/kv/terraform/app").
+		MatchParam("recurse", "true").
+		MatchHeader("X-Consul-Token", "consul-token").
+		Reply(200).
+		JSON([]map[string]interface{}{
+			{"Key": "terraform/app", "Value": []byte(`{"current-hash":"abc","chunks":["terraform/app/tfstate.abc/0","terraform/app/tfstate.abc/1"]}`)},
+			{"Key": "terraform/app/tfstate.abc/0", "Value": compressed.Bytes()[:half]},
+			{"Key": "terraform/app/tfstate.abc/1", "Value": compressed.Bytes()[half:]},
+			{"Key": "terraform/app-env:staging", "Value": []byte(`{"version": 4, "outputs": {"token": {"value": "s3cr3t", "sensitive": false}}}`)},
+		})
+
+	s := &Source{}
+	sourcestest.Init(t, s, &sourcespb.TerraformState{
+		Credential:  &sourcespb.TerraformState_Unauthenticated{Unauthenticated: &credentialspb.Unauthenticated{}},
+		Locations:   []string{"consul://consul.example.com:8500/terraform/app"},
+		ConsulToken: "consul-token",
+	}, func() *http.Client { return s.client })
+	chunks := collect(t, s)
+
+	assert.Len(t, chunks, 4)
+	out := chunks["consul://consul.example.com:8500/terraform/app output.db_password"]
+	if assert.NotNil(t, out) {
+		assert.Equal(t, "# output.db_password\nvalue = hunter2\n", string(out.Data))
+	}
+	staging := chunks["consul://consul.example.com:8500/terraform/app-env:staging output.token"]
+	if assert.NotNil(t, staging) {
+		assert.False(t, staging.SourceMetadata.GetTerraformState().Sensitive)
+		assert.True(t, strings.HasSuffix(string(staging.Data), "value = s3cr3t\n"))
+	}
+	assert.True(t, gock.IsDone())
+}
+
+func TestSource_Chunks_GCS(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://storage.googleapis.com").
+		Get("/storage/v1/b/tf-state/o$").
+		MatchParam("prefix", "envs/").
+		Reply(200).
+		JSON(map[string]interface{}{"items": []map[string]string{
+			{"name": "envs/default.tfstate"},
+			{"name": "envs/default.tflock"},
+		}})
+	gock.New("https://storage.googleapis.com").
+		Get("/storage/v1/b/tf-state/o/envs/default.tfstate").
+		MatchParam("alt", "media").
+		Reply(200).
+		BodyString(testState)
+
+	s := &Source{}
+	sourcestest.Init(t, s, &sourcespb.TerraformState{
+		Credential: &sourcespb.TerraformState_Unauthenticated{Unauthenticated: &credentialspb.Unauthenticated{}},
+		Locations:  []string{"gs://tf-state/envs/"},
+	}, func() *http.Client { return s.client })
+	chunks := collect(t, s)
+
+	assert.Len(t, chunks, 3)
+	assert.NotNil(t, chunks["gs://tf-state/envs/default.tfstate output.db_password"])
+	assert.True(t, gock.IsDone())
+}
@@ -0,0 +1,103 @@
+package website
+
+import (
+	"bufio"
+	"regexp"
+	"strings"
+)
+
+// robots holds the rules of a robots.txt file that apply to the crawler.
+type robots struct {
+	rules []rule
+}
+
+type rule struct {
+	allow bool
+	// length is the length of the path pattern, used to find the most
+	// specific rule.
+	length  int
+	pattern *regexp.Regexp
+}
+
+// parseRobots returns the rules of the group matching agent, or of the "*"
+// group when no group names it.
+func parseRobots(body, agent string) *robots {
+	agent = strings.ToLower(agent)
+
+	var named, wildcard []rule
+	var groupAgents []string
+	inRules := false
+	scanner := bufio.NewScanner(strings.NewReader(body))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+
+		switch key {
+		case "user-agent":
+			// A user-agent line after rules starts a new group.
+			if inRules {
+				groupAgents = nil
+				inRules = false
+			}
+			groupAgents = append(groupAgents, strings.ToLower(value))
+		case "allow", "disallow":
+			inRules = true
+			// An empty disallow allows everything.
+			if value == "" {
+				continue
+			}
+			r := rule{allow: key == "allow", length: len(value), pattern: compilePattern(value)}
+			for _, a := range groupAgents {
+				switch {
+				case a == "*":
+					wildcard = append(wildcard, r)
+				case strings.Contains(agent, a):
+					named = append(named, r)
+				}
+			}
+		}
+	}
+
+	if named != nil {
+		return &robots{rules: named}
+	}
+	return &robots{rules: wildcard}
+}
+
+// compilePattern turns a robots.txt path pattern, which can hold "*"
+// wildcards and end with "$", into a regular expression.
+func compilePattern(pattern string) *regexp.Regexp {
+	anchored := strings.HasSuffix(pattern, "$")
+	pattern = strings.TrimSuffix(pattern, "$")
+	expr := "^" + strings.ReplaceAll(regexp.QuoteMeta(pattern), `\*`, ".*")
+	if anchored {
+		expr += "$"
+	}
+	return regexp.MustCompile(expr)
+}
+
+// allowed reports whether path can be crawled. The most specific matching
+// rule wins, and allow rules win ties.
+func (r *robots) allowed(path string) bool {
+	if r == nil {
+		return true
+	}
+	allow, length := true, -1
+	for _, rl := range r.rules {
+		if !rl.pattern.MatchString(path) {
+			continue
+		}
+		if rl.length > length || (rl.length == length && rl.allow) {
+			allow, length = rl.allow, rl.length
+		}
+	}
+	return allow
+}
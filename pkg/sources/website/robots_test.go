@@ -0,0 +1,34 @@
+package website
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRobots_Allowed(t *testing.T) {
+	body := `# Rules for everyone
+User-agent: *
+Disallow: /admin
+Allow: /admin/public
+Disallow: /*.pdf$
+
+User-agent: BadBot
+User-agent: TruffleHog
+Disallow: /drafts
+`
+	r := parseRobots(body, "TruffleHog")
+	assert.False(t, r.allowed("/drafts/post"))
+	// Only the most specific group applies.
+	assert.True(t, r.allowed("/admin"))
+
+	r = parseRobots(body, "SomeCrawler")
+	assert.False(t, r.allowed("/admin/users"))
+	assert.True(t, r.allowed("/admin/public/index.html"))
+	assert.False(t, r.allowed("/files/report.pdf"))
+	assert.True(t, r.allowed("/files/report.pdf.html"))
+	assert.True(t, r.allowed("/drafts/post"))
+
+	var missing *robots
+	assert.True(t, missing.allowed("/anything"))
+}
@@ -0,0 +1,429 @@
+package website
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/go-errors/errors"
+	"github.com/go-logr/logr"
+	"golang.org/x/net/html"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const (
+	// userAgent is the name the crawler looks for in robots.txt, matching the
+	// User-Agent header set by the HTTP client.
+	userAgent = "TruffleHog"
+	// defaultMaxSize is the size of the largest response that will be
+	// scanned.
+	defaultMaxSize = 25 * 1024 * 1024 // 25MB
+)
+
+// sourceMappingURL finds the source map comment of a JavaScript file.
+var sourceMappingURL = regexp.MustCompile(`(?m)^//[#@] sourceMappingURL=(\S+)\s*$`)
+
+type Source struct {
+	name         string
+	sourceId     int64
+	jobId        int64
+	verify       bool
+	seeds        []*url.URL
+	maxDepth     int
+	hosts        map[string]bool
+	ignoreRobots bool
+	maxPages     int64
+	maxSize      int64
+	client       *http.Client
+
+	mu      sync.Mutex
+	visited map[string]bool
+	robots  map[string]*robots
+	pages   int64
+
+	jobPool *errgroup.Group
+	log     logr.Logger
+	sources.Progress
+}
+
+// target is a URL to fetch. Assets are the scripts and source maps of pages,
+// which are fetched whatever their depth and don't count as pages.
+type target struct {
+	url       *url.URL
+	referrer  string
+	depth     int
+	asset     bool
+	sourceMap bool
+}
+
+// Ensure the Source satisfies the interface at compile time.
+var _ sources.Source = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return sourcespb.SourceType_SOURCE_TYPE_WEBSITE
+}
+
+func (s *Source) SourceID() int64 {
+	return s.sourceId
+}
+
+func (s *Source) JobID() int64 {
+	return s.jobId
+}
+
+// Init returns an initialized website source.
+func (s *Source) Init(aCtx context.Context, name string, jobId, sourceId int64, verify bool, connection *anypb.Any, concurrency int) error {
+	s.log = context.WithValues(aCtx, "source", s.Type(), "name", name).Logger()
+
+	s.name = name
+	s.sourceId = sourceId
+	s.jobId = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	var conn sourcespb.Website
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return errors.WrapPrefix(err, "error unmarshalling connection", 0)
+	}
+
+	if len(conn.GetSeeds()) == 0 {
+		return errors.New("at least one seed URL is required")
+	}
+	s.hosts = make(map[string]bool)
+	for _, seed := range conn.GetSeeds() {
+		u, err := url.Parse(seed)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			return errors.Errorf("invalid seed URL %q", seed)
+		}
+		s.seeds = append(s.seeds, u)
+		if len(conn.GetHosts()) == 0 {
+			s.hosts[strings.ToLower(u.Host)] = true
+		}
+	}
+	for _, host := range conn.GetHosts() {
+		s.hosts[strings.ToLower(host)] = true
+	}
+
+	s.maxDepth = int(conn.GetMaxDepth())
+	s.ignoreRobots = conn.GetIgnoreRobots()
+	s.maxPages = conn.GetMaxPages()
+	s.maxSize = conn.GetMaxSize()
+	if s.maxSize <= 0 {
+		s.maxSize = defaultMaxSize
+	}
+	s.visited = make(map[string]bool)
+	s.robots = make(map[string]*robots)
+	s.client = common.RetryableHttpClientTimeout(30)
+
+	return nil
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) error {
+	var level []target
+	for _, seed := range s.seeds {
+		if s.visit(seed) {
+			level = append(level, target{url: seed})
+		}
+	}
+
+	var scanned uint64
+	// The site is crawled breadth first, one depth at a time.
+	for depth := 0; len(level) > 0; depth++ {
+		if common.IsDone(ctx) {
+			break
+		}
+		s.SetProgressComplete(depth, s.maxDepth+1, fmt.Sprintf("Depth: %d, URLs: %d", depth, len(level)), "")
+
+		var next []target
+		var nextMu sync.Mutex
+		for _, t := range level {
+			if common.IsDone(ctx) {
+				break
+			}
+			if !t.asset && !s.reservePage() {
+				continue
+			}
+
+			t := t
+			s.jobPool.Go(func() error {
+				found, err := s.scanTarget(ctx, t, chunksChan)
+				if err != nil {
+					s.log.V(2).Info("could not scan URL", "url", t.url.String(), "error", err)
+					return nil
+				}
+				atomic.AddUint64(&scanned, 1)
+				nextMu.Lock()
+				next = append(next, found...)
+				nextMu.Unlock()
+				return nil
+			})
+		}
+		_ = s.jobPool.Wait()
+		level = next
+	}
+
+	s.SetProgressComplete(s.maxDepth+1, s.maxDepth+1, fmt.Sprintf("Completed scanning source %s. %d URLs scanned.", s.name, scanned), "")
+	return nil
+}
+
+// reservePage reports whether another page can be crawled without going over
+// the page limit.
+func (s *Source) reservePage() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.maxPages > 0 && s.pages >= s.maxPages {
+		return false
+	}
+	s.pages++
+	return true
+}
+
+// visit reports whether u is in scope and hasn't been visited yet, and marks
+// it as visited.
+func (s *Source) visit(u *url.URL) bool {
+	if (u.Scheme != "http" && u.Scheme != "https") || !s.hosts[strings.ToLower(u.Host)] {
+		return false
+	}
+	key := *u
+	key.Fragment = ""
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.visited[key.String()] {
+		return false
+	}
+	s.visited[key.String()] = true
+	return true
+}
+
+// scanTarget fetches and scans a URL, and returns the URLs it links to that
+// should be crawled next.
+func (s *Source) scanTarget(ctx context.Context, t target, chunksChan chan *sources.Chunk) ([]target, error) {
+	if !s.ignoreRobots && !s.robotsFor(ctx, t.url).allowed(t.url.EscapedPath()) {
+		s.log.V(3).Info("skipping URL disallowed by robots.txt", "url", t.url.String())
+		return nil, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, t.url.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", res.StatusCode)
+	}
+	// Redirects can leave the crawled hosts.
+	final := res.Request.URL
+	if !s.hosts[strings.ToLower(final.Host)] {
+		return nil, fmt.Errorf("redirected out of scope to %s", final.Host)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, s.maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(body)) > s.maxSize {
+		return nil, fmt.Errorf("response is larger than %d bytes", s.maxSize)
+	}
+
+	if t.sourceMap {
+		return nil, s.scanSourceMap(ctx, t, body, chunksChan)
+	}
+	if err := s.chunk(ctx, t, "", body, chunksChan); err != nil {
+		return nil, err
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(res.Header.Get("Content-Type"))
+	switch {
+	case mediaType == "text/html" || mediaType == "application/xhtml+xml":
+		return s.links(t, final, body), nil
+	case strings.Contains(mediaType, "javascript") || path.Ext(final.Path) == ".js" || path.Ext(final.Path) == ".mjs":
+		return s.sourceMap(t, final, res.Header, body), nil
+	}
+	return nil, nil
+}
+
+// robotsFor returns the robots.txt rules of the host of u, fetching them on
+// first use. Hosts without a readable robots.txt can be crawled.
+func (s *Source) robotsFor(ctx context.Context, u *url.URL) *robots {
+	origin := u.Scheme + "://" + u.Host
+	s.mu.Lock()
+	r, ok := s.robots[origin]
+	s.mu.Unlock()
+	if ok {
+		return r
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, origin+"/robots.txt", nil)
+	if err == nil {
+		var res *http.Response
+		if res, err = s.client.Do(req); err == nil {
+			if res.StatusCode == http.StatusOK {
+				body, _ := io.ReadAll(io.LimitReader(res.Body, 512*1024))
+				r = parseRobots(string(body), userAgent)
+			}
+			res.Body.Close()
+		}
+	}
+
+	s.mu.Lock()
+	s.robots[origin] = r
+	s.mu.Unlock()
+	return r
+}
+
+// links returns the pages and scripts an HTML document links to.
+func (s *Source) links(t target, base *url.URL, body []byte) []target {
+	var found []target
+	add := func(ref string, asset bool) {
+		if ref == "" {
+			return
+		}
+		u, err := base.Parse(strings.TrimSpace(ref))
+		if err != nil {
+			return
+		}
+		u.Fragment = ""
+		if !asset && t.depth >= s.maxDepth {
+			return
+		}
+		if !s.visit(u) {
+			return
+		}
+		found = append(found, target{url: u, referrer: t.url.String(), depth: t.depth + 1, asset: asset})
+	}
+
+	tokenizer := html.NewTokenizer(bytes.NewReader(body))
+	for {
+		tt := tokenizer.Next()
+		if tt == html.ErrorToken {
+			return found
+		}
+		if tt != html.StartTagToken && tt != html.SelfClosingTagToken {
+			continue
+		}
+		token := tokenizer.Token()
+		attrs := make(map[string]string, len(token.Attr))
+		for _, attr := range token.Attr {
+			attrs[attr.Key] = attr.Val
+		}
+		switch token.Data {
+		case "base":
+			if u, err := base.Parse(attrs["href"]); err == nil && attrs["href"] != "" {
+				base = u
+			}
+		case "a", "area":
+			add(attrs["href"], false)
+		case "iframe", "frame":
+			add(attrs["src"], false)
+		case "script":
+			add(attrs["src"], true)
+		case "link":
+			rel := strings.ToLower(attrs["rel"])
+			if strings.Contains(rel, "modulepreload") || strings.Contains(rel, "manifest") ||
+				(strings.Contains(rel, "preload") && attrs["as"] == "script") {
+				add(attrs["href"], true)
+			}
+		}
+	}
+}
+
+// sourceMap returns the source map of a script, set by a header or by a
+// comment at the end of the script.
+func (s *Source) sourceMap(t target, base *url.URL, header http.Header, body []byte) []target {
+	ref := header.Get("SourceMap")
+	if ref == "" {
+		ref = header.Get("X-SourceMap")
+	}
+	if ref == "" {
+		if m := sourceMappingURL.FindSubmatch(body); m != nil {
+			ref = string(m[1])
+		}
+	}
+	// Inline source maps are part of the script that was scanned.
+	if ref == "" || strings.HasPrefix(ref, "data:") {
+		return nil
+	}
+	u, err := base.Parse(ref)
+	if err != nil || !s.visit(u) {
+		return nil
+	}
+	return []target{{url: u, referrer: t.url.String(), depth: t.depth, asset: true, sourceMap: true}}
+}
+
+// scanSourceMap scans the original source files embedded in a source map,
+// or the whole map when they can't be read.
+func (s *Source) scanSourceMap(ctx context.Context, t target, body []byte, chunksChan chan *sources.Chunk) error {
+	var sm struct {
+		Sources        []string  `json:"sources"`
+		SourcesContent []*string `json:"sourcesContent"`
+	}
+	if err := json.Unmarshal(body, &sm); err != nil || len(sm.SourcesContent) == 0 {
+		return s.chunk(ctx, t, "", body, chunksChan)
+	}
+	for i, content := range sm.SourcesContent {
+		if content == nil {
+			continue
+		}
+		file := ""
+		if i < len(sm.Sources) {
+			file = sm.Sources[i]
+		}
+		if err := s.chunk(ctx, t, file, []byte(*content), chunksChan); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Source) chunk(ctx context.Context, t target, file string, data []byte, chunksChan chan *sources.Chunk) error {
+	chunk := &sources.Chunk{
+		SourceType: s.Type(),
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		Data:       data,
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Website{
+				Website: &source_metadatapb.Website{
+					Url:      sanitizer.UTF8(t.url.String()),
+					Referrer: sanitizer.UTF8(t.referrer),
+					File:     sanitizer.UTF8(file),
+				},
+			},
+		},
+		Verify: s.verify,
+	}
+	for c := range sources.Chunker(chunk) {
+		select {
+		case chunksChan <- c:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
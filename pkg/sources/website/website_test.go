@@ -0,0 +1,101 @@
+package website
+
+import (
+	"net/http"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/sourcestest"
+)
+
+func TestSource_Chunks(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://example.com").
+		Get("/robots.txt").
+		Reply(200).
+		BodyString("User-agent: *\nDisallow: /private\n")
+	gock.New("https://example.com").
+		Get("/$").
+		Reply(200).
+		SetHeader("Content-Type", "text/html; charset=utf-8").
+		BodyString(`<html><head><script src="/static/app.js"></script></head><body>
+<a href="/about#team">About</a>
+<a href="/private/admin">Admin</a>
+<a href="https://other.example.org/">Elsewhere</a>
+</body></html>`)
+	gock.New("https://example.com").
+		Get("/static/app.js").
+		Reply(200).
+		SetHeader("Content-Type", "application/javascript").
+		BodyString("const key = 'abc';\n//# sourceMappingURL=app.js.map\n")
+	gock.New("https://example.com").
+		Get("/static/app.js.map").
+		Reply(200).
+		JSON(map[string]any{
+			"version":        3,
+			"sources":        []string{"src/config.ts", "src/empty.ts"},
+			"sourcesContent": []any{"export const apiKey = 'xyz';", nil},
+		})
+	gock.New("https://example.com").
+		Get("/about").
+		Reply(200).
+		SetHeader("Content-Type", "text/html").
+		BodyString(`<a href="/team">Team</a>`)
+
+	s := &Source{}
+	sourcestest.Init(t, s, &sourcespb.Website{
+		Seeds:    []string{"https://example.com/"},
+		MaxDepth: 1,
+	}, func() *http.Client { return s.client })
+	chunksCh := make(chan *sources.Chunk, 10)
+	assert.NoError(t, s.Chunks(context.Background(), chunksCh))
+	close(chunksCh)
+
+	var got []string
+	for c := range chunksCh {
+		meta := c.SourceMetadata.GetWebsite()
+		got = append(got, meta.GetUrl()+" "+meta.GetFile())
+		if meta.GetFile() == "src/config.ts" {
+			assert.Equal(t, "export const apiKey = 'xyz';", string(c.Data))
+			assert.Equal(t, "https://example.com/static/app.js", meta.GetReferrer())
+		}
+	}
+	sort.Strings(got)
+	assert.Equal(t, []string{
+		"https://example.com/ ",
+		"https://example.com/about ",
+		"https://example.com/static/app.js ",
+		"https://example.com/static/app.js.map src/config.ts",
+	}, got)
+	assert.True(t, gock.IsDone())
+}
+
+func TestSource_MaxPages(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://example.com").
+		Get("/$").
+		Reply(200).
+		SetHeader("Content-Type", "text/html").
+		BodyString(`<a href="/a">A</a><a href="/b">B</a>`)
+
+	s := &Source{}
+	sourcestest.Init(t, s, &sourcespb.Website{
+		Seeds:        []string{"https://example.com/"},
+		MaxDepth:     5,
+		MaxPages:     1,
+		IgnoreRobots: true,
+	}, func() *http.Client { return s.client })
+	chunksCh := make(chan *sources.Chunk, 10)
+	assert.NoError(t, s.Chunks(context.Background(), chunksCh))
+	close(chunksCh)
+	assert.Len(t, chunksCh, 1)
+	assert.True(t, gock.IsDone())
+}